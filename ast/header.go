@@ -83,10 +83,12 @@ func (i *CppInclude) Info() HeaderInfo {
 // generated code in certain languages.
 //
 // 	namespace py foo.bar
+// 	namespace go foo.bar (go.package = "example.com/foo/bar")
 type Namespace struct {
-	Scope string
-	Name  string
-	Line  int
+	Scope       string
+	Name        string
+	Annotations []*Annotation
+	Line        int
 }
 
 func (*Namespace) node()   {}
@@ -94,7 +96,11 @@ func (*Namespace) header() {}
 
 func (n *Namespace) lineNumber() int { return n.Line }
 
-func (*Namespace) visitChildren(nodeStack, visitor) {}
+func (n *Namespace) visitChildren(ss nodeStack, v visitor) {
+	for _, ann := range n.Annotations {
+		v.visit(ss, ann)
+	}
+}
 
 // Info for Namespace.
 func (n *Namespace) Info() HeaderInfo {
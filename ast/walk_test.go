@@ -624,6 +624,23 @@ func TestWalk(t *testing.T) {
 				{node: &ast.Namespace{Scope: "go", Name: "foo"}},
 			},
 		},
+		func() (tt test) {
+			tt.desc = "namespace with annotations"
+
+			ann := &ast.Annotation{Name: "go.package", Value: "example.com/foo"}
+			ns := &ast.Namespace{
+				Scope:       "go",
+				Name:        "foo",
+				Annotations: []*ast.Annotation{ann},
+			}
+
+			tt.node = ns
+			tt.visits = []visit{
+				{node: ns},
+				{node: ann, parent: ns, ancestors: []ast.Node{ns}},
+			}
+			return
+		}(),
 		{
 			desc: "empty program",
 			node: &ast.Program{},
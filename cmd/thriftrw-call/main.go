@@ -0,0 +1,206 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net"
+	"os"
+	"strings"
+	"time"
+
+	"go.uber.org/thriftrw/compile"
+	"go.uber.org/thriftrw/dynamic"
+	"go.uber.org/thriftrw/envelope"
+	"go.uber.org/thriftrw/internal/schema"
+	"go.uber.org/thriftrw/internal/transport"
+	"go.uber.org/thriftrw/protocol"
+	"go.uber.org/thriftrw/wire"
+
+	"github.com/jessevdk/go-flags"
+)
+
+type options struct {
+	IDL      string        `long:"idl" short:"i" value-name:"FILE" required:"yes" description:"Thrift file defining the service."`
+	Unframed bool          `long:"unframed" description:"Send messages without the 4-byte length prefix of the framed transport."`
+	Timeout  time.Duration `long:"timeout" value-name:"DURATION" default:"10s" description:"Time allowed for the entire call."`
+	SeqID    int32         `long:"seqid" value-name:"ID" default:"1" description:"Sequence ID of the request."`
+	Args     struct {
+		Address string `positional-arg-name:"host:port" required:"yes" description:"Address of the Thrift server."`
+		Method  string `positional-arg-name:"Service::method" required:"yes" description:"Function to call."`
+		Request string `positional-arg-name:"request" description:"JSON object holding the arguments of the function. Defaults to stdin."`
+	} `positional-args:"yes"`
+}
+
+// request is a call to a function, enveloped with envelope.Write.
+type request struct {
+	Function *schema.Function
+	Args     *dynamic.Struct
+}
+
+var _ envelope.Enveloper = request{}
+
+func (r request) MethodName() string {
+	return r.Function.Spec.Name
+}
+
+func (r request) EnvelopeType() wire.EnvelopeType {
+	if r.Function.Spec.OneWay {
+		return wire.OneWay
+	}
+	return wire.Call
+}
+
+func (r request) ToWire() (wire.Value, error) {
+	return r.Args.ToWire()
+}
+
+func run(args []string, stdin io.Reader, stdout io.Writer) error {
+	var opts options
+	if _, err := flags.ParseArgs(&opts, args); err != nil {
+		return fmt.Errorf("error parsing arguments: %v", err)
+	}
+
+	m, err := compile.Compile(opts.IDL)
+	if err != nil {
+		return fmt.Errorf("could not compile %q: %v", opts.IDL, err)
+	}
+
+	if !strings.Contains(opts.Args.Method, "::") {
+		return fmt.Errorf("method %q must be of the form Service::method", opts.Args.Method)
+	}
+	f, err := schema.LookupFunction(m, opts.Args.Method)
+	if err != nil {
+		return err
+	}
+
+	var input io.Reader = strings.NewReader(opts.Args.Request)
+	if opts.Args.Request == "" || opts.Args.Request == "-" {
+		input = stdin
+	}
+	argsMap, err := readRequest(input)
+	if err != nil {
+		return err
+	}
+	reqArgs, err := dynamic.FromMap(f.ArgsStruct(), argsMap)
+	if err != nil {
+		return err
+	}
+
+	var req bytes.Buffer
+	if err := envelope.Write(protocol.Binary, &req, opts.SeqID, request{Function: f, Args: reqArgs}); err != nil {
+		return fmt.Errorf("could not encode request: %v", err)
+	}
+
+	conn, err := net.DialTimeout("tcp", opts.Args.Address, opts.Timeout)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	if err := conn.SetDeadline(time.Now().Add(opts.Timeout)); err != nil {
+		return err
+	}
+
+	if err := transport.NewWriter(conn, !opts.Unframed).Write(req.Bytes()); err != nil {
+		return fmt.Errorf("call to %v failed: %v", f.Name(), err)
+	}
+	if f.Spec.OneWay {
+		return nil
+	}
+
+	res, err := transport.NewReader(conn, !opts.Unframed).Read()
+	if err != nil {
+		return fmt.Errorf("call to %v failed: %v", f.Name(), err)
+	}
+
+	v, seqID, err := envelope.ReadReply(protocol.Binary, bytes.NewReader(res))
+	if err != nil {
+		return fmt.Errorf("call to %v failed: %v", f.Name(), err)
+	}
+	if seqID != opts.SeqID {
+		return fmt.Errorf("call to %v failed: expected a response with seqid %v, got %v", f.Name(), opts.SeqID, seqID)
+	}
+
+	return printResult(stdout, f, v)
+}
+
+// readRequest reads the arguments of a function from a JSON object.
+func readRequest(r io.Reader) (map[string]interface{}, error) {
+	dec := json.NewDecoder(r)
+	dec.UseNumber() // so that large i64 values aren't rounded
+
+	var m map[string]interface{}
+	if err := dec.Decode(&m); err != nil {
+		if err == io.EOF {
+			return map[string]interface{}{}, nil
+		}
+		return nil, fmt.Errorf("could not decode request: %v", err)
+	}
+	return m, nil
+}
+
+// printResult prints the return value of a function as JSON. If the
+// function failed with one of its declared exceptions, the exception is
+// printed instead, keyed by its name, and an error is returned.
+func printResult(w io.Writer, f *schema.Function, v wire.Value) error {
+	result, err := dynamic.FromWire(f.ResultStruct(), v)
+	if err != nil {
+		return fmt.Errorf("could not decode response of %v: %v", f.Name(), err)
+	}
+
+	fields := result.ToMap()
+	if success, ok := fields["success"]; ok {
+		return printJSON(w, success)
+	}
+
+	for _, ex := range f.Spec.ResultSpec.Exceptions {
+		if value, ok := fields[ex.Name]; ok {
+			if err := printJSON(w, map[string]interface{}{ex.Name: value}); err != nil {
+				return err
+			}
+			return fmt.Errorf("%v failed with exception %v", f.Name(), ex.Type.ThriftName())
+		}
+	}
+
+	if f.Spec.ResultSpec.ReturnType != nil {
+		return fmt.Errorf("response of %v has no value", f.Name())
+	}
+	return nil // void
+}
+
+func printJSON(w io.Writer, v interface{}) error {
+	b, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(w, "%s\n", b)
+	return err
+}
+
+func main() {
+	log.SetFlags(0) // so that the error message isn't noisy
+	if err := run(os.Args[1:], os.Stdin, os.Stdout); err != nil {
+		log.Fatal(err)
+	}
+}
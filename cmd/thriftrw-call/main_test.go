@@ -0,0 +1,183 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+package main
+
+import (
+	"bytes"
+	"io/ioutil"
+	"strings"
+	"testing"
+
+	"go.uber.org/thriftrw/internal/envelope/exception"
+	"go.uber.org/thriftrw/internal/kvtest"
+	"go.uber.org/thriftrw/wire"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCall(t *testing.T) {
+	item := wire.NewValueStruct(wire.Struct{Fields: []wire.Field{
+		{ID: 1, Value: wire.NewValueString("foo")},
+		{ID: 3, Value: wire.NewValueI32(1)},
+	}})
+
+	tests := []struct {
+		desc    string
+		args    []string
+		stdin   string
+		handler kvtest.Handler
+		want    string
+		wantErr string
+	}{
+		{
+			desc: "success",
+			args: []string{"KeyValue::getItem", `{"key": "foo"}`},
+			handler: func(req wire.Envelope) *wire.Envelope {
+				assert.Equal(t, "getItem", req.Name)
+				assert.Equal(t, wire.Call, req.Type)
+				assert.Equal(t, "TStruct({1: TBinary([102 111 111])})", req.Value.String())
+				return kvtest.Reply(req, wire.Field{ID: 0, Value: item})
+			},
+			want: "{\n  \"key\": \"foo\",\n  \"status\": \"STALE\"\n}\n",
+		},
+		{
+			desc:  "request from stdin",
+			args:  []string{"KeyValue::getItem"},
+			stdin: `{"key": "foo"}`,
+			handler: func(req wire.Envelope) *wire.Envelope {
+				return kvtest.Reply(req, wire.Field{ID: 0, Value: item})
+			},
+			want: "{\n  \"key\": \"foo\",\n  \"status\": \"STALE\"\n}\n",
+		},
+		{
+			desc: "inherited function",
+			args: []string{"KeyValue::healthy", "--seqid", "42"},
+			handler: func(req wire.Envelope) *wire.Envelope {
+				assert.Equal(t, int32(42), req.SeqID)
+				return kvtest.Reply(req, wire.Field{ID: 0, Value: wire.NewValueBool(true)})
+			},
+			want: "true\n",
+		},
+		{
+			desc: "declared exception",
+			args: []string{"KeyValue::getItem", `{"key": "foo"}`},
+			handler: func(req wire.Envelope) *wire.Envelope {
+				return kvtest.Reply(req, wire.Field{ID: 1, Value: wire.NewValueStruct(wire.Struct{Fields: []wire.Field{
+					{ID: 1, Value: wire.NewValueString("foo")},
+				}})})
+			},
+			want:    "{\n  \"notFound\": {\n    \"key\": \"foo\"\n  }\n}\n",
+			wantErr: "KeyValue::getItem failed with exception NotFound",
+		},
+		{
+			desc: "application exception",
+			args: []string{"KeyValue::getItem", `{"key": "foo"}`},
+			handler: func(req wire.Envelope) *wire.Envelope {
+				message := "great sadness"
+				typ := exception.ExceptionTypeInternalError
+				v, err := (&exception.TApplicationException{Message: &message, Type: &typ}).ToWire()
+				require.NoError(t, err)
+				return &wire.Envelope{Name: req.Name, Type: wire.Exception, SeqID: req.SeqID, Value: v}
+			},
+			wantErr: "great sadness",
+		},
+		{
+			desc: "mismatched seqid",
+			args: []string{"KeyValue::healthy"},
+			handler: func(req wire.Envelope) *wire.Envelope {
+				res := kvtest.Reply(req, wire.Field{ID: 0, Value: wire.NewValueBool(true)})
+				res.SeqID = 2
+				return res
+			},
+			wantErr: "expected a response with seqid 1, got 2",
+		},
+		{
+			desc: "oneway",
+			args: []string{"KeyValue::touch", `{"key": "foo"}`},
+			handler: func(req wire.Envelope) *wire.Envelope {
+				assert.Equal(t, wire.OneWay, req.Type)
+				return nil
+			},
+		},
+		{
+			desc:    "invalid request",
+			args:    []string{"KeyValue::getItem", `{"key": 1}`},
+			wantErr: "KeyValue_getItem_Args.key: expected a value of type string",
+		},
+		{
+			desc:    "unknown function",
+			args:    []string{"KeyValue::putItem", `{}`},
+			wantErr: `"putItem"`,
+		},
+		{
+			desc:    "not a function",
+			args:    []string{"getItem", `{}`},
+			wantErr: `method "getItem" must be of the form Service::method`,
+		},
+	}
+
+	for _, framed := range []bool{true, false} {
+		for _, tt := range tests {
+			name := tt.desc
+			if !framed {
+				name += "/unframed"
+			}
+
+			t.Run(name, func(t *testing.T) {
+				handler := tt.handler
+				if handler == nil {
+					handler = func(wire.Envelope) *wire.Envelope {
+						t.Errorf("unexpected request")
+						return nil
+					}
+				}
+				addr, stop := kvtest.StartServer(t, framed, handler)
+				defer stop()
+
+				args := []string{"--idl", kvtest.IDL()}
+				if !framed {
+					args = append(args, "--unframed")
+				}
+				args = append(args, addr)
+				args = append(args, tt.args...)
+
+				var out bytes.Buffer
+				err := run(args, strings.NewReader(tt.stdin), &out)
+				if tt.wantErr != "" {
+					require.Error(t, err)
+					assert.Contains(t, err.Error(), tt.wantErr)
+				} else {
+					require.NoError(t, err)
+				}
+				assert.Equal(t, tt.want, out.String())
+			})
+		}
+	}
+}
+
+func TestCallConnectionClosed(t *testing.T) {
+	addr, stop := kvtest.StartServer(t, true, func(wire.Envelope) *wire.Envelope { return nil })
+	defer stop()
+
+	err := run([]string{"-i", kvtest.IDL(), addr, "KeyValue::healthy", "{}"}, nil, ioutil.Discard)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "call to KeyValue::healthy failed")
+}
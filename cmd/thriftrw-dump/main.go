@@ -0,0 +1,183 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package main
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"log"
+	"os"
+	"strings"
+
+	"go.uber.org/thriftrw/compile"
+	"go.uber.org/thriftrw/internal/dump"
+	"go.uber.org/thriftrw/internal/frame"
+	"go.uber.org/thriftrw/internal/schema"
+	"go.uber.org/thriftrw/protocol"
+	"go.uber.org/thriftrw/wire"
+
+	"github.com/jessevdk/go-flags"
+)
+
+type options struct {
+	ThriftFile string `long:"thrift" short:"t" value-name:"FILE" description:"Thrift file defining the type of the payload. Without this, fields are printed by ID."`
+	Type       string `long:"type" value-name:"NAME" description:"Struct, union, or exception in the payload, or Service::method for the arguments of a function. Required with --thrift unless the payload is enveloped."`
+	Result     bool   `long:"result" description:"Decode the result of the Service::method function instead of its arguments. Enveloped payloads use the envelope type instead."`
+	Framed     bool   `long:"framed" description:"The payload consists of frames, each prefixed with its 4-byte length."`
+	Envelope   bool   `long:"envelope" description:"The payload is wrapped in a Thrift envelope."`
+	Args       struct {
+		Input string `positional-arg-name:"file" description:"File holding the payload. Defaults to stdin."`
+	} `positional-args:"yes"`
+}
+
+// dumper decodes payloads and prints them.
+type dumper struct {
+	opts   *options
+	module *compile.Module // nil if no Thrift file was given
+}
+
+func run(args []string, stdin io.Reader, stdout io.Writer) error {
+	var opts options
+	if _, err := flags.ParseArgs(&opts, args); err != nil {
+		return fmt.Errorf("error parsing arguments: %v", err)
+	}
+
+	d := dumper{opts: &opts}
+	if opts.ThriftFile != "" {
+		m, err := compile.Compile(opts.ThriftFile)
+		if err != nil {
+			return fmt.Errorf("could not compile %q: %v", opts.ThriftFile, err)
+		}
+		d.module = m
+	} else if opts.Type != "" {
+		return errors.New("--type requires --thrift")
+	}
+
+	input := stdin
+	if opts.Args.Input != "" {
+		f, err := os.Open(opts.Args.Input)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		input = f
+	}
+
+	if !opts.Framed {
+		payload, err := ioutil.ReadAll(input)
+		if err != nil {
+			return fmt.Errorf("could not read payload: %v", err)
+		}
+		return d.dump(stdout, payload)
+	}
+
+	r := frame.NewReader(input)
+	for i := 0; ; i++ {
+		payload, err := r.Read()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("could not read frame %v: %v", i, err)
+		}
+
+		if i > 0 {
+			fmt.Fprintln(stdout)
+		}
+		if err := d.dump(stdout, payload); err != nil {
+			return fmt.Errorf("frame %v: %v", i, err)
+		}
+	}
+}
+
+// dump decodes a single payload and prints it to w.
+func (d *dumper) dump(w io.Writer, payload []byte) error {
+	if !d.opts.Envelope {
+		v, err := protocol.Binary.Decode(bytes.NewReader(payload), wire.TStruct)
+		if err != nil {
+			return fmt.Errorf("could not decode payload: %v", err)
+		}
+
+		t, err := d.typeFor(nil)
+		if err != nil {
+			return err
+		}
+		return dump.Value(w, t, v)
+	}
+
+	e, err := protocol.Binary.DecodeEnveloped(bytes.NewReader(payload))
+	if err != nil {
+		return fmt.Errorf("could not decode enveloped payload: %v", err)
+	}
+
+	t, err := d.typeFor(&e)
+	if err != nil {
+		return err
+	}
+	return dump.Envelope(w, t, e)
+}
+
+// typeFor determines the type of a payload. The envelope is nil if the
+// payload isn't enveloped.
+func (d *dumper) typeFor(e *wire.Envelope) (compile.TypeSpec, error) {
+	if d.module == nil {
+		return nil, nil
+	}
+
+	if e != nil && (e.Type == wire.Exception || d.opts.Type == "") {
+		return schema.EnvelopeStruct(d.module, *e)
+	}
+
+	var (
+		f   *schema.Function
+		err error
+	)
+	switch {
+	case d.opts.Type == "":
+		return nil, errors.New("--type is required to decode payloads without envelopes")
+	case strings.Contains(d.opts.Type, "::"):
+		f, err = schema.LookupFunction(d.module, d.opts.Type)
+	default:
+		return schema.LookupStruct(d.module, d.opts.Type)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	result := d.opts.Result
+	if e != nil {
+		result = e.Type == wire.Reply
+	}
+	if result {
+		return f.ResultStruct(), nil
+	}
+	return f.ArgsStruct(), nil
+}
+
+func main() {
+	log.SetFlags(0) // so that the error message isn't noisy
+	if err := run(os.Args[1:], os.Stdin, os.Stdout); err != nil {
+		log.Fatal(err)
+	}
+}
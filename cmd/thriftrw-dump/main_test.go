@@ -0,0 +1,247 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package main
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"go.uber.org/thriftrw/internal/kvtest"
+	"go.uber.org/thriftrw/wire"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDump(t *testing.T) {
+	item := wire.NewValueStruct(wire.Struct{Fields: []wire.Field{
+		{ID: 1, Value: wire.NewValueString("foo")},
+		{ID: 2, Value: wire.NewValueBinary([]byte{0xca, 0xfe})},
+		{ID: 3, Value: wire.NewValueI32(1)},
+		{ID: 4, Value: wire.NewValueList(wire.ValueListFromSlice(wire.TBinary, []wire.Value{
+			wire.NewValueString("a"), wire.NewValueString("b"),
+		}))},
+		{ID: 5, Value: wire.NewValueMap(wire.MapItemListFromSlice(wire.TBinary, wire.TI64, []wire.MapItem{
+			{Key: wire.NewValueString("hits"), Value: wire.NewValueI64(42)},
+		}))},
+		{ID: 10, Value: wire.NewValueI32(7)},
+	}})
+	args := wire.NewValueStruct(wire.Struct{Fields: []wire.Field{
+		{ID: 1, Value: wire.NewValueString("foo")},
+	}})
+
+	tests := []struct {
+		desc  string
+		args  []string
+		input []byte
+		want  string
+	}{
+		{
+			desc:  "no IDL",
+			input: kvtest.Encode(t, args),
+			want:  "TStruct({1: TBinary([102 111 111])})\n",
+		},
+		{
+			desc:  "struct",
+			args:  []string{"--thrift", kvtest.IDL(), "--type", "Item"},
+			input: kvtest.Encode(t, item),
+			want: strings.Join([]string{
+				`Item{`,
+				`  key (1): "foo"`,
+				`  value (2): 0xcafe`,
+				`  status (3): STALE (1)`,
+				`  tags (4): [`,
+				`    "a"`,
+				`    "b"`,
+				`  ]`,
+				`  counts (5): {`,
+				`    "hits": 42`,
+				`  }`,
+				`  10: TI32(7)`,
+				`}`,
+				``,
+			}, "\n"),
+		},
+		{
+			desc:  "function arguments",
+			args:  []string{"-t", kvtest.IDL(), "--type", "KeyValue::getItem"},
+			input: kvtest.Encode(t, args),
+			want:  "KeyValue_getItem_Args{\n  key (1): \"foo\"\n}\n",
+		},
+		{
+			desc: "function result",
+			args: []string{"-t", kvtest.IDL(), "--type", "KeyValue::healthy", "--result"},
+			input: kvtest.Encode(t, wire.NewValueStruct(wire.Struct{Fields: []wire.Field{
+				{ID: 0, Value: wire.NewValueBool(true)},
+			}})),
+			want: "KeyValue_healthy_Result{\n  success (0): true\n}\n",
+		},
+		{
+			desc: "envelope",
+			args: []string{"-t", kvtest.IDL(), "--envelope"},
+			input: kvtest.EncodeEnveloped(t, wire.Envelope{
+				Name:  "getItem",
+				Type:  wire.Reply,
+				SeqID: 42,
+				Value: wire.NewValueStruct(wire.Struct{Fields: []wire.Field{
+					{ID: 1, Value: wire.NewValueStruct(wire.Struct{Fields: []wire.Field{
+						{ID: 1, Value: wire.NewValueString("foo")},
+					}})},
+				}}),
+			}),
+			want: strings.Join([]string{
+				`Reply getItem (seqid 42)`,
+				`KeyValue_getItem_Result{`,
+				`  notFound (1): NotFound{`,
+				`    key (1): "foo"`,
+				`  }`,
+				`}`,
+				``,
+			}, "\n"),
+		},
+		{
+			desc: "exception envelope",
+			args: []string{"-t", kvtest.IDL(), "--envelope"},
+			input: kvtest.EncodeEnveloped(t, wire.Envelope{
+				Name:  "getItem",
+				Type:  wire.Exception,
+				SeqID: 1,
+				Value: wire.NewValueStruct(wire.Struct{Fields: []wire.Field{
+					{ID: 1, Value: wire.NewValueString("great sadness")},
+					{ID: 2, Value: wire.NewValueI32(6)},
+				}}),
+			}),
+			want: strings.Join([]string{
+				`Exception getItem (seqid 1)`,
+				`TApplicationException{`,
+				`  message (1): "great sadness"`,
+				`  type (2): INTERNAL_ERROR (6)`,
+				`}`,
+				``,
+			}, "\n"),
+		},
+		{
+			desc:  "framed envelopes without IDL",
+			args:  []string{"--framed", "--envelope"},
+			input: kvtest.Framed(t, kvtest.EncodeEnveloped(t, wire.Envelope{Name: "healthy", Type: wire.Call, SeqID: 1, Value: wire.NewValueStruct(wire.Struct{})}), kvtest.EncodeEnveloped(t, wire.Envelope{Name: "healthy", Type: wire.Call, SeqID: 2, Value: wire.NewValueStruct(wire.Struct{})})),
+			want:  "Call healthy (seqid 1)\nTStruct({})\n\nCall healthy (seqid 2)\nTStruct({})\n",
+		},
+		{
+			desc:  "inherited function",
+			args:  []string{"-t", kvtest.IDL(), "--framed", "--envelope", "--type", "KeyValue::healthy"},
+			input: kvtest.Framed(t, kvtest.EncodeEnveloped(t, wire.Envelope{Name: "healthy", Type: wire.Call, SeqID: 1, Value: wire.NewValueStruct(wire.Struct{})})),
+			want:  "Call healthy (seqid 1)\nKeyValue_healthy_Args{}\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			var out bytes.Buffer
+			require.NoError(t, run(tt.args, bytes.NewReader(tt.input), &out))
+			assert.Equal(t, tt.want, out.String())
+		})
+	}
+}
+
+func TestDumpFromFile(t *testing.T) {
+	dir, err := ioutil.TempDir("", "thriftrw-dump")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "payload.bin")
+	require.NoError(t, ioutil.WriteFile(path, kvtest.Encode(t, wire.NewValueStruct(wire.Struct{})), 0644))
+
+	var out bytes.Buffer
+	require.NoError(t, run([]string{"-t", kvtest.IDL(), "--type", "NotFound", path}, nil, &out))
+	assert.Equal(t, "NotFound{}\n", out.String())
+}
+
+func TestDumpErrors(t *testing.T) {
+	tests := []struct {
+		desc    string
+		args    []string
+		input   []byte
+		wantErr string
+	}{
+		{
+			desc:    "type without IDL",
+			args:    []string{"--type", "Item"},
+			wantErr: "--type requires --thrift",
+		},
+		{
+			desc:    "missing type",
+			args:    []string{"-t", kvtest.IDL()},
+			input:   []byte{0},
+			wantErr: "--type is required to decode payloads without envelopes",
+		},
+		{
+			desc:    "unknown type",
+			args:    []string{"-t", kvtest.IDL(), "--type", "Foo"},
+			input:   []byte{0},
+			wantErr: `does not define a type named "Foo"`,
+		},
+		{
+			desc:    "unknown function",
+			args:    []string{"-t", kvtest.IDL(), "--type", "KeyValue::foo"},
+			input:   []byte{0},
+			wantErr: `service "KeyValue" does not have a function named "foo"`,
+		},
+		{
+			desc:    "unknown envelope function",
+			args:    []string{"-t", kvtest.IDL(), "--envelope"},
+			input:   kvtest.EncodeEnveloped(t, wire.Envelope{Name: "foo", Type: wire.Call, Value: wire.NewValueStruct(wire.Struct{})}),
+			wantErr: `no service in "`,
+		},
+		{
+			desc:    "invalid payload",
+			input:   []byte{0x0b, 0x00},
+			wantErr: "could not decode payload",
+		},
+		{
+			desc:    "truncated frame",
+			args:    []string{"--framed"},
+			input:   []byte{0, 0, 0, 10, 0},
+			wantErr: "could not read frame 0",
+		},
+		{
+			desc:    "missing file",
+			args:    []string{"does_not_exist.bin"},
+			wantErr: "does_not_exist.bin",
+		},
+		{
+			desc:    "invalid IDL",
+			args:    []string{"-t", "does_not_exist.thrift"},
+			wantErr: `could not compile "does_not_exist.thrift"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			err := run(tt.args, bytes.NewReader(tt.input), ioutil.Discard)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+}
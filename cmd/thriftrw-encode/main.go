@@ -0,0 +1,229 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"go.uber.org/thriftrw/compile"
+	"go.uber.org/thriftrw/dynamic"
+	"go.uber.org/thriftrw/internal/frame"
+	"go.uber.org/thriftrw/internal/schema"
+	"go.uber.org/thriftrw/protocol"
+	"go.uber.org/thriftrw/wire"
+
+	"github.com/jessevdk/go-flags"
+	"gopkg.in/yaml.v2"
+)
+
+type options struct {
+	ThriftFile string `long:"thrift" short:"t" value-name:"FILE" required:"yes" description:"Thrift file defining the type of the payload."`
+	Type       string `long:"type" value-name:"NAME" required:"yes" description:"Struct, union, or exception to encode, or Service::method for the arguments of a function."`
+	Result     bool   `long:"result" description:"Encode the result of the Service::method function instead of its arguments."`
+	Envelope   bool   `long:"envelope" description:"Wrap the payload in a Thrift envelope. Requires a Service::method type."`
+	SeqID      int32  `long:"seqid" value-name:"ID" description:"Sequence ID of the envelope." default:"1"`
+	Framed     bool   `long:"framed" description:"Prefix the payload with its 4-byte length."`
+	Format     string `long:"format" choice:"json" choice:"yaml" description:"Format of the input. Defaults to yaml for files ending in .yaml or .yml, and json otherwise."`
+	Output     string `long:"out" short:"o" value-name:"FILE" description:"File to write the payload to. Defaults to stdout."`
+	Args       struct {
+		Input string `positional-arg-name:"file" description:"File holding the JSON or YAML document. Defaults to stdin."`
+	} `positional-args:"yes"`
+}
+
+func run(args []string, stdin io.Reader, stdout io.Writer) error {
+	var opts options
+	if _, err := flags.ParseArgs(&opts, args); err != nil {
+		return fmt.Errorf("error parsing arguments: %v", err)
+	}
+
+	m, err := compile.Compile(opts.ThriftFile)
+	if err != nil {
+		return fmt.Errorf("could not compile %q: %v", opts.ThriftFile, err)
+	}
+
+	var (
+		spec *compile.StructSpec
+		f    *schema.Function
+	)
+	if strings.Contains(opts.Type, "::") {
+		f, err = schema.LookupFunction(m, opts.Type)
+		if err != nil {
+			return err
+		}
+		spec = f.ArgsStruct()
+		if opts.Result {
+			spec = f.ResultStruct()
+		}
+	} else {
+		if opts.Result {
+			return errors.New("--result requires a Service::method type")
+		}
+		if opts.Envelope {
+			return errors.New("--envelope requires a Service::method type")
+		}
+		spec, err = schema.LookupStruct(m, opts.Type)
+		if err != nil {
+			return err
+		}
+	}
+
+	input := stdin
+	if opts.Args.Input != "" {
+		file, err := os.Open(opts.Args.Input)
+		if err != nil {
+			return err
+		}
+		defer file.Close()
+		input = file
+	}
+
+	doc, err := readDocument(input, inputFormat(&opts))
+	if err != nil {
+		return err
+	}
+
+	s, err := dynamic.FromMap(spec, doc)
+	if err != nil {
+		return err
+	}
+
+	w, err := s.ToWire()
+	if err != nil {
+		return err
+	}
+
+	var payload bytes.Buffer
+	if opts.Envelope {
+		err = protocol.Binary.EncodeEnveloped(wire.Envelope{
+			Name:  f.Spec.Name,
+			Type:  envelopeType(f, opts.Result),
+			SeqID: opts.SeqID,
+			Value: w,
+		}, &payload)
+	} else {
+		err = protocol.Binary.Encode(w, &payload)
+	}
+	if err != nil {
+		return fmt.Errorf("could not encode payload: %v", err)
+	}
+
+	out := stdout
+	if opts.Output != "" {
+		file, err := os.Create(opts.Output)
+		if err != nil {
+			return err
+		}
+		defer file.Close()
+		out = file
+	}
+
+	if opts.Framed {
+		return frame.NewWriter(out).Write(payload.Bytes())
+	}
+	_, err = out.Write(payload.Bytes())
+	return err
+}
+
+// inputFormat determines the format of the input document.
+func inputFormat(opts *options) string {
+	if opts.Format != "" {
+		return opts.Format
+	}
+	switch filepath.Ext(opts.Args.Input) {
+	case ".yaml", ".yml":
+		return "yaml"
+	default:
+		return "json"
+	}
+}
+
+// readDocument reads a JSON or YAML object from the given reader.
+func readDocument(r io.Reader, format string) (map[string]interface{}, error) {
+	if format == "json" {
+		dec := json.NewDecoder(r)
+		dec.UseNumber() // so that large i64 values aren't rounded
+		var doc map[string]interface{}
+		if err := dec.Decode(&doc); err != nil {
+			return nil, fmt.Errorf("could not decode JSON: %v", err)
+		}
+		return doc, nil
+	}
+
+	b, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	var doc map[interface{}]interface{}
+	if err := yaml.Unmarshal(b, &doc); err != nil {
+		return nil, fmt.Errorf("could not decode YAML: %v", err)
+	}
+	return fromYAML(doc).(map[string]interface{}), nil
+}
+
+// fromYAML converts the maps decoded by the YAML library into maps with
+// string keys, the same as the ones decoded from JSON.
+func fromYAML(v interface{}) interface{} {
+	switch v := v.(type) {
+	case map[interface{}]interface{}:
+		m := make(map[string]interface{}, len(v))
+		for k, item := range v {
+			m[fmt.Sprint(k)] = fromYAML(item)
+		}
+		return m
+	case []interface{}:
+		items := make([]interface{}, len(v))
+		for i, item := range v {
+			items[i] = fromYAML(item)
+		}
+		return items
+	default:
+		return v
+	}
+}
+
+// envelopeType returns the type of envelope used for the arguments or result
+// of the given function.
+func envelopeType(f *schema.Function, result bool) wire.EnvelopeType {
+	switch {
+	case result:
+		return wire.Reply
+	case f.Spec.OneWay:
+		return wire.OneWay
+	default:
+		return wire.Call
+	}
+}
+
+func main() {
+	log.SetFlags(0) // so that the error message isn't noisy
+	if err := run(os.Args[1:], os.Stdin, os.Stdout); err != nil {
+		log.Fatal(err)
+	}
+}
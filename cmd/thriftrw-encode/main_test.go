@@ -0,0 +1,261 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+package main
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"go.uber.org/thriftrw/internal/frame"
+	"go.uber.org/thriftrw/internal/kvtest"
+	"go.uber.org/thriftrw/wire"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEncode(t *testing.T) {
+	item := wire.NewValueStruct(wire.Struct{Fields: []wire.Field{
+		{ID: 1, Value: wire.NewValueString("foo")},
+		{ID: 2, Value: wire.NewValueBinary([]byte{0xca, 0xfe})},
+		{ID: 3, Value: wire.NewValueI32(1)},
+		{ID: 4, Value: wire.NewValueList(wire.ValueListFromSlice(wire.TBinary, []wire.Value{
+			wire.NewValueString("a"), wire.NewValueString("b"),
+		}))},
+		{ID: 5, Value: wire.NewValueMap(wire.MapItemListFromSlice(wire.TBinary, wire.TI64, []wire.MapItem{
+			{Key: wire.NewValueString("hits"), Value: wire.NewValueI64(9007199254740993)},
+		}))},
+	}})
+	args := wire.NewValueStruct(wire.Struct{Fields: []wire.Field{
+		{ID: 1, Value: wire.NewValueString("foo")},
+	}})
+
+	tests := []struct {
+		desc   string
+		args   []string
+		input  string
+		want   wire.Value
+		decode func(testing.TB, []byte) wire.Value
+	}{
+		{
+			desc:  "struct",
+			args:  []string{"-t", kvtest.IDL(), "--type", "Item"},
+			input: `{"key": "foo", "value": "yv4=", "status": "STALE", "tags": ["a", "b"], "counts": {"hits": 9007199254740993}}`,
+			want:  item,
+		},
+		{
+			desc: "yaml",
+			args: []string{"-t", kvtest.IDL(), "--type", "Item", "--format", "yaml"},
+			input: strings.Join([]string{
+				"key: foo",
+				"value: yv4=",
+				"status: 1",
+				"tags: [a, b]",
+				"counts:",
+				"  hits: 9007199254740993",
+			}, "\n"),
+			want: item,
+		},
+		{
+			desc:  "function arguments",
+			args:  []string{"-t", kvtest.IDL(), "--type", "KeyValue::getItem"},
+			input: `{"key": "foo"}`,
+			want:  args,
+		},
+		{
+			desc:  "function result",
+			args:  []string{"-t", kvtest.IDL(), "--type", "KeyValue::healthy", "--result"},
+			input: `{"success": true}`,
+			want: wire.NewValueStruct(wire.Struct{Fields: []wire.Field{
+				{ID: 0, Value: wire.NewValueBool(true)},
+			}}),
+		},
+		{
+			desc:  "framed",
+			args:  []string{"-t", kvtest.IDL(), "--type", "KeyValue::getItem", "--framed"},
+			input: `{"key": "foo"}`,
+			want:  args,
+			decode: func(t testing.TB, b []byte) wire.Value {
+				payload, err := frame.NewReader(bytes.NewReader(b)).Read()
+				require.NoError(t, err)
+				return kvtest.Decode(t, payload)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			var out bytes.Buffer
+			require.NoError(t, run(tt.args, strings.NewReader(tt.input), &out))
+
+			decodeFn := tt.decode
+			if decodeFn == nil {
+				decodeFn = kvtest.Decode
+			}
+			got := decodeFn(t, out.Bytes())
+			assert.True(t, wire.ValuesAreEqual(tt.want, got), "expected %v, got %v", tt.want, got)
+		})
+	}
+}
+
+func TestEncodeEnvelope(t *testing.T) {
+	tests := []struct {
+		desc     string
+		args     []string
+		input    string
+		wantName string
+		wantType wire.EnvelopeType
+		wantSeq  int32
+	}{
+		{
+			desc:     "call",
+			args:     []string{"--type", "KeyValue::getItem"},
+			input:    `{"key": "foo"}`,
+			wantName: "getItem",
+			wantType: wire.Call,
+			wantSeq:  1,
+		},
+		{
+			desc:     "inherited",
+			args:     []string{"--type", "KeyValue::healthy", "--seqid", "42"},
+			input:    `{}`,
+			wantName: "healthy",
+			wantType: wire.Call,
+			wantSeq:  42,
+		},
+		{
+			desc:     "oneway",
+			args:     []string{"--type", "KeyValue::touch"},
+			input:    `{"key": "foo"}`,
+			wantName: "touch",
+			wantType: wire.OneWay,
+			wantSeq:  1,
+		},
+		{
+			desc:     "reply",
+			args:     []string{"--type", "KeyValue::getItem", "--result"},
+			input:    `{"notFound": {"key": "foo"}}`,
+			wantName: "getItem",
+			wantType: wire.Reply,
+			wantSeq:  1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			args := append([]string{"-t", kvtest.IDL(), "--envelope"}, tt.args...)
+
+			var out bytes.Buffer
+			require.NoError(t, run(args, strings.NewReader(tt.input), &out))
+
+			e := kvtest.DecodeEnveloped(t, out.Bytes())
+			assert.Equal(t, tt.wantName, e.Name)
+			assert.Equal(t, tt.wantType, e.Type)
+			assert.Equal(t, tt.wantSeq, e.SeqID)
+		})
+	}
+}
+
+func TestEncodeFiles(t *testing.T) {
+	dir, err := ioutil.TempDir("", "thriftrw-encode")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	input := filepath.Join(dir, "item.yml")
+	output := filepath.Join(dir, "item.bin")
+	require.NoError(t, ioutil.WriteFile(input, []byte("key: foo\n"), 0644))
+
+	var out bytes.Buffer
+	require.NoError(t, run([]string{"-t", kvtest.IDL(), "--type", "Item", "-o", output, input}, nil, &out))
+	assert.Empty(t, out.String())
+
+	b, err := ioutil.ReadFile(output)
+	require.NoError(t, err)
+	want := wire.NewValueStruct(wire.Struct{Fields: []wire.Field{
+		{ID: 1, Value: wire.NewValueString("foo")},
+	}})
+	assert.True(t, wire.ValuesAreEqual(want, kvtest.Decode(t, b)))
+}
+
+func TestEncodeErrors(t *testing.T) {
+	tests := []struct {
+		desc    string
+		args    []string
+		input   string
+		wantErr string
+	}{
+		{
+			desc:    "missing type",
+			args:    []string{"-t", kvtest.IDL()},
+			wantErr: "the required flag `--type' was not specified",
+		},
+		{
+			desc:    "unknown type",
+			args:    []string{"-t", kvtest.IDL(), "--type", "Foo"},
+			wantErr: `"Foo"`,
+		},
+		{
+			desc:    "envelope without function",
+			args:    []string{"-t", kvtest.IDL(), "--type", "Item", "--envelope"},
+			wantErr: "--envelope requires a Service::method type",
+		},
+		{
+			desc:    "result without function",
+			args:    []string{"-t", kvtest.IDL(), "--type", "Item", "--result"},
+			wantErr: "--result requires a Service::method type",
+		},
+		{
+			desc:    "invalid JSON",
+			args:    []string{"-t", kvtest.IDL(), "--type", "Item"},
+			input:   `{"key": `,
+			wantErr: "could not decode JSON",
+		},
+		{
+			desc:    "missing required field",
+			args:    []string{"-t", kvtest.IDL(), "--type", "Item"},
+			input:   `{"tags": []}`,
+			wantErr: "Item.key: missing required field",
+		},
+		{
+			desc:    "type mismatch",
+			args:    []string{"-t", kvtest.IDL(), "--type", "Item"},
+			input:   `{"key": "foo", "tags": ["a", 1]}`,
+			wantErr: "Item.tags[1]: expected a value of type string, got json.Number",
+		},
+		{
+			desc:    "nested type mismatch",
+			args:    []string{"-t", kvtest.IDL(), "--type", "KeyValue::getItem", "--result"},
+			input:   `{"success": {"key": "foo", "counts": {"hits": "many"}}}`,
+			wantErr: `KeyValue_getItem_Result.success.counts["hits"]: `,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			err := run(tt.args, strings.NewReader(tt.input), ioutil.Discard)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+}
@@ -0,0 +1,276 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+package main
+
+import (
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"go.uber.org/thriftrw/ast"
+	"go.uber.org/thriftrw/idl"
+)
+
+// graph is the include graph of a set of Thrift files. All paths are
+// absolute.
+type graph struct {
+	// Files the graph was built from.
+	Roots []string
+
+	// All files in the graph, including those only reachable through
+	// includes.
+	Files map[string]*thriftFile
+}
+
+// thriftFile is a file in an include graph.
+type thriftFile struct {
+	Path     string
+	Includes []include // in the order in which they're declared
+}
+
+// include is an edge of the include graph.
+type include struct {
+	// Name through which the included file is referenced.
+	Alias string
+	Path  string
+	Line  int
+}
+
+// buildGraph parses the given Thrift files and everything they include.
+// Only the headers of files are inspected, so the files need not compile.
+func buildGraph(roots []string) (*graph, error) {
+	g := graph{Files: make(map[string]*thriftFile)}
+
+	queue := make([]string, 0, len(roots))
+	for _, root := range roots {
+		path, err := filepath.Abs(root)
+		if err != nil {
+			return nil, err
+		}
+		g.Roots = append(g.Roots, path)
+		queue = append(queue, path)
+	}
+
+	for len(queue) > 0 {
+		path := queue[0]
+		queue = queue[1:]
+		if _, ok := g.Files[path]; ok {
+			continue
+		}
+
+		f, err := parseFile(path)
+		if err != nil {
+			return nil, err
+		}
+		g.Files[path] = f
+		for _, inc := range f.Includes {
+			queue = append(queue, inc.Path)
+		}
+	}
+
+	return &g, nil
+}
+
+func parseFile(path string) (*thriftFile, error) {
+	src, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("could not read %q: %v", path, err)
+	}
+
+	prog, err := idl.Parse(src)
+	if err != nil {
+		return nil, fmt.Errorf("could not parse %q: %v", path, err)
+	}
+
+	f := thriftFile{Path: path}
+	for _, h := range prog.Headers {
+		inc, ok := h.(*ast.Include)
+		if !ok {
+			continue
+		}
+
+		alias := inc.Name
+		if alias == "" {
+			alias = strings.TrimSuffix(filepath.Base(inc.Path), filepath.Ext(inc.Path))
+		}
+		f.Includes = append(f.Includes, include{
+			Alias: alias,
+			Path:  filepath.Join(filepath.Dir(path), inc.Path),
+			Line:  inc.Line,
+		})
+	}
+	return &f, nil
+}
+
+// Paths returns the paths of all files in the graph in sorted order.
+func (g *graph) Paths() []string {
+	paths := make([]string, 0, len(g.Files))
+	for path := range g.Files {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+	return paths
+}
+
+// Deps returns the files that the given file includes, directly or
+// transitively, in sorted order. The file itself is not included unless it
+// includes itself through a cycle.
+func (g *graph) Deps(path string) []string {
+	seen := make(map[string]struct{})
+	var visit func(string)
+	visit = func(p string) {
+		for _, inc := range g.Files[p].Includes {
+			if _, ok := seen[inc.Path]; ok {
+				continue
+			}
+			seen[inc.Path] = struct{}{}
+			visit(inc.Path)
+		}
+	}
+	visit(path)
+
+	deps := make([]string, 0, len(seen))
+	for dep := range seen {
+		deps = append(deps, dep)
+	}
+	sort.Strings(deps)
+	return deps
+}
+
+// ReverseDeps returns the roots of the graph which include the given file,
+// directly or transitively, in sorted order.
+func (g *graph) ReverseDeps(path string) []string {
+	var roots []string
+	for _, root := range g.Roots {
+		for _, dep := range g.Deps(root) {
+			if dep == path {
+				roots = append(roots, root)
+				break
+			}
+		}
+	}
+	sort.Strings(roots)
+	return dedupe(roots)
+}
+
+// Cycles returns the include cycles in the graph. Each cycle is the sorted
+// list of files which include each other, directly or transitively.
+func (g *graph) Cycles() [][]string {
+	// Tarjan's algorithm for strongly connected components.
+	var (
+		index   = make(map[string]int)
+		lowlink = make(map[string]int)
+		onStack = make(map[string]bool)
+		stack   []string
+		cycles  [][]string
+	)
+
+	var connect func(string)
+	connect = func(v string) {
+		index[v] = len(index)
+		lowlink[v] = index[v]
+		stack = append(stack, v)
+		onStack[v] = true
+
+		selfLoop := false
+		for _, inc := range g.Files[v].Includes {
+			w := inc.Path
+			if w == v {
+				selfLoop = true
+			}
+			if _, ok := index[w]; !ok {
+				connect(w)
+				if lowlink[w] < lowlink[v] {
+					lowlink[v] = lowlink[w]
+				}
+			} else if onStack[w] && index[w] < lowlink[v] {
+				lowlink[v] = index[w]
+			}
+		}
+
+		if lowlink[v] != index[v] {
+			return
+		}
+
+		var component []string
+		for {
+			w := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			onStack[w] = false
+			component = append(component, w)
+			if w == v {
+				break
+			}
+		}
+		if len(component) > 1 || selfLoop {
+			sort.Strings(component)
+			cycles = append(cycles, component)
+		}
+	}
+
+	for _, path := range g.Paths() {
+		if _, ok := index[path]; !ok {
+			connect(path)
+		}
+	}
+
+	sort.Slice(cycles, func(i, j int) bool { return cycles[i][0] < cycles[j][0] })
+	return cycles
+}
+
+// conflict is a set of different files with the same base name. Such files
+// are referenced by the same name when included, and generate Go packages
+// with the same name.
+type conflict struct {
+	BaseName string
+	Paths    []string
+}
+
+// Conflicts returns the files in the graph which share their base names,
+// sorted by base name.
+func (g *graph) Conflicts() []conflict {
+	byBase := make(map[string][]string)
+	for _, path := range g.Paths() {
+		base := filepath.Base(path)
+		byBase[base] = append(byBase[base], path)
+	}
+
+	var conflicts []conflict
+	for base, paths := range byBase {
+		if len(paths) > 1 {
+			conflicts = append(conflicts, conflict{BaseName: base, Paths: paths})
+		}
+	}
+	sort.Slice(conflicts, func(i, j int) bool { return conflicts[i].BaseName < conflicts[j].BaseName })
+	return conflicts
+}
+
+// dedupe removes adjacent duplicates from a sorted list.
+func dedupe(items []string) []string {
+	out := items[:0]
+	for i, item := range items {
+		if i == 0 || item != items[i-1] {
+			out = append(out, item)
+		}
+	}
+	return out
+}
@@ -0,0 +1,84 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+package main
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func absPaths(t *testing.T, paths ...string) []string {
+	out := make([]string, len(paths))
+	for i, p := range paths {
+		var err error
+		out[i], err = filepath.Abs(filepath.Join("testdata", p))
+		require.NoError(t, err)
+	}
+	return out
+}
+
+func TestGraph(t *testing.T) {
+	g, err := buildGraph(absPaths(t, "idl/d.thrift", "idl/b.thrift"))
+	require.NoError(t, err)
+
+	assert.Equal(t, absPaths(t, "idl/a.thrift", "idl/b.thrift", "idl/c.thrift", "idl/d.thrift"), g.Paths())
+	assert.Equal(t, []include{
+		{Alias: "b", Path: absPaths(t, "idl/b.thrift")[0], Line: 1},
+		{Alias: "c", Path: absPaths(t, "idl/c.thrift")[0], Line: 2},
+	}, g.Files[absPaths(t, "idl/d.thrift")[0]].Includes)
+
+	assert.Equal(t, absPaths(t, "idl/a.thrift", "idl/b.thrift", "idl/c.thrift"), g.Deps(g.Roots[0]))
+	assert.Equal(t, absPaths(t, "idl/a.thrift"), g.Deps(g.Roots[1]))
+
+	assert.Equal(t, absPaths(t, "idl/b.thrift", "idl/d.thrift"), g.ReverseDeps(absPaths(t, "idl/a.thrift")[0]))
+	assert.Equal(t, absPaths(t, "idl/d.thrift"), g.ReverseDeps(absPaths(t, "idl/c.thrift")[0]))
+	assert.Empty(t, g.ReverseDeps(absPaths(t, "idl/d.thrift")[0]))
+
+	assert.Empty(t, g.Cycles())
+	assert.Empty(t, g.Conflicts())
+}
+
+func TestGraphCycles(t *testing.T) {
+	g, err := buildGraph(absPaths(t, "cycle/x.thrift"))
+	require.NoError(t, err)
+
+	cycle := absPaths(t, "cycle/x.thrift", "cycle/y.thrift", "cycle/z.thrift")
+	assert.Equal(t, [][]string{cycle}, g.Cycles())
+	assert.Equal(t, cycle, g.Deps(g.Roots[0]), "files in cycles depend on themselves")
+}
+
+func TestGraphConflicts(t *testing.T) {
+	g, err := buildGraph(absPaths(t, "conflict/root.thrift"))
+	require.NoError(t, err)
+
+	assert.Equal(t, []conflict{{
+		BaseName: "common.thrift",
+		Paths:    absPaths(t, "conflict/common.thrift", "conflict/nested/common.thrift"),
+	}}, g.Conflicts())
+}
+
+func TestGraphErrors(t *testing.T) {
+	_, err := buildGraph([]string{"testdata/missing.thrift"})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "could not read")
+}
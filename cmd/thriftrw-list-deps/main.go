@@ -21,19 +21,26 @@
 package main
 
 import (
+	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
 	"log"
+	"os"
 	"path/filepath"
+	"sort"
+	"strings"
 
 	"github.com/jessevdk/go-flags"
-
-	"go.uber.org/thriftrw/compile"
 )
 
-var opts struct {
+type options struct {
 	RelativeTo string `long:"relative-to" description:"If specified, output paths will be relative to this directory"`
+	Format     string `long:"format" choice:"list" choice:"json" choice:"dot" default:"list" description:"Output format: a list of paths, the include graph as JSON, or the include graph as a Graphviz DOT file"`
+	Reverse    string `long:"reverse" value-name:"FILE" description:"Instead of dependencies, list the given Thrift files which include FILE, directly or transitively"`
+	Strict     bool   `long:"strict" description:"Fail if the include graph has cycles, or different files with the same base name"`
 	Args       struct {
-		ThriftFile string `positional-arg-name:"file" description:"Path to the Thrift file"`
+		Files []string `positional-arg-name:"file" required:"1" description:"Paths to Thrift files, or to directories to search for Thrift files"`
 	} `positional-args:"yes" required:"yes"`
 }
 
@@ -43,62 +50,308 @@ var opts struct {
 // The returned file paths are absolute, unless relativeTo parameter is given, in which case the paths are relative to
 // the relativeTo directory.
 func listDependentThrifts(input string, relativeTo string) ([]string, error) {
-	var deps []string
-
-	module, err := compile.Compile(input)
+	g, err := buildGraph([]string{input})
 	if err != nil {
-		return nil, fmt.Errorf("could not compile %q: %v", input, err)
+		return nil, err
 	}
 
-	err = module.Walk(func(mod *compile.Module) error {
-		output := mod.ThriftPath
+	deps := g.Deps(g.Roots[0])
+	for i, dep := range deps {
+		if deps[i], err = relPath(dep, relativeTo); err != nil {
+			return nil, fmt.Errorf("%q depends on %q, which is not relative to %q; ensure that --relative-to"+
+				" is an an ancestor of %q: %v", input, dep, relativeTo, dep, err)
+		}
+	}
+	return deps, nil
+}
+
+// relPath makes the given path relative to relativeTo, if specified.
+func relPath(path, relativeTo string) (string, error) {
+	if relativeTo == "" {
+		return path, nil
+	}
+	return filepath.Rel(relativeTo, path)
+}
 
-		// Do not return a self-referencing dependency
-		if output == input {
-			return nil
+// findThriftFiles expands directories in the given list of paths into the
+// Thrift files they hold.
+func findThriftFiles(paths []string) ([]string, error) {
+	var files []string
+	for _, path := range paths {
+		info, err := os.Stat(path)
+		if err != nil {
+			return nil, err
+		}
+		if !info.IsDir() {
+			files = append(files, path)
+			continue
 		}
 
-		if relativeTo != "" {
-			output, err = filepath.Rel(relativeTo, output)
-			if err != nil {
-				return fmt.Errorf("%q depends on %q, which is not relative to %q; ensure that --relative-to"+
-					" is an an ancestor of %q: %v", input, output, relativeTo, output, err)
+		err = filepath.Walk(path, func(p string, info os.FileInfo, err error) error {
+			if err == nil && !info.IsDir() && filepath.Ext(p) == ".thrift" {
+				files = append(files, p)
 			}
+			return err
+		})
+		if err != nil {
+			return nil, err
 		}
+	}
+	return files, nil
+}
 
-		deps = append(deps, output)
-		return nil
-	})
+func run(args []string, stdout, stderr io.Writer) error {
+	var opts options
+	if _, err := flags.ParseArgs(&opts, args); err != nil {
+		return fmt.Errorf("error parsing arguments: %v", err)
+	}
 
+	files, err := findThriftFiles(opts.Args.Files)
 	if err != nil {
-		return nil, err
+		return err
+	}
+	if len(files) == 0 {
+		return errors.New("no Thrift files found")
 	}
 
-	return deps, nil
+	g, err := buildGraph(files)
+	if err != nil {
+		return fmt.Errorf("error listing deps: %v", err)
+	}
+
+	if opts.RelativeTo != "" {
+		// Paths in the graph are absolute so --relative-to must be too.
+		if opts.RelativeTo, err = filepath.Abs(opts.RelativeTo); err != nil {
+			return err
+		}
+	}
+
+	w := writer{Graph: g, RelativeTo: opts.RelativeTo, Out: stdout}
+	switch {
+	case opts.Reverse != "":
+		err = w.Reverse(opts.Reverse, opts.Format)
+	case opts.Format == "json":
+		err = w.JSON()
+	case opts.Format == "dot":
+		err = w.DOT()
+	default:
+		err = w.List()
+	}
+	if err != nil {
+		return err
+	}
+
+	return check(g, opts.Strict, stderr)
 }
 
-func run() error {
-	if _, err := flags.Parse(&opts); err != nil {
-		return fmt.Errorf("error parsing arguments: %v", err)
+// check reports include cycles and files with conflicting base names in the
+// graph as warnings, or as errors in strict mode.
+func check(g *graph, strict bool, stderr io.Writer) error {
+	cycles := g.Cycles()
+	conflicts := g.Conflicts()
+
+	for _, cycle := range cycles {
+		fmt.Fprintf(stderr, "warning: include cycle between %v\n", strings.Join(cycle, ", "))
+	}
+	for _, c := range conflicts {
+		fmt.Fprintf(stderr, "warning: files named %q conflict: %v\n", c.BaseName, strings.Join(c.Paths, ", "))
 	}
 
-	file := opts.Args.ThriftFile
+	if strict && len(cycles)+len(conflicts) > 0 {
+		return fmt.Errorf("found %v include cycles and %v base name conflicts", len(cycles), len(conflicts))
+	}
+	return nil
+}
+
+// writer writes the include graph in different formats.
+type writer struct {
+	Graph      *graph
+	RelativeTo string
+	Out        io.Writer
+}
 
-	paths, err := listDependentThrifts(file, opts.RelativeTo)
+func (w *writer) rel(path string) (string, error) {
+	out, err := relPath(path, w.RelativeTo)
 	if err != nil {
-		return fmt.Errorf("error listing deps of %q: %v", file, err)
+		return "", fmt.Errorf("%q is not relative to %q; ensure that --relative-to"+
+			" is an an ancestor of %q: %v", path, w.RelativeTo, path, err)
+	}
+	return out, nil
+}
+
+func (w *writer) rels(paths []string) ([]string, error) {
+	out := make([]string, len(paths))
+	for i, path := range paths {
+		var err error
+		if out[i], err = w.rel(path); err != nil {
+			return nil, err
+		}
 	}
+	return out, nil
+}
 
+func (w *writer) lines(paths []string) error {
+	paths, err := w.rels(paths)
+	if err != nil {
+		return err
+	}
 	for _, path := range paths {
-		fmt.Println(path)
+		fmt.Fprintln(w.Out, path)
+	}
+	return nil
+}
+
+// List lists the dependencies of all roots.
+func (w *writer) List() error {
+	var deps []string
+	for _, root := range w.Graph.Roots {
+		deps = append(deps, w.Graph.Deps(root)...)
 	}
+	sort.Strings(deps)
+	return w.lines(dedupe(deps))
+}
 
+// Reverse lists the roots that depend on the given file.
+func (w *writer) Reverse(file, format string) error {
+	path, err := filepath.Abs(file)
+	if err != nil {
+		return err
+	}
+	roots := w.Graph.ReverseDeps(path)
+
+	switch format {
+	case "dot":
+		return errors.New("--reverse does not support the dot format")
+	case "list":
+		return w.lines(roots)
+	}
+
+	if path, err = w.rel(path); err != nil {
+		return err
+	}
+	if roots, err = w.rels(roots); err != nil {
+		return err
+	}
+	return w.encode(struct {
+		File  string   `json:"file"`
+		Roots []string `json:"roots"`
+	}{File: path, Roots: roots})
+}
+
+type jsonGraph struct {
+	Roots     []string       `json:"roots"`
+	Files     []string       `json:"files"`
+	Edges     []jsonEdge     `json:"edges"`
+	Cycles    [][]string     `json:"cycles"`
+	Conflicts []jsonConflict `json:"conflicts"`
+}
+
+type jsonEdge struct {
+	From  string `json:"from"`
+	To    string `json:"to"`
+	Alias string `json:"alias"`
+	Line  int    `json:"line"`
+}
+
+type jsonConflict struct {
+	BaseName string   `json:"basename"`
+	Paths    []string `json:"paths"`
+}
+
+// JSON writes the include graph as JSON.
+func (w *writer) JSON() error {
+	var (
+		out = jsonGraph{
+			Edges:     []jsonEdge{},
+			Cycles:    [][]string{},
+			Conflicts: []jsonConflict{},
+		}
+		err error
+	)
+
+	if out.Roots, err = w.rels(w.Graph.Roots); err != nil {
+		return err
+	}
+	if out.Files, err = w.rels(w.Graph.Paths()); err != nil {
+		return err
+	}
+
+	for _, path := range w.Graph.Paths() {
+		for _, inc := range w.Graph.Files[path].Includes {
+			e := jsonEdge{Alias: inc.Alias, Line: inc.Line}
+			if e.From, err = w.rel(path); err != nil {
+				return err
+			}
+			if e.To, err = w.rel(inc.Path); err != nil {
+				return err
+			}
+			out.Edges = append(out.Edges, e)
+		}
+	}
+
+	for _, cycle := range w.Graph.Cycles() {
+		paths, err := w.rels(cycle)
+		if err != nil {
+			return err
+		}
+		out.Cycles = append(out.Cycles, paths)
+	}
+
+	for _, c := range w.Graph.Conflicts() {
+		paths, err := w.rels(c.Paths)
+		if err != nil {
+			return err
+		}
+		out.Conflicts = append(out.Conflicts, jsonConflict{BaseName: c.BaseName, Paths: paths})
+	}
+
+	return w.encode(out)
+}
+
+func (w *writer) encode(v interface{}) error {
+	enc := json.NewEncoder(w.Out)
+	enc.SetIndent("", "  ")
+	return enc.Encode(v)
+}
+
+// DOT writes the include graph as a Graphviz DOT file. Edges that are part
+// of include cycles are colored red.
+func (w *writer) DOT() error {
+	inCycle := make(map[string]int) // path -> index of its cycle
+	for i, cycle := range w.Graph.Cycles() {
+		for _, path := range cycle {
+			inCycle[path] = i + 1
+		}
+	}
+
+	fmt.Fprintln(w.Out, "digraph includes {")
+	for _, path := range w.Graph.Paths() {
+		from, err := w.rel(path)
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(w.Out, "  %q;\n", from)
+
+		for _, inc := range w.Graph.Files[path].Includes {
+			to, err := w.rel(inc.Path)
+			if err != nil {
+				return err
+			}
+
+			attrs := fmt.Sprintf("label=%q", inc.Alias)
+			if c := inCycle[path]; c > 0 && c == inCycle[inc.Path] {
+				attrs += ", color=red"
+			}
+			fmt.Fprintf(w.Out, "  %q -> %q [%v];\n", from, to, attrs)
+		}
+	}
+	fmt.Fprintln(w.Out, "}")
 	return nil
 }
 
 func main() {
 	log.SetFlags(0) // so that the error message isn't noisy
-	if err := run(); err != nil {
+	if err := run(os.Args[1:], os.Stdout, os.Stderr); err != nil {
 		log.Fatal(err)
 	}
 }
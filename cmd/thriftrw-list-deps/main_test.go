@@ -1,10 +1,12 @@
 package main
 
 import (
+	"bytes"
 	"io/ioutil"
 	"os"
 	"path/filepath"
 	"sort"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -70,3 +72,163 @@ include "./c.thrift"`,
 		require.Error(t, err)
 	})
 }
+
+func TestRun(t *testing.T) {
+	tests := []struct {
+		desc       string
+		args       []string
+		want       string
+		wantStderr string
+	}{
+		{
+			desc: "list",
+			args: []string{"testdata/idl/d.thrift"},
+			want: "idl/a.thrift\nidl/b.thrift\nidl/c.thrift\n",
+		},
+		{
+			desc:       "list multiple roots",
+			args:       []string{"testdata/idl/b.thrift", "testdata/cycle/x.thrift"},
+			want:       "cycle/x.thrift\ncycle/y.thrift\ncycle/z.thrift\nidl/a.thrift\n",
+			wantStderr: "warning: include cycle between cycle/x.thrift, cycle/y.thrift, cycle/z.thrift\n",
+		},
+		{
+			desc: "reverse",
+			args: []string{"--reverse", "testdata/idl/a.thrift", "testdata/idl"},
+			want: "idl/b.thrift\nidl/c.thrift\nidl/d.thrift\n",
+		},
+		{
+			desc: "reverse json",
+			args: []string{"--reverse", "testdata/idl/c.thrift", "--format", "json", "testdata/idl"},
+			want: strings.Join([]string{
+				`{`,
+				`  "file": "idl/c.thrift",`,
+				`  "roots": [`,
+				`    "idl/d.thrift"`,
+				`  ]`,
+				`}`,
+				``,
+			}, "\n"),
+		},
+		{
+			desc: "json",
+			args: []string{"--format", "json", "testdata/conflict/root.thrift"},
+			want: strings.Join([]string{
+				`{`,
+				`  "roots": [`,
+				`    "conflict/root.thrift"`,
+				`  ],`,
+				`  "files": [`,
+				`    "conflict/common.thrift",`,
+				`    "conflict/nested/common.thrift",`,
+				`    "conflict/root.thrift"`,
+				`  ],`,
+				`  "edges": [`,
+				`    {`,
+				`      "from": "conflict/root.thrift",`,
+				`      "to": "conflict/common.thrift",`,
+				`      "alias": "common",`,
+				`      "line": 1`,
+				`    },`,
+				`    {`,
+				`      "from": "conflict/root.thrift",`,
+				`      "to": "conflict/nested/common.thrift",`,
+				`      "alias": "common",`,
+				`      "line": 2`,
+				`    }`,
+				`  ],`,
+				`  "cycles": [],`,
+				`  "conflicts": [`,
+				`    {`,
+				`      "basename": "common.thrift",`,
+				`      "paths": [`,
+				`        "conflict/common.thrift",`,
+				`        "conflict/nested/common.thrift"`,
+				`      ]`,
+				`    }`,
+				`  ]`,
+				`}`,
+				``,
+			}, "\n"),
+			wantStderr: `warning: files named "common.thrift" conflict: conflict/common.thrift, conflict/nested/common.thrift` + "\n",
+		},
+		{
+			desc: "dot",
+			args: []string{"--format", "dot", "testdata/cycle/x.thrift", "testdata/idl/b.thrift"},
+			want: strings.Join([]string{
+				`digraph includes {`,
+				`  "cycle/x.thrift";`,
+				`  "cycle/x.thrift" -> "cycle/y.thrift" [label="y", color=red];`,
+				`  "cycle/y.thrift";`,
+				`  "cycle/y.thrift" -> "cycle/z.thrift" [label="z", color=red];`,
+				`  "cycle/z.thrift";`,
+				`  "cycle/z.thrift" -> "cycle/x.thrift" [label="x", color=red];`,
+				`  "idl/a.thrift";`,
+				`  "idl/b.thrift";`,
+				`  "idl/b.thrift" -> "idl/a.thrift" [label="a"];`,
+				`}`,
+				``,
+			}, "\n"),
+			wantStderr: "warning: include cycle between cycle/x.thrift, cycle/y.thrift, cycle/z.thrift\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			var stdout, stderr bytes.Buffer
+			args := append([]string{"--relative-to", "testdata"}, tt.args...)
+			require.NoError(t, run(args, &stdout, &stderr))
+			assert.Equal(t, tt.want, stdout.String())
+
+			// Warnings are printed with absolute paths.
+			abs, err := filepath.Abs("testdata")
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantStderr, strings.Replace(stderr.String(), abs+"/", "", -1))
+		})
+	}
+}
+
+func TestRunErrors(t *testing.T) {
+	tests := []struct {
+		desc    string
+		args    []string
+		wantErr string
+	}{
+		{
+			desc:    "strict cycles",
+			args:    []string{"--strict", "testdata/cycle/x.thrift"},
+			wantErr: "found 1 include cycles and 0 base name conflicts",
+		},
+		{
+			desc:    "strict conflicts",
+			args:    []string{"--strict", "testdata/conflict"},
+			wantErr: "found 0 include cycles and 1 base name conflicts",
+		},
+		{
+			desc:    "reverse dot",
+			args:    []string{"--reverse", "testdata/idl/a.thrift", "--format", "dot", "testdata/idl"},
+			wantErr: "--reverse does not support the dot format",
+		},
+		{
+			desc:    "missing file",
+			args:    []string{"testdata/missing.thrift"},
+			wantErr: "missing.thrift",
+		},
+		{
+			desc:    "no files",
+			args:    []string{"testdata/conflict/nested/.."},
+			wantErr: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			err := run(tt.args, ioutil.Discard, ioutil.Discard)
+			if tt.wantErr == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+}
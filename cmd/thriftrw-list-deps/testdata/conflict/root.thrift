@@ -0,0 +1,2 @@
+include "./common.thrift"
+include "./nested/common.thrift"
@@ -0,0 +1 @@
+include "./y.thrift"
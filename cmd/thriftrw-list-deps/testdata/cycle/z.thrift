@@ -0,0 +1 @@
+include "./x.thrift"
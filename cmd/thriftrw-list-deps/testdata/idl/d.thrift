@@ -0,0 +1,2 @@
+include "./b.thrift"
+include "./c.thrift"
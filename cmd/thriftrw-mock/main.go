@@ -0,0 +1,146 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net"
+	"os"
+	"os/signal"
+	"sync"
+	"syscall"
+
+	"go.uber.org/thriftrw/compile"
+	"go.uber.org/thriftrw/mockserver"
+
+	"github.com/jessevdk/go-flags"
+)
+
+type options struct {
+	IDL      string `long:"idl" short:"i" value-name:"FILE" required:"yes" description:"Thrift file defining the services to mock."`
+	Listen   string `long:"listen" short:"l" value-name:"ADDRESS" default:"127.0.0.1:9090" description:"TCP address to listen on."`
+	Fixtures string `long:"fixtures" short:"f" value-name:"FILE" description:"JSON file holding the fixtures for each Service::method."`
+	Fallback string `long:"fallback" choice:"default" choice:"random" choice:"error" default:"default" description:"How to answer calls that don't match any fixture: with default values, random values, or a TApplicationException."`
+	Seed     int64  `long:"seed" value-name:"SEED" description:"Seed for random values."`
+	Unframed bool   `long:"unframed" description:"Serve the unframed transport instead of the framed one."`
+	Record   string `long:"record" value-name:"FILE" description:"File to record received calls to, one JSON object per line. Use - for stdout."`
+}
+
+var _fallbacks = map[string]mockserver.Fallback{
+	"default": mockserver.FallbackDefault,
+	"random":  mockserver.FallbackRandom,
+	"error":   mockserver.FallbackError,
+}
+
+// run serves until a value is received from stop.
+func run(args []string, stdout io.Writer, stop <-chan os.Signal) error {
+	var opts options
+	if _, err := flags.ParseArgs(&opts, args); err != nil {
+		return fmt.Errorf("error parsing arguments: %v", err)
+	}
+
+	m, err := compile.Compile(opts.IDL)
+	if err != nil {
+		return fmt.Errorf("could not compile %q: %v", opts.IDL, err)
+	}
+
+	var fixtures mockserver.Fixtures
+	if opts.Fixtures != "" {
+		f, err := os.Open(opts.Fixtures)
+		if err != nil {
+			return err
+		}
+		fixtures, err = mockserver.LoadFixtures(f)
+		f.Close()
+		if err != nil {
+			return fmt.Errorf("could not load %q: %v", opts.Fixtures, err)
+		}
+	}
+
+	srvOpts := mockserver.Options{
+		Unframed: opts.Unframed,
+		Fallback: _fallbacks[opts.Fallback],
+		Seed:     opts.Seed,
+	}
+
+	switch opts.Record {
+	case "":
+	case "-":
+		srvOpts.OnCall = recorder(stdout)
+	default:
+		f, err := os.Create(opts.Record)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		srvOpts.OnCall = recorder(f)
+	}
+
+	srv := mockserver.New(m, srvOpts)
+	if err := srv.AddFixtures(fixtures); err != nil {
+		return err
+	}
+
+	ln, err := net.Listen("tcp", opts.Listen)
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(stdout, "listening on %v\n", ln.Addr())
+
+	done := make(chan error, 1)
+	go func() { done <- srv.Serve(ln) }()
+
+	select {
+	case err := <-done:
+		return err
+	case <-stop:
+		if err := srv.Stop(); err != nil {
+			return err
+		}
+		return <-done
+	}
+}
+
+// recorder returns a function which writes calls to w as JSON, one per
+// line.
+func recorder(w io.Writer) func(mockserver.Call) {
+	var mu sync.Mutex
+	enc := json.NewEncoder(w)
+	return func(c mockserver.Call) {
+		mu.Lock()
+		defer mu.Unlock()
+		if err := enc.Encode(c); err != nil {
+			log.Printf("could not record call to %v: %v", c.Method, err)
+		}
+	}
+}
+
+func main() {
+	log.SetFlags(0) // so that the error message isn't noisy
+
+	stop := make(chan os.Signal, 1)
+	signal.Notify(stop, os.Interrupt, syscall.SIGTERM)
+	if err := run(os.Args[1:], os.Stdout, stop); err != nil {
+		log.Fatal(err)
+	}
+}
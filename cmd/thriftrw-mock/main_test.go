@@ -0,0 +1,132 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+package main
+
+import (
+	"bufio"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"go.uber.org/thriftrw/internal/kvtest"
+	"go.uber.org/thriftrw/wire"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// startMock runs the tool in the background. It returns the address it's
+// listening on and a function to stop it.
+func startMock(t *testing.T, args ...string) (string, func()) {
+	r, w := io.Pipe()
+	stop := make(chan os.Signal)
+	done := make(chan error)
+	go func() {
+		done <- run(append([]string{"-i", kvtest.IDL(), "-l", "127.0.0.1:0"}, args...), w, stop)
+		w.Close()
+	}()
+
+	lines := bufio.NewScanner(r)
+	require.True(t, lines.Scan(), "expected output")
+	addr := strings.TrimPrefix(lines.Text(), "listening on ")
+	go io.Copy(ioutil.Discard, r)
+
+	return addr, func() {
+		stop <- os.Interrupt
+		assert.NoError(t, <-done)
+	}
+}
+
+func call(t *testing.T, addr, method string, args ...wire.Field) wire.Envelope {
+	return kvtest.Call(t, addr, true, wire.Envelope{
+		Name:  method,
+		Type:  wire.Call,
+		SeqID: 1,
+		Value: wire.NewValueStruct(wire.Struct{Fields: args}),
+	})[0]
+}
+
+func TestMock(t *testing.T) {
+	dir, err := ioutil.TempDir("", "thriftrw-mock")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	record := filepath.Join(dir, "calls.json")
+
+	addr, stop := startMock(t, "--fixtures", "testdata/fixtures.json", "--record", record)
+
+	e := call(t, addr, "getItem", wire.Field{ID: 1, Value: wire.NewValueString("foo")})
+	assert.Equal(t, wire.Reply, e.Type)
+	assert.Equal(t,
+		"TStruct({0: TStruct({1: TBinary([102 111 111]), 3: TI32(1)})})",
+		e.Value.String())
+
+	e = call(t, addr, "getItem", wire.Field{ID: 1, Value: wire.NewValueString("bar")})
+	assert.Equal(t, "TStruct({1: TStruct({})})", e.Value.String())
+	stop()
+
+	b, err := ioutil.ReadFile(record)
+	require.NoError(t, err)
+	calls := strings.Split(strings.TrimSpace(string(b)), "\n")
+	require.Len(t, calls, 2)
+	assert.JSONEq(t, `{"method": "KeyValue::getItem", "seqid": 1, "args": {"key": "foo"}}`, calls[0])
+	assert.JSONEq(t, `{"method": "KeyValue::getItem", "seqid": 1, "args": {"key": "bar"}}`, calls[1])
+}
+
+func TestMockFallback(t *testing.T) {
+	addr, stop := startMock(t, "--fallback", "error")
+	defer stop()
+
+	e := call(t, addr, "healthy")
+	assert.Equal(t, wire.Exception, e.Type)
+}
+
+func TestMockErrors(t *testing.T) {
+	tests := []struct {
+		desc    string
+		args    []string
+		wantErr string
+	}{
+		{
+			desc:    "missing IDL",
+			wantErr: "the required flag `-i, --idl' was not specified",
+		},
+		{
+			desc:    "missing fixtures",
+			args:    []string{"-i", kvtest.IDL(), "-f", "testdata/missing.json"},
+			wantErr: "missing.json",
+		},
+		{
+			desc:    "invalid fallback",
+			args:    []string{"-i", kvtest.IDL(), "--fallback", "panic"},
+			wantErr: "Invalid value `panic'",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			err := run(tt.args, ioutil.Discard, nil)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+}
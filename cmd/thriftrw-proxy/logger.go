@@ -0,0 +1,84 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"sync"
+
+	"go.uber.org/thriftrw/compile"
+	"go.uber.org/thriftrw/internal/dump"
+	"go.uber.org/thriftrw/internal/schema"
+	"go.uber.org/thriftrw/protocol"
+)
+
+// Messages are sent either by the client or by the server.
+const (
+	_fromClient = "client"
+	_fromServer = "server"
+)
+
+// logger decodes messages and logs them.
+type logger struct {
+	mu     sync.Mutex
+	w      io.Writer
+	module *compile.Module // nil if no Thrift file was given
+}
+
+// Log logs a message sent over the given connection.
+func (l *logger) Log(conn int, from string, msg []byte) {
+	var buff bytes.Buffer
+	fmt.Fprintf(&buff, "[%v] %v > ", conn, from)
+	l.dump(&buff, msg)
+	l.write(buff.Bytes())
+}
+
+// Printf logs a note about the given connection.
+func (l *logger) Printf(conn int, format string, args ...interface{}) {
+	l.write([]byte(fmt.Sprintf("[%v] ", conn) + fmt.Sprintf(format, args...) + "\n"))
+}
+
+func (l *logger) write(b []byte) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.w.Write(b)
+}
+
+// dump decodes the message and prints it. Messages for functions that
+// aren't defined in the Thrift file are printed with their fields by ID.
+func (l *logger) dump(w io.Writer, msg []byte) {
+	e, err := protocol.Binary.DecodeEnveloped(bytes.NewReader(msg))
+	if err != nil {
+		fmt.Fprintf(w, "could not decode message: %v\n", err)
+		return
+	}
+
+	var t compile.TypeSpec
+	if l.module != nil {
+		if spec, err := schema.EnvelopeStruct(l.module, e); err == nil {
+			t = spec
+		}
+	}
+	if err := dump.Envelope(w, t, e); err != nil {
+		fmt.Fprintf(w, "could not print message: %v\n", err)
+	}
+}
@@ -0,0 +1,132 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+package main
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"go.uber.org/thriftrw/compile"
+
+	"github.com/jessevdk/go-flags"
+)
+
+type options struct {
+	IDL      string `long:"idl" short:"i" value-name:"FILE" description:"Thrift file defining the services. Without this, fields are logged by ID."`
+	Listen   string `long:"listen" short:"l" value-name:"ADDRESS" default:"127.0.0.1:9091" description:"TCP address to accept clients on."`
+	Unframed bool   `long:"unframed" description:"Forward the unframed transport instead of the framed one."`
+	Record   string `long:"record" value-name:"FILE" description:"File to record the session to, for use with --replay."`
+	Replay   string `long:"replay" value-name:"FILE" description:"Instead of accepting clients, replay the calls recorded in the given file against the server."`
+	Args     struct {
+		Server string `positional-arg-name:"host:port" required:"yes" description:"Address of the Thrift server."`
+	} `positional-args:"yes"`
+}
+
+// run proxies until a value is received from stop.
+func run(args []string, stdout io.Writer, stop <-chan os.Signal) error {
+	var opts options
+	if _, err := flags.ParseArgs(&opts, args); err != nil {
+		return fmt.Errorf("error parsing arguments: %v", err)
+	}
+
+	l := &logger{w: stdout}
+	if opts.IDL != "" {
+		m, err := compile.Compile(opts.IDL)
+		if err != nil {
+			return fmt.Errorf("could not compile %q: %v", opts.IDL, err)
+		}
+		l.module = m
+	}
+
+	if opts.Replay != "" {
+		if opts.Record != "" {
+			return errors.New("--record cannot be used with --replay")
+		}
+		return replay(&opts, l, stdout)
+	}
+
+	var rec *recorder
+	if opts.Record != "" {
+		f, err := os.Create(opts.Record)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		rec = newRecorder(f)
+	}
+
+	ln, err := net.Listen("tcp", opts.Listen)
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(stdout, "listening on %v, forwarding to %v\n", ln.Addr(), opts.Args.Server)
+
+	p := newProxy(opts.Args.Server, !opts.Unframed, l, rec)
+	done := make(chan error, 1)
+	go func() { done <- p.Serve(ln) }()
+
+	select {
+	case err := <-done:
+		p.Stop()
+		return err
+	case <-stop:
+		err := ln.Close()
+		p.Stop()
+		<-done
+		return err
+	}
+}
+
+func replay(opts *options, l *logger, stdout io.Writer) error {
+	f, err := os.Open(opts.Replay)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	records, err := readSession(f)
+	if err != nil {
+		return fmt.Errorf("could not read %q: %v", opts.Replay, err)
+	}
+
+	r := replayer{server: opts.Args.Server, framed: !opts.Unframed, log: l}
+	if err := r.Replay(records); err != nil {
+		return err
+	}
+
+	_, err = fmt.Fprintf(stdout, "replayed %v calls, %v replies differed from the recording\n", r.calls, r.differed)
+	return err
+}
+
+func main() {
+	log.SetFlags(0) // so that the error message isn't noisy
+
+	stop := make(chan os.Signal, 1)
+	signal.Notify(stop, os.Interrupt, syscall.SIGTERM)
+	if err := run(os.Args[1:], os.Stdout, stop); err != nil {
+		log.Fatal(err)
+	}
+}
@@ -0,0 +1,289 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+package main
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"go.uber.org/thriftrw/compile"
+	"go.uber.org/thriftrw/internal/kvtest"
+	"go.uber.org/thriftrw/internal/transport"
+	"go.uber.org/thriftrw/mockserver"
+	"go.uber.org/thriftrw/wire"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// syncBuffer is a bytes.Buffer that may be written to concurrently.
+type syncBuffer struct {
+	mu sync.Mutex
+	b  bytes.Buffer
+}
+
+func (b *syncBuffer) Write(p []byte) (int, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.b.Write(p)
+}
+
+func (b *syncBuffer) String() string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.b.String()
+}
+
+// startServer starts a mock server which returns the given item for all
+// getItem calls.
+func startServer(t *testing.T, key string, framed bool) *mockserver.Server {
+	m, err := compile.Compile(kvtest.IDL())
+	require.NoError(t, err)
+
+	srv := mockserver.New(m, mockserver.Options{Unframed: !framed})
+	require.NoError(t, srv.Add("KeyValue::getItem", mockserver.Fixture{
+		Result: map[string]interface{}{"key": key, "status": "STALE"},
+	}))
+	require.NoError(t, srv.Start("127.0.0.1:0"))
+	return srv
+}
+
+// startProxy runs the proxy in the background. It returns the address it's
+// listening on and a function to stop it.
+func startProxy(t *testing.T, stdout *syncBuffer, args ...string) (string, func()) {
+	stop := make(chan os.Signal)
+	done := make(chan error)
+	go func() {
+		done <- run(append([]string{"-l", "127.0.0.1:0"}, args...), stdout, stop)
+	}()
+
+	listening := regexp.MustCompile(`listening on (\S+),`)
+	for i := 0; ; i++ {
+		if m := listening.FindStringSubmatch(stdout.String()); m != nil {
+			return m[1], func() {
+				stop <- os.Interrupt
+				assert.NoError(t, <-done)
+			}
+		}
+		require.True(t, i < 1000, "proxy did not start")
+		time.Sleep(time.Millisecond)
+	}
+}
+
+func getItem(seqID int32, key string) wire.Envelope {
+	return wire.Envelope{
+		Name:  "getItem",
+		Type:  wire.Call,
+		SeqID: seqID,
+		Value: wire.NewValueStruct(wire.Struct{Fields: []wire.Field{
+			{ID: 1, Value: wire.NewValueString(key)},
+		}}),
+	}
+}
+
+func touch(key string) wire.Envelope {
+	return wire.Envelope{
+		Name:  "touch",
+		Type:  wire.OneWay,
+		SeqID: 3,
+		Value: wire.NewValueStruct(wire.Struct{Fields: []wire.Field{
+			{ID: 1, Value: wire.NewValueString(key)},
+		}}),
+	}
+}
+
+func TestProxy(t *testing.T) {
+	for _, framed := range []bool{true, false} {
+		srv := startServer(t, "foo", framed)
+
+		args := []string{"-i", kvtest.IDL(), srv.Addr().String()}
+		if !framed {
+			args = append(args, "--unframed")
+		}
+
+		var stdout syncBuffer
+		addr, stop := startProxy(t, &stdout, args...)
+
+		replies := kvtest.Call(t, addr, framed, getItem(1, "foo"), touch("bar"), getItem(2, "baz"))
+		require.Len(t, replies, 2)
+		assert.Equal(t, int32(2), replies[1].SeqID)
+		assert.Equal(t, "TStruct({0: TStruct({1: TBinary([102 111 111]), 3: TI32(1)})})", replies[0].Value.String())
+
+		for len(srv.Calls()) < 3 {
+			time.Sleep(time.Millisecond) // wait for the oneway call
+		}
+		stop()
+		require.NoError(t, srv.Stop())
+
+		out := stdout.String()
+		for _, want := range []string{
+			strings.Join([]string{
+				`[1] client > Call getItem (seqid 1)`,
+				`KeyValue_getItem_Args{`,
+				`  key (1): "foo"`,
+				`}`,
+				`[1] server > Reply getItem (seqid 1)`,
+				`KeyValue_getItem_Result{`,
+				`  success (0): Item{`,
+				`    key (1): "foo"`,
+				`    status (3): STALE (1)`,
+				`  }`,
+				`}`,
+			}, "\n"),
+			"[1] client > OneWay touch (seqid 3)\nKeyValue_touch_Args{\n  key (1): \"bar\"\n}\n",
+			"[1] client > Call getItem (seqid 2)\n",
+			"[1] connection closed\n",
+		} {
+			assert.Contains(t, out, want, "framed: %v", framed)
+		}
+	}
+}
+
+func TestProxyWithoutIDL(t *testing.T) {
+	srv := startServer(t, "foo", true)
+	defer srv.Stop()
+
+	var stdout syncBuffer
+	addr, stop := startProxy(t, &stdout, srv.Addr().String())
+	kvtest.Call(t, addr, true, getItem(1, "foo"))
+	stop()
+
+	assert.Contains(t, stdout.String(),
+		"[1] client > Call getItem (seqid 1)\nTStruct({1: TBinary([102 111 111])})\n")
+}
+
+func TestProxyServerUnavailable(t *testing.T) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	server := ln.Addr().String()
+	require.NoError(t, ln.Close())
+
+	var stdout syncBuffer
+	addr, stop := startProxy(t, &stdout, server)
+
+	conn, err := net.Dial("tcp", addr)
+	require.NoError(t, err)
+	_, err = transport.NewReader(conn, true).Read()
+	assert.Error(t, err, "connection must be closed")
+	conn.Close()
+	stop()
+
+	assert.Contains(t, stdout.String(), "[1] could not connect to the server")
+}
+
+func TestRecordReplay(t *testing.T) {
+	dir, err := ioutil.TempDir("", "thriftrw-proxy")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	session := filepath.Join(dir, "session.json")
+
+	srv := startServer(t, "foo", true)
+	var stdout syncBuffer
+	addr, stop := startProxy(t, &stdout, "-i", kvtest.IDL(), "--record", session, srv.Addr().String())
+	kvtest.Call(t, addr, true, getItem(1, "foo"), touch("bar"))
+	kvtest.Call(t, addr, true, getItem(1, "baz"))
+	for len(srv.Calls()) < 3 {
+		time.Sleep(time.Millisecond) // wait for the oneway call
+	}
+	stop()
+	require.NoError(t, srv.Stop())
+
+	records, err := func() ([]record, error) {
+		f, err := os.Open(session)
+		require.NoError(t, err)
+		defer f.Close()
+		return readSession(f)
+	}()
+	require.NoError(t, err)
+	// Messages of different connections may be interleaved.
+	var froms []string
+	for _, rec := range records {
+		if rec.Conn == 2 {
+			froms = append(froms, rec.From)
+		}
+	}
+	require.Len(t, records, 5)
+	assert.Equal(t, []string{_fromClient, _fromServer}, froms)
+
+	t.Run("same server", func(t *testing.T) {
+		srv := startServer(t, "foo", true)
+		defer srv.Stop()
+
+		var stdout bytes.Buffer
+		require.NoError(t, run([]string{"-i", kvtest.IDL(), "--replay", session, srv.Addr().String()}, &stdout, nil))
+		assert.Contains(t, stdout.String(), "[2] client > Call getItem (seqid 1)\n")
+		assert.Contains(t, stdout.String(), "replayed 3 calls, 0 replies differed from the recording\n")
+
+		for len(srv.Calls()) < 3 {
+			time.Sleep(time.Millisecond) // wait for the oneway call
+		}
+		assert.Len(t, srv.CallsTo("KeyValue::touch"), 1)
+		assert.Len(t, srv.CallsTo("KeyValue::getItem"), 2)
+	})
+
+	t.Run("different server", func(t *testing.T) {
+		srv := startServer(t, "qux", true)
+		defer srv.Stop()
+
+		var stdout bytes.Buffer
+		require.NoError(t, run([]string{"--replay", session, srv.Addr().String()}, &stdout, nil))
+		assert.Contains(t, stdout.String(), "[1] reply to getItem (seqid 1) differs from the recording\n")
+		assert.Contains(t, stdout.String(), "replayed 3 calls, 2 replies differed from the recording\n")
+	})
+}
+
+func TestRunErrors(t *testing.T) {
+	tests := []struct {
+		desc    string
+		args    []string
+		wantErr string
+	}{
+		{
+			desc:    "missing server",
+			wantErr: "the required argument `host:port` was not provided",
+		},
+		{
+			desc:    "record and replay",
+			args:    []string{"--record", "a.json", "--replay", "b.json", "localhost:9090"},
+			wantErr: "--record cannot be used with --replay",
+		},
+		{
+			desc:    "missing session",
+			args:    []string{"--replay", "testdata/missing.json", "localhost:9090"},
+			wantErr: "missing.json",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			err := run(tt.args, ioutil.Discard, nil)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+}
@@ -0,0 +1,171 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+package main
+
+import (
+	"net"
+	"sync"
+
+	"go.uber.org/thriftrw/internal/transport"
+)
+
+// proxy forwards messages between clients and a server, logging and
+// optionally recording them on the way.
+type proxy struct {
+	server string
+	framed bool
+	log    *logger
+	rec    *recorder // nil if the session isn't recorded
+
+	mu      sync.Mutex
+	conns   map[net.Conn]struct{}
+	lastID  int
+	stopped bool
+
+	wg sync.WaitGroup
+}
+
+func newProxy(server string, framed bool, log *logger, rec *recorder) *proxy {
+	return &proxy{
+		server: server,
+		framed: framed,
+		log:    log,
+		rec:    rec,
+		conns:  make(map[net.Conn]struct{}),
+	}
+}
+
+// Serve accepts clients from the given listener until it's closed.
+func (p *proxy) Serve(ln net.Listener) error {
+	for {
+		client, err := ln.Accept()
+		if err != nil {
+			p.mu.Lock()
+			stopped := p.stopped
+			p.mu.Unlock()
+
+			if stopped {
+				return nil
+			}
+			return err
+		}
+
+		p.mu.Lock()
+		if p.stopped {
+			p.mu.Unlock()
+			client.Close()
+			return nil
+		}
+		p.lastID++
+		id := p.lastID
+		p.conns[client] = struct{}{}
+		p.wg.Add(1)
+		p.mu.Unlock()
+
+		go p.handle(id, client)
+	}
+}
+
+// Stop closes all open connections and waits for them to be released.
+// The listener must be closed separately.
+func (p *proxy) Stop() {
+	p.mu.Lock()
+	p.stopped = true
+	for conn := range p.conns {
+		conn.Close()
+	}
+	p.mu.Unlock()
+
+	p.wg.Wait()
+}
+
+// handle proxies a client connection to a new connection to the server.
+func (p *proxy) handle(id int, client net.Conn) {
+	defer p.wg.Done()
+	defer p.untrack(client)
+
+	p.log.Printf(id, "connection from %v", client.RemoteAddr())
+	server, err := net.Dial("tcp", p.server)
+	if err != nil {
+		p.log.Printf(id, "could not connect to the server: %v", err)
+		return
+	}
+	p.track(server)
+	defer p.untrack(server)
+
+	var wg sync.WaitGroup
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		p.forward(id, _fromClient, client, server)
+	}()
+	go func() {
+		defer wg.Done()
+		p.forward(id, _fromServer, server, client)
+	}()
+	wg.Wait()
+
+	p.log.Printf(id, "connection closed")
+}
+
+// forward forwards messages from src to dst until either is closed. Both
+// connections are closed once it returns.
+func (p *proxy) forward(id int, from string, src, dst net.Conn) {
+	defer src.Close()
+	defer dst.Close()
+
+	r := transport.NewReader(src, p.framed)
+	w := transport.NewWriter(dst, p.framed)
+	for {
+		msg, err := r.Read()
+		if err != nil {
+			return
+		}
+
+		p.log.Log(id, from, msg)
+		if p.rec != nil {
+			if err := p.rec.Record(id, from, msg); err != nil {
+				p.log.Printf(id, "could not record message: %v", err)
+			}
+		}
+
+		if err := w.Write(msg); err != nil {
+			return
+		}
+	}
+}
+
+func (p *proxy) track(conn net.Conn) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.stopped {
+		conn.Close()
+	}
+	p.conns[conn] = struct{}{}
+}
+
+func (p *proxy) untrack(conn net.Conn) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	conn.Close()
+	delete(p.conns, conn)
+}
@@ -0,0 +1,167 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"sync"
+	"time"
+
+	"go.uber.org/thriftrw/internal/transport"
+	"go.uber.org/thriftrw/protocol"
+	"go.uber.org/thriftrw/wire"
+)
+
+// record is a message in a recorded session. Sessions are recorded as one
+// JSON object per line.
+type record struct {
+	Conn    int       `json:"conn"`
+	From    string    `json:"from"`
+	Time    time.Time `json:"time"`
+	Message []byte    `json:"message"`
+}
+
+// recorder records messages to a session file.
+type recorder struct {
+	mu  sync.Mutex
+	enc *json.Encoder
+}
+
+func newRecorder(w io.Writer) *recorder {
+	return &recorder{enc: json.NewEncoder(w)}
+}
+
+// Record records a message sent over the given connection.
+func (r *recorder) Record(conn int, from string, msg []byte) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.enc.Encode(record{Conn: conn, From: from, Time: time.Now(), Message: msg})
+}
+
+// readSession reads the records of a session.
+func readSession(r io.Reader) ([]record, error) {
+	var records []record
+	dec := json.NewDecoder(r)
+	for {
+		var rec record
+		err := dec.Decode(&rec)
+		if err == io.EOF {
+			return records, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("could not read record %v: %v", len(records), err)
+		}
+		records = append(records, rec)
+	}
+}
+
+// replayer replays the calls of a recorded session against a server.
+type replayer struct {
+	server string
+	framed bool
+	log    *logger
+
+	// Number of calls replayed, and the number of their replies that
+	// differed from the recording.
+	calls, differed int
+}
+
+// Replay replays the calls made over each connection of the session in
+// turn, each over a new connection to the server. The replies are logged
+// and compared with the recorded replies.
+func (r *replayer) Replay(records []record) error {
+	byConn := make(map[int][]record)
+	var conns []int
+	for _, rec := range records {
+		if _, ok := byConn[rec.Conn]; !ok {
+			conns = append(conns, rec.Conn)
+		}
+		byConn[rec.Conn] = append(byConn[rec.Conn], rec)
+	}
+
+	for _, id := range conns {
+		if err := r.replayConn(id, byConn[id]); err != nil {
+			return fmt.Errorf("could not replay connection %v: %v", id, err)
+		}
+	}
+	return nil
+}
+
+func (r *replayer) replayConn(id int, records []record) error {
+	// Recorded replies, keyed by their sequence IDs.
+	replies := make(map[int32][][]byte)
+	for _, rec := range records {
+		if rec.From != _fromServer {
+			continue
+		}
+		e, err := protocol.Binary.DecodeEnveloped(bytes.NewReader(rec.Message))
+		if err != nil {
+			continue
+		}
+		replies[e.SeqID] = append(replies[e.SeqID], rec.Message)
+	}
+
+	conn, err := net.Dial("tcp", r.server)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	w := transport.NewWriter(conn, r.framed)
+	rd := transport.NewReader(conn, r.framed)
+	for _, rec := range records {
+		if rec.From != _fromClient {
+			continue
+		}
+
+		e, err := protocol.Binary.DecodeEnveloped(bytes.NewReader(rec.Message))
+		if err != nil {
+			return fmt.Errorf("could not decode recorded call: %v", err)
+		}
+
+		r.log.Log(id, _fromClient, rec.Message)
+		if err := w.Write(rec.Message); err != nil {
+			return err
+		}
+		r.calls++
+		if e.Type == wire.OneWay {
+			continue
+		}
+
+		res, err := rd.Read()
+		if err != nil {
+			return fmt.Errorf("could not read reply to %v: %v", e.Name, err)
+		}
+		r.log.Log(id, _fromServer, res)
+
+		if recorded := replies[e.SeqID]; len(recorded) > 0 {
+			replies[e.SeqID] = recorded[1:]
+			if !bytes.Equal(recorded[0], res) {
+				r.differed++
+				r.log.Printf(id, "reply to %v (seqid %v) differs from the recording", e.Name, e.SeqID)
+			}
+		}
+	}
+	return nil
+}
@@ -59,6 +59,8 @@ type compiler struct {
 	fs FS
 	// nonStrict will compile Thrift files that do not pass strict validation.
 	nonStrict bool
+	// Scopes for which a Thrift file may declare only one namespace.
+	uniqueNamespaces map[string]struct{}
 	// Map from file path to Module representing that file.
 	Modules map[string]*Module
 }
@@ -145,6 +147,7 @@ func (c compiler) load(p string) (*Module, error) {
 		Constants:  make(map[string]*Constant),
 		Types:      make(map[string]TypeSpec),
 		Services:   make(map[string]*ServiceSpec),
+		Namespaces: make(map[string]*Namespace),
 	}
 
 	m.Raw = s
@@ -174,6 +177,13 @@ func (c compiler) gather(m *Module, prog *ast.Program) error {
 
 	// Process all included modules first.
 	for _, h := range prog.Headers {
+		if ns, ok := h.(*ast.Namespace); ok {
+			if err := c.gatherNamespace(m, ns); err != nil {
+				return namespaceError{Namespace: ns, Reason: err}
+			}
+			continue
+		}
+
 		header, ok := h.(*ast.Include)
 		if !ok {
 			continue
@@ -242,6 +252,31 @@ func (c compiler) gather(m *Module, prog *ast.Program) error {
 	return nil
 }
 
+// gatherNamespace records the given namespace header in the Module.
+//
+// If the Thrift file declares more than one namespace for the same scope,
+// the last declaration is used unless the scope was marked with
+// UniqueNamespace.
+func (c compiler) gatherNamespace(m *Module, ns *ast.Namespace) error {
+	if _, ok := m.Namespaces[ns.Scope]; ok {
+		if _, unique := c.uniqueNamespaces[ns.Scope]; unique {
+			return namespaceConflictError{Scope: ns.Scope}
+		}
+	}
+
+	annotations, err := compileAnnotations(ns.Annotations)
+	if err != nil {
+		return err
+	}
+
+	m.Namespaces[ns.Scope] = &Namespace{
+		Scope:       ns.Scope,
+		Name:        ns.Name,
+		Annotations: annotations,
+	}
+	return nil
+}
+
 // include loads the file specified by the given include in the given Module.
 //
 // The path to the file is relative to the ThriftPath of the given module.
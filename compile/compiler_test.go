@@ -86,3 +86,54 @@ func TestCompileNonStrict(t *testing.T) {
 	require.NoError(t, err, "Failed to find UUID field in struct")
 	assert.False(t, uuidField.Required, "Unspecified requiredness should be treated as optional")
 }
+
+func TestCompileNamespaces(t *testing.T) {
+	files := map[string]string{
+		"/some/prefix/main.thrift": `
+			namespace go foo.bar (go.package = "example.com/foo/bar")
+			namespace * baz
+		`,
+		"/some/prefix/conflict.thrift": `
+			namespace go foo
+			namespace py foo
+			namespace go bar
+			namespace py bar
+		`,
+		"/some/prefix/annotations.thrift": `
+			namespace go foo (a = "b", a = "c")
+		`,
+	}
+
+	fs := dummyFS{"/some/prefix/", files}
+
+	module, err := Compile("main.thrift", Filesystem(fs))
+	require.NoError(t, err, "Compile failed")
+	assert.Equal(t, map[string]*Namespace{
+		"go": {
+			Scope:       "go",
+			Name:        "foo.bar",
+			Annotations: Annotations{"go.package": "example.com/foo/bar"},
+		},
+		"*": {Scope: "*", Name: "baz"},
+	}, module.Namespaces)
+
+	module, err = Compile("conflict.thrift", Filesystem(fs))
+	require.NoError(t, err, "the last namespace for a scope must be used by default")
+	assert.Equal(t, map[string]*Namespace{
+		"go": {Scope: "go", Name: "bar"},
+		"py": {Scope: "py", Name: "bar"},
+	}, module.Namespaces)
+
+	_, err = Compile("conflict.thrift", Filesystem(fs), UniqueNamespace("go"))
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(),
+			`cannot declare namespace "bar" for "go" on line 4: `+
+				`a namespace for "go" has already been declared`)
+	}
+
+	_, err = Compile("annotations.thrift", Filesystem(fs))
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), `cannot declare namespace "foo" for "go" on line 2`)
+		assert.Contains(t, err.Error(), "annotation conflict")
+	}
+}
@@ -25,6 +25,7 @@ import (
 	"fmt"
 
 	"go.uber.org/thriftrw/ast"
+	"go.uber.org/thriftrw/internal/goint"
 )
 
 // ConstantValue represents a compiled constant value or a reference to one.
@@ -90,6 +91,17 @@ func (c ConstantInt) Link(scope Scope, t TypeSpec) (ConstantValue, error) {
 	switch spec := rt.(type) {
 	case *I8Spec, *I16Spec, *I32Spec, *I64Spec:
 		// TODO bounds checks?
+		// Integer types may be represented with narrower or unsigned Go
+		// types with go.type.
+		goType, ok := goint.Lookup(rt.ThriftAnnotations()[goint.Annotation])
+		if ok && (int64(c) < goType.Min() || int64(c) > goType.Max()) {
+			return nil, constantValueCastError{
+				Value: c,
+				Type:  t,
+				Reason: fmt.Errorf("%v is out of range for %v: expected a value in [%v, %v]",
+					int64(c), goType.Name, goType.Min(), goType.Max()),
+			}
+		}
 		return c, nil
 	case *DoubleSpec:
 		return ConstantDouble(float64(c)).Link(scope, t)
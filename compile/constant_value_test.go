@@ -228,6 +228,24 @@ func TestCastConstants(t *testing.T) {
 			give:      ConstantInt(3),
 			wantError: `3 is not a valid value for enum "Role"`,
 		},
+		{
+			desc: "ConstantInt: unsigned Go type",
+			typ:  &I32Spec{Annotations: Annotations{"go.type": "uint32"}},
+			give: ConstantInt(4294967295),
+			want: ConstantInt(4294967295),
+		},
+		{
+			desc:      "ConstantInt: negative unsigned Go type",
+			typ:       &I64Spec{Annotations: Annotations{"go.type": "uint64"}},
+			give:      ConstantInt(-1),
+			wantError: `-1 is out of range for uint64: expected a value in [0, 9223372036854775807]`,
+		},
+		{
+			desc:      "ConstantInt: narrower Go type",
+			typ:       &I32Spec{Annotations: Annotations{"go.type": "int8"}},
+			give:      ConstantInt(200),
+			wantError: `200 is out of range for int8: expected a value in [-128, 127]`,
+		},
 		{
 			desc:      "ConstantInt: failure",
 			typ:       &StringSpec{},
@@ -0,0 +1,200 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package compile
+
+import (
+	"fmt"
+	"sort"
+)
+
+// deprecatedAnnotation marks a definition as deprecated, optionally with
+// the reason for the deprecation.
+//
+//   struct User {
+//     1: required string name
+//     2: optional string nickname (deprecated = "use name instead")
+//   } (deprecated)
+const deprecatedAnnotation = "deprecated"
+
+// Deprecation records that a definition was marked as deprecated with the
+// "deprecated" annotation. It may be used on structs, unions, exceptions,
+// fields, enums, enum items, typedefs, services, and functions.
+type Deprecation struct {
+	// Reason for the deprecation, if any. This is the value of the
+	// annotation.
+	Reason string
+}
+
+// compileDeprecation returns the Deprecation recorded in the given
+// annotations, or nil if the definition isn't deprecated.
+func compileDeprecation(annotations Annotations) *Deprecation {
+	reason, ok := annotations[deprecatedAnnotation]
+	if !ok {
+		return nil
+	}
+	return &Deprecation{Reason: reason}
+}
+
+// DeprecatedReference is a reference from a definition that isn't
+// deprecated to one that is.
+type DeprecatedReference struct {
+	// Thrift file in which the reference was made.
+	File string
+
+	// Definition making the reference, e.g. "User.nickname" for a field or
+	// "Users.getUser" for a function.
+	From string
+
+	// Name of the deprecated definition.
+	To string
+
+	Deprecation *Deprecation
+}
+
+func (r DeprecatedReference) String() string {
+	s := fmt.Sprintf("%v: %v references deprecated %v", r.File, r.From, r.To)
+	if r.Deprecation.Reason != "" {
+		s += ": " + r.Deprecation.Reason
+	}
+	return s
+}
+
+// FindDeprecatedReferences lists references made by definitions in the given
+// module and the modules it includes to deprecated types and services.
+// Definitions which are themselves deprecated are skipped.
+//
+// References are sorted by file and by the name of the referring definition.
+func FindDeprecatedReferences(m *Module) []DeprecatedReference {
+	var refs []DeprecatedReference
+	_ = m.Walk(func(m *Module) error {
+		check := func(from string, spec TypeSpec) {
+			for _, to := range deprecatedTypes(spec) {
+				refs = append(refs, DeprecatedReference{
+					File:        m.ThriftPath,
+					From:        from,
+					To:          to.ThriftName(),
+					Deprecation: typeDeprecation(to),
+				})
+			}
+		}
+
+		checkFields := func(prefix string, fields FieldGroup) {
+			for _, f := range fields {
+				if f.Deprecated == nil {
+					check(prefix+"."+f.Name, f.Type)
+				}
+			}
+		}
+
+		for _, c := range m.Constants {
+			check(c.Name, c.Type)
+		}
+
+		for _, t := range m.Types {
+			switch t := t.(type) {
+			case *StructSpec:
+				if t.Deprecated == nil {
+					checkFields(t.Name, t.Fields)
+				}
+			case *TypedefSpec:
+				if t.Deprecated == nil {
+					check(t.Name, t.Target)
+				}
+			}
+		}
+
+		for _, s := range m.Services {
+			if s.Deprecated != nil {
+				continue
+			}
+
+			if p := s.Parent; p != nil && p.Deprecated != nil {
+				refs = append(refs, DeprecatedReference{
+					File:        m.ThriftPath,
+					From:        s.Name,
+					To:          p.Name,
+					Deprecation: p.Deprecated,
+				})
+			}
+
+			for _, f := range s.Functions {
+				if f.Deprecated != nil {
+					continue
+				}
+
+				from := s.Name + "." + f.Name
+				checkFields(from, FieldGroup(f.ArgsSpec))
+				if f.ResultSpec != nil {
+					if f.ResultSpec.ReturnType != nil {
+						check(from, f.ResultSpec.ReturnType)
+					}
+					checkFields(from, f.ResultSpec.Exceptions)
+				}
+			}
+		}
+		return nil
+	})
+
+	sort.Slice(refs, func(i, j int) bool {
+		l, r := refs[i], refs[j]
+		if l.File != r.File {
+			return l.File < r.File
+		}
+		if l.From != r.From {
+			return l.From < r.From
+		}
+		return l.To < r.To
+	})
+	return refs
+}
+
+// deprecatedTypes returns the deprecated types referenced by the given type,
+// including those referenced by containers.
+func deprecatedTypes(spec TypeSpec) []TypeSpec {
+	switch s := spec.(type) {
+	case *MapSpec:
+		return append(deprecatedTypes(s.KeySpec), deprecatedTypes(s.ValueSpec)...)
+	case *ListSpec:
+		return deprecatedTypes(s.ValueSpec)
+	case *SetSpec:
+		return deprecatedTypes(s.ValueSpec)
+	default:
+		if typeDeprecation(spec) != nil {
+			return []TypeSpec{spec}
+		}
+		return nil
+	}
+}
+
+// typeDeprecation returns the Deprecation of the given user-defined type, or
+// nil if it isn't deprecated.
+func typeDeprecation(spec TypeSpec) *Deprecation {
+	switch s := spec.(type) {
+	case *StructSpec:
+		return s.Deprecated
+	case *EnumSpec:
+		return s.Deprecated
+	case *TypedefSpec:
+		return s.Deprecated
+	default:
+		return nil
+	}
+}
@@ -0,0 +1,103 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package compile
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeprecation(t *testing.T) {
+	files := map[string]string{
+		"/idl/main.thrift": `
+			include "./shared.thrift"
+
+			struct User {
+				1: required string name
+				2: optional shared.LegacyID legacyID
+				3: optional list<Role> roles
+				4: optional OldUser old (deprecated)
+			}
+
+			struct OldUser {
+				1: optional string name
+			} (deprecated = "Use User.")
+
+			enum Role {
+				ADMIN
+				GUEST (deprecated)
+			} (deprecated = "Roles are going away.")
+
+			service OldUsers {} (deprecated)
+
+			service Users extends OldUsers {
+				User getUser(1: shared.LegacyID id)
+				OldUser getOldUser() (deprecated)
+			}
+		`,
+		"/idl/shared.thrift": `
+			typedef string LegacyID (deprecated)
+		`,
+	}
+
+	m, err := Compile("main.thrift", Filesystem(dummyFS{"/idl/", files}))
+	require.NoError(t, err)
+
+	t.Run("specs", func(t *testing.T) {
+		spec, err := m.LookupType("OldUser")
+		require.NoError(t, err)
+		assert.Equal(t, &Deprecation{Reason: "Use User."}, spec.(*StructSpec).Deprecated)
+
+		spec, err = m.LookupType("User")
+		require.NoError(t, err)
+		user := spec.(*StructSpec)
+		assert.Nil(t, user.Deprecated)
+		assert.Equal(t, &Deprecation{}, user.Fields[3].Deprecated)
+
+		spec, err = m.LookupType("Role")
+		require.NoError(t, err)
+		role := spec.(*EnumSpec)
+		assert.Nil(t, role.Items[0].Deprecated)
+		assert.Equal(t, &Deprecation{}, role.Items[1].Deprecated)
+
+		svc, err := m.LookupService("Users")
+		require.NoError(t, err)
+		assert.Nil(t, svc.Deprecated)
+		assert.Nil(t, svc.Functions["getUser"].Deprecated)
+		assert.Equal(t, &Deprecation{}, svc.Functions["getOldUser"].Deprecated)
+		assert.Equal(t, &Deprecation{}, svc.Parent.Deprecated)
+	})
+
+	t.Run("references", func(t *testing.T) {
+		var got []string
+		for _, ref := range FindDeprecatedReferences(m) {
+			got = append(got, ref.String())
+		}
+		assert.Equal(t, []string{
+			"/idl/main.thrift: User.legacyID references deprecated LegacyID",
+			"/idl/main.thrift: User.roles references deprecated Role: Roles are going away.",
+			"/idl/main.thrift: Users references deprecated OldUsers",
+			"/idl/main.thrift: Users.getUser.id references deprecated LegacyID",
+		}, got)
+	})
+}
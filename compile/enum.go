@@ -32,6 +32,10 @@ type EnumSpec struct {
 	Items       []EnumItem
 	Annotations Annotations
 	Doc         string
+
+	// Deprecated is non-nil if this enum was marked with the deprecated
+	// annotation.
+	Deprecated *Deprecation
 }
 
 // EnumItem is a single item inside an enum.
@@ -40,6 +44,10 @@ type EnumItem struct {
 	Value       int32
 	Annotations Annotations
 	Doc         string
+
+	// Deprecated is non-nil if this item was marked with the deprecated
+	// annotation.
+	Deprecated *Deprecation
 }
 
 // compileEnum compiles the given Enum AST into an EnumSpec.
@@ -76,6 +84,7 @@ func compileEnum(file string, src *ast.Enum) (*EnumSpec, error) {
 			Value:       int32(value),
 			Doc:         astItem.Doc,
 			Annotations: itemAnnotations,
+			Deprecated:  compileDeprecation(itemAnnotations),
 		}
 		items = append(items, item)
 	}
@@ -94,6 +103,7 @@ func compileEnum(file string, src *ast.Enum) (*EnumSpec, error) {
 		Doc:         src.Doc,
 		Items:       items,
 		Annotations: annotations,
+		Deprecated:  compileDeprecation(annotations),
 	}, nil
 }
 
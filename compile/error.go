@@ -86,6 +86,30 @@ func (e includeError) Error() string {
 	)
 }
 
+// namespaceError is raised when there is an error processing a namespace
+// header.
+type namespaceError struct {
+	Namespace *ast.Namespace
+	Reason    error
+}
+
+func (e namespaceError) Error() string {
+	return fmt.Sprintf(
+		"cannot declare namespace %q for %q on line %d: %v",
+		e.Namespace.Name, e.Namespace.Scope, e.Namespace.Line, e.Reason,
+	)
+}
+
+// namespaceConflictError is raised when a Thrift file declares more than one
+// namespace for the same scope.
+type namespaceConflictError struct {
+	Scope string
+}
+
+func (e namespaceConflictError) Error() string {
+	return fmt.Sprintf("a namespace for %q has already been declared", e.Scope)
+}
+
 // definitionError is raised when there was an error compiling a definition
 // from the Thrift file.
 type definitionError struct {
@@ -103,6 +103,15 @@ type FieldSpec struct {
 	Doc         string
 	Default     ConstantValue
 	Annotations Annotations
+
+	// Redaction specifies whether and how the value of this field should be
+	// masked when it is rendered for humans. This is derived from the
+	// go.redact and sensitive annotations.
+	Redaction Redaction
+
+	// Deprecated is non-nil if this field was marked with the deprecated
+	// annotation.
+	Deprecated *Deprecation
 }
 
 // compileField compiles the given Field source into a FieldSpec.
@@ -132,6 +141,15 @@ func compileField(src *ast.Field, options fieldOptions) (*FieldSpec, error) {
 		}
 	}
 
+	redaction, err := compileRedaction(annotations)
+	if err != nil {
+		return nil, compileError{
+			Target: src.Name,
+			Line:   src.Line,
+			Reason: err,
+		}
+	}
+
 	typ, err := compileTypeReference(src.Type)
 	if err != nil {
 		return nil, compileError{
@@ -150,6 +168,8 @@ func compileField(src *ast.Field, options fieldOptions) (*FieldSpec, error) {
 		Required:    required,
 		Default:     compileConstantValue(src.Default),
 		Annotations: annotations,
+		Redaction:   redaction,
+		Deprecated:  compileDeprecation(annotations),
 	}, nil
 }
 
@@ -163,6 +183,9 @@ func (f *FieldSpec) Link(scope Scope) (err error) {
 	if f.Type, err = f.Type.Link(scope); err != nil {
 		return err
 	}
+	if err := validateRedaction(f.Redaction, f.Type); err != nil {
+		return compileError{Target: f.Name, Reason: err}
+	}
 	if f.Default != nil {
 		f.Default, err = f.Default.Link(scope, f.Type)
 	}
@@ -41,6 +41,11 @@ type Module struct {
 	Types     map[string]TypeSpec
 	Services  map[string]*ServiceSpec
 
+	// Mapping from language scope to the namespace declared for it with a
+	// `namespace` header. The scope is "*" for namespaces which apply to
+	// all languages.
+	Namespaces map[string]*Namespace
+
 	Raw []byte // The raw IDL input.
 }
 
@@ -124,3 +129,13 @@ type IncludedModule struct {
 	Name   string
 	Module *Module
 }
+
+// Namespace is a namespace declared by a Thrift file for code generated in a
+// specific language.
+//
+// 	namespace go foo.bar (go.package = "example.com/foo/bar")
+type Namespace struct {
+	Scope       string
+	Name        string
+	Annotations Annotations
+}
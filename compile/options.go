@@ -55,6 +55,18 @@ func Filesystem(fs FS) Option {
 	}
 }
 
+// UniqueNamespace makes it an error for a Thrift file to declare more than
+// one namespace for the given scope. By default, the last declaration is
+// used.
+func UniqueNamespace(scope string) Option {
+	return func(c *compiler) {
+		if c.uniqueNamespaces == nil {
+			c.uniqueNamespaces = make(map[string]struct{})
+		}
+		c.uniqueNamespaces[scope] = struct{}{}
+	}
+}
+
 // NonStrict disables strict validation of the Thrift file. This allows
 // struct fields which are not marked as optional or required.
 func NonStrict() Option {
@@ -0,0 +1,126 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package compile
+
+import "fmt"
+
+const (
+	// redactAnnotation marks a field as sensitive for Go code generation.
+	//
+	//   1: required string token (go.redact)
+	//   2: required string password (go.redact = "length")
+	redactAnnotation = "go.redact"
+
+	// sensitiveAnnotation is a language-neutral alias for redactAnnotation.
+	sensitiveAnnotation = "sensitive"
+)
+
+// Redaction specifies how the value of a sensitive field is masked when it
+// is rendered for humans, i.e. in String(), logs, and JSON output.
+//
+// Redaction does not affect how the field is serialized over the wire.
+type Redaction int
+
+const (
+	// RedactNone indicates that the field is not sensitive.
+	RedactNone Redaction = iota
+
+	// RedactValue masks the value of the field entirely.
+	RedactValue
+
+	// RedactLength masks the value of the field but retains its length.
+	// This is supported on string and binary fields only.
+	RedactLength
+
+	// RedactHash masks the value of the field but retains a SHA256 hash of
+	// it. This is supported on string and binary fields only.
+	RedactHash
+)
+
+var _redactionModes = map[string]Redaction{
+	"":       RedactValue,
+	"true":   RedactValue,
+	"length": RedactLength,
+	"hash":   RedactHash,
+}
+
+// String returns the annotation value corresponding to this Redaction.
+func (r Redaction) String() string {
+	switch r {
+	case RedactNone:
+		return "none"
+	case RedactValue:
+		return "value"
+	case RedactLength:
+		return "length"
+	case RedactHash:
+		return "hash"
+	default:
+		return fmt.Sprintf("Redaction(%d)", int(r))
+	}
+}
+
+// compileRedaction determines the Redaction for a field from its
+// annotations.
+func compileRedaction(annotations Annotations) (Redaction, error) {
+	redaction := RedactNone
+	for _, key := range []string{redactAnnotation, sensitiveAnnotation} {
+		value, ok := annotations[key]
+		if !ok {
+			continue
+		}
+
+		r, ok := _redactionModes[value]
+		if !ok {
+			return RedactNone, fmt.Errorf(
+				"unknown %v mode %q: expected one of "+
+					`"", "length", or "hash"`, key, value)
+		}
+
+		if redaction != RedactNone && redaction != r {
+			return RedactNone, fmt.Errorf(
+				"conflicting redaction modes: %v = %q and %v = %q",
+				redactAnnotation, annotations[redactAnnotation],
+				sensitiveAnnotation, annotations[sensitiveAnnotation])
+		}
+		redaction = r
+	}
+	return redaction, nil
+}
+
+// validateRedaction verifies that the given Redaction may be applied to a
+// field of the given type.
+func validateRedaction(r Redaction, t TypeSpec) error {
+	if r != RedactLength && r != RedactHash {
+		return nil
+	}
+
+	switch RootTypeSpec(t).(type) {
+	case nil, *StringSpec, *BinarySpec:
+		// A typedef that is still being linked does not have a root type
+		// yet.
+		return nil
+	default:
+		return fmt.Errorf(
+			"redaction mode %q is supported only on string and binary fields: "+
+				"cannot use it with %q", r, t.ThriftName())
+	}
+}
@@ -34,6 +34,11 @@ type ServiceSpec struct {
 	Parent      *ServiceSpec
 	Functions   map[string]*FunctionSpec
 	Annotations Annotations
+	Doc         string
+
+	// Deprecated is non-nil if this service was marked with the deprecated
+	// annotation.
+	Deprecated *Deprecation
 
 	parentSrc *ast.ServiceReference
 }
@@ -77,6 +82,8 @@ func compileService(file string, src *ast.Service) (*ServiceSpec, error) {
 		File:        file,
 		Functions:   functions,
 		Annotations: annotations,
+		Doc:         src.Doc,
+		Deprecated:  compileDeprecation(annotations),
 		parentSrc:   src.Parent,
 	}, nil
 }
@@ -166,6 +173,11 @@ type FunctionSpec struct {
 	ResultSpec  *ResultSpec // nil if OneWay is true
 	OneWay      bool
 	Annotations Annotations
+	Doc         string
+
+	// Deprecated is non-nil if this function was marked with the deprecated
+	// annotation.
+	Deprecated *Deprecation
 }
 
 func compileFunction(src *ast.Function) (*FunctionSpec, error) {
@@ -209,6 +221,8 @@ func compileFunction(src *ast.Function) (*FunctionSpec, error) {
 		ArgsSpec:    args,
 		ResultSpec:  result,
 		Annotations: annotations,
+		Doc:         src.Doc,
+		Deprecated:  compileDeprecation(annotations),
 		OneWay:      src.OneWay,
 	}, nil
 }
@@ -35,6 +35,10 @@ type StructSpec struct {
 	Fields      FieldGroup
 	Doc         string
 	Annotations Annotations
+
+	// Deprecated is non-nil if this struct was marked with the deprecated
+	// annotation.
+	Deprecated *Deprecation
 }
 
 // compileStruct compiles a struct AST into a StructSpec.
@@ -74,6 +78,7 @@ func compileStruct(file string, src *ast.Struct, requiredness fieldRequiredness,
 		Fields:      fields,
 		Doc:         src.Doc,
 		Annotations: annotations,
+		Deprecated:  compileDeprecation(annotations),
 	}, nil
 }
 
@@ -72,6 +72,37 @@ func TestCompileStructSuccess(t *testing.T) {
 				},
 			},
 		},
+		{
+			`struct Credentials {
+				1: required string token (go.redact)
+				2: optional binary key (sensitive = "hash")
+			}`,
+			nil,
+			explicitRequiredness,
+			false,
+			&StructSpec{
+				Name: "Credentials",
+				File: "test.thrift",
+				Type: ast.StructType,
+				Fields: FieldGroup{
+					{
+						ID:          1,
+						Name:        "token",
+						Type:        &StringSpec{},
+						Required:    true,
+						Annotations: Annotations{"go.redact": ""},
+						Redaction:   RedactValue,
+					},
+					{
+						ID:          2,
+						Name:        "key",
+						Type:        &BinarySpec{},
+						Annotations: Annotations{"sensitive": "hash"},
+						Redaction:   RedactHash,
+					},
+				},
+			},
+		},
 		{
 			"struct Health { 1: bool healthy = true }",
 			nil,
@@ -311,6 +342,18 @@ func TestCompileStructFailure(t *testing.T) {
 			`struct Foo { -1: optional string wat }`,
 			[]string{`field ID -1 of "wat" is out of bounds`},
 		},
+		{
+			"unknown redaction mode",
+			`struct Foo { 1: optional string token (go.redact = "rot13") }`,
+			[]string{`cannot compile "token"`, `unknown go.redact mode "rot13"`},
+		},
+		{
+			"conflicting redaction modes",
+			`struct Foo {
+				1: optional string token (go.redact = "length", sensitive = "hash")
+			}`,
+			[]string{`cannot compile "token"`, "conflicting redaction modes"},
+		},
 		{
 			"field ID too large",
 			`struct Foo { 139847139847: optional string too_many_fields }`,
@@ -355,6 +398,15 @@ func TestLinkStructFailure(t *testing.T) {
 				`could not resolve reference "DEFAULT_FOO"`,
 			},
 		},
+		{
+			"redaction mode not supported by type",
+			`struct Foo { 1: optional i64 pin (go.redact = "length") }`,
+			nil,
+			[]string{
+				`cannot compile "pin"`,
+				`redaction mode "length" is supported only on string and binary fields`,
+			},
+		},
 	}
 
 	for _, tt := range tests {
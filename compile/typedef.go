@@ -35,6 +35,10 @@ type TypedefSpec struct {
 	Annotations Annotations
 	Doc         string
 
+	// Deprecated is non-nil if this typedef was marked with the deprecated
+	// annotation.
+	Deprecated *Deprecation
+
 	root TypeSpec
 }
 
@@ -60,6 +64,7 @@ func compileTypedef(file string, src *ast.Typedef) (*TypedefSpec, error) {
 		Target:      typ,
 		Annotations: annotations,
 		Doc:         src.Doc,
+		Deprecated:  compileDeprecation(annotations),
 	}, nil
 }
 
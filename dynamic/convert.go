@@ -0,0 +1,426 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dynamic
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+
+	"go.uber.org/thriftrw/ast"
+	"go.uber.org/thriftrw/compile"
+)
+
+// ToMap converts the struct into a map from field names to values, suitable
+// for encoding/json. Only fields that are set are included.
+//
+// See the package documentation for the representation of values.
+func (s *Struct) ToMap() map[string]interface{} {
+	m := make(map[string]interface{}, len(s.values))
+	for _, f := range s.spec.Fields {
+		if v, ok := s.values[f.ID]; ok {
+			m[f.Name] = toMapValue(f.Type, v)
+		}
+	}
+	return m
+}
+
+func toMapValue(t compile.TypeSpec, v interface{}) interface{} {
+	switch spec := compile.RootTypeSpec(t).(type) {
+	case *compile.BinarySpec:
+		return base64.StdEncoding.EncodeToString(v.([]byte))
+	case *compile.EnumSpec:
+		for _, item := range spec.Items {
+			if item.Value == v.(int32) {
+				return item.Name
+			}
+		}
+		// Unknown enum values are retained as-is.
+		return v
+	case *compile.StructSpec:
+		return v.(*Struct).ToMap()
+	case *compile.ListSpec:
+		return itemsToMap(spec.ValueSpec, v.([]interface{}))
+	case *compile.SetSpec:
+		return itemsToMap(spec.ValueSpec, v.([]interface{}))
+	case *compile.MapSpec:
+		items := v.([]MapItem)
+		if _, ok := compile.RootTypeSpec(spec.KeySpec).(*compile.StringSpec); ok {
+			m := make(map[string]interface{}, len(items))
+			for _, item := range items {
+				m[item.Key.(string)] = toMapValue(spec.ValueSpec, item.Value)
+			}
+			return m
+		}
+
+		pairs := make([]interface{}, len(items))
+		for i, item := range items {
+			pairs[i] = map[string]interface{}{
+				"key":   toMapValue(spec.KeySpec, item.Key),
+				"value": toMapValue(spec.ValueSpec, item.Value),
+			}
+		}
+		return pairs
+	default:
+		return v
+	}
+}
+
+func itemsToMap(t compile.TypeSpec, items []interface{}) []interface{} {
+	values := make([]interface{}, len(items))
+	for i, item := range items {
+		values[i] = toMapValue(t, item)
+	}
+	return values
+}
+
+// FromMap builds a value of the given struct, union, or exception from a
+// map of field names to values, like one decoded by encoding/json. Default
+// values are applied to fields that are absent, and the result is
+// validated.
+//
+// An error is returned for unknown fields, missing required fields, or
+// values that cannot be converted to the types of their fields. The error
+// message starts with the path to the offending value, e.g.
+// "User.contacts[0].email".
+func FromMap(spec *compile.StructSpec, m map[string]interface{}) (*Struct, error) {
+	s, err := fromMap(spec, m)
+	if err != nil {
+		return nil, wrapPath(spec.Name, err)
+	}
+	return s, nil
+}
+
+func fromMap(spec *compile.StructSpec, m map[string]interface{}) (*Struct, error) {
+	s := NewStruct(spec)
+
+	names := make([]string, 0, len(m))
+	for name := range m {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		f, err := s.field(name)
+		if err != nil {
+			return nil, wrapPath(name, errors.New("unknown field"))
+		}
+
+		if m[name] == nil {
+			continue
+		}
+
+		v, err := fromMapValue(f.Type, m[name])
+		if err != nil {
+			return nil, wrapPath(name, err)
+		}
+		s.values[f.ID] = v
+	}
+
+	if err := s.ApplyDefaults(); err != nil {
+		return nil, err
+	}
+
+	if spec.Type != ast.UnionType {
+		for _, f := range s.fields() {
+			if _, ok := s.values[f.ID]; f.Required && !ok {
+				return nil, wrapPath(f.Name, errors.New("missing required field"))
+			}
+		}
+	}
+	if err := s.Validate(); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// pathError is an error for a value nested inside a struct.
+type pathError struct {
+	Path string
+	Err  error
+}
+
+func (e *pathError) Error() string {
+	return e.Path + ": " + e.Err.Error()
+}
+
+// wrapPath prefixes the path of the given error with elem. elem is either a
+// field name or an index like "[0]".
+func wrapPath(elem string, err error) error {
+	pe, ok := err.(*pathError)
+	if !ok {
+		return &pathError{Path: elem, Err: err}
+	}
+
+	sep := "."
+	if strings.HasPrefix(pe.Path, "[") {
+		sep = ""
+	}
+	return &pathError{Path: elem + sep + pe.Path, Err: pe.Err}
+}
+
+func fromMapValue(t compile.TypeSpec, v interface{}) (interface{}, error) {
+	switch spec := compile.RootTypeSpec(t).(type) {
+	case *compile.BoolSpec:
+		if b, ok := v.(bool); ok {
+			return b, nil
+		}
+	case *compile.I8Spec:
+		i, err := toInt(t, v, math.MinInt8, math.MaxInt8)
+		return int8(i), err
+	case *compile.I16Spec:
+		i, err := toInt(t, v, math.MinInt16, math.MaxInt16)
+		return int16(i), err
+	case *compile.I32Spec:
+		i, err := toInt(t, v, math.MinInt32, math.MaxInt32)
+		return int32(i), err
+	case *compile.I64Spec:
+		return toInt(t, v, math.MinInt64, math.MaxInt64)
+	case *compile.DoubleSpec:
+		return toFloat(t, v)
+	case *compile.StringSpec:
+		if s, ok := v.(string); ok {
+			return s, nil
+		}
+	case *compile.BinarySpec:
+		switch b := v.(type) {
+		case []byte:
+			return b, nil
+		case string:
+			decoded, err := base64.StdEncoding.DecodeString(b)
+			if err != nil {
+				return nil, fmt.Errorf("invalid base64 value for %v: %v", t.ThriftName(), err)
+			}
+			return decoded, nil
+		}
+	case *compile.EnumSpec:
+		if name, ok := v.(string); ok {
+			for _, item := range spec.Items {
+				if item.Name == name {
+					return item.Value, nil
+				}
+			}
+			return nil, fmt.Errorf("unknown item %q for enum %v", name, spec.Name)
+		}
+		i, err := toInt(t, v, math.MinInt32, math.MaxInt32)
+		return int32(i), err
+	case *compile.StructSpec:
+		switch s := v.(type) {
+		case *Struct:
+			if err := checkValue(t, s); err != nil {
+				return nil, err
+			}
+			return s, nil
+		case map[string]interface{}:
+			return fromMap(spec, s)
+		}
+	case *compile.ListSpec:
+		if items, ok := v.([]interface{}); ok {
+			return itemsFromMap(spec.ValueSpec, items)
+		}
+	case *compile.SetSpec:
+		if items, ok := v.([]interface{}); ok {
+			return itemsFromMap(spec.ValueSpec, items)
+		}
+	case *compile.MapSpec:
+		return mapFromMap(spec, v)
+	default:
+		return nil, fmt.Errorf("unsupported type %v", t.ThriftName())
+	}
+
+	return nil, typeError{Type: t, Value: v}
+}
+
+func itemsFromMap(t compile.TypeSpec, items []interface{}) ([]interface{}, error) {
+	values := make([]interface{}, len(items))
+	for i, item := range items {
+		v, err := fromMapValue(t, item)
+		if err != nil {
+			return nil, wrapPath(fmt.Sprintf("[%v]", i), err)
+		}
+		values[i] = v
+	}
+	return values, nil
+}
+
+// mapFromMap converts a map from either a map[string]interface{} or a list
+// of objects with "key" and "value" entries.
+func mapFromMap(spec *compile.MapSpec, v interface{}) ([]MapItem, error) {
+	switch m := v.(type) {
+	case []MapItem:
+		if err := checkValue(spec, m); err != nil {
+			return nil, err
+		}
+		return m, nil
+	case map[string]interface{}:
+		keys := make([]string, 0, len(m))
+		for k := range m {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+
+		items := make([]MapItem, len(keys))
+		for i, k := range keys {
+			elem := fmt.Sprintf("[%q]", k)
+			key, err := keyFromString(spec.KeySpec, k)
+			if err != nil {
+				return nil, wrapPath(elem, fmt.Errorf("invalid key: %v", err))
+			}
+			value, err := fromMapValue(spec.ValueSpec, m[k])
+			if err != nil {
+				return nil, wrapPath(elem, err)
+			}
+			items[i] = MapItem{Key: key, Value: value}
+		}
+		return items, nil
+	case []interface{}:
+		items := make([]MapItem, len(m))
+		for i, pair := range m {
+			elem := fmt.Sprintf("[%v]", i)
+			p, ok := pair.(map[string]interface{})
+			if !ok || len(p) != 2 || p["key"] == nil || p["value"] == nil {
+				return nil, wrapPath(elem, fmt.Errorf(`expected an object with "key" and "value", got %v`, pair))
+			}
+			key, err := fromMapValue(spec.KeySpec, p["key"])
+			if err != nil {
+				return nil, wrapPath(elem, fmt.Errorf("invalid key: %v", err))
+			}
+			value, err := fromMapValue(spec.ValueSpec, p["value"])
+			if err != nil {
+				return nil, wrapPath(elem, err)
+			}
+			items[i] = MapItem{Key: key, Value: value}
+		}
+		return items, nil
+	default:
+		return nil, typeError{Type: spec, Value: v}
+	}
+}
+
+// keyFromString converts a key of a JSON object into a map key of the given
+// type. Numbers and booleans are parsed from their string representation.
+func keyFromString(t compile.TypeSpec, k string) (interface{}, error) {
+	switch compile.RootTypeSpec(t).(type) {
+	case *compile.I8Spec, *compile.I16Spec, *compile.I32Spec, *compile.I64Spec, *compile.DoubleSpec:
+		return fromMapValue(t, json.Number(k))
+	case *compile.BoolSpec:
+		b, err := strconv.ParseBool(k)
+		if err != nil {
+			return nil, typeError{Type: t, Value: k}
+		}
+		return b, nil
+	default:
+		return fromMapValue(t, k)
+	}
+}
+
+// toInt converts a number of any Go numeric type into an integer in the
+// range [min, max].
+func toInt(t compile.TypeSpec, v interface{}, min, max int64) (int64, error) {
+	var (
+		i   int64
+		err error
+	)
+	switch n := v.(type) {
+	case int:
+		i = int64(n)
+	case int8:
+		i = int64(n)
+	case int16:
+		i = int64(n)
+	case int32:
+		i = int64(n)
+	case int64:
+		i = n
+	case uint8:
+		i = int64(n)
+	case uint16:
+		i = int64(n)
+	case uint32:
+		i = int64(n)
+	case uint, uint64:
+		u := toUint64(n)
+		if u > math.MaxInt64 {
+			return 0, fmt.Errorf("%v is out of range for %v", u, t.ThriftName())
+		}
+		i = int64(u)
+	case float32:
+		return floatToInt(t, float64(n), min, max)
+	case float64:
+		return floatToInt(t, n, min, max)
+	case json.Number:
+		i, err = strconv.ParseInt(string(n), 10, 64)
+		if err != nil {
+			return 0, fmt.Errorf("%v is not a valid %v", n, t.ThriftName())
+		}
+	default:
+		return 0, typeError{Type: t, Value: v}
+	}
+
+	if i < min || i > max {
+		return 0, fmt.Errorf("%v is out of range for %v", i, t.ThriftName())
+	}
+	return i, nil
+}
+
+func toUint64(v interface{}) uint64 {
+	if u, ok := v.(uint); ok {
+		return uint64(u)
+	}
+	return v.(uint64)
+}
+
+func floatToInt(t compile.TypeSpec, f float64, min, max int64) (int64, error) {
+	if f != math.Trunc(f) {
+		return 0, fmt.Errorf("%v is not a valid %v", f, t.ThriftName())
+	}
+	// float64(max) may round up past max, so compare with >=.
+	if f < float64(min) || f >= float64(max)+1 {
+		return 0, fmt.Errorf("%v is out of range for %v", f, t.ThriftName())
+	}
+	return int64(f), nil
+}
+
+func toFloat(t compile.TypeSpec, v interface{}) (float64, error) {
+	switch n := v.(type) {
+	case float64:
+		return n, nil
+	case float32:
+		return float64(n), nil
+	case json.Number:
+		f, err := n.Float64()
+		if err != nil {
+			return 0, fmt.Errorf("%v is not a valid %v", n, t.ThriftName())
+		}
+		return f, nil
+	}
+
+	i, err := toInt(t, v, math.MinInt64, math.MaxInt64)
+	if err != nil {
+		return 0, err
+	}
+	return float64(i), nil
+}
@@ -0,0 +1,201 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dynamic
+
+import (
+	"bytes"
+	"encoding/json"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMapRoundTripJSON(t *testing.T) {
+	m := compileTestdata(t)
+	spec := structSpec(t, m, "User")
+
+	give := `{
+		"name": "Alice",
+		"age": 42,
+		"role": "ADMIN",
+		"tags": ["a", "b"],
+		"avatar": "AQID",
+		"location": {"x": 1.5, "y": 2},
+		"counters": {"logins": 9007199254740993},
+		"permissions": [{"key": "ADMIN", "value": ["read", "write"]}],
+		"level": 3,
+		"rank": 7,
+		"score": 1.5,
+		"active": false
+	}`
+
+	dec := json.NewDecoder(bytes.NewReader([]byte(give)))
+	dec.UseNumber()
+	var decoded map[string]interface{}
+	require.NoError(t, dec.Decode(&decoded))
+
+	s, err := FromMap(spec, decoded)
+	require.NoError(t, err)
+
+	age, _ := s.Get("age")
+	assert.Equal(t, int32(42), age)
+	avatar, _ := s.Get("avatar")
+	assert.Equal(t, []byte{1, 2, 3}, avatar)
+	counters, _ := s.Get("counters")
+	assert.Equal(t, []MapItem{{Key: "logins", Value: int64(9007199254740993)}}, counters)
+	permissions, _ := s.Get("permissions")
+	assert.Equal(t, []MapItem{{Key: int32(2), Value: []interface{}{"read", "write"}}}, permissions)
+
+	got, err := json.Marshal(s.ToMap())
+	require.NoError(t, err)
+	assert.JSONEq(t, give, string(got))
+}
+
+func TestFromMap(t *testing.T) {
+	m := compileTestdata(t)
+	spec := structSpec(t, m, "User")
+
+	t.Run("go values", func(t *testing.T) {
+		point, err := FromMap(structSpec(t, m.Includes["shared"].Module, "Point"),
+			map[string]interface{}{"x": 1, "y": float32(2)})
+		require.NoError(t, err)
+
+		s, err := FromMap(spec, map[string]interface{}{
+			"name":        "Alice",
+			"age":         uint8(42),
+			"role":        int64(2),
+			"avatar":      []byte{1},
+			"location":    point,
+			"counters":    []MapItem{{Key: "logins", Value: int64(1)}},
+			"permissions": nil,
+		})
+		require.NoError(t, err)
+		role, _ := s.Get("role")
+		assert.Equal(t, int32(2), role)
+		_, ok := s.Get("permissions")
+		assert.False(t, ok, "nil values must be skipped")
+	})
+
+	t.Run("numeric map keys", func(t *testing.T) {
+		s, err := FromMap(spec, map[string]interface{}{
+			"name":    "Alice",
+			"aliases": map[string]interface{}{"2": "b", "1": "a"},
+		})
+		require.NoError(t, err)
+		aliases, _ := s.Get("aliases")
+		assert.Equal(t, []MapItem{{Key: int32(1), Value: "a"}, {Key: int32(2), Value: "b"}}, aliases)
+	})
+
+	tests := []struct {
+		desc    string
+		give    map[string]interface{}
+		wantErr string
+	}{
+		{
+			desc:    "unknown field",
+			give:    map[string]interface{}{"name": "Alice", "email": "alice@example.com"},
+			wantErr: "User.email: unknown field",
+		},
+		{
+			desc:    "missing required field",
+			give:    map[string]interface{}{"age": 42},
+			wantErr: "User.name: missing required field",
+		},
+		{
+			desc:    "out of range",
+			give:    map[string]interface{}{"name": "Alice", "level": 128},
+			wantErr: "User.level: 128 is out of range for byte",
+		},
+		{
+			desc:    "float out of range",
+			give:    map[string]interface{}{"name": "Alice", "age": float64(math.MaxInt32 + 1)},
+			wantErr: "User.age: 2.147483648e+09 is out of range for i32",
+		},
+		{
+			desc:    "fractional",
+			give:    map[string]interface{}{"name": "Alice", "rank": 1.5},
+			wantErr: "User.rank: 1.5 is not a valid i16",
+		},
+		{
+			desc:    "invalid number",
+			give:    map[string]interface{}{"name": "Alice", "age": json.Number("1e3")},
+			wantErr: "User.age: 1e3 is not a valid i32",
+		},
+		{
+			desc:    "unknown enum item",
+			give:    map[string]interface{}{"name": "Alice", "role": "ROOT"},
+			wantErr: `User.role: unknown item "ROOT" for enum Role`,
+		},
+		{
+			desc:    "invalid base64",
+			give:    map[string]interface{}{"name": "Alice", "avatar": "!"},
+			wantErr: "User.avatar: invalid base64 value for binary",
+		},
+		{
+			desc:    "wrong type",
+			give:    map[string]interface{}{"name": 42},
+			wantErr: "User.name: expected a value of type Name, got int",
+		},
+		{
+			desc:    "nested struct",
+			give:    map[string]interface{}{"name": "Alice", "location": map[string]interface{}{"x": 1}},
+			wantErr: "User.location.y: missing required field",
+		},
+		{
+			desc:    "invalid list item",
+			give:    map[string]interface{}{"name": "Alice", "tags": []interface{}{"a", 1}},
+			wantErr: "User.tags[1]: expected a value of type string, got int",
+		},
+		{
+			desc:    "invalid map value",
+			give:    map[string]interface{}{"name": "Alice", "counters": map[string]interface{}{"hits": true}},
+			wantErr: `User.counters["hits"]: expected a value of type i64, got bool`,
+		},
+		{
+			desc:    "invalid numeric map key",
+			give:    map[string]interface{}{"name": "Alice", "aliases": map[string]interface{}{"one": "a"}},
+			wantErr: `User.aliases["one"]: invalid key: one is not a valid i32`,
+		},
+		{
+			desc: "invalid map item",
+			give: map[string]interface{}{
+				"name":        "Alice",
+				"permissions": []interface{}{map[string]interface{}{"key": "ADMIN"}},
+			},
+			wantErr: `User.permissions[0]: expected an object with "key" and "value"`,
+		},
+		{
+			desc:    "invalid map key",
+			give:    map[string]interface{}{"name": "Alice", "permissions": map[string]interface{}{"ROOT": []interface{}{}}},
+			wantErr: `User.permissions["ROOT"]: invalid key: unknown item "ROOT" for enum Role`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			_, err := FromMap(spec, tt.give)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+}
@@ -0,0 +1,71 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Package dynamic reads and writes Thrift values whose types are known only
+// at runtime.
+//
+// Where generated code bakes the structure of a Thrift type into Go types
+// with ToWire and FromWire methods, this package works from the compiled
+// representation of a Thrift file provided by the compile package. This is
+// useful for programs like gateways and debugging tools that receive IDLs
+// at runtime.
+//
+// 	spec := module.Types["User"].(*compile.StructSpec)
+//
+// 	user := dynamic.NewStruct(spec)
+// 	if err := user.Set("name", "Alice"); err != nil {
+// 		return err
+// 	}
+// 	w, err := user.ToWire()
+//
+// Values
+//
+// Values of Thrift types are represented with the following Go types.
+//
+// 	bool    bool
+// 	byte    int8
+// 	i16     int16
+// 	i32     int32
+// 	i64     int64
+// 	double  float64
+// 	string  string
+// 	binary  []byte
+// 	enum    int32
+// 	struct  *Struct
+// 	list    []interface{}
+// 	set     []interface{}
+// 	map     []MapItem
+//
+// Structs, unions, and exceptions are all represented by *Struct. Typedefs
+// are represented by the representation of the type they refer to.
+//
+// Maps
+//
+// Structs may be converted to and from map[string]interface{} with ToMap and
+// FromMap. These use a representation suited for encoding/json: binary
+// values are base64-encoded strings, enums are the names of their items,
+// and maps with string keys are map[string]interface{}. Other maps are
+// lists of objects with "key" and "value" entries.
+//
+// 	{"name": "Alice", "roles": [{"key": 1, "value": "admin"}]}
+//
+// FromMap also accepts the Go representations listed above, and numbers of
+// any Go numeric type or json.Number as long as they fit in the Thrift type.
+package dynamic
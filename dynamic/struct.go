@@ -0,0 +1,224 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dynamic
+
+import (
+	"fmt"
+	"sort"
+
+	"go.uber.org/thriftrw/ast"
+	"go.uber.org/thriftrw/compile"
+	"go.uber.org/thriftrw/wire"
+)
+
+// Struct is a value of a Thrift struct, union, or exception.
+//
+// Fields are accessed by their names in the Thrift file, and values are type
+// checked against the field types.
+type Struct struct {
+	spec   *compile.StructSpec
+	values map[int16]interface{}
+}
+
+// MapItem is a key-value pair in a Thrift map.
+type MapItem struct {
+	Key, Value interface{}
+}
+
+// NewStruct builds a new, empty value of the given struct, union, or
+// exception. Call ApplyDefaults to populate fields with default values.
+func NewStruct(spec *compile.StructSpec) *Struct {
+	return &Struct{spec: spec, values: make(map[int16]interface{})}
+}
+
+// Spec returns the compiled representation of this struct's type.
+func (s *Struct) Spec() *compile.StructSpec {
+	return s.spec
+}
+
+// field looks up the field with the given name.
+func (s *Struct) field(name string) (*compile.FieldSpec, error) {
+	for _, f := range s.spec.Fields {
+		if f.Name == name {
+			return f, nil
+		}
+	}
+	return nil, fmt.Errorf("%v %q does not have a field %q", structKind(s.spec), s.spec.Name, name)
+}
+
+// Get returns the value of the field with the given name, and whether it
+// is set.
+func (s *Struct) Get(name string) (interface{}, bool) {
+	f, err := s.field(name)
+	if err != nil {
+		return nil, false
+	}
+	v, ok := s.values[f.ID]
+	return v, ok
+}
+
+// Set sets the value of the field with the given name. An error is returned
+// if the struct doesn't have such a field, or if the value doesn't match the
+// type of the field.
+//
+// Setting a field to nil clears it.
+func (s *Struct) Set(name string, v interface{}) error {
+	f, err := s.field(name)
+	if err != nil {
+		return err
+	}
+
+	if v == nil {
+		delete(s.values, f.ID)
+		return nil
+	}
+
+	if err := checkValue(f.Type, v); err != nil {
+		return fmt.Errorf("cannot set field %q of %q: %v", name, s.spec.Name, err)
+	}
+	s.values[f.ID] = v
+	return nil
+}
+
+// fields returns the fields of the struct ordered by ID.
+func (s *Struct) fields() []*compile.FieldSpec {
+	fields := make([]*compile.FieldSpec, len(s.spec.Fields))
+	copy(fields, s.spec.Fields)
+	sort.Slice(fields, func(i, j int) bool {
+		return fields[i].ID < fields[j].ID
+	})
+	return fields
+}
+
+// ApplyDefaults sets fields that are not set to their default values, if
+// they have one.
+func (s *Struct) ApplyDefaults() error {
+	for _, f := range s.fields() {
+		if _, ok := s.values[f.ID]; ok || f.Default == nil {
+			continue
+		}
+
+		v, err := constantValue(f.Type, f.Default)
+		if err != nil {
+			return fmt.Errorf("invalid default value for field %q of %q: %v", f.Name, s.spec.Name, err)
+		}
+		s.values[f.ID] = v
+	}
+	return nil
+}
+
+// Validate verifies that all required fields of a struct or exception are
+// set, and that exactly one field of a union is set.
+//
+// Nested structs are not validated.
+func (s *Struct) Validate() error {
+	if s.spec.Type == ast.UnionType {
+		if n := len(s.values); n != 1 {
+			return fmt.Errorf("%v should have exactly one field: got %v fields", s.spec.Name, n)
+		}
+		return nil
+	}
+
+	for _, f := range s.fields() {
+		if _, ok := s.values[f.ID]; f.Required && !ok {
+			return fmt.Errorf("field %v of %v is required", f.Name, s.spec.Name)
+		}
+	}
+	return nil
+}
+
+// ToWire converts the struct into its Thrift-level representation after
+// validating it.
+func (s *Struct) ToWire() (wire.Value, error) {
+	if err := s.Validate(); err != nil {
+		return wire.Value{}, err
+	}
+
+	fields := make([]wire.Field, 0, len(s.values))
+	for _, f := range s.fields() {
+		v, ok := s.values[f.ID]
+		if !ok {
+			continue
+		}
+
+		w, err := toWire(f.Type, v)
+		if err != nil {
+			return wire.Value{}, fmt.Errorf("field %v of %v: %v", f.Name, s.spec.Name, err)
+		}
+		fields = append(fields, wire.Field{ID: f.ID, Value: w})
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields}), nil
+}
+
+// FromWire decodes a value of the given struct, union, or exception from
+// its Thrift-level representation.
+//
+// As with generated code, unknown fields and fields of unexpected types are
+// ignored, default values are applied, and the result is validated.
+func FromWire(spec *compile.StructSpec, w wire.Value) (*Struct, error) {
+	if w.Type() != wire.TStruct {
+		return nil, fmt.Errorf("cannot decode %v %q from a %v", structKind(spec), spec.Name, w.Type())
+	}
+
+	s := NewStruct(spec)
+	for _, field := range w.GetStruct().Fields {
+		f := fieldByID(spec, field.ID)
+		if f == nil || field.Value.Type() != f.Type.TypeCode() {
+			continue
+		}
+
+		v, err := fromWire(f.Type, field.Value)
+		if err != nil {
+			return nil, fmt.Errorf("field %v of %v: %v", f.Name, spec.Name, err)
+		}
+		s.values[f.ID] = v
+	}
+
+	if err := s.ApplyDefaults(); err != nil {
+		return nil, err
+	}
+	if err := s.Validate(); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+func fieldByID(spec *compile.StructSpec, id int16) *compile.FieldSpec {
+	for _, f := range spec.Fields {
+		if f.ID == id {
+			return f
+		}
+	}
+	return nil
+}
+
+// structKind returns "struct", "union", or "exception" for the given spec.
+func structKind(spec *compile.StructSpec) string {
+	switch spec.Type {
+	case ast.UnionType:
+		return "union"
+	case ast.ExceptionType:
+		return "exception"
+	default:
+		return "struct"
+	}
+}
@@ -0,0 +1,261 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dynamic
+
+import (
+	"testing"
+
+	"go.uber.org/thriftrw/compile"
+	"go.uber.org/thriftrw/wire"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func compileTestdata(t *testing.T) *compile.Module {
+	m, err := compile.Compile("testdata/test.thrift")
+	require.NoError(t, err)
+	return m
+}
+
+func structSpec(t *testing.T, m *compile.Module, name string) *compile.StructSpec {
+	spec, ok := m.Types[name].(*compile.StructSpec)
+	require.True(t, ok, "%v must be a struct", name)
+	return spec
+}
+
+func TestStructGetSet(t *testing.T) {
+	m := compileTestdata(t)
+	user := NewStruct(structSpec(t, m, "User"))
+	point := NewStruct(structSpec(t, m.Includes["shared"].Module, "Point"))
+
+	_, ok := user.Get("name")
+	assert.False(t, ok)
+
+	require.NoError(t, user.Set("name", "Alice"))
+	v, ok := user.Get("name")
+	assert.True(t, ok)
+	assert.Equal(t, "Alice", v)
+
+	require.NoError(t, user.Set("name", nil))
+	_, ok = user.Get("name")
+	assert.False(t, ok, "setting nil must clear the field")
+
+	_, ok = user.Get("unknown")
+	assert.False(t, ok)
+
+	tests := []struct {
+		desc    string
+		field   string
+		give    interface{}
+		wantErr string
+	}{
+		{desc: "typedef", field: "name", give: "Bob"},
+		{desc: "enum", field: "role", give: int32(2)},
+		{desc: "list", field: "tags", give: []interface{}{"a", "b"}},
+		{desc: "binary", field: "avatar", give: []byte{1, 2}},
+		{desc: "struct", field: "location", give: point},
+		{
+			desc:  "map",
+			field: "permissions",
+			give:  []MapItem{{Key: int32(1), Value: []interface{}{"read"}}},
+		},
+		{
+			desc:    "unknown field",
+			field:   "email",
+			give:    "foo",
+			wantErr: `struct "User" does not have a field "email"`,
+		},
+		{
+			desc:    "wrong type",
+			field:   "age",
+			give:    42,
+			wantErr: `cannot set field "age" of "User": expected a value of type i32, got int`,
+		},
+		{
+			desc:    "wrong struct",
+			field:   "location",
+			give:    user,
+			wantErr: `expected a value of type Point, got *dynamic.Struct`,
+		},
+		{
+			desc:    "wrong item",
+			field:   "tags",
+			give:    []interface{}{"a", 1},
+			wantErr: `item 1: expected a value of type string, got int`,
+		},
+		{
+			desc:    "wrong map value",
+			field:   "permissions",
+			give:    []MapItem{{Key: int32(1), Value: "read"}},
+			wantErr: `value 0: expected a value of type set<string>, got string`,
+		},
+		{
+			desc:    "map not a list of items",
+			field:   "counters",
+			give:    map[string]int64{},
+			wantErr: `expected a value of type map<string, i64>, got map[string]int64`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			err := user.Set(tt.field, tt.give)
+			if tt.wantErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.wantErr)
+				return
+			}
+
+			require.NoError(t, err)
+			v, ok := user.Get(tt.field)
+			assert.True(t, ok)
+			assert.Equal(t, tt.give, v)
+		})
+	}
+}
+
+func TestStructDefaultsAndValidate(t *testing.T) {
+	m := compileTestdata(t)
+
+	user := NewStruct(structSpec(t, m, "User"))
+	require.NoError(t, user.ApplyDefaults())
+
+	role, _ := user.Get("role")
+	assert.Equal(t, int32(1), role)
+	level, _ := user.Get("level")
+	assert.Equal(t, int8(1), level)
+	score, _ := user.Get("score")
+	assert.Equal(t, 0.5, score)
+	active, _ := user.Get("active")
+	assert.Equal(t, true, active)
+
+	err := user.Validate()
+	require.Error(t, err)
+	assert.Equal(t, "field name of User is required", err.Error())
+
+	_, err = user.ToWire()
+	assert.Error(t, err)
+
+	require.NoError(t, user.Set("name", "Alice"))
+	assert.NoError(t, user.Validate())
+
+	t.Run("constants", func(t *testing.T) {
+		defaults := NewStruct(structSpec(t, m, "Defaults"))
+		require.NoError(t, defaults.ApplyDefaults())
+		assert.Equal(t, map[string]interface{}{
+			"origin":  map[string]interface{}{"x": 0.0, "y": 0.0},
+			"numbers": []interface{}{int32(1), int32(2), int32(3)},
+			"roles":   map[string]interface{}{"root": "ADMIN"},
+		}, defaults.ToMap())
+	})
+
+	t.Run("union", func(t *testing.T) {
+		contact := NewStruct(structSpec(t, m, "Contact"))
+		assert.EqualError(t, contact.Validate(), "Contact should have exactly one field: got 0 fields")
+
+		require.NoError(t, contact.Set("email", "alice@example.com"))
+		assert.NoError(t, contact.Validate())
+
+		require.NoError(t, contact.Set("phone", "555-0100"))
+		assert.EqualError(t, contact.Validate(), "Contact should have exactly one field: got 2 fields")
+	})
+}
+
+func TestStructWireRoundTrip(t *testing.T) {
+	m := compileTestdata(t)
+	spec := structSpec(t, m, "User")
+
+	point := NewStruct(structSpec(t, m.Includes["shared"].Module, "Point"))
+	require.NoError(t, point.Set("x", 1.0))
+	require.NoError(t, point.Set("y", 2.0))
+
+	user := NewStruct(spec)
+	for name, v := range map[string]interface{}{
+		"name":     "Alice",
+		"age":      int32(42),
+		"role":     int32(2),
+		"tags":     []interface{}{"a", "b"},
+		"avatar":   []byte{1, 2, 3},
+		"location": point,
+		"counters": []MapItem{{Key: "logins", Value: int64(10)}},
+		"permissions": []MapItem{
+			{Key: int32(2), Value: []interface{}{"read", "write"}},
+		},
+		"level":  int8(3),
+		"rank":   int16(7),
+		"score":  1.5,
+		"active": false,
+	} {
+		require.NoError(t, user.Set(name, v), "failed to set %v", name)
+	}
+
+	w, err := user.ToWire()
+	require.NoError(t, err)
+
+	got, err := FromWire(spec, w)
+	require.NoError(t, err)
+	assert.Equal(t, user.ToMap(), got.ToMap())
+
+	// Verify a few fields of the wire representation directly.
+	fields := w.GetStruct().Fields
+	require.Len(t, fields, 12)
+	assert.Equal(t, wire.Field{ID: 1, Value: wire.NewValueString("Alice")}, fields[0])
+	assert.Equal(t, wire.Field{ID: 2, Value: wire.NewValueI32(42)}, fields[1])
+}
+
+func TestFromWire(t *testing.T) {
+	m := compileTestdata(t)
+	spec := structSpec(t, m, "User")
+
+	t.Run("unknown and mismatched fields are ignored", func(t *testing.T) {
+		s, err := FromWire(spec, wire.NewValueStruct(wire.Struct{Fields: []wire.Field{
+			{ID: 1, Value: wire.NewValueString("Alice")},
+			{ID: 2, Value: wire.NewValueString("not an i32")},
+			{ID: 100, Value: wire.NewValueI32(1)},
+		}}))
+		require.NoError(t, err)
+
+		_, ok := s.Get("age")
+		assert.False(t, ok)
+		role, _ := s.Get("role")
+		assert.Equal(t, int32(1), role, "defaults must be applied")
+	})
+
+	t.Run("missing required field", func(t *testing.T) {
+		_, err := FromWire(spec, wire.NewValueStruct(wire.Struct{}))
+		assert.EqualError(t, err, "field name of User is required")
+	})
+
+	t.Run("not a struct", func(t *testing.T) {
+		_, err := FromWire(spec, wire.NewValueI32(1))
+		assert.EqualError(t, err, `cannot decode struct "User" from a TI32`)
+	})
+
+	t.Run("mismatched item", func(t *testing.T) {
+		_, err := FromWire(spec, wire.NewValueStruct(wire.Struct{Fields: []wire.Field{
+			{ID: 1, Value: wire.NewValueString("Alice")},
+			{ID: 4, Value: wire.NewValueList(wire.ValueListFromSlice(wire.TI32, []wire.Value{wire.NewValueI32(1)}))},
+		}}))
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "field tags of User: item 0: expected a value of type string, got TI32")
+	})
+}
@@ -0,0 +1,41 @@
+include "./shared.thrift"
+
+enum Role {
+    USER = 1
+    ADMIN = 2
+}
+
+typedef string Name
+
+struct User {
+    1: required Name name
+    2: optional i32 age
+    3: optional Role role = Role.USER
+    4: optional list<string> tags
+    5: optional binary avatar
+    6: optional shared.Point location
+    7: optional map<string, i64> counters
+    8: optional map<Role, set<string>> permissions
+    9: optional byte level = 1
+    10: optional i16 rank
+    11: optional double score = 0.5
+    12: optional bool active = true
+    13: optional map<i32, string> aliases
+}
+
+union Contact {
+    1: string email
+    2: string phone
+}
+
+exception NotFound {
+    1: optional string message
+}
+
+const shared.Point ORIGIN = {"x": 0, "y": 0}
+
+struct Defaults {
+    1: optional shared.Point origin = ORIGIN
+    2: optional list<i32> numbers = [1, 2, 3]
+    3: optional map<string, Role> roles = {"root": Role.ADMIN}
+}
@@ -0,0 +1,329 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dynamic
+
+import (
+	"fmt"
+
+	"go.uber.org/thriftrw/compile"
+	"go.uber.org/thriftrw/wire"
+)
+
+// typeError is returned when a value doesn't match the Thrift type it is
+// used for.
+type typeError struct {
+	Type  compile.TypeSpec
+	Value interface{}
+}
+
+func (e typeError) Error() string {
+	return fmt.Sprintf("expected a value of type %v, got %T", e.Type.ThriftName(), e.Value)
+}
+
+// checkValue verifies that the given value uses the representation of the
+// given Thrift type, recursing into containers.
+func checkValue(t compile.TypeSpec, v interface{}) error {
+	var ok bool
+	switch spec := compile.RootTypeSpec(t).(type) {
+	case *compile.BoolSpec:
+		_, ok = v.(bool)
+	case *compile.I8Spec:
+		_, ok = v.(int8)
+	case *compile.I16Spec:
+		_, ok = v.(int16)
+	case *compile.I32Spec, *compile.EnumSpec:
+		_, ok = v.(int32)
+	case *compile.I64Spec:
+		_, ok = v.(int64)
+	case *compile.DoubleSpec:
+		_, ok = v.(float64)
+	case *compile.StringSpec:
+		_, ok = v.(string)
+	case *compile.BinarySpec:
+		_, ok = v.([]byte)
+	case *compile.StructSpec:
+		var s *Struct
+		s, ok = v.(*Struct)
+		ok = ok && s != nil && s.spec == spec
+	case *compile.ListSpec:
+		return checkItems(t, spec.ValueSpec, v)
+	case *compile.SetSpec:
+		return checkItems(t, spec.ValueSpec, v)
+	case *compile.MapSpec:
+		var items []MapItem
+		if items, ok = v.([]MapItem); ok {
+			for i, item := range items {
+				if err := checkValue(spec.KeySpec, item.Key); err != nil {
+					return fmt.Errorf("key %v: %v", i, err)
+				}
+				if err := checkValue(spec.ValueSpec, item.Value); err != nil {
+					return fmt.Errorf("value %v: %v", i, err)
+				}
+			}
+		}
+	default:
+		return fmt.Errorf("unsupported type %v", t.ThriftName())
+	}
+
+	if !ok {
+		return typeError{Type: t, Value: v}
+	}
+	return nil
+}
+
+// checkItems verifies that v is a list of values of the type valueSpec.
+func checkItems(t, valueSpec compile.TypeSpec, v interface{}) error {
+	items, ok := v.([]interface{})
+	if !ok {
+		return typeError{Type: t, Value: v}
+	}
+
+	for i, item := range items {
+		if err := checkValue(valueSpec, item); err != nil {
+			return fmt.Errorf("item %v: %v", i, err)
+		}
+	}
+	return nil
+}
+
+// toWire converts a value of the given type to its Thrift-level
+// representation.
+func toWire(t compile.TypeSpec, v interface{}) (wire.Value, error) {
+	if err := checkValue(t, v); err != nil {
+		return wire.Value{}, err
+	}
+
+	switch spec := compile.RootTypeSpec(t).(type) {
+	case *compile.BoolSpec:
+		return wire.NewValueBool(v.(bool)), nil
+	case *compile.I8Spec:
+		return wire.NewValueI8(v.(int8)), nil
+	case *compile.I16Spec:
+		return wire.NewValueI16(v.(int16)), nil
+	case *compile.I32Spec, *compile.EnumSpec:
+		return wire.NewValueI32(v.(int32)), nil
+	case *compile.I64Spec:
+		return wire.NewValueI64(v.(int64)), nil
+	case *compile.DoubleSpec:
+		return wire.NewValueDouble(v.(float64)), nil
+	case *compile.StringSpec:
+		return wire.NewValueString(v.(string)), nil
+	case *compile.BinarySpec:
+		return wire.NewValueBinary(v.([]byte)), nil
+	case *compile.StructSpec:
+		return v.(*Struct).ToWire()
+	case *compile.ListSpec:
+		values, err := itemsToWire(spec.ValueSpec, v.([]interface{}))
+		if err != nil {
+			return wire.Value{}, err
+		}
+		return wire.NewValueList(wire.ValueListFromSlice(spec.ValueSpec.TypeCode(), values)), nil
+	case *compile.SetSpec:
+		values, err := itemsToWire(spec.ValueSpec, v.([]interface{}))
+		if err != nil {
+			return wire.Value{}, err
+		}
+		return wire.NewValueSet(wire.ValueListFromSlice(spec.ValueSpec.TypeCode(), values)), nil
+	case *compile.MapSpec:
+		items := v.([]MapItem)
+		wireItems := make([]wire.MapItem, len(items))
+		for i, item := range items {
+			key, err := toWire(spec.KeySpec, item.Key)
+			if err != nil {
+				return wire.Value{}, fmt.Errorf("key %v: %v", i, err)
+			}
+			value, err := toWire(spec.ValueSpec, item.Value)
+			if err != nil {
+				return wire.Value{}, fmt.Errorf("value %v: %v", i, err)
+			}
+			wireItems[i] = wire.MapItem{Key: key, Value: value}
+		}
+		return wire.NewValueMap(wire.MapItemListFromSlice(
+			spec.KeySpec.TypeCode(), spec.ValueSpec.TypeCode(), wireItems)), nil
+	default:
+		return wire.Value{}, fmt.Errorf("unsupported type %v", t.ThriftName())
+	}
+}
+
+func itemsToWire(t compile.TypeSpec, items []interface{}) ([]wire.Value, error) {
+	values := make([]wire.Value, len(items))
+	for i, item := range items {
+		w, err := toWire(t, item)
+		if err != nil {
+			return nil, fmt.Errorf("item %v: %v", i, err)
+		}
+		values[i] = w
+	}
+	return values, nil
+}
+
+// fromWire decodes a value of the given type from its Thrift-level
+// representation.
+func fromWire(t compile.TypeSpec, w wire.Value) (interface{}, error) {
+	if w.Type() != t.TypeCode() {
+		return nil, fmt.Errorf("expected a value of type %v, got %v", t.ThriftName(), w.Type())
+	}
+
+	switch spec := compile.RootTypeSpec(t).(type) {
+	case *compile.BoolSpec:
+		return w.GetBool(), nil
+	case *compile.I8Spec:
+		return w.GetI8(), nil
+	case *compile.I16Spec:
+		return w.GetI16(), nil
+	case *compile.I32Spec, *compile.EnumSpec:
+		return w.GetI32(), nil
+	case *compile.I64Spec:
+		return w.GetI64(), nil
+	case *compile.DoubleSpec:
+		return w.GetDouble(), nil
+	case *compile.StringSpec:
+		return w.GetString(), nil
+	case *compile.BinarySpec:
+		return w.GetBinary(), nil
+	case *compile.StructSpec:
+		return FromWire(spec, w)
+	case *compile.ListSpec:
+		return itemsFromWire(spec.ValueSpec, w.GetList())
+	case *compile.SetSpec:
+		return itemsFromWire(spec.ValueSpec, w.GetSet())
+	case *compile.MapSpec:
+		items := make([]MapItem, 0, w.GetMap().Size())
+		err := w.GetMap().ForEach(func(item wire.MapItem) error {
+			key, err := fromWire(spec.KeySpec, item.Key)
+			if err != nil {
+				return fmt.Errorf("key %v: %v", len(items), err)
+			}
+			value, err := fromWire(spec.ValueSpec, item.Value)
+			if err != nil {
+				return fmt.Errorf("value %v: %v", len(items), err)
+			}
+			items = append(items, MapItem{Key: key, Value: value})
+			return nil
+		})
+		return items, err
+	default:
+		return nil, fmt.Errorf("unsupported type %v", t.ThriftName())
+	}
+}
+
+func itemsFromWire(t compile.TypeSpec, l wire.ValueList) ([]interface{}, error) {
+	items := make([]interface{}, 0, l.Size())
+	err := l.ForEach(func(w wire.Value) error {
+		v, err := fromWire(t, w)
+		if err != nil {
+			return fmt.Errorf("item %v: %v", len(items), err)
+		}
+		items = append(items, v)
+		return nil
+	})
+	return items, err
+}
+
+// constantValue converts a constant from a Thrift file into a value of the
+// given type.
+func constantValue(t compile.TypeSpec, c compile.ConstantValue) (interface{}, error) {
+	root := compile.RootTypeSpec(t)
+	switch c := c.(type) {
+	case compile.ConstantBool:
+		return bool(c), nil
+	case compile.ConstantInt:
+		switch root.(type) {
+		case *compile.I8Spec:
+			return int8(c), nil
+		case *compile.I16Spec:
+			return int16(c), nil
+		case *compile.I32Spec, *compile.EnumSpec:
+			return int32(c), nil
+		case *compile.I64Spec:
+			return int64(c), nil
+		}
+	case compile.ConstantDouble:
+		return float64(c), nil
+	case compile.ConstantString:
+		if _, ok := root.(*compile.BinarySpec); ok {
+			return []byte(c), nil
+		}
+		return string(c), nil
+	case compile.EnumItemReference:
+		return c.Item.Value, nil
+	case compile.ConstReference:
+		return constantValue(t, c.Target.Value)
+	case *compile.ConstantStruct:
+		spec, ok := root.(*compile.StructSpec)
+		if !ok {
+			break
+		}
+		s := NewStruct(spec)
+		for name, fc := range c.Fields {
+			f, err := s.field(name)
+			if err != nil {
+				return nil, err
+			}
+			v, err := constantValue(f.Type, fc)
+			if err != nil {
+				return nil, fmt.Errorf("field %v: %v", name, err)
+			}
+			s.values[f.ID] = v
+		}
+		return s, nil
+	case compile.ConstantList:
+		if spec, ok := root.(*compile.ListSpec); ok {
+			return constantItems(spec.ValueSpec, c)
+		}
+	case compile.ConstantSet:
+		if spec, ok := root.(*compile.SetSpec); ok {
+			return constantItems(spec.ValueSpec, c)
+		}
+	case compile.ConstantMap:
+		spec, ok := root.(*compile.MapSpec)
+		if !ok {
+			break
+		}
+		items := make([]MapItem, len(c))
+		for i, pair := range c {
+			key, err := constantValue(spec.KeySpec, pair.Key)
+			if err != nil {
+				return nil, fmt.Errorf("key %v: %v", i, err)
+			}
+			value, err := constantValue(spec.ValueSpec, pair.Value)
+			if err != nil {
+				return nil, fmt.Errorf("value %v: %v", i, err)
+			}
+			items[i] = MapItem{Key: key, Value: value}
+		}
+		return items, nil
+	}
+
+	return nil, fmt.Errorf("cannot use constant %v as a value of type %v", c, t.ThriftName())
+}
+
+func constantItems(t compile.TypeSpec, cs []compile.ConstantValue) ([]interface{}, error) {
+	items := make([]interface{}, len(cs))
+	for i, c := range cs {
+		v, err := constantValue(t, c)
+		if err != nil {
+			return nil, fmt.Errorf("item %v: %v", i, err)
+		}
+		items[i] = v
+	}
+	return items, nil
+}
@@ -0,0 +1,155 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package gen
+
+import (
+	"encoding/json"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/thriftrw/compile"
+	ta "go.uber.org/thriftrw/gen/internal/tests/adapters"
+	"go.uber.org/zap/zapcore"
+)
+
+func TestAdaptedTypes(t *testing.T) {
+	createdAt := time.Date(2021, 6, 1, 12, 30, 0, 0, time.UTC)
+	updatedAt := ta.Timestamp(createdAt.Add(time.Hour))
+	link := ta.URL(url.URL{Scheme: "https", Host: "example.com", Path: "/events"})
+
+	give := &ta.Event{
+		CreatedAt: createdAt,
+		UpdatedAt: &updatedAt,
+		Link:      &link,
+		History:   []ta.Timestamp{ta.Timestamp(createdAt), updatedAt},
+		Mirrors:   []ta.URL{link},
+		Hits: []struct {
+			Key   ta.URL
+			Value int32
+		}{{Key: link, Value: 42}},
+	}
+
+	t.Run("wire", func(t *testing.T) {
+		w, err := give.ToWire()
+		require.NoError(t, err)
+
+		createdAtField := w.GetStruct().Fields[0]
+		assert.Equal(t, int16(1), createdAtField.ID)
+		assert.Equal(t, createdAt.UnixNano(), createdAtField.Value.GetI64(),
+			"adapter must be used to serialize the field")
+
+		var got ta.Event
+		require.NoError(t, got.FromWire(w))
+		assert.True(t, give.Equals(&got), "round trip: %v != %v", give, &got)
+	})
+
+	t.Run("equals uses adapter", func(t *testing.T) {
+		other := *give
+		// Same instant in a different location is equal per time.Time.Equal.
+		other.CreatedAt = createdAt.In(time.FixedZone("X", 3600))
+		assert.True(t, give.Equals(&other))
+
+		other.CreatedAt = createdAt.Add(time.Second)
+		assert.False(t, give.Equals(&other))
+	})
+
+	t.Run("defaults", func(t *testing.T) {
+		epoch := time.Unix(0, 0).UTC()
+		assert.True(t, time.Time(ta.Epoch).Equal(epoch))
+		assert.True(t, time.Time(ta.Default_Event().GetUpdatedAt()).Equal(epoch))
+
+		var e ta.Event
+		assert.True(t, time.Time(e.GetUpdatedAt()).Equal(epoch))
+	})
+
+	t.Run("zap", func(t *testing.T) {
+		enc := zapcore.NewMapObjectEncoder()
+		require.NoError(t, give.MarshalLogObject(enc))
+		assert.Equal(t, createdAt, enc.Fields["createdAt"])
+		assert.Equal(t, time.Time(updatedAt), enc.Fields["updatedAt"])
+		assert.Equal(t, url.URL(link), enc.Fields["link"])
+	})
+
+	t.Run("json", func(t *testing.T) {
+		b, err := json.Marshal(give)
+		require.NoError(t, err)
+
+		var got ta.Event
+		require.NoError(t, json.Unmarshal(b, &got))
+		assert.True(t, give.Equals(&got), "round trip: %v != %v", give, &got)
+	})
+}
+
+func TestAdapterErrors(t *testing.T) {
+	tests := []struct {
+		desc    string
+		spec    compile.TypeSpec
+		wantErr string
+	}{
+		{
+			desc: "missing go.type",
+			spec: &compile.I64Spec{Annotations: compile.Annotations{
+				"go.adapter": "example.com/foo.Bar",
+			}},
+			wantErr: `go.adapter on "i64" requires a go.type annotation`,
+		},
+		{
+			desc: "unqualified go.type",
+			spec: &compile.I64Spec{Annotations: compile.Annotations{
+				"go.type":    "Time",
+				"go.adapter": "example.com/foo.Bar",
+			}},
+			wantErr: `invalid go.type on "i64": "Time" is not a qualified Go name`,
+		},
+		{
+			desc: "unqualified go.adapter",
+			spec: &compile.StringSpec{Annotations: compile.Annotations{
+				"go.type":    "net/url.URL",
+				"go.adapter": "example.com/foo/",
+			}},
+			wantErr: `invalid go.adapter on "string": "example.com/foo/" is not a qualified Go name`,
+		},
+		{
+			desc: "not a primitive",
+			spec: &compile.BinarySpec{Annotations: compile.Annotations{
+				"go.type":    "net.IP",
+				"go.adapter": "example.com/foo.IP",
+			}},
+			wantErr: `go.adapter is not supported on "binary"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			g := NewGenerator(&GeneratorOptions{
+				Importer:    thriftPackageImporter{},
+				ImportPath:  "go.uber.org/thriftrw/gen/internal/tests/foo",
+				PackageName: "foo",
+			})
+			_, err := typeName(g, tt.spec)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+}
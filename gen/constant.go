@@ -44,6 +44,10 @@ func Constant(g Generator, c *compile.Constant) error {
 //
 // The constant must already have been linked to the given type.
 func ConstantValue(g Generator, c compile.ConstantValue, t compile.TypeSpec) (string, error) {
+	if hasAdapter(t) {
+		return constantAdapted(g, c, t)
+	}
+
 	switch v := c.(type) {
 	case compile.ConstantBool:
 		return constantBool(g, v, t)
@@ -73,6 +77,27 @@ func ConstantValue(g Generator, c compile.ConstantValue, t compile.TypeSpec) (st
 	}
 }
 
+// constantAdapted generates an expression containing the given constant
+// value for a primitive type mapped to a custom Go type, or a typedef of one.
+func constantAdapted(g Generator, c compile.ConstantValue, t compile.TypeSpec) (string, error) {
+	// References to other constants are resolved to their values because
+	// the adapter converts from the native Thrift representation.
+	for {
+		ref, ok := c.(compile.ConstReference)
+		if !ok {
+			break
+		}
+		c = ref.Target.Value
+	}
+
+	root := compile.RootTypeSpec(t)
+	s, err := adaptedConstant(g, c, root)
+	if err == nil && t != root {
+		s, err = castConstant(g, t, s)
+	}
+	return s, err
+}
+
 func castConstant(g Generator, t compile.TypeSpec, s string) (string, error) {
 	n, err := typeName(g, t)
 	if err != nil {
@@ -182,10 +207,47 @@ func constantSet(g Generator, v compile.ConstantSet, t compile.TypeSpec) (string
 }
 
 func constantStruct(g Generator, v *compile.ConstantStruct, t compile.TypeSpec) (string, error) {
-	fields := compile.RootTypeSpec(t).(*compile.StructSpec).Fields
+	spec := compile.RootTypeSpec(t).(*compile.StructSpec)
+	if isUnionInterface(spec) {
+		return constantUnionVariant(g, v, spec)
+	}
+
+	byValue, err := storesOptionalsByValue(spec)
+	if err != nil {
+		return "", err
+	}
+
+	var presence presenceBits
+	if byValue {
+		presence = newPresenceBits(spec.Fields)
+	}
+
+	// Optional fields stored by value must be set with their Set methods to
+	// record their presence.
 	return g.TextTemplate(
 		`
 		<- $fields := .Fields ->
+		<- $presence := .Presence ->
+		<- if .Presence ->
+		<- $v := newVar "v" ->
+		func() *<typeName .Spec> {
+			<$v> := &<typeName .Spec>{
+				<- range $name, $value := .Value.Fields>
+					<- $field := $fields.FindByName $name>
+					<- if not ($presence.Has $field)>
+						<goName $field>: <constantValue $value $field.Type>,
+					<- end>
+				<- end>
+			}
+			<- range $name, $value := .Value.Fields>
+				<- $field := $fields.FindByName $name>
+				<- if $presence.Has $field>
+					<$v>.Set<goName $field>(<constantValue $value $field.Type>)
+				<- end>
+			<- end>
+			return <$v>
+		}()
+		<- else ->
 		&<typeName .Spec>{
 			<range $name, $value := .Value.Fields>
 				<- $field := $fields.FindByName $name ->
@@ -195,16 +257,46 @@ func constantStruct(g Generator, v *compile.ConstantStruct, t compile.TypeSpec)
 					<goName $field>: <constantValue $value $field.Type>,
 				<- end>
 			<end>
-		}`, struct {
-			Spec   compile.TypeSpec
-			Fields compile.FieldGroup
-			Value  *compile.ConstantStruct
-		}{Spec: t, Fields: fields, Value: v},
+		}
+		<- end>`, struct {
+			Spec     compile.TypeSpec
+			Fields   compile.FieldGroup
+			Value    *compile.ConstantStruct
+			Presence presenceBits
+		}{Spec: t, Fields: spec.Fields, Value: v, Presence: presence},
 		TemplateFunc("constantValue", ConstantValue),
 		TemplateFunc("constantValuePtr", ConstantValuePtr),
 	)
 }
 
+// constantUnionVariant generates an expression holding the given constant
+// value of a union represented as a sealed interface.
+func constantUnionVariant(g Generator, v *compile.ConstantStruct, spec *compile.StructSpec) (string, error) {
+	if len(v.Fields) != 1 {
+		return "", fmt.Errorf(
+			"constant of union %q must set exactly one field: got %v fields",
+			spec.ThriftName(), len(v.Fields))
+	}
+
+	var (
+		name  string
+		value compile.ConstantValue
+	)
+	for name, value = range v.Fields {
+	}
+
+	field, err := spec.Fields.FindByName(name)
+	if err != nil {
+		return "", err
+	}
+	variant, err := unionVariantName(g, spec, field)
+	if err != nil {
+		return "", err
+	}
+	s, err := ConstantValue(g, value, field.Type)
+	return fmt.Sprintf("%v{Value: %v}", variant, s), err
+}
+
 func enumItemReference(g Generator, v compile.EnumItemReference, t compile.TypeSpec) (_ string, err error) {
 	s, err := g.TextTemplate(`<enumItemName (typeName .Enum) .Item>`, v)
 	if err != nil {
@@ -221,6 +313,14 @@ func enumItemReference(g Generator, v compile.EnumItemReference, t compile.TypeS
 func ConstantValuePtr(g Generator, c compile.ConstantValue, t compile.TypeSpec) (string, error) {
 	var ptrFunc string
 
+	if it, err := intTypeFor(t); err != nil {
+		return "", err
+	} else if it != nil {
+		// "uint32" becomes "ptr.Uint32"
+		s, err := ConstantValue(g, c, t)
+		return fmt.Sprintf("%v.%v(%v)", g.Import("go.uber.org/thriftrw/ptr"), goCase(it.Name), s), err
+	}
+
 	switch t.(type) {
 	case *compile.BoolSpec:
 		ptrFunc = fmt.Sprintf("%v.Bool", g.Import("go.uber.org/thriftrw/ptr"))
@@ -237,6 +337,14 @@ func ConstantValuePtr(g Generator, c compile.ConstantValue, t compile.TypeSpec)
 	case *compile.StringSpec:
 		ptrFunc = fmt.Sprintf("%v.String", g.Import("go.uber.org/thriftrw/ptr"))
 	case *compile.EnumSpec, *compile.TypedefSpec:
+		// handled below
+	default:
+		return ConstantValue(g, c, t) // not a primitive
+	}
+
+	// Enums, typedefs, and custom Go types don't have helpers in the ptr
+	// package.
+	if ptrFunc == "" || hasAdapter(t) {
 		ptrFunc = fmt.Sprintf("_%s_ptr", g.MangleType(t))
 		err := g.EnsureDeclared(
 			`func <.Name>(v <typeReference .Spec>) *<typeReference .Spec> {
@@ -248,8 +356,6 @@ func ConstantValuePtr(g Generator, c compile.ConstantValue, t compile.TypeSpec)
 		if err != nil {
 			return "", err
 		}
-	default:
-		return ConstantValue(g, c, t) // not a primitive
 	}
 
 	s, err := ConstantValue(g, c, t)
@@ -0,0 +1,144 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package gen
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/thriftrw/compile"
+)
+
+func TestWithDeprecation(t *testing.T) {
+	tests := []struct {
+		desc string
+		doc  string
+		dep  *compile.Deprecation
+		want string
+	}{
+		{desc: "not deprecated", doc: "Foo does things.", want: "Foo does things."},
+		{
+			desc: "no doc",
+			dep:  &compile.Deprecation{Reason: "Use Bar."},
+			want: "Deprecated: Use Bar.",
+		},
+		{
+			desc: "no reason",
+			doc:  "Foo does things.",
+			dep:  &compile.Deprecation{},
+			want: "Foo does things.\n\nDeprecated: This is deprecated in the Thrift file.",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			assert.Equal(t, tt.want, withDeprecation(tt.doc, tt.dep))
+		})
+	}
+}
+
+func TestDeprecatedDocComments(t *testing.T) {
+	fset := token.NewFileSet()
+	f, err := parser.ParseFile(fset, "internal/tests/deprecated/deprecated.go", nil, parser.ParseComments)
+	require.NoError(t, err)
+
+	// Names of top-level declarations, fields, and enum items whose doc
+	// comments have a "Deprecated:" paragraph.
+	got := make(map[string]struct{})
+	check := func(name string, doc *ast.CommentGroup) {
+		if doc == nil {
+			return
+		}
+		if text := doc.Text(); strings.HasPrefix(text, "Deprecated: ") || strings.Contains(text, "\nDeprecated: ") {
+			got[name] = struct{}{}
+		}
+	}
+
+	for _, decl := range f.Decls {
+		switch decl := decl.(type) {
+		case *ast.FuncDecl:
+			if decl.Recv == nil {
+				check(decl.Name.Name, decl.Doc)
+			}
+		case *ast.GenDecl:
+			for _, spec := range decl.Specs {
+				switch spec := spec.(type) {
+				case *ast.TypeSpec:
+					doc := spec.Doc
+					if doc == nil {
+						doc = decl.Doc
+					}
+					check(spec.Name.Name, doc)
+					if st, ok := spec.Type.(*ast.StructType); ok {
+						for _, field := range st.Fields.List {
+							for _, n := range field.Names {
+								check(spec.Name.Name+"."+n.Name, field.Doc)
+							}
+						}
+					}
+				case *ast.ValueSpec:
+					doc := spec.Doc
+					if doc == nil && len(decl.Specs) == 1 {
+						doc = decl.Doc
+					}
+					for _, n := range spec.Names {
+						check(n.Name, doc)
+					}
+				}
+			}
+		}
+	}
+
+	for _, name := range []string{
+		"LegacyID",
+		"StatusSuspended",
+		"Role",
+		"User.LegacyID",
+		"Contact_Pager",
+		"OldUser",
+		"Users_GetOldUser_Args",
+		"Users_GetOldUser_Result",
+		"Users_GetOldUser_Helper",
+		"LegacyUsers_Get_Args",
+		"LegacyUsers_Get_Result",
+		"LegacyUsers_Get_Helper",
+	} {
+		assert.Contains(t, got, name, "%v must be marked deprecated", name)
+	}
+
+	for _, name := range []string{
+		"UserID",
+		"Status",
+		"StatusBanned",
+		"User",
+		"User.ID",
+		"Contact",
+		"Users_GetUser_Args",
+		"Users_GetUser_Helper",
+	} {
+		assert.NotContains(t, got, name, "%v must not be marked deprecated", name)
+	}
+}
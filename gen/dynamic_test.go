@@ -0,0 +1,110 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package gen
+
+import (
+	"testing"
+
+	"go.uber.org/thriftrw/compile"
+	"go.uber.org/thriftrw/dynamic"
+	"go.uber.org/thriftrw/gen/internal/tests/structs"
+	"go.uber.org/thriftrw/gen/internal/tests/unions"
+	"go.uber.org/thriftrw/ptr"
+	"go.uber.org/thriftrw/wire"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestDynamicMatchesGeneratedCode verifies that the dynamic package encodes
+// and decodes values the same way as generated code.
+func TestDynamicMatchesGeneratedCode(t *testing.T) {
+	type wireValuer interface {
+		ToWire() (wire.Value, error)
+		FromWire(wire.Value) error
+	}
+
+	tests := []struct {
+		desc string
+		file string
+		name string
+		give wireValuer
+		// An empty value of the same type as give.
+		empty wireValuer
+	}{
+		{
+			desc: "nested structs",
+			file: "structs.thrift",
+			name: "Frame",
+			give: &structs.Frame{
+				TopLeft: &structs.Point{X: 1, Y: 2},
+				Size:    &structs.Size{Width: 3, Height: 4},
+			},
+			empty: &structs.Frame{},
+		},
+		{
+			desc: "defaults",
+			file: "structs.thrift",
+			name: "DefaultsStruct",
+			give: &structs.DefaultsStruct{
+				RequiredPrimitive: ptr.Int32(1),
+			},
+			empty: &structs.DefaultsStruct{},
+		},
+		{
+			desc: "recursive union",
+			file: "unions.thrift",
+			name: "ArbitraryValue",
+			give: &unions.ArbitraryValue{
+				MapValue: map[string]*unions.ArbitraryValue{
+					"foo": {ListValue: []*unions.ArbitraryValue{
+						{BoolValue: ptr.Bool(true)},
+						{StringValue: ptr.String("bar")},
+					}},
+				},
+			},
+			empty: &unions.ArbitraryValue{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			m, err := compile.Compile(testdata(t, "thrift", tt.file))
+			require.NoError(t, err)
+			spec := m.Types[tt.name].(*compile.StructSpec)
+
+			// FromWire in generated code applies defaults, so we compare
+			// against the result of a round trip.
+			w, err := tt.give.ToWire()
+			require.NoError(t, err)
+			require.NoError(t, tt.empty.FromWire(w))
+			want, err := tt.empty.ToWire()
+			require.NoError(t, err)
+
+			s, err := dynamic.FromWire(spec, w)
+			require.NoError(t, err)
+			got, err := s.ToWire()
+			require.NoError(t, err)
+
+			assert.True(t, wire.ValuesAreEqual(want, got), "wire values must match:\n%v\n%v", want, got)
+		})
+	}
+}
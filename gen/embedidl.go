@@ -28,8 +28,10 @@ import (
 	"go.uber.org/thriftrw/compile"
 )
 
-// embedIDL generate Go code with a full copy of the IDL embeded.
-func embedIDL(g Generator, i thriftPackageImporter, m *compile.Module) error {
+// embedIDL generate Go code with a full copy of the IDL embeded. If register
+// is set, the module is registered with the thriftreflect registry on
+// initialization.
+func embedIDL(g Generator, i thriftPackageImporter, m *compile.Module, register bool) error {
 	pkg, err := i.Package(m.ThriftPath)
 	if err != nil {
 		return wrapGenerateError("idl embedding", err)
@@ -51,12 +53,34 @@ func embedIDL(g Generator, i thriftPackageImporter, m *compile.Module) error {
 
 	sort.Strings(includes)
 
+	// Constructors for the generated struct types are registered with the
+	// runtime registry alongside the module.
+	type structType struct {
+		ThriftName string
+		GoName     string
+	}
+	var types []structType
+	for _, name := range sortStringKeys(m.Types) {
+		spec, ok := m.Types[name].(*compile.StructSpec)
+		if !ok || isUnionInterface(spec) {
+			continue
+		}
+
+		goName, err := goName(spec)
+		if err != nil {
+			return wrapGenerateError("idl embedding", err)
+		}
+		types = append(types, structType{ThriftName: name, GoName: goName})
+	}
+
 	data := struct {
 		Name     string
 		Package  string
 		FilePath string
 		SHA1     string
 		Includes []string
+		Register bool
+		Types    []structType
 		Raw      []byte
 	}{
 		Name:     m.Name,
@@ -64,6 +88,8 @@ func embedIDL(g Generator, i thriftPackageImporter, m *compile.Module) error {
 		FilePath: packageRelPath,
 		SHA1:     hex.EncodeToString(hash[:]),
 		Includes: includes,
+		Register: register,
+		Types:    types,
 		Raw:      m.Raw,
 	}
 	err = g.DeclareFromTemplate(`
@@ -83,6 +109,18 @@ func embedIDL(g Generator, i thriftPackageImporter, m *compile.Module) error {
 			Raw: rawIDL,
 		}
 		const rawIDL = <printf "%q" .Raw>
+		<if .Register>
+
+		func init() {
+			<if .Types ->
+				<$idl>.Register(ThriftModule, map[string]<$idl>.Constructor{<range .Types>
+					<printf "%q" .ThriftName>: func() interface{} { return new(<.GoName>) },<end>
+				})
+			<- else ->
+				<$idl>.Register(ThriftModule, nil)
+			<- end>
+		}
+		<end>
 		`, data)
 	return wrapGenerateError("idl embedding", err)
 }
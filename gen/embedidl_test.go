@@ -25,10 +25,13 @@ import (
 	"encoding/hex"
 	"io/ioutil"
 	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/thriftrw/compile"
 	te "go.uber.org/thriftrw/gen/internal/tests/enums"
 	ts "go.uber.org/thriftrw/gen/internal/tests/structs"
 	"go.uber.org/thriftrw/thriftreflect"
@@ -80,3 +83,48 @@ func TestIDLEmbeddingInclude(t *testing.T) {
 		assert.Equal(t, te.ThriftModule, tm.Includes[0])
 	}
 }
+
+func TestIDLEmbeddingRegister(t *testing.T) {
+	tests := []struct {
+		desc         string
+		registerIDL  bool
+		noEmbedIDL   bool
+		wantRegister bool
+	}{
+		{desc: "default"},
+		{desc: "register", registerIDL: true, wantRegister: true},
+		{desc: "register without embedding", registerIDL: true, noEmbedIDL: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			thriftRoot, err := ioutil.TempDir("", "thriftrw-register-test")
+			require.NoError(t, err)
+			defer os.RemoveAll(thriftRoot)
+
+			outputDir, err := ioutil.TempDir("", "thriftrw-register-test")
+			require.NoError(t, err)
+			defer os.RemoveAll(outputDir)
+
+			path := filepath.Join(thriftRoot, "foo.thrift")
+			require.NoError(t, ioutil.WriteFile(path, []byte("struct Foo {}"), 0644))
+
+			module, err := compile.Compile(path)
+			require.NoError(t, err)
+
+			require.NoError(t, Generate(module, &Options{
+				OutputDir:      outputDir,
+				PackagePrefix:  "example.com/idl",
+				ThriftRoot:     thriftRoot,
+				NoVersionCheck: true,
+				NoEmbedIDL:     tt.noEmbedIDL,
+				RegisterIDL:    tt.registerIDL,
+			}))
+
+			contents, err := ioutil.ReadFile(filepath.Join(outputDir, "foo", "foo.go"))
+			require.NoError(t, err)
+			assert.Equal(t, !tt.noEmbedIDL, strings.Contains(string(contents), "var ThriftModule"))
+			assert.Equal(t, tt.wantRegister, strings.Contains(string(contents), "thriftreflect.Register("))
+		})
+	}
+}
@@ -71,12 +71,12 @@ func enum(g Generator, spec *compile.EnumSpec) error {
 		<$wire := import "go.uber.org/thriftrw/wire">
 
 		<$enumName := goName .Spec>
-		<formatDoc .Spec.Doc>type <$enumName> int32
+		<formatDoc (withDeprecation .Spec.Doc .Spec.Deprecated)>type <$enumName> int32
 
 		<if .Spec.Items>
 			const (
 			<range .Spec.Items>
-				<- formatDoc .Doc><enumItemName $enumName .> <$enumName> = <.Value>
+				<- formatDoc (withDeprecation .Doc .Deprecated)><enumItemName $enumName .> <$enumName> = <.Value>
 			<end>
 			)
 		<end>
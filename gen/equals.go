@@ -37,12 +37,24 @@ type equalsGenerator struct {
 // Equals generates a string comparing rhs to the given lhs.
 // Equals generates an expression of type bool.
 func (e *equalsGenerator) Equals(g Generator, spec compile.TypeSpec, lhs, rhs string) (string, error) {
+	if a, err := adapterFor(spec); err != nil {
+		return "", err
+	} else if a != nil {
+		return fmt.Sprintf("%s.Equals(%s, %s)", a.Adapter.Reference(g), lhs, rhs), nil
+	}
+
 	if isPrimitiveType(spec) {
 		if _, isEnum := spec.(*compile.EnumSpec); !isEnum {
 			return fmt.Sprintf("(%s == %s)", lhs, rhs), nil
 		}
 	}
 
+	if isUnionInterface(spec) {
+		// The Equals method can't be called on a nil interface.
+		equals, err := unionFuncName(g, spec, "Equals")
+		return fmt.Sprintf("%s(%s, %s)", equals, lhs, rhs), err
+	}
+
 	switch s := spec.(type) {
 	case *compile.BinarySpec:
 		bytes := g.Import("bytes")
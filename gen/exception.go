@@ -0,0 +1,249 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package gen
+
+import (
+	"fmt"
+
+	"go.uber.org/thriftrw/ast"
+	"go.uber.org/thriftrw/compile"
+)
+
+const (
+	// goCauseKey is a Thrift annotation on a field of an exception which
+	// marks it as the error that caused the exception. The field must hold
+	// another exception.
+	//
+	// 	exception StorageError {
+	// 		1: required string message
+	// 		2: optional TimeoutError cause (go.cause)
+	// 	}
+	//
+	// The generated StorageError implements Unwrap, allowing errors.Is and
+	// errors.As to inspect the cause.
+	goCauseKey = "go.cause"
+
+	// goErrorCodeKey is a Thrift annotation on exceptions which assigns them
+	// a stable error code, returned by the generated ErrorCode method.
+	//
+	// 	exception NotFoundError {
+	// 		1: required string message
+	// 	} (go.error_code = "not-found")
+	//
+	// Error codes must be unique within a Thrift file.
+	goErrorCodeKey = "go.error_code"
+)
+
+// causeField returns the field of the given struct annotated with go.cause,
+// or nil if there isn't one.
+func causeField(spec *compile.StructSpec) (*compile.FieldSpec, error) {
+	var cause *compile.FieldSpec
+	for _, f := range spec.Fields {
+		if _, ok := f.Annotations[goCauseKey]; !ok {
+			continue
+		}
+
+		if spec.Type != ast.ExceptionType {
+			return nil, fmt.Errorf(
+				"%v on field %q is only supported on exceptions", goCauseKey, f.Name)
+		}
+		if cause != nil {
+			return nil, fmt.Errorf(
+				"%v may only be used on one field: found on %q and %q",
+				goCauseKey, cause.Name, f.Name)
+		}
+		if !isException(f.Type) {
+			return nil, fmt.Errorf(
+				"%v on field %q requires an exception: got %q",
+				goCauseKey, f.Name, f.Type.ThriftName())
+		}
+		cause = f
+	}
+	return cause, nil
+}
+
+// errorCode returns the error code of the given struct, if any.
+func errorCode(spec *compile.StructSpec) (string, error) {
+	code, ok := spec.Annotations[goErrorCodeKey]
+	if !ok {
+		return "", nil
+	}
+	if spec.Type != ast.ExceptionType {
+		return "", fmt.Errorf("%v is only supported on exceptions", goErrorCodeKey)
+	}
+	if code == "" {
+		return "", fmt.Errorf("%v must not be empty", goErrorCodeKey)
+	}
+	return code, nil
+}
+
+// isException returns true if the given type is a Thrift exception.
+func isException(spec compile.TypeSpec) bool {
+	s, ok := compile.RootTypeSpec(spec).(*compile.StructSpec)
+	return ok && s.Type == ast.ExceptionType
+}
+
+// exception generates the methods that integrate the given exception with
+// the errors package.
+func exception(g Generator, spec *compile.StructSpec) error {
+	cause, err := causeField(spec)
+	if err != nil {
+		return err
+	}
+
+	code, err := errorCode(spec)
+	if err != nil {
+		return err
+	}
+
+	return g.DeclareFromTemplate(
+		`
+		<$v := newVar "v">
+		<$name := typeName .Spec>
+		func (<$v> *<$name>) Error() string {
+			return <$v>.String()
+		}
+
+		<if .Cause>
+		<- $f := printf "%s.%s" $v (goName .Cause)>
+		// Unwrap returns the error that caused this <$name>, if any.
+		func (<$v> *<$name>) Unwrap() error {
+			if <$v> == nil || <$f> == nil {
+				return nil
+			}
+			return <$f>
+		}
+		<end>
+
+		<if .Code>
+		// ErrorCode returns the stable error code of <$name>,
+		// <printf "%q" .Code>.
+		func (*<$name>) ErrorCode() string {
+			return <printf "%q" .Code>
+		}
+		<end>
+		`,
+		struct {
+			Spec  *compile.StructSpec
+			Cause *compile.FieldSpec
+			Code  string
+		}{Spec: spec, Cause: cause, Code: code},
+	)
+}
+
+// exceptionFromWireName is the name of the function generated by
+// exceptionRegistry. It is reserved in packages which define exceptions.
+const exceptionFromWireName = "ExceptionFromWire"
+
+// checkExceptionFromWireName fails if a type or constant defined in the given
+// module uses the name reserved for the generated ExceptionFromWire function.
+func checkExceptionFromWireName(m *compile.Module) error {
+	for _, name := range sortStringKeys(m.Types) {
+		goName, err := goName(m.Types[name])
+		if err != nil {
+			return wrapGenerateError(name, err)
+		}
+		if goName == exceptionFromWireName {
+			return wrapGenerateError(name, fmt.Errorf(
+				"%q is a reserved ThriftRW identifier: use go.name to rename it", goName))
+		}
+	}
+	for _, name := range sortStringKeys(m.Constants) {
+		if goName := constantName(name); goName == exceptionFromWireName {
+			return wrapGenerateError(name, fmt.Errorf(
+				"%q is a reserved ThriftRW identifier", goName))
+		}
+	}
+	return nil
+}
+
+// exceptionRegistry generates the ExceptionFromWire function which decodes any
+// exception defined in the given module by its Thrift name.
+func exceptionRegistry(g Generator, m *compile.Module) error {
+	var exceptions []*compile.StructSpec
+	codes := make(map[string]string)
+	for _, name := range sortStringKeys(m.Types) {
+		spec, ok := m.Types[name].(*compile.StructSpec)
+		if !ok || spec.Type != ast.ExceptionType {
+			continue
+		}
+
+		code, err := errorCode(spec)
+		if err != nil {
+			return wrapGenerateError(spec.Name, err)
+		}
+		if other, ok := codes[code]; ok && code != "" {
+			return wrapGenerateError(spec.Name, fmt.Errorf(
+				"%v %q is already used by %q", goErrorCodeKey, code, other))
+		}
+		codes[code] = spec.Name
+		exceptions = append(exceptions, spec)
+	}
+
+	if len(exceptions) == 0 {
+		return nil
+	}
+
+	if err := checkExceptionFromWireName(m); err != nil {
+		return err
+	}
+
+	return g.DeclareFromTemplate(
+		`
+		<$wire := import "go.uber.org/thriftrw/wire">
+		<$name := newVar "name">
+		<$w := newVar "w">
+		// ExceptionFromWire deserializes the exception defined in this
+		// package with the given Thrift name from its Thrift-level
+		// representation.
+		//
+		// ok is false if this package does not define an exception with the
+		// given name. err is non-nil if the exception could not be
+		// deserialized.
+		//
+		//   e, ok, err := ExceptionFromWire("<(index .Exceptions 0).Name>", value)
+		//   if err != nil {
+		//     return err
+		//   }
+		//   if ok {
+		//     return e
+		//   }
+		func ExceptionFromWire(<$name> string, <$w> <$wire>.Value) (exc error, ok bool, err error) {
+			switch <$name> {
+			<range .Exceptions ->
+			case "<.Name>":
+				<- $v := newVar "v">
+				var <$v> <typeName .>
+				if err := <$v>.FromWire(<$w>); err != nil {
+					return nil, true, err
+				}
+				return &<$v>, true, nil
+			<end ->
+			default:
+				return nil, false, nil
+			}
+		}
+		`,
+		struct {
+			Exceptions []*compile.StructSpec
+		}{Exceptions: exceptions},
+	)
+}
@@ -0,0 +1,265 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package gen
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/thriftrw/ast"
+	"go.uber.org/thriftrw/compile"
+	tx "go.uber.org/thriftrw/gen/internal/tests/exceptions"
+	"go.uber.org/thriftrw/wire"
+)
+
+func TestExceptionErrors(t *testing.T) {
+	t.Run("unwrap", func(t *testing.T) {
+		timeout := &tx.TimeoutError{Message: "too slow"}
+		var err error = &tx.StorageError{Message: "write failed", Cause: timeout}
+
+		assert.True(t, errors.Is(err, timeout))
+		assert.Equal(t, timeout, errors.Unwrap(err))
+
+		var got *tx.TimeoutError
+		require.True(t, errors.As(err, &got))
+		assert.Equal(t, "too slow", got.Message)
+
+		assert.Nil(t, errors.Unwrap(&tx.StorageError{Message: "write failed"}),
+			"a nil cause must not be wrapped in a non-nil error")
+	})
+
+	t.Run("error codes and names", func(t *testing.T) {
+		assert.Equal(t, "storage", (&tx.StorageError{}).ErrorCode())
+		assert.Equal(t, "timeout", (&tx.TimeoutError{}).ErrorCode())
+		assert.Equal(t, "StorageError", (&tx.StorageError{}).ErrorName())
+	})
+
+	t.Run("registry", func(t *testing.T) {
+		give := &tx.StorageError{
+			Message: "write failed",
+			Cause:   &tx.TimeoutError{Message: "too slow"},
+		}
+		w, err := give.ToWire()
+		require.NoError(t, err)
+
+		got, ok, err := tx.ExceptionFromWire("StorageError", w)
+		require.NoError(t, err)
+		require.True(t, ok)
+		assert.Equal(t, give, got)
+
+		got, ok, err = tx.ExceptionFromWire("StorageError", wire.NewValueStruct(wire.Struct{}))
+		assert.EqualError(t, err, "field Message of StorageError is required")
+		assert.True(t, ok)
+		assert.Nil(t, got)
+
+		got, ok, err = tx.ExceptionFromWire("UnknownError", w)
+		require.NoError(t, err)
+		assert.False(t, ok)
+		assert.Nil(t, got)
+	})
+}
+
+func TestExceptionAnnotationErrors(t *testing.T) {
+	exception := &compile.StructSpec{
+		Name: "Cause",
+		Type: ast.ExceptionType,
+	}
+
+	tests := []struct {
+		desc    string
+		spec    *compile.StructSpec
+		wantErr string
+	}{
+		{
+			desc: "cause on struct",
+			spec: &compile.StructSpec{
+				Name: "Foo",
+				Type: ast.StructType,
+				Fields: compile.FieldGroup{
+					{
+						ID:          1,
+						Name:        "cause",
+						Type:        exception,
+						Annotations: compile.Annotations{"go.cause": ""},
+					},
+				},
+			},
+			wantErr: `go.cause on field "cause" is only supported on exceptions`,
+		},
+		{
+			desc: "cause is not an exception",
+			spec: &compile.StructSpec{
+				Name: "Foo",
+				Type: ast.ExceptionType,
+				Fields: compile.FieldGroup{
+					{
+						ID:          1,
+						Name:        "cause",
+						Type:        &compile.StringSpec{},
+						Annotations: compile.Annotations{"go.cause": ""},
+					},
+				},
+			},
+			wantErr: `go.cause on field "cause" requires an exception: got "string"`,
+		},
+		{
+			desc: "multiple causes",
+			spec: &compile.StructSpec{
+				Name: "Foo",
+				Type: ast.ExceptionType,
+				Fields: compile.FieldGroup{
+					{
+						ID:          1,
+						Name:        "first",
+						Type:        exception,
+						Annotations: compile.Annotations{"go.cause": ""},
+					},
+					{
+						ID:          2,
+						Name:        "second",
+						Type:        exception,
+						Annotations: compile.Annotations{"go.cause": ""},
+					},
+				},
+			},
+			wantErr: `go.cause may only be used on one field: found on "first" and "second"`,
+		},
+		{
+			desc: "error code on struct",
+			spec: &compile.StructSpec{
+				Name:        "Foo",
+				Type:        ast.StructType,
+				Annotations: compile.Annotations{"go.error_code": "foo"},
+			},
+			wantErr: `go.error_code is only supported on exceptions`,
+		},
+		{
+			desc: "empty error code",
+			spec: &compile.StructSpec{
+				Name:        "Foo",
+				Type:        ast.ExceptionType,
+				Annotations: compile.Annotations{"go.error_code": ""},
+			},
+			wantErr: `go.error_code must not be empty`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			g := NewGenerator(&GeneratorOptions{
+				Importer:    thriftPackageImporter{},
+				ImportPath:  "go.uber.org/thriftrw/gen/internal/tests/foo",
+				PackageName: "foo",
+			})
+			err := structure(g, tt.spec)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+
+	t.Run("duplicate error code", func(t *testing.T) {
+		g := NewGenerator(&GeneratorOptions{
+			Importer:    thriftPackageImporter{},
+			ImportPath:  "go.uber.org/thriftrw/gen/internal/tests/foo",
+			PackageName: "foo",
+		})
+		err := exceptionRegistry(g, &compile.Module{
+			Types: map[string]compile.TypeSpec{
+				"A": &compile.StructSpec{
+					Name:        "A",
+					Type:        ast.ExceptionType,
+					Annotations: compile.Annotations{"go.error_code": "foo"},
+				},
+				"B": &compile.StructSpec{
+					Name:        "B",
+					Type:        ast.ExceptionType,
+					Annotations: compile.Annotations{"go.error_code": "foo"},
+				},
+			},
+		})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), `go.error_code "foo" is already used by "A"`)
+	})
+
+	t.Run("reserved name", func(t *testing.T) {
+		err := checkExceptionFromWireName(&compile.Module{
+			Types: map[string]compile.TypeSpec{
+				"ExceptionFromWire": &compile.StructSpec{
+					Name: "ExceptionFromWire",
+					Type: ast.StructType,
+				},
+			},
+		})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), `"ExceptionFromWire" is a reserved ThriftRW identifier`)
+
+		err = checkExceptionFromWireName(&compile.Module{
+			Constants: map[string]*compile.Constant{
+				"exceptionFromWire": {Name: "exceptionFromWire"},
+			},
+		})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), `"ExceptionFromWire" is a reserved ThriftRW identifier`)
+
+		err = checkExceptionFromWireName(&compile.Module{
+			Types: map[string]compile.TypeSpec{
+				"ExceptionFromWire": &compile.StructSpec{
+					Name:        "ExceptionFromWire",
+					Type:        ast.StructType,
+					Annotations: compile.Annotations{"go.name": "WireException"},
+				},
+			},
+		})
+		assert.NoError(t, err, "renamed types must not conflict")
+
+		g := NewGenerator(&GeneratorOptions{
+			Importer:    thriftPackageImporter{},
+			ImportPath:  "go.uber.org/thriftrw/gen/internal/tests/foo",
+			PackageName: "foo",
+		})
+		err = exceptionRegistry(g, &compile.Module{
+			Types: map[string]compile.TypeSpec{
+				"ExceptionFromWire": &compile.StructSpec{
+					Name: "ExceptionFromWire",
+					Type: ast.StructType,
+				},
+			},
+		})
+		assert.NoError(t, err, "the name is only reserved in packages with exceptions")
+
+		err = exceptionRegistry(g, &compile.Module{
+			Types: map[string]compile.TypeSpec{
+				"ExceptionFromWire": &compile.StructSpec{
+					Name: "ExceptionFromWire",
+					Type: ast.StructType,
+				},
+				"Failure": &compile.StructSpec{
+					Name: "Failure",
+					Type: ast.ExceptionType,
+				},
+			},
+		})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), `"ExceptionFromWire" is a reserved ThriftRW identifier`)
+	})
+}
@@ -62,6 +62,10 @@ type fieldGroupGenerator struct {
 	// This field group represents a Thrift exception.
 	IsException bool
 
+	// Optional fields stored by value rather than as pointers, if any.
+	// See goOptionalKey.
+	Presence presenceBits
+
 	Doc string
 }
 
@@ -74,6 +78,32 @@ func (f fieldGroupGenerator) checkReservedIdentifier(name string) error {
 	return nil
 }
 
+// byValue returns true if the given optional field is stored by value with
+// its presence tracked in the presence bitmap.
+func (f fieldGroupGenerator) byValue(fs *compile.FieldSpec) bool {
+	return f.Presence.Has(fs)
+}
+
+// fieldValue returns an expression holding the value of the given field.
+// fieldRef is a reference to the field as it is stored in the struct.
+func (f fieldGroupGenerator) fieldValue(fs *compile.FieldSpec, fieldRef string) string {
+	if !fs.Required && !f.byValue(fs) && isPrimitiveType(fs.Type) {
+		return "*" + fieldRef
+	}
+	return fieldRef
+}
+
+// templateFuncs returns the template functions used to access fields of
+// this group.
+func (f fieldGroupGenerator) templateFuncs() []TemplateOption {
+	return []TemplateOption{
+		TemplateFunc("byValue", f.byValue),
+		TemplateFunc("fieldValue", f.fieldValue),
+		TemplateFunc("presenceWord", f.Presence.Word),
+		TemplateFunc("presenceMask", f.Presence.Mask),
+	}
+}
+
 func (f fieldGroupGenerator) Generate(g Generator) error {
 	if err := verifyUniqueFieldLabels(f.Fields); err != nil {
 		return err
@@ -99,6 +129,18 @@ func (f fieldGroupGenerator) Generate(g Generator) error {
 		return err
 	}
 
+	if hasRedactedFields(f.Fields) || len(f.Presence) > 0 {
+		if err := f.CustomMarshalJSON(g); err != nil {
+			return err
+		}
+	}
+
+	if len(f.Presence) > 0 || hasUnionInterfaceFields(f.Fields) {
+		if err := f.CustomUnmarshalJSON(g); err != nil {
+			return err
+		}
+	}
+
 	if f.IsException {
 		if err := f.ErrorName(g); err != nil {
 			return err
@@ -122,16 +164,24 @@ func (f fieldGroupGenerator) DefineStruct(g Generator) error {
 	return g.DeclareFromTemplate(
 		`<formatDoc .Doc>type <.Name> struct {
 			<range .Fields>
-				<- if .Required ->
-					<formatDoc .Doc><declFieldName .> <typeReference .Type> <tag .>
+				<- if or .Required (byValue .) ->
+					<formatDoc (withDeprecation .Doc .Deprecated)><declFieldName .> <typeReference .Type> <tag .>
 				<- else ->
-					<formatDoc .Doc><declFieldName .> <typeReferencePtr .Type> <tag .>
+					<formatDoc (withDeprecation .Doc .Deprecated)><declFieldName .> <typeReferencePtr .Type> <tag .>
 				<- end>
 			<end>
+			<- if .Presence>
+				<declPresenceField> [<.Presence.Words>]uint64
+			<- end>
 		}`,
 		f,
-		TemplateFunc("tag", generateTags),
-		TemplateFunc("declFieldName", f.declFieldName),
+		append(f.templateFuncs(),
+			TemplateFunc("tag", generateTags),
+			TemplateFunc("declFieldName", f.declFieldName),
+			TemplateFunc("declPresenceField", func() (string, error) {
+				return presenceField, f.Reserve(presenceField)
+			}),
+		)...,
 	)
 }
 
@@ -268,13 +318,18 @@ func (f fieldGroupGenerator) DefineDefaultConstructor(g Generator) error {
 			var v <.Name>
 			<- range .Fields ->
 				<- $fname := goName . ->
-				<- if isNotNil .Default>
+				<- if and (isNotNil .Default) (byValue .)>
+					<$v>.Set<$fname>(<constantValue .Default .Type>)
+				<- else if isNotNil .Default>
 					<$v>.<$fname> = <constantValuePtr .Default .Type>
 				<- end ->
 			<end>
 			return &v
 		}
-		`, f, TemplateFunc("constantValuePtr", ConstantValuePtr))
+		`, f, append(f.templateFuncs(),
+			TemplateFunc("constantValue", ConstantValue),
+			TemplateFunc("constantValuePtr", ConstantValuePtr),
+		)...)
 }
 
 func (f fieldGroupGenerator) ToWire(g Generator) error {
@@ -329,7 +384,15 @@ func (f fieldGroupGenerator) ToWire(g Generator) error {
 						<$fields>[<$i>] = <$wire>.Field{ID: <.ID>, Value: <$wVal>}
 						<$i>++
 				<- else ->
-					<- if isNotNil .Default ->
+					<- if and (isNotNil .Default) (byValue .) ->
+						<- $fval := printf "%s%s" $v $fname ->
+						<$fval> := <$f>
+						if !<$v>.IsSet<$fname>() {
+							<$fval> = <constantValue .Default .Type>
+						}
+						{
+							<$wVal>, err = <toWire .Type $fval>
+					<- else if isNotNil .Default ->
 						<- $fval := printf "%s%s" $v $fname ->
 						<$fval> := <$f>
 						if <$fval> == nil {
@@ -337,6 +400,9 @@ func (f fieldGroupGenerator) ToWire(g Generator) error {
 						}
 						{
 							<$wVal>, err = <toWirePtr .Type $fval>
+					<- else if byValue . ->
+						if <$v>.IsSet<$fname>() {
+							<$wVal>, err = <toWire .Type $f>
 					<- else ->
 						if <$f> != nil {
 							<$wVal>, err = <toWirePtr .Type $f>
@@ -365,7 +431,10 @@ func (f fieldGroupGenerator) ToWire(g Generator) error {
 
 			return <$wire>.NewValueStruct(<$wire>.Struct{Fields: <$fields>[:<$i>]}), nil
 		}
-		`, f, TemplateFunc("constantValuePtr", ConstantValuePtr))
+		`, f, append(f.templateFuncs(),
+			TemplateFunc("constantValue", ConstantValue),
+			TemplateFunc("constantValuePtr", ConstantValuePtr),
+		)...)
 }
 
 func (f fieldGroupGenerator) FromWire(g Generator) error {
@@ -410,7 +479,7 @@ func (f fieldGroupGenerator) FromWire(g Generator) error {
 					if <$f>.Value.Type() == <typeCode .Type> {
 						<- $lhs := printf "%s.%s" $v (goName .) ->
 						<- $value := printf "%s.Value" $f ->
-						<- if .Required ->
+						<- if or .Required (byValue .) ->
 							<$lhs>, err = <fromWire .Type $value>
 						<- else ->
 							<fromWirePtr .Type $lhs $value>
@@ -420,6 +489,8 @@ func (f fieldGroupGenerator) FromWire(g Generator) error {
 						}
 						<if .Required ->
 							<$isSet.Rotate (printf "%sIsSet" .Name)> = true
+						<- else if byValue . ->
+							<$v>.<presenceWord .> |= <presenceMask .>
 						<- end>
 					}
 				<end ->
@@ -430,7 +501,11 @@ func (f fieldGroupGenerator) FromWire(g Generator) error {
 			<range .Fields>
 				<$fname := goName .>
 				<$f := printf "%s.%s" $v $fname>
-				<if isNotNil .Default>
+				<if and (isNotNil .Default) (byValue .)>
+					if !<$v>.IsSet<$fname>() {
+						<$v>.Set<$fname>(<constantValue .Default .Type>)
+					}
+				<else if isNotNil .Default>
 					if <$f> == nil {
 						<$f> = <constantValuePtr .Default .Type>
 					}
@@ -464,7 +539,10 @@ func (f fieldGroupGenerator) FromWire(g Generator) error {
 			<end>
 			return nil
 		}
-		`, f, TemplateFunc("constantValuePtr", ConstantValuePtr))
+		`, f, append(f.templateFuncs(),
+			TemplateFunc("constantValue", ConstantValue),
+			TemplateFunc("constantValuePtr", ConstantValuePtr),
+		)...)
 }
 
 func (f fieldGroupGenerator) String(g Generator) error {
@@ -491,8 +569,10 @@ func (f fieldGroupGenerator) String(g Generator) error {
 				<- $f := printf "%s.%s" $v $fname ->
 
 				<- if not .Required ->
-					if <$f> != nil {
-						<if isPrimitiveType .Type ->
+					if <if byValue .><$v>.IsSet<$fname>()<else><$f> != nil<end> {
+						<if isRedacted . ->
+							<$fields>[<$i>] = "<$fname>: " + <redacted . (fieldValue . $f)>
+						<- else if and (isPrimitiveType .Type) (not (byValue .)) ->
 							<$fields>[<$i>] = <$fmt>.Sprintf("<$fname>: %v", *(<$f>))
 						<- else ->
 							<$fields>[<$i>] = <$fmt>.Sprintf("<$fname>: %v", <$f>)
@@ -500,14 +580,146 @@ func (f fieldGroupGenerator) String(g Generator) error {
 						<$i>++
 					}
 				<- else ->
-					<$fields>[<$i>] = <$fmt>.Sprintf("<$fname>: %v", <$f>)
+					<if isRedacted . ->
+						<$fields>[<$i>] = "<$fname>: " + <redacted . $f>
+					<- else ->
+						<$fields>[<$i>] = <$fmt>.Sprintf("<$fname>: %v", <$f>)
+					<- end>
 					<$i>++
 				<- end>
 			<end>
 
 			return <$fmt>.Sprintf("<.Name>{%v}", <$strings>.Join(<$fields>[:<$i>], ", "))
 		}
-		`, f)
+		`, f,
+		append(f.templateFuncs(),
+			TemplateFunc("isRedacted", isRedacted),
+			TemplateFunc("redacted", redactedValue),
+		)...,
+	)
+}
+
+// CustomMarshalJSON generates a json.Marshaler implementation for structs
+// with redacted fields or optional fields stored by value. The generated
+// method shadows these fields with their masked representations or with
+// pointers that are nil if the field is unset, and leaves all other fields
+// as-is.
+func (f fieldGroupGenerator) CustomMarshalJSON(g Generator) error {
+	return g.DeclareFromTemplate(
+		`
+		<$json := import "encoding/json">
+		<$v := newVar "v">
+		// MarshalJSON implements json.Marshaler<if .Presence>, omitting unset
+		// fields of <.Name><end><if hasRedactedFields .Fields>, masking the values of
+		// sensitive fields of <.Name><end>.
+		//
+		// MarshalJSON has a value receiver so that it is also used for
+		// <.Name> values that are not addressable.
+		func (<$v> <.Name>) MarshalJSON() ([]byte, error) {
+			<- $alias := newVar "alias">
+			<- $x := newVar "x">
+			type <$alias> <.Name>
+			<$x> := struct {
+				*<$alias>
+				<- range .Fields>
+					<- if isRedacted .>
+						<goName .> <if .Required>string<else>*string<end> <tag .>
+					<- else if byValue .>
+						<goName .> *<typeReference .Type> <tag .>
+					<- end>
+				<- end>
+			}{<$alias>: (*<$alias>)(&<$v>)}
+
+			<range .Fields>
+				<- $fname := goName .>
+				<- $f := printf "%s.%s" $v $fname>
+				<- if and (isRedacted .) .Required>
+					<$x>.<$fname> = <redacted . $f>
+				<- else if isRedacted .>
+					if <if byValue .><$v>.IsSet<$fname>()<else><$f> != nil<end> {
+						<- $s := newVar "s">
+						<$s> := <redacted . (fieldValue . $f)>
+						<$x>.<$fname> = &<$s>
+					}
+				<- else if byValue .>
+					if <$v>.IsSet<$fname>() {
+						<$x>.<$fname> = &<$f>
+					}
+				<- end>
+			<- end>
+
+			return <$json>.Marshal(<$x>)
+		}
+		`, f,
+		append(f.templateFuncs(),
+			TemplateFunc("hasRedactedFields", hasRedactedFields),
+			TemplateFunc("isRedacted", isRedacted),
+			TemplateFunc("redacted", redactedValue),
+			TemplateFunc("tag", generateTags),
+		)...,
+	)
+}
+
+// CustomUnmarshalJSON generates a json.Unmarshaler implementation for
+// structs with optional fields stored by value or fields holding unions
+// represented as sealed interfaces, directly or inside lists, sets, or maps.
+// Fields present in the JSON are marked as set, and unions are decoded into
+// the matching variant.
+func (f fieldGroupGenerator) CustomUnmarshalJSON(g Generator) error {
+	return g.DeclareFromTemplate(
+		`
+		<$json := import "encoding/json">
+		<$v := newVar "v">
+		<$b := newVar "b">
+		// UnmarshalJSON implements json.Unmarshaler<if .Presence>, marking fields of
+		// <.Name> present in the JSON as set<end><if hasUnionInterfaceFields .Fields>, decoding unions
+		// held by <.Name> into their variants<end>.
+		func (<$v> *<.Name>) UnmarshalJSON(<$b> []byte) error {
+			<- $alias := newVar "alias">
+			<- $x := newVar "x">
+			type <$alias> <.Name>
+			<$x> := struct {
+				*<$alias>
+				<- range .Fields>
+					<- if byValue .>
+						<goName .> *<typeReference .Type> <tag .>
+					<- else if needsUnmarshalJSONFunc .Type>
+						<goName .> <$json>.RawMessage <tag .>
+					<- end>
+				<- end>
+			}{<$alias>: (*<$alias>)(<$v>)}
+			if err := <$json>.Unmarshal(<$b>, &<$x>); err != nil {
+				return err
+			}
+
+			<range .Fields>
+				<- $fname := goName .>
+				<- if byValue .>
+					if <$x>.<$fname> != nil {
+						<$v>.Set<$fname>(*<$x>.<$fname>)
+					}
+				<- else if needsUnmarshalJSONFunc .Type>
+					if len(<$x>.<$fname>) > 0 && string(<$x>.<$fname>) != "null" {
+						<- $u := newVar "u">
+						<$u>, err := <unmarshalJSON .Type>(<$x>.<$fname>)
+						if err != nil {
+							return err
+						}
+						<$v>.<$fname> = <$u>
+					}
+				<- end>
+			<- end>
+
+			return nil
+		}
+		`, f,
+		append(f.templateFuncs(),
+			TemplateFunc("tag", generateTags),
+			TemplateFunc("hasUnionInterfaceFields", hasUnionInterfaceFields),
+			TemplateFunc("needsUnmarshalJSONFunc", needsUnmarshalJSONFunc),
+			TemplateFunc("unmarshalJSON", unmarshalJSONFunc),
+		)...,
+	)
 }
 
 func (f fieldGroupGenerator) ErrorName(g Generator) error {
@@ -545,6 +757,13 @@ func (f fieldGroupGenerator) Equals(g Generator) error {
 					if !<equals .Type $lhsField $rhsField> {
 						return false
 					}
+				<- else if byValue . ->
+					if <$v>.IsSet<$fname>() != <$rhs>.IsSet<$fname>() {
+						return false
+					}
+					if <$v>.IsSet<$fname>() && !<equals .Type $lhsField $rhsField> {
+						return false
+					}
 				<- else ->
 					if !<equalsPtr .Type $lhsField $rhsField> {
 						return false
@@ -553,7 +772,7 @@ func (f fieldGroupGenerator) Equals(g Generator) error {
 			<end>
 			return true
 		}
-		`, f)
+		`, f, f.templateFuncs()...)
 }
 
 func (f fieldGroupGenerator) Zap(g Generator) error {
@@ -572,10 +791,28 @@ func (f fieldGroupGenerator) Zap(g Generator) error {
 			<range .Fields>
 				<- if not (zapOptOut .) ->
 					<- $fval := printf "%s.%s" $v (goName .) ->
-					<- if .Required ->
+					<- if isRedacted . ->
+						<- if .Required ->
+							<$enc>.AddString("<fieldLabel .>", <redacted . $fval>)
+						<- else if byValue . ->
+							if <$v>.IsSet<goName .>() {
+								<$enc>.AddString("<fieldLabel .>", <redacted . $fval>)
+							}
+						<- else ->
+							if <$fval> != nil {
+								<$enc>.AddString("<fieldLabel .>", <redacted . (fieldValue . $fval)>)
+							}
+						<- end>
+					<- else if .Required ->
 						<zapEncodeBegin .Type ->
 							<$enc>.Add<zapEncoder .Type>("<fieldLabel .>", <zapMarshaler .Type $fval>)
 						<- zapEncodeEnd .Type>
+					<- else if byValue . ->
+						if <$v>.IsSet<goName .>() {
+							<zapEncodeBegin .Type ->
+								<$enc>.Add<zapEncoder .Type>("<fieldLabel .>", <zapMarshaler .Type $fval>)
+							<- zapEncodeEnd .Type>
+						}
 					<- else ->
 						if <$fval> != nil {
 							<zapEncodeBegin .Type ->
@@ -588,8 +825,12 @@ func (f fieldGroupGenerator) Zap(g Generator) error {
 			return err
 		}
 		`, f,
-		TemplateFunc("zapOptOut", zapOptOut),
-		TemplateFunc("fieldLabel", entityLabel),
+		append(f.templateFuncs(),
+			TemplateFunc("zapOptOut", zapOptOut),
+			TemplateFunc("fieldLabel", entityLabel),
+			TemplateFunc("isRedacted", isRedacted),
+			TemplateFunc("redacted", redactedValue),
+		)...,
 	)
 }
 
@@ -610,12 +851,19 @@ func (f fieldGroupGenerator) Accessors(g Generator) error {
 			<reserveFieldOrMethod (printf "Get%v" $fname)>
 			// Get<$fname> returns the value of <$fname> if it is set or its
 			// <if isNotNil .Default>default<else>zero<end> value if it is unset.
-			func (<$v> *<$name>) Get<$fname>() (<$o> <typeReference .Type>) {
+			<if .Deprecated>//
+			<formatDoc (withDeprecation "" .Deprecated)><end>func (<$v> *<$name>) Get<$fname>() (<$o> <typeReference .Type>) {
 				<- if .Required ->
 				  if <$v> != nil {
 				    <$o> = <$v>.<$fname>
 				  }
 				  return
+				<- else if byValue . ->
+				  if <$v>.IsSet<$fname>() {
+				    return <$v>.<$fname>
+				  }
+				  <if isNotNil .Default><$o> = <constantValue .Default .Type><end>
+				  return
 				<- else ->
 				  if <$v> != nil && <$v>.<$fname> != nil {
 					<- if and (not .Required) (isPrimitiveType .Type) ->
@@ -629,7 +877,29 @@ func (f fieldGroupGenerator) Accessors(g Generator) error {
 				<- end ->
 			}
 
-			<if shouldGenerateIsSet .>
+			<if byValue .>
+				<reserveFieldOrMethod (printf "IsSet%v" $fname)>
+				// IsSet<$fname> returns true if <$fname> is set.
+				func (<$v> *<$name>) IsSet<$fname>() bool {
+					return <$v> != nil && <$v>.<presenceWord .>&<presenceMask .> != 0
+				}
+
+				<- $x := newVar "x">
+				<reserveFieldOrMethod (printf "Set%v" $fname)>
+				// Set<$fname> sets the value of <$fname> and marks it as set.
+				func (<$v> *<$name>) Set<$fname>(<$x> <typeReference .Type>) {
+					<$v>.<$fname> = <$x>
+					<$v>.<presenceWord .> |= <presenceMask .>
+				}
+
+				<reserveFieldOrMethod (printf "Clear%v" $fname)>
+				// Clear<$fname> unsets <$fname> and resets it to its zero value.
+				func (<$v> *<$name>) Clear<$fname>() {
+					var <$x> <typeReference .Type>
+					<$v>.<$fname> = <$x>
+					<$v>.<presenceWord .> &^= <presenceMask .>
+				}
+			<else if shouldGenerateIsSet .>
 				<reserveFieldOrMethod (printf "IsSet%v" $fname)>
 				// IsSet<$fname> returns true if <$fname> is not nil.
 				func (<$v> *<$name>) IsSet<$fname>() bool {
@@ -638,17 +908,19 @@ func (f fieldGroupGenerator) Accessors(g Generator) error {
 			<end>
 		<end>
 		`, f,
-		TemplateFunc("constantValue", ConstantValue),
-		TemplateFunc("shouldGenerateIsSet", func(f *compile.FieldSpec) bool {
-			// Generate IsSet functions for a field only if the field is
-			// optional or the field value itself is nillable.
-			return !f.Required || isReferenceType(f.Type) || isStructType(f.Type)
-		}),
-		TemplateFunc("reserveFieldOrMethod", func(name string) (string, error) {
-			// we return an empty string for the sake of the templating system
-			err := fieldsAndMethods.Reserve(name)
-			return "", err
-		}),
+		append(f.templateFuncs(),
+			TemplateFunc("constantValue", ConstantValue),
+			TemplateFunc("shouldGenerateIsSet", func(f *compile.FieldSpec) bool {
+				// Generate IsSet functions for a field only if the field is
+				// optional or the field value itself is nillable.
+				return !f.Required || isReferenceType(f.Type) || isStructType(f.Type)
+			}),
+			TemplateFunc("reserveFieldOrMethod", func(name string) (string, error) {
+				// we return an empty string for the sake of the templating system
+				err := fieldsAndMethods.Reserve(name)
+				return "", err
+			}),
+		)...,
 	)
 }
 
@@ -23,13 +23,16 @@ package gen
 import (
 	"bytes"
 	"fmt"
+	"io"
 	"io/ioutil"
 	"os"
 	"path/filepath"
 	"strings"
 
 	"go.uber.org/thriftrw/compile"
-	"go.uber.org/thriftrw/internal/plugin"
+	"go.uber.org/thriftrw/internal/goast"
+	intplugin "go.uber.org/thriftrw/internal/plugin"
+	"go.uber.org/thriftrw/plugin"
 	"go.uber.org/thriftrw/plugin/api"
 
 	"go.uber.org/multierr"
@@ -53,11 +56,23 @@ type Options struct {
 
 	// ThriftRoot is the directory within whose tree all Thrift files consumed
 	// are contained. The locations of the Thrift files relative to the
-	// ThriftFile determines the module structure in OutputDir.
+	// ThriftFile determines the module structure in OutputDir, unless
+	// UseGoNamespace is set.
 	//
 	// This must be an absolute path.
 	ThriftRoot string
 
+	// UseGoNamespace places Thrift files which declare a `namespace go` in
+	// the package named by it instead of the one implied by their location
+	// under ThriftRoot, relative to PackagePrefix. The go.package annotation
+	// on the namespace specifies the full import path.
+	//
+	// 	namespace go users (go.package = "example.com/idl/users/v2")
+	//
+	// Modules should be compiled with compile.UniqueNamespace("go") so that
+	// conflicting `namespace go` headers are reported.
+	UseGoNamespace bool
+
 	// NoRecurse determines whether code should be generated for included Thrift
 	// files as well. If true, code gets generated only for the first module.
 	NoRecurse bool
@@ -68,6 +83,15 @@ type Options struct {
 	// Code generation plugin
 	Plugin CodeGenerator
 
+	// Plugins that run inside this process alongside Plugin. This allows
+	// programs using ThriftRW as a library to provide plugins without
+	// building separate executables.
+	//
+	// These plugins go through the same handshake and validation as plugin
+	// executables. Their output is merged with that of Plugin; it is an error
+	// for two plugins to write to the same file.
+	InProcessPlugins []*plugin.Plugin
+
 	// Do not generate types.go
 	NoTypes bool
 
@@ -80,11 +104,48 @@ type Options struct {
 	// Do not embed IDLs in generated code
 	NoEmbedIDL bool
 
+	// Register the embedded IDLs with the thriftreflect registry when the
+	// generated packages are initialized. Ignored if NoEmbedIDL is set.
+	RegisterIDL bool
+
 	// Do not generate Zap logging code
 	NoZap bool
 
 	// Name of the file to be generated by ThriftRW.
 	OutputFile string
+
+	// ArtifactDir is the directory into which non-Go artifacts produced by
+	// plugins are written. Artifacts are written as-is, without any
+	// formatting.
+	//
+	// This must be an absolute path if set. Defaults to OutputDir.
+	ArtifactDir string
+
+	// Manifest enables cleanup of stale generated files. If set, the files
+	// generated into each output directory are recorded in a ManifestFile
+	// inside it. On the next run, files recorded for the same root Thrift
+	// file that are no longer generated are removed.
+	//
+	// Only files that bear a "Code generated by thriftrw" header are
+	// removed.
+	Manifest bool
+
+	// ImportPaths maps Thrift files to the import paths of Go packages
+	// already generated for them elsewhere. Code is not generated for these
+	// files or for the files they include. References to them use the given
+	// import paths. Those packages must have been generated by ThriftRW
+	// from the same Thrift files.
+	//
+	// Keys must be absolute paths to Thrift files.
+	ImportPaths map[string]string
+
+	// DryRun prevents any changes to the output directories. Instead, the
+	// files that would be written or removed are listed on DryRunOutput.
+	DryRun bool
+
+	// DryRunOutput receives the listing of changes with DryRun. Defaults to
+	// discarding it.
+	DryRunOutput io.Writer
 }
 
 // Generate generates code based on the given options.
@@ -101,9 +162,18 @@ func Generate(m *compile.Module, o *Options) error {
 			o.OutputDir)
 	}
 
-	importer := thriftPackageImporter{
-		ImportPrefix: o.PackagePrefix,
-		ThriftRoot:   o.ThriftRoot,
+	artifactDir := o.ArtifactDir
+	if artifactDir == "" {
+		artifactDir = o.OutputDir
+	} else if !filepath.IsAbs(artifactDir) {
+		return fmt.Errorf(
+			"ArtifactDir must be an absolute path: %q is not absolute",
+			artifactDir)
+	}
+
+	importer, err := newThriftPackageImporter(m, o)
+	if err != nil {
+		return err
 	}
 
 	// Mapping of filenames relative to OutputDir to their contents.
@@ -141,14 +211,33 @@ func Generate(m *compile.Module, o *Options) error {
 			return err
 		}
 	} else {
-		if err := m.Walk(generate); err != nil {
+		err := WalkModules(m, o.ImportPaths, func(m *compile.Module) error {
+			// Code for mapped modules was already generated elsewhere.
+			if _, ok := o.ImportPaths[m.ThriftPath]; ok {
+				return nil
+			}
+			return generate(m)
+		})
+		if err != nil {
 			return err
 		}
 	}
 
 	plug := o.Plugin.ServiceGenerator
 	if plug == nil {
-		plug = plugin.EmptyServiceGenerator
+		plug = intplugin.EmptyServiceGenerator
+	}
+
+	if names := intplugin.MissingFeature(plug, api.FeatureUnsignedTypes); len(names) > 0 {
+		field, err := genBuilder.IntTypeField(m)
+		if err != nil {
+			return err
+		}
+		if field != "" {
+			return fmt.Errorf(
+				"plugin %q does not support unsigned or narrower Go integer types: "+
+					"%v uses %v", names[0], field, goTypeKey)
+		}
 	}
 
 	res, err := plug.Generate(genBuilder.Build())
@@ -160,8 +249,106 @@ func Generate(m *compile.Module, o *Options) error {
 		return err
 	}
 
+	artifacts := make(map[string][]byte, len(res.Artifacts))
+	if err := mergeFiles(artifacts, res.Artifacts); err != nil {
+		return err
+	}
+
+	if len(o.InProcessPlugins) > 0 {
+		res, err := generateInProcess(o.InProcessPlugins, genBuilder.Build())
+		if err != nil {
+			return err
+		}
+
+		if err := mergeFiles(files, res.Files); err != nil {
+			return err
+		}
+		if err := mergeFiles(artifacts, res.Artifacts); err != nil {
+			return err
+		}
+	}
+
+	// Artifacts may be written to the same directory as the Go code so they
+	// must not conflict with generated files.
+	if artifactDir == o.OutputDir {
+		for path := range artifacts {
+			if _, ok := files[path]; ok {
+				return fmt.Errorf("file generation conflict: "+
+					"an artifact and a Go file are trying to write to %q", path)
+			}
+		}
+	}
+
+	dryRunOutput := o.DryRunOutput
+	if dryRunOutput == nil {
+		dryRunOutput = ioutil.Discard
+	}
+
+	if o.DryRun {
+		if err := listFiles(dryRunOutput, o.OutputDir, files); err != nil {
+			return err
+		}
+		if err := listFiles(dryRunOutput, artifactDir, artifacts); err != nil {
+			return err
+		}
+	} else {
+		if err := writeFiles(o.OutputDir, files); err != nil {
+			return err
+		}
+		if err := writeFiles(artifactDir, artifacts); err != nil {
+			return err
+		}
+	}
+
+	if !o.Manifest {
+		return nil
+	}
+
+	root, err := importer.RelativeThriftFilePath(m.ThriftPath)
+	if err != nil {
+		return err
+	}
+	root = filepath.ToSlash(root)
+
+	if artifactDir == o.OutputDir {
+		paths := append(sortStringKeys(files), sortStringKeys(artifacts)...)
+		return cleanStale(o.OutputDir, root, paths, o.DryRun, dryRunOutput)
+	}
+
+	if err := cleanStale(o.OutputDir, root, sortStringKeys(files), o.DryRun, dryRunOutput); err != nil {
+		return err
+	}
+	return cleanStale(artifactDir, root, sortStringKeys(artifacts), o.DryRun, dryRunOutput)
+}
+
+// generateInProcess runs the given in-process plugins and consolidates their
+// output.
+func generateInProcess(plugins []*plugin.Plugin, req *api.GenerateServiceRequest) (_ *api.GenerateServiceResponse, err error) {
+	handle := make(intplugin.MultiHandle, 0, len(plugins))
+	defer func() {
+		err = multierr.Append(err, handle.Close())
+	}()
+
+	for _, p := range plugins {
+		h, err := intplugin.NewInProcessHandle(p.Name, p.ServiceGenerator)
+		if err != nil {
+			return nil, err
+		}
+		handle = append(handle, h)
+	}
+
+	return handle.ServiceGenerator().Generate(req)
+}
+
+// writeFiles writes the given files to the directory. Paths are relative to
+// the directory and must not escape it.
+func writeFiles(dir string, files map[string][]byte) error {
 	for relPath, contents := range files {
-		fullPath := filepath.Join(o.OutputDir, relPath)
+		if err := checkRelativePath(relPath); err != nil {
+			return err
+		}
+
+		fullPath := filepath.Join(dir, relPath)
 		directory := filepath.Dir(fullPath)
 
 		if err := os.MkdirAll(directory, 0755); err != nil {
@@ -172,7 +359,32 @@ func Generate(m *compile.Module, o *Options) error {
 			return fmt.Errorf("failed to write %q: %v", fullPath, err)
 		}
 	}
+	return nil
+}
+
+// listFiles lists the files that would be written to the given directory
+// on w, in sorted order.
+func listFiles(w io.Writer, dir string, files map[string][]byte) error {
+	for _, relPath := range sortStringKeys(files) {
+		if err := checkRelativePath(relPath); err != nil {
+			return err
+		}
+		fmt.Fprintf(w, "write %v\n", filepath.Join(dir, filepath.FromSlash(relPath)))
+	}
+	return nil
+}
+
+// checkRelativePath verifies that the given path is relative and stays
+// inside the directory it is relative to.
+func checkRelativePath(p string) error {
+	if filepath.IsAbs(p) {
+		return fmt.Errorf("cannot write to %q: path must be relative", p)
+	}
 
+	clean := filepath.Clean(p)
+	if clean == "." || clean == ".." || strings.HasPrefix(clean, ".."+string(filepath.Separator)) {
+		return fmt.Errorf("cannot write to %q: path must be inside the output directory", p)
+	}
 	return nil
 }
 
@@ -199,9 +411,25 @@ type ThriftPackageImporter interface {
 type thriftPackageImporter struct {
 	ImportPrefix string
 	ThriftRoot   string
+
+	// Packages for Thrift files which declare a `namespace go`, keyed by the
+	// absolute path to the Thrift file. Packages for other files are based
+	// on their location under ThriftRoot.
+	Packages map[string]goPackage
+
+	// Import paths of packages generated elsewhere, keyed by the absolute
+	// path to the Thrift file. These take precedence over Packages.
+	ImportPaths map[string]string
 }
 
 func (i thriftPackageImporter) RelativePackage(file string) (string, error) {
+	if importPath, ok := i.ImportPaths[file]; ok {
+		return "", fmt.Errorf(
+			"%q is mapped to %q: code is not generated for it", file, importPath)
+	}
+	if pkg, ok := i.Packages[file]; ok {
+		return pkg.RelPath, nil
+	}
 	return filepath.Rel(i.ThriftRoot, strings.TrimSuffix(file, ".thrift"))
 }
 
@@ -210,6 +438,10 @@ func (i thriftPackageImporter) RelativeThriftFilePath(file string) (string, erro
 }
 
 func (i thriftPackageImporter) Package(file string) (string, error) {
+	if importPath, ok := i.ImportPaths[file]; ok {
+		return importPath, nil
+	}
+
 	pkg, err := i.RelativePackage(file)
 	if err != nil {
 		return "", err
@@ -217,6 +449,24 @@ func (i thriftPackageImporter) Package(file string) (string, error) {
 	return filepath.Join(i.ImportPrefix, pkg), nil
 }
 
+// PackageName returns the name of the top-level package of the given Thrift
+// file.
+func (i thriftPackageImporter) PackageName(file string) (string, error) {
+	if importPath, ok := i.ImportPaths[file]; ok {
+		return goast.DeterminePackageName(importPath), nil
+	}
+	if pkg, ok := i.Packages[file]; ok {
+		return pkg.Name, nil
+	}
+
+	pkg, err := i.RelativePackage(file)
+	if err != nil {
+		return "", err
+	}
+	// converts package name from ab-def to ab_def for golang code generation
+	return normalizePackageName(filepath.Base(pkg)), nil
+}
+
 func mergeFiles(dest, src map[string][]byte) error {
 	var err error
 	for path, contents := range src {
@@ -251,11 +501,12 @@ func generateModule(
 	if err != nil {
 		return "", nil, err
 	}
-	// TODO(abg): Prefer top-level package name from `namespace go` directive.
-	outputFilename := filepath.Base(packageRelPath)
-
-	// Output file name defaults to the package name.
-	outputFilename = outputFilename + ".go"
+	// Output file name defaults to the package name for files with a
+	// `namespace go` and to the base name of the Thrift file otherwise.
+	outputFilename := filepath.Base(packageRelPath) + ".go"
+	if pkg, ok := i.Packages[m.ThriftPath]; ok {
+		outputFilename = pkg.Name + ".go"
+	}
 	if len(o.OutputFile) > 0 {
 		outputFilename = o.OutputFile
 	}
@@ -268,12 +519,15 @@ func generateModule(
 		return "", nil, err
 	}
 
-	// converts package name from ab-def to ab_def for golang code generation
-	normalizedPackageName := normalizePackageName(filepath.Base(packageRelPath))
+	packageName, err := i.PackageName(m.ThriftPath)
+	if err != nil {
+		return "", nil, err
+	}
+
 	g := NewGenerator(&GeneratorOptions{
 		Importer:    i,
 		ImportPath:  importPath,
-		PackageName: normalizedPackageName,
+		PackageName: packageName,
 		NoZap:       o.NoZap,
 	})
 
@@ -293,8 +547,12 @@ func generateModule(
 		}
 	}
 
+	if err := exceptionRegistry(g, m); err != nil {
+		return "", nil, err
+	}
+
 	if !o.NoEmbedIDL {
-		if err := embedIDL(g, i, m); err != nil {
+		if err := embedIDL(g, i, m, o.RegisterIDL); err != nil {
 			return "", nil, err
 		}
 	}
@@ -304,7 +562,7 @@ func generateModule(
 		return err
 	}
 
-	if err := m.Walk(addModules); err != nil {
+	if err := WalkModules(m, o.ImportPaths, addModules); err != nil {
 		return "", nil, err
 	}
 
@@ -33,7 +33,9 @@ import (
 	"go.uber.org/thriftrw/compile"
 	"go.uber.org/thriftrw/internal/plugin"
 	"go.uber.org/thriftrw/internal/plugin/handletest"
+	thriftrwplugin "go.uber.org/thriftrw/plugin"
 	"go.uber.org/thriftrw/plugin/api"
+	"go.uber.org/thriftrw/plugin/plugintest"
 
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
@@ -189,8 +191,12 @@ func TestGenerate(t *testing.T) {
 		getPlugin  func(*gomock.Controller) plugin.Handle
 		outputFile string
 
-		wantFiles []string
-		wantError string
+		// Whether artifacts should be written to a separate directory.
+		separateArtifactDir bool
+
+		wantFiles     []string
+		wantArtifacts []string
+		wantError     string
 	}{
 		{
 			desc:      "nil plugin; no recurse; output file defaults to package name",
@@ -304,6 +310,101 @@ func TestGenerate(t *testing.T) {
 			},
 			wantError: `great sadness`,
 		},
+		{
+			desc: "ServiceGenerator plugin artifacts",
+			getPlugin: func(mockCtrl *gomock.Controller) plugin.Handle {
+				sgen := handletest.NewMockServiceGenerator(mockCtrl)
+				sgen.EXPECT().Generate(gomock.Any()).
+					Return(&api.GenerateServiceResponse{
+						Files: map[string][]byte{
+							"bar/baz.go": []byte("package bar\n"),
+						},
+						Artifacts: map[string][]byte{
+							"schema/foo.json": []byte("{}"),
+						},
+					}, nil)
+
+				handle := handletest.NewMockHandle(mockCtrl)
+				handle.EXPECT().ServiceGenerator().Return(sgen)
+				return handle
+			},
+			wantFiles: []string{
+				"foo/foo.go",
+				"bar/baz.go",
+				"schema/foo.json",
+			},
+		},
+		{
+			desc: "ServiceGenerator plugin artifacts in a separate directory",
+			getPlugin: func(mockCtrl *gomock.Controller) plugin.Handle {
+				sgen := handletest.NewMockServiceGenerator(mockCtrl)
+				sgen.EXPECT().Generate(gomock.Any()).
+					Return(&api.GenerateServiceResponse{
+						// Doesn't conflict with the Go code.
+						Artifacts: map[string][]byte{
+							"foo/foo.go": []byte("not Go code"),
+						},
+					}, nil)
+
+				handle := handletest.NewMockHandle(mockCtrl)
+				handle.EXPECT().ServiceGenerator().Return(sgen)
+				return handle
+			},
+			separateArtifactDir: true,
+			wantFiles:           []string{"foo/foo.go"},
+			wantArtifacts:       []string{"foo/foo.go"},
+		},
+		{
+			desc: "ServiceGenerator plugin artifact conflict",
+			getPlugin: func(mockCtrl *gomock.Controller) plugin.Handle {
+				sgen := handletest.NewMockServiceGenerator(mockCtrl)
+				sgen.EXPECT().Generate(gomock.Any()).
+					Return(&api.GenerateServiceResponse{
+						Artifacts: map[string][]byte{
+							"foo/foo.go": []byte("not Go code"),
+						},
+					}, nil)
+
+				handle := handletest.NewMockHandle(mockCtrl)
+				handle.EXPECT().ServiceGenerator().Return(sgen)
+				return handle
+			},
+			wantError: `file generation conflict: an artifact and a Go file are trying to write to "foo/foo.go"`,
+		},
+		{
+			desc: "ServiceGenerator plugin artifact outside the directory",
+			getPlugin: func(mockCtrl *gomock.Controller) plugin.Handle {
+				sgen := handletest.NewMockServiceGenerator(mockCtrl)
+				sgen.EXPECT().Generate(gomock.Any()).
+					Return(&api.GenerateServiceResponse{
+						Artifacts: map[string][]byte{
+							"docs/../../foo.md": []byte("# foo"),
+						},
+					}, nil)
+
+				handle := handletest.NewMockHandle(mockCtrl)
+				handle.EXPECT().ServiceGenerator().Return(sgen)
+				return handle
+			},
+			wantError: `cannot write to "docs/../../foo.md": path must be inside the output directory`,
+		},
+		{
+			desc: "ServiceGenerator plugin absolute artifact path",
+			getPlugin: func(mockCtrl *gomock.Controller) plugin.Handle {
+				sgen := handletest.NewMockServiceGenerator(mockCtrl)
+				sgen.EXPECT().Generate(gomock.Any()).
+					Return(&api.GenerateServiceResponse{
+						Artifacts: map[string][]byte{
+							"/etc/foo.md": []byte("# foo"),
+						},
+					}, nil)
+
+				handle := handletest.NewMockHandle(mockCtrl)
+				handle.EXPECT().ServiceGenerator().Return(sgen)
+				return handle
+			},
+			wantError: `cannot write to "/etc/foo.md": path must be relative`,
+		},
 	}
 
 	for _, tt := range tests {
@@ -315,6 +416,13 @@ func TestGenerate(t *testing.T) {
 			require.NoError(t, err)
 			defer os.RemoveAll(outputDir)
 
+			var artifactDir string
+			if tt.separateArtifactDir {
+				artifactDir, err = ioutil.TempDir(os.TempDir(), "test-generate-artifacts")
+				require.NoError(t, err)
+				defer os.RemoveAll(artifactDir)
+			}
+
 			var p CodeGenerator
 			if tt.getPlugin != nil {
 				handle := tt.getPlugin(mockCtrl)
@@ -330,6 +438,7 @@ func TestGenerate(t *testing.T) {
 				Plugin:        p,
 				NoRecurse:     tt.noRecurse,
 				OutputFile:    tt.outputFile,
+				ArtifactDir:   artifactDir,
 			})
 			if tt.wantError != "" {
 				assert.Contains(t, err.Error(), tt.wantError)
@@ -341,11 +450,179 @@ func TestGenerate(t *testing.T) {
 					_, err = os.Stat(filepath.Join(outputDir, f))
 					assert.NoError(t, err, tt.desc)
 				}
+				for _, f := range tt.wantArtifacts {
+					_, err = os.Stat(filepath.Join(artifactDir, f))
+					assert.NoError(t, err, tt.desc)
+				}
 			}
 		}()
 	}
 }
 
+func TestGenerateInProcessPlugins(t *testing.T) {
+	module, err := compile.Compile(testdata(t, "thrift/services.thrift"))
+	require.NoError(t, err)
+
+	// generatesFiles returns a ServiceGenerator that writes the given files
+	// and artifacts after verifying the request.
+	generatesFiles := func(mockCtrl *gomock.Controller, files, artifacts map[string][]byte) api.ServiceGenerator {
+		sg := plugintest.NewMockServiceGenerator(mockCtrl)
+		sg.EXPECT().Generate(gomock.Any()).DoAndReturn(
+			func(req *api.GenerateServiceRequest) (*api.GenerateServiceResponse, error) {
+				var names []string
+				for _, id := range req.RootServices {
+					names = append(names, req.Services[id].ThriftName)
+				}
+				assert.Contains(t, names, "KeyValue")
+				return &api.GenerateServiceResponse{Files: files, Artifacts: artifacts}, nil
+			})
+		return sg
+	}
+
+	tests := []struct {
+		desc      string
+		getPlugin func(*gomock.Controller) api.ServiceGenerator
+		plugins   func(*gomock.Controller) []*thriftrwplugin.Plugin
+
+		wantFiles []string
+		wantError string
+	}{
+		{
+			desc: "single plugin",
+			plugins: func(mockCtrl *gomock.Controller) []*thriftrwplugin.Plugin {
+				return []*thriftrwplugin.Plugin{
+					{
+						Name: "foo",
+						ServiceGenerator: generatesFiles(mockCtrl,
+							map[string][]byte{"services/foo.go": []byte("package services\n")},
+							map[string][]byte{"docs/keyvalue.md": []byte("# KeyValue\n")},
+						),
+					},
+				}
+			},
+			wantFiles: []string{"services/services.go", "services/foo.go", "docs/keyvalue.md"},
+		},
+		{
+			desc: "multiple plugins with an executable plugin",
+			getPlugin: func(mockCtrl *gomock.Controller) api.ServiceGenerator {
+				return generatesFiles(mockCtrl, map[string][]byte{"services/baz.go": []byte("package services\n")}, nil)
+			},
+			plugins: func(mockCtrl *gomock.Controller) []*thriftrwplugin.Plugin {
+				return []*thriftrwplugin.Plugin{
+					{
+						Name:             "foo",
+						ServiceGenerator: generatesFiles(mockCtrl, map[string][]byte{"services/foo.go": []byte("package services\n")}, nil),
+					},
+					{
+						Name:             "bar",
+						ServiceGenerator: generatesFiles(mockCtrl, map[string][]byte{"services/bar.go": []byte("package services\n")}, nil),
+					},
+					{Name: "empty"},
+				}
+			},
+			wantFiles: []string{"services/foo.go", "services/bar.go", "services/baz.go"},
+		},
+		{
+			desc: "conflict between plugins",
+			plugins: func(mockCtrl *gomock.Controller) []*thriftrwplugin.Plugin {
+				return []*thriftrwplugin.Plugin{
+					{
+						Name:             "foo",
+						ServiceGenerator: generatesFiles(mockCtrl, map[string][]byte{"services/foo.go": []byte("package services\n")}, nil),
+					},
+					{
+						Name:             "bar",
+						ServiceGenerator: generatesFiles(mockCtrl, map[string][]byte{"services/foo.go": []byte("package services\n")}, nil),
+					},
+				}
+			},
+			wantError: `plugin conflict: cannot write file "services/foo.go" for plugin`,
+		},
+		{
+			desc: "conflict with an executable plugin",
+			getPlugin: func(mockCtrl *gomock.Controller) api.ServiceGenerator {
+				return generatesFiles(mockCtrl, map[string][]byte{"services/foo.go": []byte("package services\n")}, nil)
+			},
+			plugins: func(mockCtrl *gomock.Controller) []*thriftrwplugin.Plugin {
+				return []*thriftrwplugin.Plugin{
+					{
+						Name:             "foo",
+						ServiceGenerator: generatesFiles(mockCtrl, map[string][]byte{"services/foo.go": []byte("package services\n")}, nil),
+					},
+				}
+			},
+			wantError: `file generation conflict: multiple sources are trying to write to "services/foo.go"`,
+		},
+		{
+			desc: "conflict with generated code",
+			plugins: func(mockCtrl *gomock.Controller) []*thriftrwplugin.Plugin {
+				return []*thriftrwplugin.Plugin{
+					{
+						Name:             "foo",
+						ServiceGenerator: generatesFiles(mockCtrl, map[string][]byte{"services/services.go": []byte("package services\n")}, nil),
+					},
+				}
+			},
+			wantError: `file generation conflict: multiple sources are trying to write to "services/services.go"`,
+		},
+		{
+			desc: "parent directory",
+			plugins: func(mockCtrl *gomock.Controller) []*thriftrwplugin.Plugin {
+				return []*thriftrwplugin.Plugin{
+					{
+						Name:             "foo",
+						ServiceGenerator: generatesFiles(mockCtrl, map[string][]byte{"../foo.go": []byte("package foo\n")}, nil),
+					},
+				}
+			},
+			wantError: `plugin "foo" is attempting to write to a parent directory`,
+		},
+		{
+			desc: "no name",
+			plugins: func(mockCtrl *gomock.Controller) []*thriftrwplugin.Plugin {
+				return []*thriftrwplugin.Plugin{{}}
+			},
+			wantError: "a plugin name must be provided",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			outputDir, err := ioutil.TempDir("", "test-generate-in-process")
+			require.NoError(t, err)
+			defer os.RemoveAll(outputDir)
+
+			var p CodeGenerator
+			if tt.getPlugin != nil {
+				p.ServiceGenerator = tt.getPlugin(mockCtrl)
+			}
+
+			err = Generate(module, &Options{
+				OutputDir:        outputDir,
+				PackagePrefix:    "go.uber.org/thriftrw/gen/internal/tests",
+				ThriftRoot:       testdata(t, "thrift"),
+				NoRecurse:        true,
+				Plugin:           p,
+				InProcessPlugins: tt.plugins(mockCtrl),
+			})
+			if tt.wantError != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.wantError)
+				return
+			}
+
+			require.NoError(t, err)
+			for _, f := range tt.wantFiles {
+				_, err := os.Stat(filepath.Join(outputDir, f))
+				assert.NoError(t, err, "file %v must exist", f)
+			}
+		})
+	}
+}
+
 func TestGenerateModule(t *testing.T) {
 	t.Run("module data should be added to the GenerateServiceBuilder even if the Thrift module contains no service data", func(t *testing.T) {
 		thriftRoot := testdata(t, "thrift")
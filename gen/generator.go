@@ -150,7 +150,6 @@ type GeneratorOptions struct {
 
 // NewGenerator sets up a new generator for Go code.
 func NewGenerator(o *GeneratorOptions) Generator {
-	// TODO(abg): Determine package name from `namespace go` directive.
 	namespace := NewNamespace()
 	return &generator{
 		PackageName:    o.PackageName,
@@ -218,6 +217,7 @@ func (g *generator) TextTemplate(s string, data interface{}, opts ...TemplateOpt
 		"lessthan":         lessThanSymbol,
 		"enumItemName":     enumItemName,
 		"formatDoc":        formatDoc,
+		"withDeprecation":  withDeprecation,
 		"goCase":           goCase,
 		"goName":           goName,
 		"import":           g.Import,
@@ -464,6 +464,11 @@ func (g *generator) declare(ignoreConflicts bool, s string, data interface{}, op
 // this NEXT to the thing being documented.
 //
 //   <formatDoc .Doc>type Foo
+//
+// withDeprecation(string, *Deprecation): Appends a "Deprecated:" paragraph
+// to a docblock if the Deprecation is non-nil.
+//
+//   <formatDoc (withDeprecation .Doc .Deprecated)>type Foo
 func (g *generator) DeclareFromTemplate(s string, data interface{}, opts ...TemplateOption) error {
 	return g.declare(false, s, data, opts...)
 }
@@ -541,6 +546,24 @@ func formatDoc(s string) string {
 	return strings.Join(lines, "\n") + "\n"
 }
 
+// withDeprecation appends a "Deprecated:" paragraph to the given docblock if
+// d is non-nil, allowing tools like staticcheck to flag uses of deprecated
+// definitions.
+func withDeprecation(doc string, d *compile.Deprecation) string {
+	if d == nil {
+		return doc
+	}
+
+	reason := d.Reason
+	if reason == "" {
+		reason = "This is deprecated in the Thrift file."
+	}
+	if doc != "" {
+		doc += "\n\n"
+	}
+	return doc + "Deprecated: " + reason
+}
+
 func lessThanSymbol() string {
 	return "<"
 }
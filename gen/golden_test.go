@@ -75,6 +75,7 @@ func TestCodeIsUpToDate(t *testing.T) {
 			ThriftRoot:    thriftRoot,
 			NoRecurse:     true,
 			NoZap:         nozap,
+			RegisterIDL:   true,
 		})
 		require.NoError(t, err, "failed to generate code for %q", thriftFile)
 
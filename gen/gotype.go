@@ -20,6 +20,14 @@
 
 package gen
 
+import (
+	"fmt"
+	"strings"
+
+	"go.uber.org/thriftrw/compile"
+	"go.uber.org/thriftrw/internal/goint"
+)
+
 const (
 	// goTypeKey is a Thrift annotation that allows overriding the type of
 	// a typedef target type or a struct field type. By default, thrift set type
@@ -29,7 +37,419 @@ const (
 	//
 	//     (go.type = "slice")
 	//
-	// Currently, only thrift set to go slice type overriding is supported.
+	// On Thrift integer types, go.type may specify an unsigned or narrower Go
+	// integer type. See intTypeFor.
+	//
+	//     i32 (go.type = "uint32")
+	//
+	// On primitive types, go.type may be combined with go.adapter to map the
+	// Thrift type to an arbitrary Go type. See goAdapterKey.
 	goTypeKey = "go.type"
 	sliceType = "slice"
+
+	// goAdapterKey is a Thrift annotation that maps a primitive Thrift type to
+	// the arbitrary Go type named by the go.type annotation on the same type.
+	//
+	//     i64 (go.type = "time.Time", go.adapter = "example.com/thriftadapt.UnixNanos")
+	//
+	// The adapter is a package-level value which provides the following
+	// methods where T is the Go type.
+	//
+	//     ToWire(T) (wire.Value, error)
+	//     FromWire(wire.Value) (T, error)
+	//     Equals(T, T) bool
+	//
+	// Values of the Go type are logged with zapcore's AddReflected and
+	// formatted with fmt's %v verb.
+	goAdapterKey = "go.adapter"
+
+	// goTimeKey is a Thrift annotation that maps an i64 holding a Unix
+	// timestamp to time.Time. Its value specifies the precision of the
+	// timestamp and is one of the keys of _timeAdapters.
+	//
+	//     i64 (go.time = "unix_millis")
+	//
+	// This is shorthand for go.type and go.adapter with an adapter from the
+	// thrifttime package. time.Time values are logged with zapcore's AddTime.
+	goTimeKey = "go.time"
+
+	// goDurationKey is a Thrift annotation that maps an i64 holding a
+	// duration to time.Duration. Its value specifies the unit of the duration
+	// and is one of the keys of _durationAdapters.
+	//
+	//     i64 (go.duration = "ms")
+	//
+	// This is shorthand for go.type and go.adapter with an adapter from the
+	// thrifttime package. time.Duration values are logged with zapcore's
+	// AddDuration.
+	goDurationKey = "go.duration"
+
+	thriftTimePackage = "go.uber.org/thriftrw/thrifttime"
 )
+
+// Names of the adapters in the thrifttime package for the supported values
+// of go.time and go.duration.
+var (
+	_timeAdapters = map[string]string{
+		"unix_seconds": "UnixSeconds",
+		"unix_millis":  "UnixMillis",
+		"unix_micros":  "UnixMicros",
+		"unix_nanos":   "UnixNanos",
+	}
+
+	_durationAdapters = map[string]string{
+		"s":  "Seconds",
+		"ms": "Milliseconds",
+		"us": "Microseconds",
+		"ns": "Nanoseconds",
+	}
+)
+
+// goQualifiedName is a reference to a top-level Go declaration in a
+// specific package.
+type goQualifiedName struct {
+	ImportPath string
+	Name       string
+}
+
+// parseGoQualifiedName parses names in the form,
+//
+//   example.com/foo/bar.Baz
+func parseGoQualifiedName(s string) (goQualifiedName, error) {
+	i := strings.LastIndexByte(s, '.')
+	if i <= 0 || i < strings.LastIndexByte(s, '/') || i == len(s)-1 {
+		return goQualifiedName{}, fmt.Errorf(
+			"%q is not a qualified Go name: expected $importPath.$name", s)
+	}
+	return goQualifiedName{ImportPath: s[:i], Name: s[i+1:]}, nil
+}
+
+// Reference returns an expression referencing this name, importing the
+// package if necessary.
+func (n goQualifiedName) Reference(g Generator) string {
+	return g.Import(n.ImportPath) + "." + n.Name
+}
+
+// typeAdapter maps a primitive Thrift type to an arbitrary Go type using the
+// go.type and go.adapter annotations.
+type typeAdapter struct {
+	Type    goQualifiedName
+	Adapter goQualifiedName
+}
+
+// hasAdapter returns true if the given TypeSpec, or the type it resolves to,
+// is mapped to a custom Go type with a go.adapter, go.time, or go.duration
+// annotation.
+func hasAdapter(spec compile.TypeSpec) bool {
+	root := compile.RootTypeSpec(spec)
+	if root == nil {
+		return false
+	}
+	return isAdapted(root)
+}
+
+// isAdapted returns true if the given native Thrift type has a go.adapter,
+// go.time, or go.duration annotation.
+func isAdapted(spec compile.TypeSpec) bool {
+	annotations := spec.ThriftAnnotations()
+	for _, key := range []string{goAdapterKey, goTimeKey, goDurationKey} {
+		if _, ok := annotations[key]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+// adapterFor returns the typeAdapter for the given native Thrift type or nil
+// if it does not have a go.adapter, go.time, or go.duration annotation.
+//
+// Typedefs are not resolved: a typedef of an adapted type is its own Go
+// type.
+func adapterFor(spec compile.TypeSpec) (*typeAdapter, error) {
+	annotations := spec.ThriftAnnotations()
+	if a, err := timeAdapterFor(spec); a != nil || err != nil {
+		return a, err
+	}
+
+	adapter, ok := annotations[goAdapterKey]
+	if !ok {
+		return nil, nil
+	}
+
+	switch spec.(type) {
+	case *compile.BoolSpec, *compile.I8Spec, *compile.I16Spec,
+		*compile.I32Spec, *compile.I64Spec, *compile.DoubleSpec,
+		*compile.StringSpec:
+		// ok
+	default:
+		return nil, fmt.Errorf(
+			"%v is not supported on %q: only primitive types may be adapted",
+			goAdapterKey, spec.ThriftName())
+	}
+
+	goType, ok := annotations[goTypeKey]
+	if !ok {
+		return nil, fmt.Errorf("%v on %q requires a %v annotation",
+			goAdapterKey, spec.ThriftName(), goTypeKey)
+	}
+
+	typeName, err := parseGoQualifiedName(goType)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %v on %q: %v", goTypeKey, spec.ThriftName(), err)
+	}
+
+	adapterName, err := parseGoQualifiedName(adapter)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %v on %q: %v", goAdapterKey, spec.ThriftName(), err)
+	}
+
+	return &typeAdapter{Type: typeName, Adapter: adapterName}, nil
+}
+
+// timeAdapterFor returns the typeAdapter for the given native Thrift type if
+// it has a go.time or go.duration annotation.
+func timeAdapterFor(spec compile.TypeSpec) (*typeAdapter, error) {
+	annotations := spec.ThriftAnnotations()
+
+	var (
+		key, goType string
+		adapters    map[string]string
+	)
+	if _, ok := annotations[goTimeKey]; ok {
+		key, goType, adapters = goTimeKey, "Time", _timeAdapters
+	}
+	if _, ok := annotations[goDurationKey]; ok {
+		if key != "" {
+			return nil, fmt.Errorf("%v and %v cannot be used together on %q",
+				goTimeKey, goDurationKey, spec.ThriftName())
+		}
+		key, goType, adapters = goDurationKey, "Duration", _durationAdapters
+	}
+	if key == "" {
+		return nil, nil
+	}
+
+	if _, ok := spec.(*compile.I64Spec); !ok {
+		return nil, fmt.Errorf("%v is not supported on %q: only i64 is supported",
+			key, spec.ThriftName())
+	}
+	for _, other := range []string{goTypeKey, goAdapterKey} {
+		if _, ok := annotations[other]; ok {
+			return nil, fmt.Errorf("%v and %v cannot be used together on %q",
+				key, other, spec.ThriftName())
+		}
+	}
+
+	value := annotations[key]
+	name, ok := adapters[value]
+	if !ok {
+		return nil, fmt.Errorf("unknown %v %q on %q: expected one of %v",
+			key, value, spec.ThriftName(), strings.Join(sortStringKeys(adapters), ", "))
+	}
+
+	return &typeAdapter{
+		Type:    goQualifiedName{ImportPath: "time", Name: goType},
+		Adapter: goQualifiedName{ImportPath: thriftTimePackage, Name: name},
+	}, nil
+}
+
+// unadapted returns the native Thrift type backing the given adapted type,
+// without any annotations.
+func unadapted(spec compile.TypeSpec) compile.TypeSpec {
+	switch spec.(type) {
+	case *compile.BoolSpec:
+		return &compile.BoolSpec{}
+	case *compile.I8Spec:
+		return &compile.I8Spec{}
+	case *compile.I16Spec:
+		return &compile.I16Spec{}
+	case *compile.I32Spec:
+		return &compile.I32Spec{}
+	case *compile.I64Spec:
+		return &compile.I64Spec{}
+	case *compile.DoubleSpec:
+		return &compile.DoubleSpec{}
+	case *compile.StringSpec:
+		return &compile.StringSpec{}
+	default:
+		panic(fmt.Sprintf("%q cannot be adapted", spec.ThriftName()))
+	}
+}
+
+// adaptedConstant generates an expression holding the given constant value
+// converted to the adapted Go type by the adapter's FromWire.
+//
+// The adapter is called when the package is initialized; constants which it
+// rejects cause a panic.
+func adaptedConstant(g Generator, c compile.ConstantValue, spec compile.TypeSpec) (string, error) {
+	base := unadapted(spec)
+	value, err := ConstantValue(g, c, base)
+	if err != nil {
+		return "", err
+	}
+
+	var wireName string
+	switch base.(type) {
+	case *compile.BoolSpec:
+		wireName = "Bool"
+	case *compile.I8Spec:
+		wireName = "I8"
+	case *compile.I16Spec:
+		wireName = "I16"
+	case *compile.I32Spec:
+		wireName = "I32"
+	case *compile.I64Spec:
+		wireName = "I64"
+	case *compile.DoubleSpec:
+		wireName = "Double"
+	case *compile.StringSpec:
+		wireName = "String"
+	}
+	w := fmt.Sprintf("%v.NewValue%v(%v)", g.Import("go.uber.org/thriftrw/wire"), wireName, value)
+
+	name := fmt.Sprintf("_%s_FromConstant", g.MangleType(spec))
+	err = g.EnsureDeclared(
+		`
+		<$wire := import "go.uber.org/thriftrw/wire">
+		<$w := newVar "w">
+		<$x := newVar "x">
+		func <.Name>(<$w> <$wire>.Value) <typeReference .Spec> {
+			<$x>, err := <fromWire .Spec $w>
+			if err != nil {
+				panic(err)
+			}
+			return <$x>
+		}
+		`,
+		struct {
+			Name string
+			Spec compile.TypeSpec
+		}{Name: name, Spec: spec},
+	)
+	return fmt.Sprintf("%s(%s)", name, w), err
+}
+
+// thriftIntBits returns the width of the given native Thrift integer type
+// or 0 if it's not an integer type.
+func thriftIntBits(spec compile.TypeSpec) int {
+	switch spec.(type) {
+	case *compile.I8Spec:
+		return 8
+	case *compile.I16Spec:
+		return 16
+	case *compile.I32Spec:
+		return 32
+	case *compile.I64Spec:
+		return 64
+	default:
+		return 0
+	}
+}
+
+// intTypeFor returns the Go integer type for the given native Thrift integer
+// type or nil if it uses the default Go type.
+//
+// Typedefs are not resolved.
+func intTypeFor(spec compile.TypeSpec) (*goint.Type, error) {
+	bits := thriftIntBits(spec)
+	if bits == 0 {
+		return nil, nil
+	}
+
+	annotations := spec.ThriftAnnotations()
+	name, ok := annotations[goTypeKey]
+	if !ok {
+		return nil, nil
+	}
+	if isAdapted(spec) {
+		return nil, nil
+	}
+
+	t, ok := goint.Lookup(name)
+	if !ok {
+		return nil, fmt.Errorf(
+			"unsupported %v %q on %q: expected a Go integer type or a %v",
+			goTypeKey, name, spec.ThriftName(), goAdapterKey)
+	}
+	if t.Bits > bits {
+		return nil, fmt.Errorf(
+			"%v %q on %q is wider than %v bits", goTypeKey, name, spec.ThriftName(), bits)
+	}
+	if t.Signed && t.Bits == bits {
+		// Same as the default Go type.
+		return nil, nil
+	}
+	return &t, nil
+}
+
+// hasIntType returns true if the given TypeSpec, or the type it resolves to,
+// is a Thrift integer type with a custom Go integer type.
+func hasIntType(spec compile.TypeSpec) bool {
+	root := compile.RootTypeSpec(spec)
+	if root == nil {
+		return false
+	}
+	t, err := intTypeFor(root)
+	return err == nil && t != nil
+}
+
+// intToWire generates an expression of type (Value, error) that converts
+// varName of the given Go integer type into its wire representation.
+func intToWire(g Generator, spec compile.TypeSpec, t *goint.Type, varName string) string {
+	var wireName, goName string
+	switch spec.(type) {
+	case *compile.I8Spec:
+		wireName, goName = "I8", "int8"
+	case *compile.I16Spec:
+		wireName, goName = "I16", "int16"
+	case *compile.I32Spec:
+		wireName, goName = "I32", "int32"
+	case *compile.I64Spec:
+		wireName, goName = "I64", "int64"
+	}
+	return fmt.Sprintf("%v.NewValue%v(%v(%v)), error(nil)",
+		g.Import("go.uber.org/thriftrw/wire"), wireName, goName, varName)
+}
+
+// intFromWire generates an expression of type (T, error) which reads the
+// given Value into the given Go integer type.
+func intFromWire(g Generator, spec compile.TypeSpec, t *goint.Type, value string) (string, error) {
+	var getter string
+	switch spec.(type) {
+	case *compile.I8Spec:
+		getter = "GetI8"
+	case *compile.I16Spec:
+		getter = "GetI16"
+	case *compile.I32Spec:
+		getter = "GetI32"
+	case *compile.I64Spec:
+		getter = "GetI64"
+	}
+
+	if t.Bits == thriftIntBits(spec) {
+		// Bit-preserving conversion between types of the same width.
+		return fmt.Sprintf("%v(%v.%v()), error(nil)", t.Name, value, getter), nil
+	}
+
+	name := readerFuncName(g, spec)
+	err := g.EnsureDeclared(
+		`
+		<$wire := import "go.uber.org/thriftrw/wire">
+		<$w := newVar "w">
+		<$x := newVar "x">
+		func <.Name>(<$w> <$wire>.Value) (<.Type.Name>, error) {
+			<$x> := <$w>.<.Getter>()
+			if <$x> <lessthan> <.Type.Min> || <$x> > <.Type.Max> {
+				return 0, <import "fmt">.Errorf("%v is out of range for <.Type.Name>", <$x>)
+			}
+			return <.Type.Name>(<$x>), nil
+		}
+		`,
+		struct {
+			Name   string
+			Type   *goint.Type
+			Getter string
+		}{Name: name, Type: t, Getter: getter},
+	)
+	return fmt.Sprintf("%v(%v)", name, value), err
+}
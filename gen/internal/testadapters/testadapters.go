@@ -0,0 +1,69 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Package testadapters provides go.adapter implementations used by the
+// generated code in gen/internal/tests.
+package testadapters
+
+import (
+	"net/url"
+	"time"
+
+	"go.uber.org/thriftrw/wire"
+)
+
+// UnixNanos maps i64 to time.Time as the number of nanoseconds since the
+// Unix epoch.
+var UnixNanos unixNanos
+
+type unixNanos struct{}
+
+func (unixNanos) ToWire(t time.Time) (wire.Value, error) {
+	return wire.NewValueI64(t.UnixNano()), nil
+}
+
+func (unixNanos) FromWire(w wire.Value) (time.Time, error) {
+	return time.Unix(0, w.GetI64()).UTC(), nil
+}
+
+func (unixNanos) Equals(l, r time.Time) bool {
+	return l.Equal(r)
+}
+
+// URL maps string to url.URL.
+var URL urlAdapter
+
+type urlAdapter struct{}
+
+func (urlAdapter) ToWire(u url.URL) (wire.Value, error) {
+	return wire.NewValueString(u.String()), nil
+}
+
+func (urlAdapter) FromWire(w wire.Value) (url.URL, error) {
+	u, err := url.Parse(w.GetString())
+	if err != nil {
+		return url.URL{}, err
+	}
+	return *u, nil
+}
+
+func (urlAdapter) Equals(l, r url.URL) bool {
+	return l.String() == r.String()
+}
@@ -0,0 +1,1288 @@
+// Code generated by thriftrw v1.28.0. DO NOT EDIT.
+// @generated
+
+package adapters
+
+import (
+	json "encoding/json"
+	errors "errors"
+	fmt "fmt"
+	multierr "go.uber.org/multierr"
+	testadapters "go.uber.org/thriftrw/gen/internal/testadapters"
+	thriftreflect "go.uber.org/thriftrw/thriftreflect"
+	wire "go.uber.org/thriftrw/wire"
+	zapcore "go.uber.org/zap/zapcore"
+	url "net/url"
+	strings "strings"
+	time "time"
+)
+
+func _I64_UnixNanos_FromConstant(w wire.Value) time.Time {
+	x, err := testadapters.UnixNanos.FromWire(w)
+	if err != nil {
+		panic(err)
+	}
+	return x
+}
+
+var Epoch Timestamp = Timestamp(_I64_UnixNanos_FromConstant(wire.NewValueI64(0)))
+
+type Event struct {
+	CreatedAt time.Time   `json:"createdAt,required"`
+	UpdatedAt *Timestamp  `json:"updatedAt,omitempty"`
+	Link      *URL        `json:"link,omitempty"`
+	History   []Timestamp `json:"history,omitempty"`
+	Mirrors   []URL       `json:"mirrors,omitempty"`
+	Hits      []struct {
+		Key   URL
+		Value int32
+	} `json:"hits,omitempty"`
+}
+
+func _Timestamp_ptr(v Timestamp) *Timestamp {
+	return &v
+}
+
+// Default_Event constructs a new Event struct,
+// pre-populating any fields with defined default values.
+func Default_Event() *Event {
+	var v Event
+	v.UpdatedAt = _Timestamp_ptr(Timestamp(_I64_UnixNanos_FromConstant(wire.NewValueI64(0))))
+	return &v
+}
+
+type _List_Timestamp_ValueList []Timestamp
+
+func (v _List_Timestamp_ValueList) ForEach(f func(wire.Value) error) error {
+	for _, x := range v {
+		w, err := x.ToWire()
+		if err != nil {
+			return err
+		}
+		err = f(w)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v _List_Timestamp_ValueList) Size() int {
+	return len(v)
+}
+
+func (_List_Timestamp_ValueList) ValueType() wire.Type {
+	return wire.TI64
+}
+
+func (_List_Timestamp_ValueList) Close() {}
+
+type _Set_URL_sliceType_ValueList []URL
+
+func (v _Set_URL_sliceType_ValueList) ForEach(f func(wire.Value) error) error {
+	for _, x := range v {
+		w, err := x.ToWire()
+		if err != nil {
+			return err
+		}
+
+		if err := f(w); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v _Set_URL_sliceType_ValueList) Size() int {
+	return len(v)
+}
+
+func (_Set_URL_sliceType_ValueList) ValueType() wire.Type {
+	return wire.TBinary
+}
+
+func (_Set_URL_sliceType_ValueList) Close() {}
+
+type _Map_URL_I32_MapItemList []struct {
+	Key   URL
+	Value int32
+}
+
+func (m _Map_URL_I32_MapItemList) ForEach(f func(wire.MapItem) error) error {
+	for _, i := range m {
+		k := i.Key
+		v := i.Value
+		kw, err := k.ToWire()
+		if err != nil {
+			return err
+		}
+
+		vw, err := wire.NewValueI32(v), error(nil)
+		if err != nil {
+			return err
+		}
+		err = f(wire.MapItem{Key: kw, Value: vw})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m _Map_URL_I32_MapItemList) Size() int {
+	return len(m)
+}
+
+func (_Map_URL_I32_MapItemList) KeyType() wire.Type {
+	return wire.TBinary
+}
+
+func (_Map_URL_I32_MapItemList) ValueType() wire.Type {
+	return wire.TI32
+}
+
+func (_Map_URL_I32_MapItemList) Close() {}
+
+// ToWire translates a Event struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *Event) ToWire() (wire.Value, error) {
+	var (
+		fields [6]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	w, err = testadapters.UnixNanos.ToWire(v.CreatedAt)
+	if err != nil {
+		return w, err
+	}
+	fields[i] = wire.Field{ID: 1, Value: w}
+	i++
+	vUpdatedAt := v.UpdatedAt
+	if vUpdatedAt == nil {
+		vUpdatedAt = _Timestamp_ptr(Timestamp(_I64_UnixNanos_FromConstant(wire.NewValueI64(0))))
+	}
+	{
+		w, err = vUpdatedAt.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 2, Value: w}
+		i++
+	}
+	if v.Link != nil {
+		w, err = v.Link.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 3, Value: w}
+		i++
+	}
+	if v.History != nil {
+		w, err = wire.NewValueList(_List_Timestamp_ValueList(v.History)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 4, Value: w}
+		i++
+	}
+	if v.Mirrors != nil {
+		w, err = wire.NewValueSet(_Set_URL_sliceType_ValueList(v.Mirrors)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 5, Value: w}
+		i++
+	}
+	if v.Hits != nil {
+		w, err = wire.NewValueMap(_Map_URL_I32_MapItemList(v.Hits)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 6, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _Timestamp_Read(w wire.Value) (Timestamp, error) {
+	var x Timestamp
+	err := x.FromWire(w)
+	return x, err
+}
+
+func _URL_Read(w wire.Value) (URL, error) {
+	var x URL
+	err := x.FromWire(w)
+	return x, err
+}
+
+func _List_Timestamp_Read(l wire.ValueList) ([]Timestamp, error) {
+	if l.ValueType() != wire.TI64 {
+		return nil, nil
+	}
+
+	o := make([]Timestamp, 0, l.Size())
+	err := l.ForEach(func(x wire.Value) error {
+		i, err := _Timestamp_Read(x)
+		if err != nil {
+			return err
+		}
+		o = append(o, i)
+		return nil
+	})
+	l.Close()
+	return o, err
+}
+
+func _Set_URL_sliceType_Read(s wire.ValueList) ([]URL, error) {
+	if s.ValueType() != wire.TBinary {
+		return nil, nil
+	}
+
+	o := make([]URL, 0, s.Size())
+	err := s.ForEach(func(x wire.Value) error {
+		i, err := _URL_Read(x)
+		if err != nil {
+			return err
+		}
+
+		o = append(o, i)
+		return nil
+	})
+	s.Close()
+	return o, err
+}
+
+func _Map_URL_I32_Read(m wire.MapItemList) ([]struct {
+	Key   URL
+	Value int32
+}, error) {
+	if m.KeyType() != wire.TBinary {
+		return nil, nil
+	}
+
+	if m.ValueType() != wire.TI32 {
+		return nil, nil
+	}
+
+	o := make([]struct {
+		Key   URL
+		Value int32
+	}, 0, m.Size())
+	err := m.ForEach(func(x wire.MapItem) error {
+		k, err := _URL_Read(x.Key)
+		if err != nil {
+			return err
+		}
+
+		v, err := x.Value.GetI32(), error(nil)
+		if err != nil {
+			return err
+		}
+
+		o = append(o, struct {
+			Key   URL
+			Value int32
+		}{k, v})
+		return nil
+	})
+	m.Close()
+	return o, err
+}
+
+// FromWire deserializes a Event struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a Event struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v Event
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *Event) FromWire(w wire.Value) error {
+	var err error
+
+	createdAtIsSet := false
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TI64 {
+				v.CreatedAt, err = testadapters.UnixNanos.FromWire(field.Value)
+				if err != nil {
+					return err
+				}
+				createdAtIsSet = true
+			}
+		case 2:
+			if field.Value.Type() == wire.TI64 {
+				var x Timestamp
+				x, err = _Timestamp_Read(field.Value)
+				v.UpdatedAt = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 3:
+			if field.Value.Type() == wire.TBinary {
+				var x URL
+				x, err = _URL_Read(field.Value)
+				v.Link = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 4:
+			if field.Value.Type() == wire.TList {
+				v.History, err = _List_Timestamp_Read(field.Value.GetList())
+				if err != nil {
+					return err
+				}
+
+			}
+		case 5:
+			if field.Value.Type() == wire.TSet {
+				v.Mirrors, err = _Set_URL_sliceType_Read(field.Value.GetSet())
+				if err != nil {
+					return err
+				}
+
+			}
+		case 6:
+			if field.Value.Type() == wire.TMap {
+				v.Hits, err = _Map_URL_I32_Read(field.Value.GetMap())
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	if !createdAtIsSet {
+		return errors.New("field CreatedAt of Event is required")
+	}
+
+	if v.UpdatedAt == nil {
+		v.UpdatedAt = _Timestamp_ptr(Timestamp(_I64_UnixNanos_FromConstant(wire.NewValueI64(0))))
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a Event
+// struct.
+func (v *Event) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [6]string
+	i := 0
+	fields[i] = fmt.Sprintf("CreatedAt: %v", v.CreatedAt)
+	i++
+	if v.UpdatedAt != nil {
+		fields[i] = fmt.Sprintf("UpdatedAt: %v", *(v.UpdatedAt))
+		i++
+	}
+	if v.Link != nil {
+		fields[i] = fmt.Sprintf("Link: %v", *(v.Link))
+		i++
+	}
+	if v.History != nil {
+		fields[i] = fmt.Sprintf("History: %v", v.History)
+		i++
+	}
+	if v.Mirrors != nil {
+		fields[i] = fmt.Sprintf("Mirrors: %v", v.Mirrors)
+		i++
+	}
+	if v.Hits != nil {
+		fields[i] = fmt.Sprintf("Hits: %v", v.Hits)
+		i++
+	}
+
+	return fmt.Sprintf("Event{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _Timestamp_EqualsPtr(lhs, rhs *Timestamp) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return (x == y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+func _URL_EqualsPtr(lhs, rhs *URL) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return (x == y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+func _List_Timestamp_Equals(lhs, rhs []Timestamp) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for i, lv := range lhs {
+		rv := rhs[i]
+		if !(lv == rv) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func _Set_URL_sliceType_Equals(lhs, rhs []URL) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for _, x := range lhs {
+		ok := false
+		for _, y := range rhs {
+			if x == y {
+				ok = true
+				break
+			}
+		}
+		if !ok {
+			return false
+		}
+	}
+
+	return true
+}
+
+func _Map_URL_I32_Equals(lhs, rhs []struct {
+	Key   URL
+	Value int32
+}) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for _, i := range lhs {
+		lk := i.Key
+		lv := i.Value
+		ok := false
+		for _, j := range rhs {
+			rk := j.Key
+			rv := j.Value
+			if !(lk == rk) {
+				continue
+			}
+
+			if !(lv == rv) {
+				return false
+			}
+			ok = true
+			break
+		}
+
+		if !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// Equals returns true if all the fields of this Event match the
+// provided Event.
+//
+// This function performs a deep comparison.
+func (v *Event) Equals(rhs *Event) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !testadapters.UnixNanos.Equals(v.CreatedAt, rhs.CreatedAt) {
+		return false
+	}
+	if !_Timestamp_EqualsPtr(v.UpdatedAt, rhs.UpdatedAt) {
+		return false
+	}
+	if !_URL_EqualsPtr(v.Link, rhs.Link) {
+		return false
+	}
+	if !((v.History == nil && rhs.History == nil) || (v.History != nil && rhs.History != nil && _List_Timestamp_Equals(v.History, rhs.History))) {
+		return false
+	}
+	if !((v.Mirrors == nil && rhs.Mirrors == nil) || (v.Mirrors != nil && rhs.Mirrors != nil && _Set_URL_sliceType_Equals(v.Mirrors, rhs.Mirrors))) {
+		return false
+	}
+	if !((v.Hits == nil && rhs.Hits == nil) || (v.Hits != nil && rhs.Hits != nil && _Map_URL_I32_Equals(v.Hits, rhs.Hits))) {
+		return false
+	}
+
+	return true
+}
+
+type _List_Timestamp_Zapper []Timestamp
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _List_Timestamp_Zapper.
+func (l _List_Timestamp_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
+	for _, v := range l {
+		err = multierr.Append(err, enc.AppendReflected((time.Time)(v)))
+	}
+	return err
+}
+
+type _Set_URL_sliceType_Zapper []URL
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _Set_URL_sliceType_Zapper.
+func (s _Set_URL_sliceType_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
+	for _, v := range s {
+		err = multierr.Append(err, enc.AppendReflected((url.URL)(v)))
+	}
+	return err
+}
+
+type _Map_URL_I32_Item_Zapper struct {
+	Key   URL
+	Value int32
+}
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _Map_URL_I32_Item_Zapper.
+func (v _Map_URL_I32_Item_Zapper) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	err = multierr.Append(err, enc.AddReflected("key", (url.URL)(v.Key)))
+	enc.AddInt32("value", v.Value)
+	return err
+}
+
+type _Map_URL_I32_Zapper []struct {
+	Key   URL
+	Value int32
+}
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _Map_URL_I32_Zapper.
+func (m _Map_URL_I32_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
+	for _, i := range m {
+		k := i.Key
+		v := i.Value
+		err = multierr.Append(err, enc.AppendObject(_Map_URL_I32_Item_Zapper{Key: k, Value: v}))
+	}
+	return err
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Event.
+func (v *Event) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	err = multierr.Append(err, enc.AddReflected("createdAt", v.CreatedAt))
+	if v.UpdatedAt != nil {
+		err = multierr.Append(err, enc.AddReflected("updatedAt", (time.Time)(*v.UpdatedAt)))
+	}
+	if v.Link != nil {
+		err = multierr.Append(err, enc.AddReflected("link", (url.URL)(*v.Link)))
+	}
+	if v.History != nil {
+		err = multierr.Append(err, enc.AddArray("history", (_List_Timestamp_Zapper)(v.History)))
+	}
+	if v.Mirrors != nil {
+		err = multierr.Append(err, enc.AddArray("mirrors", (_Set_URL_sliceType_Zapper)(v.Mirrors)))
+	}
+	if v.Hits != nil {
+		err = multierr.Append(err, enc.AddArray("hits", (_Map_URL_I32_Zapper)(v.Hits)))
+	}
+	return err
+}
+
+// GetCreatedAt returns the value of CreatedAt if it is set or its
+// zero value if it is unset.
+func (v *Event) GetCreatedAt() (o time.Time) {
+	if v != nil {
+		o = v.CreatedAt
+	}
+	return
+}
+
+// GetUpdatedAt returns the value of UpdatedAt if it is set or its
+// default value if it is unset.
+func (v *Event) GetUpdatedAt() (o Timestamp) {
+	if v != nil && v.UpdatedAt != nil {
+		return *v.UpdatedAt
+	}
+	o = Timestamp(_I64_UnixNanos_FromConstant(wire.NewValueI64(0)))
+	return
+}
+
+// IsSetUpdatedAt returns true if UpdatedAt is not nil.
+func (v *Event) IsSetUpdatedAt() bool {
+	return v != nil && v.UpdatedAt != nil
+}
+
+// GetLink returns the value of Link if it is set or its
+// zero value if it is unset.
+func (v *Event) GetLink() (o URL) {
+	if v != nil && v.Link != nil {
+		return *v.Link
+	}
+
+	return
+}
+
+// IsSetLink returns true if Link is not nil.
+func (v *Event) IsSetLink() bool {
+	return v != nil && v.Link != nil
+}
+
+// GetHistory returns the value of History if it is set or its
+// zero value if it is unset.
+func (v *Event) GetHistory() (o []Timestamp) {
+	if v != nil && v.History != nil {
+		return v.History
+	}
+
+	return
+}
+
+// IsSetHistory returns true if History is not nil.
+func (v *Event) IsSetHistory() bool {
+	return v != nil && v.History != nil
+}
+
+// GetMirrors returns the value of Mirrors if it is set or its
+// zero value if it is unset.
+func (v *Event) GetMirrors() (o []URL) {
+	if v != nil && v.Mirrors != nil {
+		return v.Mirrors
+	}
+
+	return
+}
+
+// IsSetMirrors returns true if Mirrors is not nil.
+func (v *Event) IsSetMirrors() bool {
+	return v != nil && v.Mirrors != nil
+}
+
+// GetHits returns the value of Hits if it is set or its
+// zero value if it is unset.
+func (v *Event) GetHits() (o []struct {
+	Key   URL
+	Value int32
+}) {
+	if v != nil && v.Hits != nil {
+		return v.Hits
+	}
+
+	return
+}
+
+// IsSetHits returns true if Hits is not nil.
+func (v *Event) IsSetHits() bool {
+	return v != nil && v.Hits != nil
+}
+
+type Timestamp time.Time
+
+// TimestampPtr returns a pointer to a Timestamp
+func (v Timestamp) Ptr() *Timestamp {
+	return &v
+}
+
+// ToWire translates Timestamp into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+func (v Timestamp) ToWire() (wire.Value, error) {
+	x := (time.Time)(v)
+	return testadapters.UnixNanos.ToWire(x)
+}
+
+// String returns a readable string representation of Timestamp.
+func (v Timestamp) String() string {
+	x := (time.Time)(v)
+	return fmt.Sprint(x)
+}
+
+// FromWire deserializes Timestamp from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+func (v *Timestamp) FromWire(w wire.Value) error {
+	x, err := testadapters.UnixNanos.FromWire(w)
+	*v = (Timestamp)(x)
+	return err
+}
+
+// Equals returns true if this Timestamp is equal to the provided
+// Timestamp.
+func (lhs Timestamp) Equals(rhs Timestamp) bool {
+	return testadapters.UnixNanos.Equals((time.Time)(lhs), (time.Time)(rhs))
+}
+
+// MarshalJSON serializes Timestamp into JSON the same way as
+// time.Time.
+func (v Timestamp) MarshalJSON() ([]byte, error) {
+	return json.Marshal((time.Time)(v))
+}
+
+// UnmarshalJSON deserializes Timestamp from JSON the same way as
+// time.Time.
+func (v *Timestamp) UnmarshalJSON(b []byte) error {
+	return json.Unmarshal(b, (*time.Time)(v))
+}
+
+type URL url.URL
+
+// URLPtr returns a pointer to a URL
+func (v URL) Ptr() *URL {
+	return &v
+}
+
+// ToWire translates URL into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+func (v URL) ToWire() (wire.Value, error) {
+	x := (url.URL)(v)
+	return testadapters.URL.ToWire(x)
+}
+
+// String returns a readable string representation of URL.
+func (v URL) String() string {
+	x := (url.URL)(v)
+	return fmt.Sprint(x)
+}
+
+// FromWire deserializes URL from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+func (v *URL) FromWire(w wire.Value) error {
+	x, err := testadapters.URL.FromWire(w)
+	*v = (URL)(x)
+	return err
+}
+
+// Equals returns true if this URL is equal to the provided
+// URL.
+func (lhs URL) Equals(rhs URL) bool {
+	return testadapters.URL.Equals((url.URL)(lhs), (url.URL)(rhs))
+}
+
+// MarshalJSON serializes URL into JSON the same way as
+// url.URL.
+func (v URL) MarshalJSON() ([]byte, error) {
+	return json.Marshal((url.URL)(v))
+}
+
+// UnmarshalJSON deserializes URL from JSON the same way as
+// url.URL.
+func (v *URL) UnmarshalJSON(b []byte) error {
+	return json.Unmarshal(b, (*url.URL)(v))
+}
+
+// ThriftModule represents the IDL file used to generate this package.
+var ThriftModule = &thriftreflect.ThriftModule{
+	Name:     "adapters",
+	Package:  "go.uber.org/thriftrw/gen/internal/tests/adapters",
+	FilePath: "adapters.thrift",
+	SHA1:     "e40e795ed166b03b8be2de78cc568c1666c9a76e",
+	Raw:      rawIDL,
+}
+
+const rawIDL = "typedef i64 (\n    go.type = \"time.Time\",\n    go.adapter = \"go.uber.org/thriftrw/gen/internal/testadapters.UnixNanos\",\n) Timestamp\n\ntypedef string (\n    go.type = \"net/url.URL\",\n    go.adapter = \"go.uber.org/thriftrw/gen/internal/testadapters.URL\",\n) URL\n\nconst Timestamp EPOCH = 0\n\nstruct Event {\n    1: required i64 (\n        go.type = \"time.Time\",\n        go.adapter = \"go.uber.org/thriftrw/gen/internal/testadapters.UnixNanos\",\n    ) createdAt\n    2: optional Timestamp updatedAt = EPOCH\n    3: optional URL link\n    4: optional list<Timestamp> history\n    5: optional set<URL> mirrors\n    6: optional map<URL, i32> hits\n}\n\nservice EventStore {\n    Timestamp record(1: Event event, 2: Timestamp at)\n}\n"
+
+func init() {
+	thriftreflect.Register(ThriftModule, map[string]thriftreflect.Constructor{
+		"Event": func() interface{} { return new(Event) },
+	})
+}
+
+// EventStore_Record_Args represents the arguments for the EventStore.record function.
+//
+// The arguments for record are sent and received over the wire as this struct.
+type EventStore_Record_Args struct {
+	Event *Event     `json:"event,omitempty"`
+	At    *Timestamp `json:"at,omitempty"`
+}
+
+// ToWire translates a EventStore_Record_Args struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *EventStore_Record_Args) ToWire() (wire.Value, error) {
+	var (
+		fields [2]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Event != nil {
+		w, err = v.Event.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 1, Value: w}
+		i++
+	}
+	if v.At != nil {
+		w, err = v.At.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 2, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _Event_Read(w wire.Value) (*Event, error) {
+	var v Event
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// FromWire deserializes a EventStore_Record_Args struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a EventStore_Record_Args struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v EventStore_Record_Args
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *EventStore_Record_Args) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TStruct {
+				v.Event, err = _Event_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 2:
+			if field.Value.Type() == wire.TI64 {
+				var x Timestamp
+				x, err = _Timestamp_Read(field.Value)
+				v.At = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a EventStore_Record_Args
+// struct.
+func (v *EventStore_Record_Args) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [2]string
+	i := 0
+	if v.Event != nil {
+		fields[i] = fmt.Sprintf("Event: %v", v.Event)
+		i++
+	}
+	if v.At != nil {
+		fields[i] = fmt.Sprintf("At: %v", *(v.At))
+		i++
+	}
+
+	return fmt.Sprintf("EventStore_Record_Args{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this EventStore_Record_Args match the
+// provided EventStore_Record_Args.
+//
+// This function performs a deep comparison.
+func (v *EventStore_Record_Args) Equals(rhs *EventStore_Record_Args) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !((v.Event == nil && rhs.Event == nil) || (v.Event != nil && rhs.Event != nil && v.Event.Equals(rhs.Event))) {
+		return false
+	}
+	if !_Timestamp_EqualsPtr(v.At, rhs.At) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of EventStore_Record_Args.
+func (v *EventStore_Record_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Event != nil {
+		err = multierr.Append(err, enc.AddObject("event", v.Event))
+	}
+	if v.At != nil {
+		err = multierr.Append(err, enc.AddReflected("at", (time.Time)(*v.At)))
+	}
+	return err
+}
+
+// GetEvent returns the value of Event if it is set or its
+// zero value if it is unset.
+func (v *EventStore_Record_Args) GetEvent() (o *Event) {
+	if v != nil && v.Event != nil {
+		return v.Event
+	}
+
+	return
+}
+
+// IsSetEvent returns true if Event is not nil.
+func (v *EventStore_Record_Args) IsSetEvent() bool {
+	return v != nil && v.Event != nil
+}
+
+// GetAt returns the value of At if it is set or its
+// zero value if it is unset.
+func (v *EventStore_Record_Args) GetAt() (o Timestamp) {
+	if v != nil && v.At != nil {
+		return *v.At
+	}
+
+	return
+}
+
+// IsSetAt returns true if At is not nil.
+func (v *EventStore_Record_Args) IsSetAt() bool {
+	return v != nil && v.At != nil
+}
+
+// MethodName returns the name of the Thrift function as specified in
+// the IDL, for which this struct represent the arguments.
+//
+// This will always be "record" for this struct.
+func (v *EventStore_Record_Args) MethodName() string {
+	return "record"
+}
+
+// EnvelopeType returns the kind of value inside this struct.
+//
+// This will always be Call for this struct.
+func (v *EventStore_Record_Args) EnvelopeType() wire.EnvelopeType {
+	return wire.Call
+}
+
+// EventStore_Record_Helper provides functions that aid in handling the
+// parameters and return values of the EventStore.record
+// function.
+var EventStore_Record_Helper = struct {
+	// Args accepts the parameters of record in-order and returns
+	// the arguments struct for the function.
+	Args func(
+		event *Event,
+		at *Timestamp,
+	) *EventStore_Record_Args
+
+	// IsException returns true if the given error can be thrown
+	// by record.
+	//
+	// An error can be thrown by record only if the
+	// corresponding exception type was mentioned in the 'throws'
+	// section for it in the Thrift file.
+	IsException func(error) bool
+
+	// WrapResponse returns the result struct for record
+	// given its return value and error.
+	//
+	// This allows mapping values and errors returned by
+	// record into a serializable result struct.
+	// WrapResponse returns a non-nil error if the provided
+	// error cannot be thrown by record
+	//
+	//   value, err := record(args)
+	//   result, err := EventStore_Record_Helper.WrapResponse(value, err)
+	//   if err != nil {
+	//     return fmt.Errorf("unexpected error from record: %v", err)
+	//   }
+	//   serialize(result)
+	WrapResponse func(Timestamp, error) (*EventStore_Record_Result, error)
+
+	// UnwrapResponse takes the result struct for record
+	// and returns the value or error returned by it.
+	//
+	// The error is non-nil only if record threw an
+	// exception.
+	//
+	//   result := deserialize(bytes)
+	//   value, err := EventStore_Record_Helper.UnwrapResponse(result)
+	UnwrapResponse func(*EventStore_Record_Result) (Timestamp, error)
+}{}
+
+func init() {
+	EventStore_Record_Helper.Args = func(
+		event *Event,
+		at *Timestamp,
+	) *EventStore_Record_Args {
+		return &EventStore_Record_Args{
+			Event: event,
+			At:    at,
+		}
+	}
+
+	EventStore_Record_Helper.IsException = func(err error) bool {
+		switch err.(type) {
+		default:
+			return false
+		}
+	}
+
+	EventStore_Record_Helper.WrapResponse = func(success Timestamp, err error) (*EventStore_Record_Result, error) {
+		if err == nil {
+			return &EventStore_Record_Result{Success: &success}, nil
+		}
+
+		return nil, err
+	}
+	EventStore_Record_Helper.UnwrapResponse = func(result *EventStore_Record_Result) (success Timestamp, err error) {
+
+		if result.Success != nil {
+			success = *result.Success
+			return
+		}
+
+		err = errors.New("expected a non-void result")
+		return
+	}
+
+}
+
+// EventStore_Record_Result represents the result of a EventStore.record function call.
+//
+// The result of a record execution is sent and received over the wire as this struct.
+//
+// Success is set only if the function did not throw an exception.
+type EventStore_Record_Result struct {
+	// Value returned by record after a successful execution.
+	Success *Timestamp `json:"success,omitempty"`
+}
+
+// ToWire translates a EventStore_Record_Result struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *EventStore_Record_Result) ToWire() (wire.Value, error) {
+	var (
+		fields [1]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Success != nil {
+		w, err = v.Success.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 0, Value: w}
+		i++
+	}
+
+	if i != 1 {
+		return wire.Value{}, fmt.Errorf("EventStore_Record_Result should have exactly one field: got %v fields", i)
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a EventStore_Record_Result struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a EventStore_Record_Result struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v EventStore_Record_Result
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *EventStore_Record_Result) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 0:
+			if field.Value.Type() == wire.TI64 {
+				var x Timestamp
+				x, err = _Timestamp_Read(field.Value)
+				v.Success = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	count := 0
+	if v.Success != nil {
+		count++
+	}
+	if count != 1 {
+		return fmt.Errorf("EventStore_Record_Result should have exactly one field: got %v fields", count)
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a EventStore_Record_Result
+// struct.
+func (v *EventStore_Record_Result) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [1]string
+	i := 0
+	if v.Success != nil {
+		fields[i] = fmt.Sprintf("Success: %v", *(v.Success))
+		i++
+	}
+
+	return fmt.Sprintf("EventStore_Record_Result{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this EventStore_Record_Result match the
+// provided EventStore_Record_Result.
+//
+// This function performs a deep comparison.
+func (v *EventStore_Record_Result) Equals(rhs *EventStore_Record_Result) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_Timestamp_EqualsPtr(v.Success, rhs.Success) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of EventStore_Record_Result.
+func (v *EventStore_Record_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Success != nil {
+		err = multierr.Append(err, enc.AddReflected("success", (time.Time)(*v.Success)))
+	}
+	return err
+}
+
+// GetSuccess returns the value of Success if it is set or its
+// zero value if it is unset.
+func (v *EventStore_Record_Result) GetSuccess() (o Timestamp) {
+	if v != nil && v.Success != nil {
+		return *v.Success
+	}
+
+	return
+}
+
+// IsSetSuccess returns true if Success is not nil.
+func (v *EventStore_Record_Result) IsSetSuccess() bool {
+	return v != nil && v.Success != nil
+}
+
+// MethodName returns the name of the Thrift function as specified in
+// the IDL, for which this struct represent the result.
+//
+// This will always be "record" for this struct.
+func (v *EventStore_Record_Result) MethodName() string {
+	return "record"
+}
+
+// EnvelopeType returns the kind of value inside this struct.
+//
+// This will always be Reply for this struct.
+func (v *EventStore_Record_Result) EnvelopeType() wire.EnvelopeType {
+	return wire.Reply
+}
@@ -5033,3 +5033,17 @@ var ThriftModule = &thriftreflect.ThriftModule{
 }
 
 const rawIDL = "include \"./enums.thrift\"\ninclude \"./enum_conflict.thrift\"\ninclude \"./typedefs.thrift\"\ninclude \"./uuid_conflict.thrift\"\n\nstruct PrimitiveContainers {\n    1: optional list<binary> listOfBinary\n    2: optional list<i64> listOfInts\n    3: optional set<string> setOfStrings\n    4: optional set<byte> setOfBytes\n    5: optional map<i32, string> mapOfIntToString\n    6: optional map<string, bool> mapOfStringToBool\n}\n\nstruct PrimitiveContainersRequired {\n    1: required list<string> listOfStrings\n    2: required set<i32> setOfInts\n    3: required map<i64, double> mapOfIntsToDoubles\n}\n\nstruct EnumContainers {\n    1: optional list<enums.EnumDefault> listOfEnums\n    2: optional set<enums.EnumWithValues> setOfEnums\n    3: optional map<enums.EnumWithDuplicateValues, i32> mapOfEnums\n}\n\nstruct ContainersOfContainers {\n    1: optional list<list<i32>> listOfLists;\n    2: optional list<set<i32>> listOfSets;\n    3: optional list<map<i32, i32>> listOfMaps;\n\n    4: optional set<set<string>> setOfSets;\n    5: optional set<list<string>> setOfLists;\n    6: optional set<map<string, string>> setOfMaps;\n\n    7: optional map<map<string, i32>, i64> mapOfMapToInt;\n    8: optional map<list<i32>, set<i64>> mapOfListToSet;\n    9: optional map<set<i32>, list<double>> mapOfSetToListOfDouble;\n}\n\nstruct MapOfBinaryAndString {\n    1: optional map<binary, string> binaryToString;\n    2: optional map<string, binary> stringToBinary;\n}\n\nstruct ListOfRequiredPrimitives {\n    1: required list<string> listOfStrings\n}\n\nstruct ListOfOptionalPrimitives {\n    1: optional list<string> listOfStrings\n}\n\nstruct ListOfConflictingEnums {\n    1: required list<enum_conflict.RecordType> records\n    2: required list<enums.RecordType> otherRecords\n}\n\nstruct ListOfConflictingUUIDs {\n    1: required list<typedefs.UUID> uuids\n    2: required list<uuid_conflict.UUID> otherUUIDs\n}\n"
+
+func init() {
+	thriftreflect.Register(ThriftModule, map[string]thriftreflect.Constructor{
+		"ContainersOfContainers":      func() interface{} { return new(ContainersOfContainers) },
+		"EnumContainers":              func() interface{} { return new(EnumContainers) },
+		"ListOfConflictingEnums":      func() interface{} { return new(ListOfConflictingEnums) },
+		"ListOfConflictingUUIDs":      func() interface{} { return new(ListOfConflictingUUIDs) },
+		"ListOfOptionalPrimitives":    func() interface{} { return new(ListOfOptionalPrimitives) },
+		"ListOfRequiredPrimitives":    func() interface{} { return new(ListOfRequiredPrimitives) },
+		"MapOfBinaryAndString":        func() interface{} { return new(MapOfBinaryAndString) },
+		"PrimitiveContainers":         func() interface{} { return new(PrimitiveContainers) },
+		"PrimitiveContainersRequired": func() interface{} { return new(PrimitiveContainersRequired) },
+	})
+}
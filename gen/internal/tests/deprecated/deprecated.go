@@ -0,0 +1,2389 @@
+// Code generated by thriftrw v1.28.0. DO NOT EDIT.
+// @generated
+
+package deprecated
+
+import (
+	bytes "bytes"
+	json "encoding/json"
+	errors "errors"
+	fmt "fmt"
+	multierr "go.uber.org/multierr"
+	thriftreflect "go.uber.org/thriftrw/thriftreflect"
+	wire "go.uber.org/thriftrw/wire"
+	zapcore "go.uber.org/zap/zapcore"
+	math "math"
+	strconv "strconv"
+	strings "strings"
+)
+
+// Contact_Email is the email variant of Contact.
+type Contact_Email struct {
+	Value string
+}
+
+func (Contact_Email) isContact() {}
+
+// ToWire translates a Contact_Email into a Thrift-level intermediate
+// representation of Contact.
+func (v Contact_Email) ToWire() (wire.Value, error) {
+	w, err := wire.NewValueString(v.Value), error(nil)
+	if err != nil {
+		return w, err
+	}
+	return wire.NewValueStruct(wire.Struct{Fields: []wire.Field{
+		{ID: 1, Value: w},
+	}}), nil
+}
+
+// String returns a readable string representation of a Contact_Email.
+func (v Contact_Email) String() string {
+	return fmt.Sprintf("Contact{Email: %v}", v.Value)
+}
+
+// Equals returns true if the provided Contact is a Contact_Email with
+// the same value.
+func (v Contact_Email) Equals(rhs Contact) bool {
+	r, ok := rhs.(Contact_Email)
+	return ok && (v.Value == r.Value)
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Contact_Email.
+func (v Contact_Email) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	enc.AddString("email", v.Value)
+	return err
+}
+
+// MarshalJSON implements json.Marshaler, encoding Contact_Email as an
+// object with a single "email" key.
+func (v Contact_Email) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Value string `json:"email"`
+	}{v.Value})
+}
+
+// Contact_Pager is the pager variant of Contact.
+//
+// Deprecated: Pagers are no longer supported.
+type Contact_Pager struct {
+	Value string
+}
+
+func (Contact_Pager) isContact() {}
+
+// ToWire translates a Contact_Pager into a Thrift-level intermediate
+// representation of Contact.
+func (v Contact_Pager) ToWire() (wire.Value, error) {
+	w, err := wire.NewValueString(v.Value), error(nil)
+	if err != nil {
+		return w, err
+	}
+	return wire.NewValueStruct(wire.Struct{Fields: []wire.Field{
+		{ID: 2, Value: w},
+	}}), nil
+}
+
+// String returns a readable string representation of a Contact_Pager.
+func (v Contact_Pager) String() string {
+	return fmt.Sprintf("Contact{Pager: %v}", v.Value)
+}
+
+// Equals returns true if the provided Contact is a Contact_Pager with
+// the same value.
+func (v Contact_Pager) Equals(rhs Contact) bool {
+	r, ok := rhs.(Contact_Pager)
+	return ok && (v.Value == r.Value)
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Contact_Pager.
+func (v Contact_Pager) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	enc.AddString("pager", v.Value)
+	return err
+}
+
+// MarshalJSON implements json.Marshaler, encoding Contact_Pager as an
+// object with a single "pager" key.
+func (v Contact_Pager) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Value string `json:"pager"`
+	}{v.Value})
+}
+
+// Contact is a union of the following types, exactly one of which is
+// held by any non-nil Contact:
+//
+//  - Contact_Email
+//  - Contact_Pager
+type Contact interface {
+	isContact()
+
+	// ToWire translates this Contact into a Thrift-level
+	// intermediate representation.
+	ToWire() (wire.Value, error)
+
+	// String returns a readable string representation of this
+	// Contact.
+	String() string
+
+	// Equals returns true if the provided Contact holds the same
+	// variant with the same value.
+	Equals(Contact) bool
+
+	// MarshalLogObject implements zapcore.ObjectMarshaler.
+	MarshalLogObject(zapcore.ObjectEncoder) error
+}
+
+// Contact_FromWire deserializes a Contact from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned unless exactly one variant of Contact is
+// present in the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   return Contact_FromWire(x)
+func Contact_FromWire(w wire.Value) (Contact, error) {
+	var (
+		v     Contact
+		count int
+		err   error
+	)
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TBinary {
+				var x Contact_Email
+				x.Value, err = field.Value.GetString(), error(nil)
+				if err != nil {
+					return nil, err
+				}
+				v = x
+				count++
+			}
+		case 2:
+			if field.Value.Type() == wire.TBinary {
+				var x2 Contact_Pager
+				x2.Value, err = field.Value.GetString(), error(nil)
+				if err != nil {
+					return nil, err
+				}
+				v = x2
+				count++
+			}
+		}
+	}
+
+	if count != 1 {
+		return nil, fmt.Errorf("Contact should have exactly one field: got %v fields", count)
+	}
+	return v, nil
+}
+
+// Contact_Equals returns true if the two provided Contact values hold
+// the same variant with the same value, or if both are nil.
+func Contact_Equals(lhs, rhs Contact) bool {
+	if lhs == nil {
+		return rhs == nil
+	}
+	return lhs.Equals(rhs)
+}
+
+// Contact_UnmarshalJSON decodes a Contact from JSON produced by the
+// MarshalJSON method of one of its variants: an object with exactly
+// one key naming the variant. null is decoded as a nil Contact.
+func Contact_UnmarshalJSON(b []byte) (Contact, error) {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(b, &fields); err != nil {
+		return nil, err
+	}
+	if fields == nil {
+		return nil, nil
+	}
+	if len(fields) != 1 {
+		return nil, fmt.Errorf("Contact should have exactly one field: got %v fields", len(fields))
+	}
+
+	// Pick the only entry of the map.
+	var (
+		k   string
+		raw json.RawMessage
+	)
+	for k, raw = range fields {
+	}
+
+	switch k {
+	case "email":
+		var x Contact_Email
+		err := json.Unmarshal(raw, &x.Value)
+		return x, err
+	case "pager":
+		var x2 Contact_Pager
+		err := json.Unmarshal(raw, &x2.Value)
+		return x2, err
+	default:
+		return nil, fmt.Errorf("unknown field %q of Contact", k)
+	}
+}
+
+// An old way of identifying users.
+//
+// Deprecated: Use UserID instead.
+type LegacyID string
+
+// LegacyIDPtr returns a pointer to a LegacyID
+func (v LegacyID) Ptr() *LegacyID {
+	return &v
+}
+
+// ToWire translates LegacyID into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+func (v LegacyID) ToWire() (wire.Value, error) {
+	x := (string)(v)
+	return wire.NewValueString(x), error(nil)
+}
+
+// String returns a readable string representation of LegacyID.
+func (v LegacyID) String() string {
+	x := (string)(v)
+	return fmt.Sprint(x)
+}
+
+// FromWire deserializes LegacyID from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+func (v *LegacyID) FromWire(w wire.Value) error {
+	x, err := w.GetString(), error(nil)
+	*v = (LegacyID)(x)
+	return err
+}
+
+// Equals returns true if this LegacyID is equal to the provided
+// LegacyID.
+func (lhs LegacyID) Equals(rhs LegacyID) bool {
+	return ((string)(lhs) == (string)(rhs))
+}
+
+// Old user representation.
+//
+// Deprecated: Use User instead.
+type OldUser struct {
+	ID LegacyID `json:"id,required"`
+}
+
+// ToWire translates a OldUser struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *OldUser) ToWire() (wire.Value, error) {
+	var (
+		fields [1]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	w, err = v.ID.ToWire()
+	if err != nil {
+		return w, err
+	}
+	fields[i] = wire.Field{ID: 1, Value: w}
+	i++
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _LegacyID_Read(w wire.Value) (LegacyID, error) {
+	var x LegacyID
+	err := x.FromWire(w)
+	return x, err
+}
+
+// FromWire deserializes a OldUser struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a OldUser struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v OldUser
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *OldUser) FromWire(w wire.Value) error {
+	var err error
+
+	idIsSet := false
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TBinary {
+				v.ID, err = _LegacyID_Read(field.Value)
+				if err != nil {
+					return err
+				}
+				idIsSet = true
+			}
+		}
+	}
+
+	if !idIsSet {
+		return errors.New("field ID of OldUser is required")
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a OldUser
+// struct.
+func (v *OldUser) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [1]string
+	i := 0
+	fields[i] = fmt.Sprintf("ID: %v", v.ID)
+	i++
+
+	return fmt.Sprintf("OldUser{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this OldUser match the
+// provided OldUser.
+//
+// This function performs a deep comparison.
+func (v *OldUser) Equals(rhs *OldUser) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !(v.ID == rhs.ID) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of OldUser.
+func (v *OldUser) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	enc.AddString("id", (string)(v.ID))
+	return err
+}
+
+// GetID returns the value of ID if it is set or its
+// zero value if it is unset.
+func (v *OldUser) GetID() (o LegacyID) {
+	if v != nil {
+		o = v.ID
+	}
+	return
+}
+
+// Deprecated: This is deprecated in the Thrift file.
+type Role int32
+
+const (
+	RoleAdmin  Role = 0
+	RoleMember Role = 1
+)
+
+// Role_Values returns all recognized values of Role.
+func Role_Values() []Role {
+	return []Role{
+		RoleAdmin,
+		RoleMember,
+	}
+}
+
+// UnmarshalText tries to decode Role from a byte slice
+// containing its name.
+//
+//   var v Role
+//   err := v.UnmarshalText([]byte("ADMIN"))
+func (v *Role) UnmarshalText(value []byte) error {
+	switch s := string(value); s {
+	case "ADMIN":
+		*v = RoleAdmin
+		return nil
+	case "MEMBER":
+		*v = RoleMember
+		return nil
+	default:
+		val, err := strconv.ParseInt(s, 10, 32)
+		if err != nil {
+			return fmt.Errorf("unknown enum value %q for %q: %v", s, "Role", err)
+		}
+		*v = Role(val)
+		return nil
+	}
+}
+
+// MarshalText encodes Role to text.
+//
+// If the enum value is recognized, its name is returned. Otherwise,
+// its integer value is returned.
+//
+// This implements the TextMarshaler interface.
+func (v Role) MarshalText() ([]byte, error) {
+	switch int32(v) {
+	case 0:
+		return []byte("ADMIN"), nil
+	case 1:
+		return []byte("MEMBER"), nil
+	}
+	return []byte(strconv.FormatInt(int64(v), 10)), nil
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Role.
+// Enums are logged as objects, where the value is logged with key "value", and
+// if this value's name is known, the name is logged with key "name".
+func (v Role) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	enc.AddInt32("value", int32(v))
+	switch int32(v) {
+	case 0:
+		enc.AddString("name", "ADMIN")
+	case 1:
+		enc.AddString("name", "MEMBER")
+	}
+	return nil
+}
+
+// Ptr returns a pointer to this enum value.
+func (v Role) Ptr() *Role {
+	return &v
+}
+
+// ToWire translates Role into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// Enums are represented as 32-bit integers over the wire.
+func (v Role) ToWire() (wire.Value, error) {
+	return wire.NewValueI32(int32(v)), nil
+}
+
+// FromWire deserializes Role from its Thrift-level
+// representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TI32)
+//   if err != nil {
+//     return Role(0), err
+//   }
+//
+//   var v Role
+//   if err := v.FromWire(x); err != nil {
+//     return Role(0), err
+//   }
+//   return v, nil
+func (v *Role) FromWire(w wire.Value) error {
+	*v = (Role)(w.GetI32())
+	return nil
+}
+
+// String returns a readable string representation of Role.
+func (v Role) String() string {
+	w := int32(v)
+	switch w {
+	case 0:
+		return "ADMIN"
+	case 1:
+		return "MEMBER"
+	}
+	return fmt.Sprintf("Role(%d)", w)
+}
+
+// Equals returns true if this Role value matches the provided
+// value.
+func (v Role) Equals(rhs Role) bool {
+	return v == rhs
+}
+
+// MarshalJSON serializes Role into JSON.
+//
+// If the enum value is recognized, its name is returned. Otherwise,
+// its integer value is returned.
+//
+// This implements json.Marshaler.
+func (v Role) MarshalJSON() ([]byte, error) {
+	switch int32(v) {
+	case 0:
+		return ([]byte)("\"ADMIN\""), nil
+	case 1:
+		return ([]byte)("\"MEMBER\""), nil
+	}
+	return ([]byte)(strconv.FormatInt(int64(v), 10)), nil
+}
+
+// UnmarshalJSON attempts to decode Role from its JSON
+// representation.
+//
+// This implementation supports both, numeric and string inputs. If a
+// string is provided, it must be a known enum name.
+//
+// This implements json.Unmarshaler.
+func (v *Role) UnmarshalJSON(text []byte) error {
+	d := json.NewDecoder(bytes.NewReader(text))
+	d.UseNumber()
+	t, err := d.Token()
+	if err != nil {
+		return err
+	}
+
+	switch w := t.(type) {
+	case json.Number:
+		x, err := w.Int64()
+		if err != nil {
+			return err
+		}
+		if x > math.MaxInt32 {
+			return fmt.Errorf("enum overflow from JSON %q for %q", text, "Role")
+		}
+		if x < math.MinInt32 {
+			return fmt.Errorf("enum underflow from JSON %q for %q", text, "Role")
+		}
+		*v = (Role)(x)
+		return nil
+	case string:
+		return v.UnmarshalText([]byte(w))
+	default:
+		return fmt.Errorf("invalid JSON value %q (%T) to unmarshal into %q", t, t, "Role")
+	}
+}
+
+type Status int32
+
+const (
+	StatusActive Status = 0
+	// Users are never suspended anymore.
+	//
+	// Deprecated: Use BANNED instead.
+	StatusSuspended Status = 1
+	StatusBanned    Status = 2
+)
+
+// Status_Values returns all recognized values of Status.
+func Status_Values() []Status {
+	return []Status{
+		StatusActive,
+		StatusSuspended,
+		StatusBanned,
+	}
+}
+
+// UnmarshalText tries to decode Status from a byte slice
+// containing its name.
+//
+//   var v Status
+//   err := v.UnmarshalText([]byte("ACTIVE"))
+func (v *Status) UnmarshalText(value []byte) error {
+	switch s := string(value); s {
+	case "ACTIVE":
+		*v = StatusActive
+		return nil
+	case "SUSPENDED":
+		*v = StatusSuspended
+		return nil
+	case "BANNED":
+		*v = StatusBanned
+		return nil
+	default:
+		val, err := strconv.ParseInt(s, 10, 32)
+		if err != nil {
+			return fmt.Errorf("unknown enum value %q for %q: %v", s, "Status", err)
+		}
+		*v = Status(val)
+		return nil
+	}
+}
+
+// MarshalText encodes Status to text.
+//
+// If the enum value is recognized, its name is returned. Otherwise,
+// its integer value is returned.
+//
+// This implements the TextMarshaler interface.
+func (v Status) MarshalText() ([]byte, error) {
+	switch int32(v) {
+	case 0:
+		return []byte("ACTIVE"), nil
+	case 1:
+		return []byte("SUSPENDED"), nil
+	case 2:
+		return []byte("BANNED"), nil
+	}
+	return []byte(strconv.FormatInt(int64(v), 10)), nil
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Status.
+// Enums are logged as objects, where the value is logged with key "value", and
+// if this value's name is known, the name is logged with key "name".
+func (v Status) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	enc.AddInt32("value", int32(v))
+	switch int32(v) {
+	case 0:
+		enc.AddString("name", "ACTIVE")
+	case 1:
+		enc.AddString("name", "SUSPENDED")
+	case 2:
+		enc.AddString("name", "BANNED")
+	}
+	return nil
+}
+
+// Ptr returns a pointer to this enum value.
+func (v Status) Ptr() *Status {
+	return &v
+}
+
+// ToWire translates Status into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// Enums are represented as 32-bit integers over the wire.
+func (v Status) ToWire() (wire.Value, error) {
+	return wire.NewValueI32(int32(v)), nil
+}
+
+// FromWire deserializes Status from its Thrift-level
+// representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TI32)
+//   if err != nil {
+//     return Status(0), err
+//   }
+//
+//   var v Status
+//   if err := v.FromWire(x); err != nil {
+//     return Status(0), err
+//   }
+//   return v, nil
+func (v *Status) FromWire(w wire.Value) error {
+	*v = (Status)(w.GetI32())
+	return nil
+}
+
+// String returns a readable string representation of Status.
+func (v Status) String() string {
+	w := int32(v)
+	switch w {
+	case 0:
+		return "ACTIVE"
+	case 1:
+		return "SUSPENDED"
+	case 2:
+		return "BANNED"
+	}
+	return fmt.Sprintf("Status(%d)", w)
+}
+
+// Equals returns true if this Status value matches the provided
+// value.
+func (v Status) Equals(rhs Status) bool {
+	return v == rhs
+}
+
+// MarshalJSON serializes Status into JSON.
+//
+// If the enum value is recognized, its name is returned. Otherwise,
+// its integer value is returned.
+//
+// This implements json.Marshaler.
+func (v Status) MarshalJSON() ([]byte, error) {
+	switch int32(v) {
+	case 0:
+		return ([]byte)("\"ACTIVE\""), nil
+	case 1:
+		return ([]byte)("\"SUSPENDED\""), nil
+	case 2:
+		return ([]byte)("\"BANNED\""), nil
+	}
+	return ([]byte)(strconv.FormatInt(int64(v), 10)), nil
+}
+
+// UnmarshalJSON attempts to decode Status from its JSON
+// representation.
+//
+// This implementation supports both, numeric and string inputs. If a
+// string is provided, it must be a known enum name.
+//
+// This implements json.Unmarshaler.
+func (v *Status) UnmarshalJSON(text []byte) error {
+	d := json.NewDecoder(bytes.NewReader(text))
+	d.UseNumber()
+	t, err := d.Token()
+	if err != nil {
+		return err
+	}
+
+	switch w := t.(type) {
+	case json.Number:
+		x, err := w.Int64()
+		if err != nil {
+			return err
+		}
+		if x > math.MaxInt32 {
+			return fmt.Errorf("enum overflow from JSON %q for %q", text, "Status")
+		}
+		if x < math.MinInt32 {
+			return fmt.Errorf("enum underflow from JSON %q for %q", text, "Status")
+		}
+		*v = (Status)(x)
+		return nil
+	case string:
+		return v.UnmarshalText([]byte(w))
+	default:
+		return fmt.Errorf("invalid JSON value %q (%T) to unmarshal into %q", t, t, "Status")
+	}
+}
+
+type User struct {
+	ID UserID `json:"id,required"`
+	// Deprecated: Use id instead.
+	LegacyID *LegacyID `json:"legacyID,omitempty"`
+	Status   *Status   `json:"status,omitempty"`
+	Role     *Role     `json:"role,omitempty"`
+}
+
+// ToWire translates a User struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *User) ToWire() (wire.Value, error) {
+	var (
+		fields [4]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	w, err = v.ID.ToWire()
+	if err != nil {
+		return w, err
+	}
+	fields[i] = wire.Field{ID: 1, Value: w}
+	i++
+	if v.LegacyID != nil {
+		w, err = v.LegacyID.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 2, Value: w}
+		i++
+	}
+	if v.Status != nil {
+		w, err = v.Status.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 3, Value: w}
+		i++
+	}
+	if v.Role != nil {
+		w, err = v.Role.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 4, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _UserID_Read(w wire.Value) (UserID, error) {
+	var x UserID
+	err := x.FromWire(w)
+	return x, err
+}
+
+func _Status_Read(w wire.Value) (Status, error) {
+	var v Status
+	err := v.FromWire(w)
+	return v, err
+}
+
+func _Role_Read(w wire.Value) (Role, error) {
+	var v Role
+	err := v.FromWire(w)
+	return v, err
+}
+
+// FromWire deserializes a User struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a User struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v User
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *User) FromWire(w wire.Value) error {
+	var err error
+
+	idIsSet := false
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TBinary {
+				v.ID, err = _UserID_Read(field.Value)
+				if err != nil {
+					return err
+				}
+				idIsSet = true
+			}
+		case 2:
+			if field.Value.Type() == wire.TBinary {
+				var x LegacyID
+				x, err = _LegacyID_Read(field.Value)
+				v.LegacyID = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 3:
+			if field.Value.Type() == wire.TI32 {
+				var x Status
+				x, err = _Status_Read(field.Value)
+				v.Status = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 4:
+			if field.Value.Type() == wire.TI32 {
+				var x Role
+				x, err = _Role_Read(field.Value)
+				v.Role = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	if !idIsSet {
+		return errors.New("field ID of User is required")
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a User
+// struct.
+func (v *User) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [4]string
+	i := 0
+	fields[i] = fmt.Sprintf("ID: %v", v.ID)
+	i++
+	if v.LegacyID != nil {
+		fields[i] = fmt.Sprintf("LegacyID: %v", *(v.LegacyID))
+		i++
+	}
+	if v.Status != nil {
+		fields[i] = fmt.Sprintf("Status: %v", *(v.Status))
+		i++
+	}
+	if v.Role != nil {
+		fields[i] = fmt.Sprintf("Role: %v", *(v.Role))
+		i++
+	}
+
+	return fmt.Sprintf("User{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _LegacyID_EqualsPtr(lhs, rhs *LegacyID) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return (x == y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+func _Status_EqualsPtr(lhs, rhs *Status) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return x.Equals(y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+func _Role_EqualsPtr(lhs, rhs *Role) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return x.Equals(y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+// Equals returns true if all the fields of this User match the
+// provided User.
+//
+// This function performs a deep comparison.
+func (v *User) Equals(rhs *User) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !(v.ID == rhs.ID) {
+		return false
+	}
+	if !_LegacyID_EqualsPtr(v.LegacyID, rhs.LegacyID) {
+		return false
+	}
+	if !_Status_EqualsPtr(v.Status, rhs.Status) {
+		return false
+	}
+	if !_Role_EqualsPtr(v.Role, rhs.Role) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of User.
+func (v *User) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	enc.AddString("id", (string)(v.ID))
+	if v.LegacyID != nil {
+		enc.AddString("legacyID", (string)(*v.LegacyID))
+	}
+	if v.Status != nil {
+		err = multierr.Append(err, enc.AddObject("status", *v.Status))
+	}
+	if v.Role != nil {
+		err = multierr.Append(err, enc.AddObject("role", *v.Role))
+	}
+	return err
+}
+
+// GetID returns the value of ID if it is set or its
+// zero value if it is unset.
+func (v *User) GetID() (o UserID) {
+	if v != nil {
+		o = v.ID
+	}
+	return
+}
+
+// GetLegacyID returns the value of LegacyID if it is set or its
+// zero value if it is unset.
+//
+// Deprecated: Use id instead.
+func (v *User) GetLegacyID() (o LegacyID) {
+	if v != nil && v.LegacyID != nil {
+		return *v.LegacyID
+	}
+
+	return
+}
+
+// IsSetLegacyID returns true if LegacyID is not nil.
+func (v *User) IsSetLegacyID() bool {
+	return v != nil && v.LegacyID != nil
+}
+
+// GetStatus returns the value of Status if it is set or its
+// zero value if it is unset.
+func (v *User) GetStatus() (o Status) {
+	if v != nil && v.Status != nil {
+		return *v.Status
+	}
+
+	return
+}
+
+// IsSetStatus returns true if Status is not nil.
+func (v *User) IsSetStatus() bool {
+	return v != nil && v.Status != nil
+}
+
+// GetRole returns the value of Role if it is set or its
+// zero value if it is unset.
+func (v *User) GetRole() (o Role) {
+	if v != nil && v.Role != nil {
+		return *v.Role
+	}
+
+	return
+}
+
+// IsSetRole returns true if Role is not nil.
+func (v *User) IsSetRole() bool {
+	return v != nil && v.Role != nil
+}
+
+type UserID string
+
+// UserIDPtr returns a pointer to a UserID
+func (v UserID) Ptr() *UserID {
+	return &v
+}
+
+// ToWire translates UserID into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+func (v UserID) ToWire() (wire.Value, error) {
+	x := (string)(v)
+	return wire.NewValueString(x), error(nil)
+}
+
+// String returns a readable string representation of UserID.
+func (v UserID) String() string {
+	x := (string)(v)
+	return fmt.Sprint(x)
+}
+
+// FromWire deserializes UserID from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+func (v *UserID) FromWire(w wire.Value) error {
+	x, err := w.GetString(), error(nil)
+	*v = (UserID)(x)
+	return err
+}
+
+// Equals returns true if this UserID is equal to the provided
+// UserID.
+func (lhs UserID) Equals(rhs UserID) bool {
+	return ((string)(lhs) == (string)(rhs))
+}
+
+// ThriftModule represents the IDL file used to generate this package.
+var ThriftModule = &thriftreflect.ThriftModule{
+	Name:     "deprecated",
+	Package:  "go.uber.org/thriftrw/gen/internal/tests/deprecated",
+	FilePath: "deprecated.thrift",
+	SHA1:     "fb5c5a652b866e020dec50e819007cd9c6860b5b",
+	Raw:      rawIDL,
+}
+
+const rawIDL = "/**\n * An old way of identifying users.\n */\ntypedef string LegacyID (deprecated = \"Use UserID instead.\")\n\ntypedef string UserID\n\nenum Status {\n    ACTIVE,\n    /** Users are never suspended anymore. */\n    SUSPENDED (deprecated = \"Use BANNED instead.\"),\n    BANNED,\n}\n\nenum Role {\n    ADMIN,\n    MEMBER,\n} (deprecated)\n\nstruct User {\n    1: required UserID id\n    2: optional LegacyID legacyID (deprecated = \"Use id instead.\")\n    3: optional Status status\n    4: optional Role role\n}\n\nunion Contact {\n    1: string email\n    2: string pager (deprecated = \"Pagers are no longer supported.\")\n} (go.union = \"interface\")\n\n/**\n * Old user representation.\n */\nstruct OldUser {\n    1: required LegacyID id\n} (deprecated = \"Use User instead.\")\n\nservice Users {\n    User getUser(1: UserID id)\n    OldUser getOldUser(1: LegacyID id) (deprecated = \"Use getUser instead.\")\n}\n\nservice LegacyUsers {\n    OldUser get(1: LegacyID id)\n} (deprecated)\n"
+
+func init() {
+	thriftreflect.Register(ThriftModule, map[string]thriftreflect.Constructor{
+		"OldUser": func() interface{} { return new(OldUser) },
+		"User":    func() interface{} { return new(User) },
+	})
+}
+
+// LegacyUsers_Get_Args represents the arguments for the LegacyUsers.get function.
+//
+// The arguments for get are sent and received over the wire as this struct.
+//
+// Deprecated: This is deprecated in the Thrift file.
+type LegacyUsers_Get_Args struct {
+	ID *LegacyID `json:"id,omitempty"`
+}
+
+// ToWire translates a LegacyUsers_Get_Args struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *LegacyUsers_Get_Args) ToWire() (wire.Value, error) {
+	var (
+		fields [1]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.ID != nil {
+		w, err = v.ID.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 1, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a LegacyUsers_Get_Args struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a LegacyUsers_Get_Args struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v LegacyUsers_Get_Args
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *LegacyUsers_Get_Args) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TBinary {
+				var x LegacyID
+				x, err = _LegacyID_Read(field.Value)
+				v.ID = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a LegacyUsers_Get_Args
+// struct.
+func (v *LegacyUsers_Get_Args) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [1]string
+	i := 0
+	if v.ID != nil {
+		fields[i] = fmt.Sprintf("ID: %v", *(v.ID))
+		i++
+	}
+
+	return fmt.Sprintf("LegacyUsers_Get_Args{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this LegacyUsers_Get_Args match the
+// provided LegacyUsers_Get_Args.
+//
+// This function performs a deep comparison.
+func (v *LegacyUsers_Get_Args) Equals(rhs *LegacyUsers_Get_Args) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_LegacyID_EqualsPtr(v.ID, rhs.ID) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of LegacyUsers_Get_Args.
+func (v *LegacyUsers_Get_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.ID != nil {
+		enc.AddString("id", (string)(*v.ID))
+	}
+	return err
+}
+
+// GetID returns the value of ID if it is set or its
+// zero value if it is unset.
+func (v *LegacyUsers_Get_Args) GetID() (o LegacyID) {
+	if v != nil && v.ID != nil {
+		return *v.ID
+	}
+
+	return
+}
+
+// IsSetID returns true if ID is not nil.
+func (v *LegacyUsers_Get_Args) IsSetID() bool {
+	return v != nil && v.ID != nil
+}
+
+// MethodName returns the name of the Thrift function as specified in
+// the IDL, for which this struct represent the arguments.
+//
+// This will always be "get" for this struct.
+func (v *LegacyUsers_Get_Args) MethodName() string {
+	return "get"
+}
+
+// EnvelopeType returns the kind of value inside this struct.
+//
+// This will always be Call for this struct.
+func (v *LegacyUsers_Get_Args) EnvelopeType() wire.EnvelopeType {
+	return wire.Call
+}
+
+// LegacyUsers_Get_Helper provides functions that aid in handling the
+// parameters and return values of the LegacyUsers.get
+// function.
+//
+// Deprecated: This is deprecated in the Thrift file.
+var LegacyUsers_Get_Helper = struct {
+	// Args accepts the parameters of get in-order and returns
+	// the arguments struct for the function.
+	Args func(
+		id *LegacyID,
+	) *LegacyUsers_Get_Args
+
+	// IsException returns true if the given error can be thrown
+	// by get.
+	//
+	// An error can be thrown by get only if the
+	// corresponding exception type was mentioned in the 'throws'
+	// section for it in the Thrift file.
+	IsException func(error) bool
+
+	// WrapResponse returns the result struct for get
+	// given its return value and error.
+	//
+	// This allows mapping values and errors returned by
+	// get into a serializable result struct.
+	// WrapResponse returns a non-nil error if the provided
+	// error cannot be thrown by get
+	//
+	//   value, err := get(args)
+	//   result, err := LegacyUsers_Get_Helper.WrapResponse(value, err)
+	//   if err != nil {
+	//     return fmt.Errorf("unexpected error from get: %v", err)
+	//   }
+	//   serialize(result)
+	WrapResponse func(*OldUser, error) (*LegacyUsers_Get_Result, error)
+
+	// UnwrapResponse takes the result struct for get
+	// and returns the value or error returned by it.
+	//
+	// The error is non-nil only if get threw an
+	// exception.
+	//
+	//   result := deserialize(bytes)
+	//   value, err := LegacyUsers_Get_Helper.UnwrapResponse(result)
+	UnwrapResponse func(*LegacyUsers_Get_Result) (*OldUser, error)
+}{}
+
+func init() {
+	LegacyUsers_Get_Helper.Args = func(
+		id *LegacyID,
+	) *LegacyUsers_Get_Args {
+		return &LegacyUsers_Get_Args{
+			ID: id,
+		}
+	}
+
+	LegacyUsers_Get_Helper.IsException = func(err error) bool {
+		switch err.(type) {
+		default:
+			return false
+		}
+	}
+
+	LegacyUsers_Get_Helper.WrapResponse = func(success *OldUser, err error) (*LegacyUsers_Get_Result, error) {
+		if err == nil {
+			return &LegacyUsers_Get_Result{Success: success}, nil
+		}
+
+		return nil, err
+	}
+	LegacyUsers_Get_Helper.UnwrapResponse = func(result *LegacyUsers_Get_Result) (success *OldUser, err error) {
+
+		if result.Success != nil {
+			success = result.Success
+			return
+		}
+
+		err = errors.New("expected a non-void result")
+		return
+	}
+
+}
+
+// LegacyUsers_Get_Result represents the result of a LegacyUsers.get function call.
+//
+// The result of a get execution is sent and received over the wire as this struct.
+//
+// Success is set only if the function did not throw an exception.
+//
+// Deprecated: This is deprecated in the Thrift file.
+type LegacyUsers_Get_Result struct {
+	// Value returned by get after a successful execution.
+	Success *OldUser `json:"success,omitempty"`
+}
+
+// ToWire translates a LegacyUsers_Get_Result struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *LegacyUsers_Get_Result) ToWire() (wire.Value, error) {
+	var (
+		fields [1]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Success != nil {
+		w, err = v.Success.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 0, Value: w}
+		i++
+	}
+
+	if i != 1 {
+		return wire.Value{}, fmt.Errorf("LegacyUsers_Get_Result should have exactly one field: got %v fields", i)
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _OldUser_Read(w wire.Value) (*OldUser, error) {
+	var v OldUser
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// FromWire deserializes a LegacyUsers_Get_Result struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a LegacyUsers_Get_Result struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v LegacyUsers_Get_Result
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *LegacyUsers_Get_Result) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 0:
+			if field.Value.Type() == wire.TStruct {
+				v.Success, err = _OldUser_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	count := 0
+	if v.Success != nil {
+		count++
+	}
+	if count != 1 {
+		return fmt.Errorf("LegacyUsers_Get_Result should have exactly one field: got %v fields", count)
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a LegacyUsers_Get_Result
+// struct.
+func (v *LegacyUsers_Get_Result) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [1]string
+	i := 0
+	if v.Success != nil {
+		fields[i] = fmt.Sprintf("Success: %v", v.Success)
+		i++
+	}
+
+	return fmt.Sprintf("LegacyUsers_Get_Result{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this LegacyUsers_Get_Result match the
+// provided LegacyUsers_Get_Result.
+//
+// This function performs a deep comparison.
+func (v *LegacyUsers_Get_Result) Equals(rhs *LegacyUsers_Get_Result) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !((v.Success == nil && rhs.Success == nil) || (v.Success != nil && rhs.Success != nil && v.Success.Equals(rhs.Success))) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of LegacyUsers_Get_Result.
+func (v *LegacyUsers_Get_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Success != nil {
+		err = multierr.Append(err, enc.AddObject("success", v.Success))
+	}
+	return err
+}
+
+// GetSuccess returns the value of Success if it is set or its
+// zero value if it is unset.
+func (v *LegacyUsers_Get_Result) GetSuccess() (o *OldUser) {
+	if v != nil && v.Success != nil {
+		return v.Success
+	}
+
+	return
+}
+
+// IsSetSuccess returns true if Success is not nil.
+func (v *LegacyUsers_Get_Result) IsSetSuccess() bool {
+	return v != nil && v.Success != nil
+}
+
+// MethodName returns the name of the Thrift function as specified in
+// the IDL, for which this struct represent the result.
+//
+// This will always be "get" for this struct.
+func (v *LegacyUsers_Get_Result) MethodName() string {
+	return "get"
+}
+
+// EnvelopeType returns the kind of value inside this struct.
+//
+// This will always be Reply for this struct.
+func (v *LegacyUsers_Get_Result) EnvelopeType() wire.EnvelopeType {
+	return wire.Reply
+}
+
+// Users_GetOldUser_Args represents the arguments for the Users.getOldUser function.
+//
+// The arguments for getOldUser are sent and received over the wire as this struct.
+//
+// Deprecated: Use getUser instead.
+type Users_GetOldUser_Args struct {
+	ID *LegacyID `json:"id,omitempty"`
+}
+
+// ToWire translates a Users_GetOldUser_Args struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *Users_GetOldUser_Args) ToWire() (wire.Value, error) {
+	var (
+		fields [1]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.ID != nil {
+		w, err = v.ID.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 1, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a Users_GetOldUser_Args struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a Users_GetOldUser_Args struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v Users_GetOldUser_Args
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *Users_GetOldUser_Args) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TBinary {
+				var x LegacyID
+				x, err = _LegacyID_Read(field.Value)
+				v.ID = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a Users_GetOldUser_Args
+// struct.
+func (v *Users_GetOldUser_Args) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [1]string
+	i := 0
+	if v.ID != nil {
+		fields[i] = fmt.Sprintf("ID: %v", *(v.ID))
+		i++
+	}
+
+	return fmt.Sprintf("Users_GetOldUser_Args{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this Users_GetOldUser_Args match the
+// provided Users_GetOldUser_Args.
+//
+// This function performs a deep comparison.
+func (v *Users_GetOldUser_Args) Equals(rhs *Users_GetOldUser_Args) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_LegacyID_EqualsPtr(v.ID, rhs.ID) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Users_GetOldUser_Args.
+func (v *Users_GetOldUser_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.ID != nil {
+		enc.AddString("id", (string)(*v.ID))
+	}
+	return err
+}
+
+// GetID returns the value of ID if it is set or its
+// zero value if it is unset.
+func (v *Users_GetOldUser_Args) GetID() (o LegacyID) {
+	if v != nil && v.ID != nil {
+		return *v.ID
+	}
+
+	return
+}
+
+// IsSetID returns true if ID is not nil.
+func (v *Users_GetOldUser_Args) IsSetID() bool {
+	return v != nil && v.ID != nil
+}
+
+// MethodName returns the name of the Thrift function as specified in
+// the IDL, for which this struct represent the arguments.
+//
+// This will always be "getOldUser" for this struct.
+func (v *Users_GetOldUser_Args) MethodName() string {
+	return "getOldUser"
+}
+
+// EnvelopeType returns the kind of value inside this struct.
+//
+// This will always be Call for this struct.
+func (v *Users_GetOldUser_Args) EnvelopeType() wire.EnvelopeType {
+	return wire.Call
+}
+
+// Users_GetOldUser_Helper provides functions that aid in handling the
+// parameters and return values of the Users.getOldUser
+// function.
+//
+// Deprecated: Use getUser instead.
+var Users_GetOldUser_Helper = struct {
+	// Args accepts the parameters of getOldUser in-order and returns
+	// the arguments struct for the function.
+	Args func(
+		id *LegacyID,
+	) *Users_GetOldUser_Args
+
+	// IsException returns true if the given error can be thrown
+	// by getOldUser.
+	//
+	// An error can be thrown by getOldUser only if the
+	// corresponding exception type was mentioned in the 'throws'
+	// section for it in the Thrift file.
+	IsException func(error) bool
+
+	// WrapResponse returns the result struct for getOldUser
+	// given its return value and error.
+	//
+	// This allows mapping values and errors returned by
+	// getOldUser into a serializable result struct.
+	// WrapResponse returns a non-nil error if the provided
+	// error cannot be thrown by getOldUser
+	//
+	//   value, err := getOldUser(args)
+	//   result, err := Users_GetOldUser_Helper.WrapResponse(value, err)
+	//   if err != nil {
+	//     return fmt.Errorf("unexpected error from getOldUser: %v", err)
+	//   }
+	//   serialize(result)
+	WrapResponse func(*OldUser, error) (*Users_GetOldUser_Result, error)
+
+	// UnwrapResponse takes the result struct for getOldUser
+	// and returns the value or error returned by it.
+	//
+	// The error is non-nil only if getOldUser threw an
+	// exception.
+	//
+	//   result := deserialize(bytes)
+	//   value, err := Users_GetOldUser_Helper.UnwrapResponse(result)
+	UnwrapResponse func(*Users_GetOldUser_Result) (*OldUser, error)
+}{}
+
+func init() {
+	Users_GetOldUser_Helper.Args = func(
+		id *LegacyID,
+	) *Users_GetOldUser_Args {
+		return &Users_GetOldUser_Args{
+			ID: id,
+		}
+	}
+
+	Users_GetOldUser_Helper.IsException = func(err error) bool {
+		switch err.(type) {
+		default:
+			return false
+		}
+	}
+
+	Users_GetOldUser_Helper.WrapResponse = func(success *OldUser, err error) (*Users_GetOldUser_Result, error) {
+		if err == nil {
+			return &Users_GetOldUser_Result{Success: success}, nil
+		}
+
+		return nil, err
+	}
+	Users_GetOldUser_Helper.UnwrapResponse = func(result *Users_GetOldUser_Result) (success *OldUser, err error) {
+
+		if result.Success != nil {
+			success = result.Success
+			return
+		}
+
+		err = errors.New("expected a non-void result")
+		return
+	}
+
+}
+
+// Users_GetOldUser_Result represents the result of a Users.getOldUser function call.
+//
+// The result of a getOldUser execution is sent and received over the wire as this struct.
+//
+// Success is set only if the function did not throw an exception.
+//
+// Deprecated: Use getUser instead.
+type Users_GetOldUser_Result struct {
+	// Value returned by getOldUser after a successful execution.
+	Success *OldUser `json:"success,omitempty"`
+}
+
+// ToWire translates a Users_GetOldUser_Result struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *Users_GetOldUser_Result) ToWire() (wire.Value, error) {
+	var (
+		fields [1]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Success != nil {
+		w, err = v.Success.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 0, Value: w}
+		i++
+	}
+
+	if i != 1 {
+		return wire.Value{}, fmt.Errorf("Users_GetOldUser_Result should have exactly one field: got %v fields", i)
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a Users_GetOldUser_Result struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a Users_GetOldUser_Result struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v Users_GetOldUser_Result
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *Users_GetOldUser_Result) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 0:
+			if field.Value.Type() == wire.TStruct {
+				v.Success, err = _OldUser_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	count := 0
+	if v.Success != nil {
+		count++
+	}
+	if count != 1 {
+		return fmt.Errorf("Users_GetOldUser_Result should have exactly one field: got %v fields", count)
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a Users_GetOldUser_Result
+// struct.
+func (v *Users_GetOldUser_Result) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [1]string
+	i := 0
+	if v.Success != nil {
+		fields[i] = fmt.Sprintf("Success: %v", v.Success)
+		i++
+	}
+
+	return fmt.Sprintf("Users_GetOldUser_Result{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this Users_GetOldUser_Result match the
+// provided Users_GetOldUser_Result.
+//
+// This function performs a deep comparison.
+func (v *Users_GetOldUser_Result) Equals(rhs *Users_GetOldUser_Result) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !((v.Success == nil && rhs.Success == nil) || (v.Success != nil && rhs.Success != nil && v.Success.Equals(rhs.Success))) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Users_GetOldUser_Result.
+func (v *Users_GetOldUser_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Success != nil {
+		err = multierr.Append(err, enc.AddObject("success", v.Success))
+	}
+	return err
+}
+
+// GetSuccess returns the value of Success if it is set or its
+// zero value if it is unset.
+func (v *Users_GetOldUser_Result) GetSuccess() (o *OldUser) {
+	if v != nil && v.Success != nil {
+		return v.Success
+	}
+
+	return
+}
+
+// IsSetSuccess returns true if Success is not nil.
+func (v *Users_GetOldUser_Result) IsSetSuccess() bool {
+	return v != nil && v.Success != nil
+}
+
+// MethodName returns the name of the Thrift function as specified in
+// the IDL, for which this struct represent the result.
+//
+// This will always be "getOldUser" for this struct.
+func (v *Users_GetOldUser_Result) MethodName() string {
+	return "getOldUser"
+}
+
+// EnvelopeType returns the kind of value inside this struct.
+//
+// This will always be Reply for this struct.
+func (v *Users_GetOldUser_Result) EnvelopeType() wire.EnvelopeType {
+	return wire.Reply
+}
+
+// Users_GetUser_Args represents the arguments for the Users.getUser function.
+//
+// The arguments for getUser are sent and received over the wire as this struct.
+type Users_GetUser_Args struct {
+	ID *UserID `json:"id,omitempty"`
+}
+
+// ToWire translates a Users_GetUser_Args struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *Users_GetUser_Args) ToWire() (wire.Value, error) {
+	var (
+		fields [1]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.ID != nil {
+		w, err = v.ID.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 1, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a Users_GetUser_Args struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a Users_GetUser_Args struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v Users_GetUser_Args
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *Users_GetUser_Args) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TBinary {
+				var x UserID
+				x, err = _UserID_Read(field.Value)
+				v.ID = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a Users_GetUser_Args
+// struct.
+func (v *Users_GetUser_Args) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [1]string
+	i := 0
+	if v.ID != nil {
+		fields[i] = fmt.Sprintf("ID: %v", *(v.ID))
+		i++
+	}
+
+	return fmt.Sprintf("Users_GetUser_Args{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _UserID_EqualsPtr(lhs, rhs *UserID) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return (x == y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+// Equals returns true if all the fields of this Users_GetUser_Args match the
+// provided Users_GetUser_Args.
+//
+// This function performs a deep comparison.
+func (v *Users_GetUser_Args) Equals(rhs *Users_GetUser_Args) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_UserID_EqualsPtr(v.ID, rhs.ID) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Users_GetUser_Args.
+func (v *Users_GetUser_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.ID != nil {
+		enc.AddString("id", (string)(*v.ID))
+	}
+	return err
+}
+
+// GetID returns the value of ID if it is set or its
+// zero value if it is unset.
+func (v *Users_GetUser_Args) GetID() (o UserID) {
+	if v != nil && v.ID != nil {
+		return *v.ID
+	}
+
+	return
+}
+
+// IsSetID returns true if ID is not nil.
+func (v *Users_GetUser_Args) IsSetID() bool {
+	return v != nil && v.ID != nil
+}
+
+// MethodName returns the name of the Thrift function as specified in
+// the IDL, for which this struct represent the arguments.
+//
+// This will always be "getUser" for this struct.
+func (v *Users_GetUser_Args) MethodName() string {
+	return "getUser"
+}
+
+// EnvelopeType returns the kind of value inside this struct.
+//
+// This will always be Call for this struct.
+func (v *Users_GetUser_Args) EnvelopeType() wire.EnvelopeType {
+	return wire.Call
+}
+
+// Users_GetUser_Helper provides functions that aid in handling the
+// parameters and return values of the Users.getUser
+// function.
+var Users_GetUser_Helper = struct {
+	// Args accepts the parameters of getUser in-order and returns
+	// the arguments struct for the function.
+	Args func(
+		id *UserID,
+	) *Users_GetUser_Args
+
+	// IsException returns true if the given error can be thrown
+	// by getUser.
+	//
+	// An error can be thrown by getUser only if the
+	// corresponding exception type was mentioned in the 'throws'
+	// section for it in the Thrift file.
+	IsException func(error) bool
+
+	// WrapResponse returns the result struct for getUser
+	// given its return value and error.
+	//
+	// This allows mapping values and errors returned by
+	// getUser into a serializable result struct.
+	// WrapResponse returns a non-nil error if the provided
+	// error cannot be thrown by getUser
+	//
+	//   value, err := getUser(args)
+	//   result, err := Users_GetUser_Helper.WrapResponse(value, err)
+	//   if err != nil {
+	//     return fmt.Errorf("unexpected error from getUser: %v", err)
+	//   }
+	//   serialize(result)
+	WrapResponse func(*User, error) (*Users_GetUser_Result, error)
+
+	// UnwrapResponse takes the result struct for getUser
+	// and returns the value or error returned by it.
+	//
+	// The error is non-nil only if getUser threw an
+	// exception.
+	//
+	//   result := deserialize(bytes)
+	//   value, err := Users_GetUser_Helper.UnwrapResponse(result)
+	UnwrapResponse func(*Users_GetUser_Result) (*User, error)
+}{}
+
+func init() {
+	Users_GetUser_Helper.Args = func(
+		id *UserID,
+	) *Users_GetUser_Args {
+		return &Users_GetUser_Args{
+			ID: id,
+		}
+	}
+
+	Users_GetUser_Helper.IsException = func(err error) bool {
+		switch err.(type) {
+		default:
+			return false
+		}
+	}
+
+	Users_GetUser_Helper.WrapResponse = func(success *User, err error) (*Users_GetUser_Result, error) {
+		if err == nil {
+			return &Users_GetUser_Result{Success: success}, nil
+		}
+
+		return nil, err
+	}
+	Users_GetUser_Helper.UnwrapResponse = func(result *Users_GetUser_Result) (success *User, err error) {
+
+		if result.Success != nil {
+			success = result.Success
+			return
+		}
+
+		err = errors.New("expected a non-void result")
+		return
+	}
+
+}
+
+// Users_GetUser_Result represents the result of a Users.getUser function call.
+//
+// The result of a getUser execution is sent and received over the wire as this struct.
+//
+// Success is set only if the function did not throw an exception.
+type Users_GetUser_Result struct {
+	// Value returned by getUser after a successful execution.
+	Success *User `json:"success,omitempty"`
+}
+
+// ToWire translates a Users_GetUser_Result struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *Users_GetUser_Result) ToWire() (wire.Value, error) {
+	var (
+		fields [1]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Success != nil {
+		w, err = v.Success.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 0, Value: w}
+		i++
+	}
+
+	if i != 1 {
+		return wire.Value{}, fmt.Errorf("Users_GetUser_Result should have exactly one field: got %v fields", i)
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _User_Read(w wire.Value) (*User, error) {
+	var v User
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// FromWire deserializes a Users_GetUser_Result struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a Users_GetUser_Result struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v Users_GetUser_Result
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *Users_GetUser_Result) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 0:
+			if field.Value.Type() == wire.TStruct {
+				v.Success, err = _User_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	count := 0
+	if v.Success != nil {
+		count++
+	}
+	if count != 1 {
+		return fmt.Errorf("Users_GetUser_Result should have exactly one field: got %v fields", count)
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a Users_GetUser_Result
+// struct.
+func (v *Users_GetUser_Result) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [1]string
+	i := 0
+	if v.Success != nil {
+		fields[i] = fmt.Sprintf("Success: %v", v.Success)
+		i++
+	}
+
+	return fmt.Sprintf("Users_GetUser_Result{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this Users_GetUser_Result match the
+// provided Users_GetUser_Result.
+//
+// This function performs a deep comparison.
+func (v *Users_GetUser_Result) Equals(rhs *Users_GetUser_Result) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !((v.Success == nil && rhs.Success == nil) || (v.Success != nil && rhs.Success != nil && v.Success.Equals(rhs.Success))) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Users_GetUser_Result.
+func (v *Users_GetUser_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Success != nil {
+		err = multierr.Append(err, enc.AddObject("success", v.Success))
+	}
+	return err
+}
+
+// GetSuccess returns the value of Success if it is set or its
+// zero value if it is unset.
+func (v *Users_GetUser_Result) GetSuccess() (o *User) {
+	if v != nil && v.Success != nil {
+		return v.Success
+	}
+
+	return
+}
+
+// IsSetSuccess returns true if Success is not nil.
+func (v *Users_GetUser_Result) IsSetSuccess() bool {
+	return v != nil && v.Success != nil
+}
+
+// MethodName returns the name of the Thrift function as specified in
+// the IDL, for which this struct represent the result.
+//
+// This will always be "getUser" for this struct.
+func (v *Users_GetUser_Result) MethodName() string {
+	return "getUser"
+}
+
+// EnvelopeType returns the kind of value inside this struct.
+//
+// This will always be Reply for this struct.
+func (v *Users_GetUser_Result) EnvelopeType() wire.EnvelopeType {
+	return wire.Reply
+}
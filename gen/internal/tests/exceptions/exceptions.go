@@ -6,6 +6,7 @@ package exceptions
 import (
 	errors "errors"
 	fmt "fmt"
+	multierr "go.uber.org/multierr"
 	thriftreflect "go.uber.org/thriftrw/thriftreflect"
 	wire "go.uber.org/thriftrw/wire"
 	zapcore "go.uber.org/zap/zapcore"
@@ -493,13 +494,424 @@ func (v *EmptyException) Error() string {
 	return v.String()
 }
 
+// Raised when the storage backend failed.
+type StorageError struct {
+	Message string        `json:"message,required"`
+	Cause   *TimeoutError `json:"cause,omitempty"`
+}
+
+// ToWire translates a StorageError struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *StorageError) ToWire() (wire.Value, error) {
+	var (
+		fields [2]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	w, err = wire.NewValueString(v.Message), error(nil)
+	if err != nil {
+		return w, err
+	}
+	fields[i] = wire.Field{ID: 1, Value: w}
+	i++
+	if v.Cause != nil {
+		w, err = v.Cause.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 2, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _TimeoutError_Read(w wire.Value) (*TimeoutError, error) {
+	var v TimeoutError
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// FromWire deserializes a StorageError struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a StorageError struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v StorageError
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *StorageError) FromWire(w wire.Value) error {
+	var err error
+
+	messageIsSet := false
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TBinary {
+				v.Message, err = field.Value.GetString(), error(nil)
+				if err != nil {
+					return err
+				}
+				messageIsSet = true
+			}
+		case 2:
+			if field.Value.Type() == wire.TStruct {
+				v.Cause, err = _TimeoutError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	if !messageIsSet {
+		return errors.New("field Message of StorageError is required")
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a StorageError
+// struct.
+func (v *StorageError) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [2]string
+	i := 0
+	fields[i] = fmt.Sprintf("Message: %v", v.Message)
+	i++
+	if v.Cause != nil {
+		fields[i] = fmt.Sprintf("Cause: %v", v.Cause)
+		i++
+	}
+
+	return fmt.Sprintf("StorageError{%v}", strings.Join(fields[:i], ", "))
+}
+
+// ErrorName is the name of this type as defined in the Thrift
+// file.
+func (*StorageError) ErrorName() string {
+	return "StorageError"
+}
+
+// Equals returns true if all the fields of this StorageError match the
+// provided StorageError.
+//
+// This function performs a deep comparison.
+func (v *StorageError) Equals(rhs *StorageError) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !(v.Message == rhs.Message) {
+		return false
+	}
+	if !((v.Cause == nil && rhs.Cause == nil) || (v.Cause != nil && rhs.Cause != nil && v.Cause.Equals(rhs.Cause))) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of StorageError.
+func (v *StorageError) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	enc.AddString("message", v.Message)
+	if v.Cause != nil {
+		err = multierr.Append(err, enc.AddObject("cause", v.Cause))
+	}
+	return err
+}
+
+// GetMessage returns the value of Message if it is set or its
+// zero value if it is unset.
+func (v *StorageError) GetMessage() (o string) {
+	if v != nil {
+		o = v.Message
+	}
+	return
+}
+
+// GetCause returns the value of Cause if it is set or its
+// zero value if it is unset.
+func (v *StorageError) GetCause() (o *TimeoutError) {
+	if v != nil && v.Cause != nil {
+		return v.Cause
+	}
+
+	return
+}
+
+// IsSetCause returns true if Cause is not nil.
+func (v *StorageError) IsSetCause() bool {
+	return v != nil && v.Cause != nil
+}
+
+func (v *StorageError) Error() string {
+	return v.String()
+}
+
+// Unwrap returns the error that caused this StorageError, if any.
+func (v *StorageError) Unwrap() error {
+	if v == nil || v.Cause == nil {
+		return nil
+	}
+	return v.Cause
+}
+
+// ErrorCode returns the stable error code of StorageError,
+// "storage".
+func (*StorageError) ErrorCode() string {
+	return "storage"
+}
+
+type TimeoutError struct {
+	Message string `json:"message,required"`
+}
+
+// ToWire translates a TimeoutError struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *TimeoutError) ToWire() (wire.Value, error) {
+	var (
+		fields [1]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	w, err = wire.NewValueString(v.Message), error(nil)
+	if err != nil {
+		return w, err
+	}
+	fields[i] = wire.Field{ID: 1, Value: w}
+	i++
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a TimeoutError struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a TimeoutError struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v TimeoutError
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *TimeoutError) FromWire(w wire.Value) error {
+	var err error
+
+	messageIsSet := false
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TBinary {
+				v.Message, err = field.Value.GetString(), error(nil)
+				if err != nil {
+					return err
+				}
+				messageIsSet = true
+			}
+		}
+	}
+
+	if !messageIsSet {
+		return errors.New("field Message of TimeoutError is required")
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a TimeoutError
+// struct.
+func (v *TimeoutError) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [1]string
+	i := 0
+	fields[i] = fmt.Sprintf("Message: %v", v.Message)
+	i++
+
+	return fmt.Sprintf("TimeoutError{%v}", strings.Join(fields[:i], ", "))
+}
+
+// ErrorName is the name of this type as defined in the Thrift
+// file.
+func (*TimeoutError) ErrorName() string {
+	return "TimeoutError"
+}
+
+// Equals returns true if all the fields of this TimeoutError match the
+// provided TimeoutError.
+//
+// This function performs a deep comparison.
+func (v *TimeoutError) Equals(rhs *TimeoutError) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !(v.Message == rhs.Message) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of TimeoutError.
+func (v *TimeoutError) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	enc.AddString("message", v.Message)
+	return err
+}
+
+// GetMessage returns the value of Message if it is set or its
+// zero value if it is unset.
+func (v *TimeoutError) GetMessage() (o string) {
+	if v != nil {
+		o = v.Message
+	}
+	return
+}
+
+func (v *TimeoutError) Error() string {
+	return v.String()
+}
+
+// ErrorCode returns the stable error code of TimeoutError,
+// "timeout".
+func (*TimeoutError) ErrorCode() string {
+	return "timeout"
+}
+
+// ExceptionFromWire deserializes the exception defined in this
+// package with the given Thrift name from its Thrift-level
+// representation.
+//
+// ok is false if this package does not define an exception with the
+// given name. err is non-nil if the exception could not be
+// deserialized.
+//
+//   e, ok, err := ExceptionFromWire("DoesNotExistException", value)
+//   if err != nil {
+//     return err
+//   }
+//   if ok {
+//     return e
+//   }
+func ExceptionFromWire(name string, w wire.Value) (exc error, ok bool, err error) {
+	switch name {
+	case "DoesNotExistException":
+		var v DoesNotExistException
+		if err := v.FromWire(w); err != nil {
+			return nil, true, err
+		}
+		return &v, true, nil
+	case "Does_Not_Exist_Exception_Collision":
+		var v2 DoesNotExistException2
+		if err := v2.FromWire(w); err != nil {
+			return nil, true, err
+		}
+		return &v2, true, nil
+	case "EmptyException":
+		var v3 EmptyException
+		if err := v3.FromWire(w); err != nil {
+			return nil, true, err
+		}
+		return &v3, true, nil
+	case "StorageError":
+		var v4 StorageError
+		if err := v4.FromWire(w); err != nil {
+			return nil, true, err
+		}
+		return &v4, true, nil
+	case "TimeoutError":
+		var v5 TimeoutError
+		if err := v5.FromWire(w); err != nil {
+			return nil, true, err
+		}
+		return &v5, true, nil
+	default:
+		return nil, false, nil
+	}
+}
+
 // ThriftModule represents the IDL file used to generate this package.
 var ThriftModule = &thriftreflect.ThriftModule{
 	Name:     "exceptions",
 	Package:  "go.uber.org/thriftrw/gen/internal/tests/exceptions",
 	FilePath: "exceptions.thrift",
-	SHA1:     "a31a29f9f7bca3221100e43e32b0d833ca9c774e",
+	SHA1:     "637b0e1bbd30396928a1e7fbdecb9fd8183cc541",
 	Raw:      rawIDL,
 }
 
-const rawIDL = "exception EmptyException {}\n\n/**\n * Raised when something doesn't exist.\n */\nexception DoesNotExistException {\n    /** Key that was missing. */\n    1: required string key\n    2: optional string Error (go.name=\"Error2\")\n}\n\nexception Does_Not_Exist_Exception_Collision {\n /** Key that was missing. */\n    1: required string key\n    2: optional string Error (go.name=\"Error2\")\n} (go.name=\"DoesNotExistException2\")\n"
+const rawIDL = "exception EmptyException {}\n\n/**\n * Raised when something doesn't exist.\n */\nexception DoesNotExistException {\n    /** Key that was missing. */\n    1: required string key\n    2: optional string Error (go.name=\"Error2\")\n}\n\nexception Does_Not_Exist_Exception_Collision {\n /** Key that was missing. */\n    1: required string key\n    2: optional string Error (go.name=\"Error2\")\n} (go.name=\"DoesNotExistException2\")\n\nexception TimeoutError {\n    1: required string message\n} (go.error_code = \"timeout\")\n\n/**\n * Raised when the storage backend failed.\n */\nexception StorageError {\n    1: required string message\n    2: optional TimeoutError cause (go.cause)\n} (go.error_code = \"storage\")\n"
+
+func init() {
+	thriftreflect.Register(ThriftModule, map[string]thriftreflect.Constructor{
+		"DoesNotExistException":              func() interface{} { return new(DoesNotExistException) },
+		"Does_Not_Exist_Exception_Collision": func() interface{} { return new(DoesNotExistException2) },
+		"EmptyException":                     func() interface{} { return new(EmptyException) },
+		"StorageError":                       func() interface{} { return new(StorageError) },
+		"TimeoutError":                       func() interface{} { return new(TimeoutError) },
+	})
+}
@@ -171,3 +171,9 @@ var ThriftModule = &thriftreflect.ThriftModule{
 }
 
 const rawIDL = "include \"./non_hyphenated.thrift\"\n\nstruct DocumentStruct {\n 1: required non_hyphenated.Second second\n}"
+
+func init() {
+	thriftreflect.Register(ThriftModule, map[string]thriftreflect.Constructor{
+		"DocumentStruct": func() interface{} { return new(DocumentStruct) },
+	})
+}
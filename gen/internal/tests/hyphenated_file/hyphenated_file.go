@@ -171,3 +171,9 @@ var ThriftModule = &thriftreflect.ThriftModule{
 }
 
 const rawIDL = "// This file is named hyphenated_file to possibly conflict with the code\n// generated from hyphenated-file.\n\ninclude \"./non_hyphenated.thrift\"\n\nstruct DocumentStructure {\n 1: required non_hyphenated.Second r2\n}\n"
+
+func init() {
+	thriftreflect.Register(ThriftModule, map[string]thriftreflect.Constructor{
+		"DocumentStructure": func() interface{} { return new(DocumentStructure) },
+	})
+}
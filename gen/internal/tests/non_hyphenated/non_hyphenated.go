@@ -201,3 +201,10 @@ var ThriftModule = &thriftreflect.ThriftModule{
 }
 
 const rawIDL = "struct First {}\n\nstruct Second {}"
+
+func init() {
+	thriftreflect.Register(ThriftModule, map[string]thriftreflect.Constructor{
+		"First":  func() interface{} { return new(First) },
+		"Second": func() interface{} { return new(Second) },
+	})
+}
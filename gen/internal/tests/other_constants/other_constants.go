@@ -32,3 +32,7 @@ var ThriftModule = &thriftreflect.ThriftModule{
 }
 
 const rawIDL = "include \"./structs.thrift\"\n\nconst list<i32> listOfInts = [1, 2, 3]\n\nconst structs.Point some_point = {\"x\": 1, \"y\": 2.0}\n"
+
+func init() {
+	thriftreflect.Register(ThriftModule, nil)
+}
@@ -0,0 +1,5622 @@
+// Code generated by thriftrw v1.28.0. DO NOT EDIT.
+// @generated
+
+package presence
+
+import (
+	json "encoding/json"
+	errors "errors"
+	fmt "fmt"
+	multierr "go.uber.org/multierr"
+	enums "go.uber.org/thriftrw/gen/internal/tests/enums"
+	thriftreflect "go.uber.org/thriftrw/thriftreflect"
+	wire "go.uber.org/thriftrw/wire"
+	zapcore "go.uber.org/zap/zapcore"
+	strings "strings"
+)
+
+var DefaultSettings *Settings = func() *Settings {
+	v := &Settings{
+		Name: "default",
+		Tags: []string{
+			"a",
+		},
+	}
+	v.SetEnabled(true)
+	v.SetRetries(3)
+	v.SetVersion(Version(1))
+	return v
+}()
+
+type ManySettings struct {
+	F1  int32 `json:"f1,omitempty"`
+	F2  int32 `json:"f2,omitempty"`
+	F3  int32 `json:"f3,omitempty"`
+	F4  int32 `json:"f4,omitempty"`
+	F5  int32 `json:"f5,omitempty"`
+	F6  int32 `json:"f6,omitempty"`
+	F7  int32 `json:"f7,omitempty"`
+	F8  int32 `json:"f8,omitempty"`
+	F9  int32 `json:"f9,omitempty"`
+	F10 int32 `json:"f10,omitempty"`
+	F11 int32 `json:"f11,omitempty"`
+	F12 int32 `json:"f12,omitempty"`
+	F13 int32 `json:"f13,omitempty"`
+	F14 int32 `json:"f14,omitempty"`
+	F15 int32 `json:"f15,omitempty"`
+	F16 int32 `json:"f16,omitempty"`
+	F17 int32 `json:"f17,omitempty"`
+	F18 int32 `json:"f18,omitempty"`
+	F19 int32 `json:"f19,omitempty"`
+	F20 int32 `json:"f20,omitempty"`
+	F21 int32 `json:"f21,omitempty"`
+	F22 int32 `json:"f22,omitempty"`
+	F23 int32 `json:"f23,omitempty"`
+	F24 int32 `json:"f24,omitempty"`
+	F25 int32 `json:"f25,omitempty"`
+	F26 int32 `json:"f26,omitempty"`
+	F27 int32 `json:"f27,omitempty"`
+	F28 int32 `json:"f28,omitempty"`
+	F29 int32 `json:"f29,omitempty"`
+	F30 int32 `json:"f30,omitempty"`
+	F31 int32 `json:"f31,omitempty"`
+	F32 int32 `json:"f32,omitempty"`
+	F33 int32 `json:"f33,omitempty"`
+	F34 int32 `json:"f34,omitempty"`
+	F35 int32 `json:"f35,omitempty"`
+	F36 int32 `json:"f36,omitempty"`
+	F37 int32 `json:"f37,omitempty"`
+	F38 int32 `json:"f38,omitempty"`
+	F39 int32 `json:"f39,omitempty"`
+	F40 int32 `json:"f40,omitempty"`
+	F41 int32 `json:"f41,omitempty"`
+	F42 int32 `json:"f42,omitempty"`
+	F43 int32 `json:"f43,omitempty"`
+	F44 int32 `json:"f44,omitempty"`
+	F45 int32 `json:"f45,omitempty"`
+	F46 int32 `json:"f46,omitempty"`
+	F47 int32 `json:"f47,omitempty"`
+	F48 int32 `json:"f48,omitempty"`
+	F49 int32 `json:"f49,omitempty"`
+	F50 int32 `json:"f50,omitempty"`
+	F51 int32 `json:"f51,omitempty"`
+	F52 int32 `json:"f52,omitempty"`
+	F53 int32 `json:"f53,omitempty"`
+	F54 int32 `json:"f54,omitempty"`
+	F55 int32 `json:"f55,omitempty"`
+	F56 int32 `json:"f56,omitempty"`
+	F57 int32 `json:"f57,omitempty"`
+	F58 int32 `json:"f58,omitempty"`
+	F59 int32 `json:"f59,omitempty"`
+	F60 int32 `json:"f60,omitempty"`
+	F61 int32 `json:"f61,omitempty"`
+	F62 int32 `json:"f62,omitempty"`
+	F63 int32 `json:"f63,omitempty"`
+	F64 int32 `json:"f64,omitempty"`
+	F65 int32 `json:"f65,omitempty"`
+
+	presence [2]uint64
+}
+
+// ToWire translates a ManySettings struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *ManySettings) ToWire() (wire.Value, error) {
+	var (
+		fields [65]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.IsSetF1() {
+		w, err = wire.NewValueI32(v.F1), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 1, Value: w}
+		i++
+	}
+	if v.IsSetF2() {
+		w, err = wire.NewValueI32(v.F2), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 2, Value: w}
+		i++
+	}
+	if v.IsSetF3() {
+		w, err = wire.NewValueI32(v.F3), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 3, Value: w}
+		i++
+	}
+	if v.IsSetF4() {
+		w, err = wire.NewValueI32(v.F4), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 4, Value: w}
+		i++
+	}
+	if v.IsSetF5() {
+		w, err = wire.NewValueI32(v.F5), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 5, Value: w}
+		i++
+	}
+	if v.IsSetF6() {
+		w, err = wire.NewValueI32(v.F6), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 6, Value: w}
+		i++
+	}
+	if v.IsSetF7() {
+		w, err = wire.NewValueI32(v.F7), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 7, Value: w}
+		i++
+	}
+	if v.IsSetF8() {
+		w, err = wire.NewValueI32(v.F8), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 8, Value: w}
+		i++
+	}
+	if v.IsSetF9() {
+		w, err = wire.NewValueI32(v.F9), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 9, Value: w}
+		i++
+	}
+	if v.IsSetF10() {
+		w, err = wire.NewValueI32(v.F10), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.IsSetF11() {
+		w, err = wire.NewValueI32(v.F11), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 11, Value: w}
+		i++
+	}
+	if v.IsSetF12() {
+		w, err = wire.NewValueI32(v.F12), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 12, Value: w}
+		i++
+	}
+	if v.IsSetF13() {
+		w, err = wire.NewValueI32(v.F13), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 13, Value: w}
+		i++
+	}
+	if v.IsSetF14() {
+		w, err = wire.NewValueI32(v.F14), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 14, Value: w}
+		i++
+	}
+	if v.IsSetF15() {
+		w, err = wire.NewValueI32(v.F15), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 15, Value: w}
+		i++
+	}
+	if v.IsSetF16() {
+		w, err = wire.NewValueI32(v.F16), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 16, Value: w}
+		i++
+	}
+	if v.IsSetF17() {
+		w, err = wire.NewValueI32(v.F17), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 17, Value: w}
+		i++
+	}
+	if v.IsSetF18() {
+		w, err = wire.NewValueI32(v.F18), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 18, Value: w}
+		i++
+	}
+	if v.IsSetF19() {
+		w, err = wire.NewValueI32(v.F19), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 19, Value: w}
+		i++
+	}
+	if v.IsSetF20() {
+		w, err = wire.NewValueI32(v.F20), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+	if v.IsSetF21() {
+		w, err = wire.NewValueI32(v.F21), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 21, Value: w}
+		i++
+	}
+	if v.IsSetF22() {
+		w, err = wire.NewValueI32(v.F22), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 22, Value: w}
+		i++
+	}
+	if v.IsSetF23() {
+		w, err = wire.NewValueI32(v.F23), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 23, Value: w}
+		i++
+	}
+	if v.IsSetF24() {
+		w, err = wire.NewValueI32(v.F24), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 24, Value: w}
+		i++
+	}
+	if v.IsSetF25() {
+		w, err = wire.NewValueI32(v.F25), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 25, Value: w}
+		i++
+	}
+	if v.IsSetF26() {
+		w, err = wire.NewValueI32(v.F26), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 26, Value: w}
+		i++
+	}
+	if v.IsSetF27() {
+		w, err = wire.NewValueI32(v.F27), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 27, Value: w}
+		i++
+	}
+	if v.IsSetF28() {
+		w, err = wire.NewValueI32(v.F28), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 28, Value: w}
+		i++
+	}
+	if v.IsSetF29() {
+		w, err = wire.NewValueI32(v.F29), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 29, Value: w}
+		i++
+	}
+	if v.IsSetF30() {
+		w, err = wire.NewValueI32(v.F30), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
+	if v.IsSetF31() {
+		w, err = wire.NewValueI32(v.F31), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 31, Value: w}
+		i++
+	}
+	if v.IsSetF32() {
+		w, err = wire.NewValueI32(v.F32), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 32, Value: w}
+		i++
+	}
+	if v.IsSetF33() {
+		w, err = wire.NewValueI32(v.F33), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 33, Value: w}
+		i++
+	}
+	if v.IsSetF34() {
+		w, err = wire.NewValueI32(v.F34), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 34, Value: w}
+		i++
+	}
+	if v.IsSetF35() {
+		w, err = wire.NewValueI32(v.F35), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 35, Value: w}
+		i++
+	}
+	if v.IsSetF36() {
+		w, err = wire.NewValueI32(v.F36), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 36, Value: w}
+		i++
+	}
+	if v.IsSetF37() {
+		w, err = wire.NewValueI32(v.F37), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 37, Value: w}
+		i++
+	}
+	if v.IsSetF38() {
+		w, err = wire.NewValueI32(v.F38), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 38, Value: w}
+		i++
+	}
+	if v.IsSetF39() {
+		w, err = wire.NewValueI32(v.F39), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 39, Value: w}
+		i++
+	}
+	if v.IsSetF40() {
+		w, err = wire.NewValueI32(v.F40), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 40, Value: w}
+		i++
+	}
+	if v.IsSetF41() {
+		w, err = wire.NewValueI32(v.F41), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 41, Value: w}
+		i++
+	}
+	if v.IsSetF42() {
+		w, err = wire.NewValueI32(v.F42), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 42, Value: w}
+		i++
+	}
+	if v.IsSetF43() {
+		w, err = wire.NewValueI32(v.F43), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 43, Value: w}
+		i++
+	}
+	if v.IsSetF44() {
+		w, err = wire.NewValueI32(v.F44), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 44, Value: w}
+		i++
+	}
+	if v.IsSetF45() {
+		w, err = wire.NewValueI32(v.F45), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 45, Value: w}
+		i++
+	}
+	if v.IsSetF46() {
+		w, err = wire.NewValueI32(v.F46), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 46, Value: w}
+		i++
+	}
+	if v.IsSetF47() {
+		w, err = wire.NewValueI32(v.F47), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 47, Value: w}
+		i++
+	}
+	if v.IsSetF48() {
+		w, err = wire.NewValueI32(v.F48), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 48, Value: w}
+		i++
+	}
+	if v.IsSetF49() {
+		w, err = wire.NewValueI32(v.F49), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 49, Value: w}
+		i++
+	}
+	if v.IsSetF50() {
+		w, err = wire.NewValueI32(v.F50), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 50, Value: w}
+		i++
+	}
+	if v.IsSetF51() {
+		w, err = wire.NewValueI32(v.F51), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 51, Value: w}
+		i++
+	}
+	if v.IsSetF52() {
+		w, err = wire.NewValueI32(v.F52), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 52, Value: w}
+		i++
+	}
+	if v.IsSetF53() {
+		w, err = wire.NewValueI32(v.F53), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 53, Value: w}
+		i++
+	}
+	if v.IsSetF54() {
+		w, err = wire.NewValueI32(v.F54), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 54, Value: w}
+		i++
+	}
+	if v.IsSetF55() {
+		w, err = wire.NewValueI32(v.F55), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 55, Value: w}
+		i++
+	}
+	if v.IsSetF56() {
+		w, err = wire.NewValueI32(v.F56), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 56, Value: w}
+		i++
+	}
+	if v.IsSetF57() {
+		w, err = wire.NewValueI32(v.F57), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 57, Value: w}
+		i++
+	}
+	if v.IsSetF58() {
+		w, err = wire.NewValueI32(v.F58), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 58, Value: w}
+		i++
+	}
+	if v.IsSetF59() {
+		w, err = wire.NewValueI32(v.F59), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 59, Value: w}
+		i++
+	}
+	if v.IsSetF60() {
+		w, err = wire.NewValueI32(v.F60), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 60, Value: w}
+		i++
+	}
+	if v.IsSetF61() {
+		w, err = wire.NewValueI32(v.F61), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 61, Value: w}
+		i++
+	}
+	if v.IsSetF62() {
+		w, err = wire.NewValueI32(v.F62), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 62, Value: w}
+		i++
+	}
+	if v.IsSetF63() {
+		w, err = wire.NewValueI32(v.F63), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 63, Value: w}
+		i++
+	}
+	if v.IsSetF64() {
+		w, err = wire.NewValueI32(v.F64), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 64, Value: w}
+		i++
+	}
+	if v.IsSetF65() {
+		w, err = wire.NewValueI32(v.F65), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 65, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a ManySettings struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a ManySettings struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v ManySettings
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *ManySettings) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TI32 {
+				v.F1, err = field.Value.GetI32(), error(nil)
+				if err != nil {
+					return err
+				}
+				v.presence[0] |= 0x1
+			}
+		case 2:
+			if field.Value.Type() == wire.TI32 {
+				v.F2, err = field.Value.GetI32(), error(nil)
+				if err != nil {
+					return err
+				}
+				v.presence[0] |= 0x2
+			}
+		case 3:
+			if field.Value.Type() == wire.TI32 {
+				v.F3, err = field.Value.GetI32(), error(nil)
+				if err != nil {
+					return err
+				}
+				v.presence[0] |= 0x4
+			}
+		case 4:
+			if field.Value.Type() == wire.TI32 {
+				v.F4, err = field.Value.GetI32(), error(nil)
+				if err != nil {
+					return err
+				}
+				v.presence[0] |= 0x8
+			}
+		case 5:
+			if field.Value.Type() == wire.TI32 {
+				v.F5, err = field.Value.GetI32(), error(nil)
+				if err != nil {
+					return err
+				}
+				v.presence[0] |= 0x10
+			}
+		case 6:
+			if field.Value.Type() == wire.TI32 {
+				v.F6, err = field.Value.GetI32(), error(nil)
+				if err != nil {
+					return err
+				}
+				v.presence[0] |= 0x20
+			}
+		case 7:
+			if field.Value.Type() == wire.TI32 {
+				v.F7, err = field.Value.GetI32(), error(nil)
+				if err != nil {
+					return err
+				}
+				v.presence[0] |= 0x40
+			}
+		case 8:
+			if field.Value.Type() == wire.TI32 {
+				v.F8, err = field.Value.GetI32(), error(nil)
+				if err != nil {
+					return err
+				}
+				v.presence[0] |= 0x80
+			}
+		case 9:
+			if field.Value.Type() == wire.TI32 {
+				v.F9, err = field.Value.GetI32(), error(nil)
+				if err != nil {
+					return err
+				}
+				v.presence[0] |= 0x100
+			}
+		case 10:
+			if field.Value.Type() == wire.TI32 {
+				v.F10, err = field.Value.GetI32(), error(nil)
+				if err != nil {
+					return err
+				}
+				v.presence[0] |= 0x200
+			}
+		case 11:
+			if field.Value.Type() == wire.TI32 {
+				v.F11, err = field.Value.GetI32(), error(nil)
+				if err != nil {
+					return err
+				}
+				v.presence[0] |= 0x400
+			}
+		case 12:
+			if field.Value.Type() == wire.TI32 {
+				v.F12, err = field.Value.GetI32(), error(nil)
+				if err != nil {
+					return err
+				}
+				v.presence[0] |= 0x800
+			}
+		case 13:
+			if field.Value.Type() == wire.TI32 {
+				v.F13, err = field.Value.GetI32(), error(nil)
+				if err != nil {
+					return err
+				}
+				v.presence[0] |= 0x1000
+			}
+		case 14:
+			if field.Value.Type() == wire.TI32 {
+				v.F14, err = field.Value.GetI32(), error(nil)
+				if err != nil {
+					return err
+				}
+				v.presence[0] |= 0x2000
+			}
+		case 15:
+			if field.Value.Type() == wire.TI32 {
+				v.F15, err = field.Value.GetI32(), error(nil)
+				if err != nil {
+					return err
+				}
+				v.presence[0] |= 0x4000
+			}
+		case 16:
+			if field.Value.Type() == wire.TI32 {
+				v.F16, err = field.Value.GetI32(), error(nil)
+				if err != nil {
+					return err
+				}
+				v.presence[0] |= 0x8000
+			}
+		case 17:
+			if field.Value.Type() == wire.TI32 {
+				v.F17, err = field.Value.GetI32(), error(nil)
+				if err != nil {
+					return err
+				}
+				v.presence[0] |= 0x10000
+			}
+		case 18:
+			if field.Value.Type() == wire.TI32 {
+				v.F18, err = field.Value.GetI32(), error(nil)
+				if err != nil {
+					return err
+				}
+				v.presence[0] |= 0x20000
+			}
+		case 19:
+			if field.Value.Type() == wire.TI32 {
+				v.F19, err = field.Value.GetI32(), error(nil)
+				if err != nil {
+					return err
+				}
+				v.presence[0] |= 0x40000
+			}
+		case 20:
+			if field.Value.Type() == wire.TI32 {
+				v.F20, err = field.Value.GetI32(), error(nil)
+				if err != nil {
+					return err
+				}
+				v.presence[0] |= 0x80000
+			}
+		case 21:
+			if field.Value.Type() == wire.TI32 {
+				v.F21, err = field.Value.GetI32(), error(nil)
+				if err != nil {
+					return err
+				}
+				v.presence[0] |= 0x100000
+			}
+		case 22:
+			if field.Value.Type() == wire.TI32 {
+				v.F22, err = field.Value.GetI32(), error(nil)
+				if err != nil {
+					return err
+				}
+				v.presence[0] |= 0x200000
+			}
+		case 23:
+			if field.Value.Type() == wire.TI32 {
+				v.F23, err = field.Value.GetI32(), error(nil)
+				if err != nil {
+					return err
+				}
+				v.presence[0] |= 0x400000
+			}
+		case 24:
+			if field.Value.Type() == wire.TI32 {
+				v.F24, err = field.Value.GetI32(), error(nil)
+				if err != nil {
+					return err
+				}
+				v.presence[0] |= 0x800000
+			}
+		case 25:
+			if field.Value.Type() == wire.TI32 {
+				v.F25, err = field.Value.GetI32(), error(nil)
+				if err != nil {
+					return err
+				}
+				v.presence[0] |= 0x1000000
+			}
+		case 26:
+			if field.Value.Type() == wire.TI32 {
+				v.F26, err = field.Value.GetI32(), error(nil)
+				if err != nil {
+					return err
+				}
+				v.presence[0] |= 0x2000000
+			}
+		case 27:
+			if field.Value.Type() == wire.TI32 {
+				v.F27, err = field.Value.GetI32(), error(nil)
+				if err != nil {
+					return err
+				}
+				v.presence[0] |= 0x4000000
+			}
+		case 28:
+			if field.Value.Type() == wire.TI32 {
+				v.F28, err = field.Value.GetI32(), error(nil)
+				if err != nil {
+					return err
+				}
+				v.presence[0] |= 0x8000000
+			}
+		case 29:
+			if field.Value.Type() == wire.TI32 {
+				v.F29, err = field.Value.GetI32(), error(nil)
+				if err != nil {
+					return err
+				}
+				v.presence[0] |= 0x10000000
+			}
+		case 30:
+			if field.Value.Type() == wire.TI32 {
+				v.F30, err = field.Value.GetI32(), error(nil)
+				if err != nil {
+					return err
+				}
+				v.presence[0] |= 0x20000000
+			}
+		case 31:
+			if field.Value.Type() == wire.TI32 {
+				v.F31, err = field.Value.GetI32(), error(nil)
+				if err != nil {
+					return err
+				}
+				v.presence[0] |= 0x40000000
+			}
+		case 32:
+			if field.Value.Type() == wire.TI32 {
+				v.F32, err = field.Value.GetI32(), error(nil)
+				if err != nil {
+					return err
+				}
+				v.presence[0] |= 0x80000000
+			}
+		case 33:
+			if field.Value.Type() == wire.TI32 {
+				v.F33, err = field.Value.GetI32(), error(nil)
+				if err != nil {
+					return err
+				}
+				v.presence[0] |= 0x100000000
+			}
+		case 34:
+			if field.Value.Type() == wire.TI32 {
+				v.F34, err = field.Value.GetI32(), error(nil)
+				if err != nil {
+					return err
+				}
+				v.presence[0] |= 0x200000000
+			}
+		case 35:
+			if field.Value.Type() == wire.TI32 {
+				v.F35, err = field.Value.GetI32(), error(nil)
+				if err != nil {
+					return err
+				}
+				v.presence[0] |= 0x400000000
+			}
+		case 36:
+			if field.Value.Type() == wire.TI32 {
+				v.F36, err = field.Value.GetI32(), error(nil)
+				if err != nil {
+					return err
+				}
+				v.presence[0] |= 0x800000000
+			}
+		case 37:
+			if field.Value.Type() == wire.TI32 {
+				v.F37, err = field.Value.GetI32(), error(nil)
+				if err != nil {
+					return err
+				}
+				v.presence[0] |= 0x1000000000
+			}
+		case 38:
+			if field.Value.Type() == wire.TI32 {
+				v.F38, err = field.Value.GetI32(), error(nil)
+				if err != nil {
+					return err
+				}
+				v.presence[0] |= 0x2000000000
+			}
+		case 39:
+			if field.Value.Type() == wire.TI32 {
+				v.F39, err = field.Value.GetI32(), error(nil)
+				if err != nil {
+					return err
+				}
+				v.presence[0] |= 0x4000000000
+			}
+		case 40:
+			if field.Value.Type() == wire.TI32 {
+				v.F40, err = field.Value.GetI32(), error(nil)
+				if err != nil {
+					return err
+				}
+				v.presence[0] |= 0x8000000000
+			}
+		case 41:
+			if field.Value.Type() == wire.TI32 {
+				v.F41, err = field.Value.GetI32(), error(nil)
+				if err != nil {
+					return err
+				}
+				v.presence[0] |= 0x10000000000
+			}
+		case 42:
+			if field.Value.Type() == wire.TI32 {
+				v.F42, err = field.Value.GetI32(), error(nil)
+				if err != nil {
+					return err
+				}
+				v.presence[0] |= 0x20000000000
+			}
+		case 43:
+			if field.Value.Type() == wire.TI32 {
+				v.F43, err = field.Value.GetI32(), error(nil)
+				if err != nil {
+					return err
+				}
+				v.presence[0] |= 0x40000000000
+			}
+		case 44:
+			if field.Value.Type() == wire.TI32 {
+				v.F44, err = field.Value.GetI32(), error(nil)
+				if err != nil {
+					return err
+				}
+				v.presence[0] |= 0x80000000000
+			}
+		case 45:
+			if field.Value.Type() == wire.TI32 {
+				v.F45, err = field.Value.GetI32(), error(nil)
+				if err != nil {
+					return err
+				}
+				v.presence[0] |= 0x100000000000
+			}
+		case 46:
+			if field.Value.Type() == wire.TI32 {
+				v.F46, err = field.Value.GetI32(), error(nil)
+				if err != nil {
+					return err
+				}
+				v.presence[0] |= 0x200000000000
+			}
+		case 47:
+			if field.Value.Type() == wire.TI32 {
+				v.F47, err = field.Value.GetI32(), error(nil)
+				if err != nil {
+					return err
+				}
+				v.presence[0] |= 0x400000000000
+			}
+		case 48:
+			if field.Value.Type() == wire.TI32 {
+				v.F48, err = field.Value.GetI32(), error(nil)
+				if err != nil {
+					return err
+				}
+				v.presence[0] |= 0x800000000000
+			}
+		case 49:
+			if field.Value.Type() == wire.TI32 {
+				v.F49, err = field.Value.GetI32(), error(nil)
+				if err != nil {
+					return err
+				}
+				v.presence[0] |= 0x1000000000000
+			}
+		case 50:
+			if field.Value.Type() == wire.TI32 {
+				v.F50, err = field.Value.GetI32(), error(nil)
+				if err != nil {
+					return err
+				}
+				v.presence[0] |= 0x2000000000000
+			}
+		case 51:
+			if field.Value.Type() == wire.TI32 {
+				v.F51, err = field.Value.GetI32(), error(nil)
+				if err != nil {
+					return err
+				}
+				v.presence[0] |= 0x4000000000000
+			}
+		case 52:
+			if field.Value.Type() == wire.TI32 {
+				v.F52, err = field.Value.GetI32(), error(nil)
+				if err != nil {
+					return err
+				}
+				v.presence[0] |= 0x8000000000000
+			}
+		case 53:
+			if field.Value.Type() == wire.TI32 {
+				v.F53, err = field.Value.GetI32(), error(nil)
+				if err != nil {
+					return err
+				}
+				v.presence[0] |= 0x10000000000000
+			}
+		case 54:
+			if field.Value.Type() == wire.TI32 {
+				v.F54, err = field.Value.GetI32(), error(nil)
+				if err != nil {
+					return err
+				}
+				v.presence[0] |= 0x20000000000000
+			}
+		case 55:
+			if field.Value.Type() == wire.TI32 {
+				v.F55, err = field.Value.GetI32(), error(nil)
+				if err != nil {
+					return err
+				}
+				v.presence[0] |= 0x40000000000000
+			}
+		case 56:
+			if field.Value.Type() == wire.TI32 {
+				v.F56, err = field.Value.GetI32(), error(nil)
+				if err != nil {
+					return err
+				}
+				v.presence[0] |= 0x80000000000000
+			}
+		case 57:
+			if field.Value.Type() == wire.TI32 {
+				v.F57, err = field.Value.GetI32(), error(nil)
+				if err != nil {
+					return err
+				}
+				v.presence[0] |= 0x100000000000000
+			}
+		case 58:
+			if field.Value.Type() == wire.TI32 {
+				v.F58, err = field.Value.GetI32(), error(nil)
+				if err != nil {
+					return err
+				}
+				v.presence[0] |= 0x200000000000000
+			}
+		case 59:
+			if field.Value.Type() == wire.TI32 {
+				v.F59, err = field.Value.GetI32(), error(nil)
+				if err != nil {
+					return err
+				}
+				v.presence[0] |= 0x400000000000000
+			}
+		case 60:
+			if field.Value.Type() == wire.TI32 {
+				v.F60, err = field.Value.GetI32(), error(nil)
+				if err != nil {
+					return err
+				}
+				v.presence[0] |= 0x800000000000000
+			}
+		case 61:
+			if field.Value.Type() == wire.TI32 {
+				v.F61, err = field.Value.GetI32(), error(nil)
+				if err != nil {
+					return err
+				}
+				v.presence[0] |= 0x1000000000000000
+			}
+		case 62:
+			if field.Value.Type() == wire.TI32 {
+				v.F62, err = field.Value.GetI32(), error(nil)
+				if err != nil {
+					return err
+				}
+				v.presence[0] |= 0x2000000000000000
+			}
+		case 63:
+			if field.Value.Type() == wire.TI32 {
+				v.F63, err = field.Value.GetI32(), error(nil)
+				if err != nil {
+					return err
+				}
+				v.presence[0] |= 0x4000000000000000
+			}
+		case 64:
+			if field.Value.Type() == wire.TI32 {
+				v.F64, err = field.Value.GetI32(), error(nil)
+				if err != nil {
+					return err
+				}
+				v.presence[0] |= 0x8000000000000000
+			}
+		case 65:
+			if field.Value.Type() == wire.TI32 {
+				v.F65, err = field.Value.GetI32(), error(nil)
+				if err != nil {
+					return err
+				}
+				v.presence[1] |= 0x1
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a ManySettings
+// struct.
+func (v *ManySettings) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [65]string
+	i := 0
+	if v.IsSetF1() {
+		fields[i] = fmt.Sprintf("F1: %v", v.F1)
+		i++
+	}
+	if v.IsSetF2() {
+		fields[i] = fmt.Sprintf("F2: %v", v.F2)
+		i++
+	}
+	if v.IsSetF3() {
+		fields[i] = fmt.Sprintf("F3: %v", v.F3)
+		i++
+	}
+	if v.IsSetF4() {
+		fields[i] = fmt.Sprintf("F4: %v", v.F4)
+		i++
+	}
+	if v.IsSetF5() {
+		fields[i] = fmt.Sprintf("F5: %v", v.F5)
+		i++
+	}
+	if v.IsSetF6() {
+		fields[i] = fmt.Sprintf("F6: %v", v.F6)
+		i++
+	}
+	if v.IsSetF7() {
+		fields[i] = fmt.Sprintf("F7: %v", v.F7)
+		i++
+	}
+	if v.IsSetF8() {
+		fields[i] = fmt.Sprintf("F8: %v", v.F8)
+		i++
+	}
+	if v.IsSetF9() {
+		fields[i] = fmt.Sprintf("F9: %v", v.F9)
+		i++
+	}
+	if v.IsSetF10() {
+		fields[i] = fmt.Sprintf("F10: %v", v.F10)
+		i++
+	}
+	if v.IsSetF11() {
+		fields[i] = fmt.Sprintf("F11: %v", v.F11)
+		i++
+	}
+	if v.IsSetF12() {
+		fields[i] = fmt.Sprintf("F12: %v", v.F12)
+		i++
+	}
+	if v.IsSetF13() {
+		fields[i] = fmt.Sprintf("F13: %v", v.F13)
+		i++
+	}
+	if v.IsSetF14() {
+		fields[i] = fmt.Sprintf("F14: %v", v.F14)
+		i++
+	}
+	if v.IsSetF15() {
+		fields[i] = fmt.Sprintf("F15: %v", v.F15)
+		i++
+	}
+	if v.IsSetF16() {
+		fields[i] = fmt.Sprintf("F16: %v", v.F16)
+		i++
+	}
+	if v.IsSetF17() {
+		fields[i] = fmt.Sprintf("F17: %v", v.F17)
+		i++
+	}
+	if v.IsSetF18() {
+		fields[i] = fmt.Sprintf("F18: %v", v.F18)
+		i++
+	}
+	if v.IsSetF19() {
+		fields[i] = fmt.Sprintf("F19: %v", v.F19)
+		i++
+	}
+	if v.IsSetF20() {
+		fields[i] = fmt.Sprintf("F20: %v", v.F20)
+		i++
+	}
+	if v.IsSetF21() {
+		fields[i] = fmt.Sprintf("F21: %v", v.F21)
+		i++
+	}
+	if v.IsSetF22() {
+		fields[i] = fmt.Sprintf("F22: %v", v.F22)
+		i++
+	}
+	if v.IsSetF23() {
+		fields[i] = fmt.Sprintf("F23: %v", v.F23)
+		i++
+	}
+	if v.IsSetF24() {
+		fields[i] = fmt.Sprintf("F24: %v", v.F24)
+		i++
+	}
+	if v.IsSetF25() {
+		fields[i] = fmt.Sprintf("F25: %v", v.F25)
+		i++
+	}
+	if v.IsSetF26() {
+		fields[i] = fmt.Sprintf("F26: %v", v.F26)
+		i++
+	}
+	if v.IsSetF27() {
+		fields[i] = fmt.Sprintf("F27: %v", v.F27)
+		i++
+	}
+	if v.IsSetF28() {
+		fields[i] = fmt.Sprintf("F28: %v", v.F28)
+		i++
+	}
+	if v.IsSetF29() {
+		fields[i] = fmt.Sprintf("F29: %v", v.F29)
+		i++
+	}
+	if v.IsSetF30() {
+		fields[i] = fmt.Sprintf("F30: %v", v.F30)
+		i++
+	}
+	if v.IsSetF31() {
+		fields[i] = fmt.Sprintf("F31: %v", v.F31)
+		i++
+	}
+	if v.IsSetF32() {
+		fields[i] = fmt.Sprintf("F32: %v", v.F32)
+		i++
+	}
+	if v.IsSetF33() {
+		fields[i] = fmt.Sprintf("F33: %v", v.F33)
+		i++
+	}
+	if v.IsSetF34() {
+		fields[i] = fmt.Sprintf("F34: %v", v.F34)
+		i++
+	}
+	if v.IsSetF35() {
+		fields[i] = fmt.Sprintf("F35: %v", v.F35)
+		i++
+	}
+	if v.IsSetF36() {
+		fields[i] = fmt.Sprintf("F36: %v", v.F36)
+		i++
+	}
+	if v.IsSetF37() {
+		fields[i] = fmt.Sprintf("F37: %v", v.F37)
+		i++
+	}
+	if v.IsSetF38() {
+		fields[i] = fmt.Sprintf("F38: %v", v.F38)
+		i++
+	}
+	if v.IsSetF39() {
+		fields[i] = fmt.Sprintf("F39: %v", v.F39)
+		i++
+	}
+	if v.IsSetF40() {
+		fields[i] = fmt.Sprintf("F40: %v", v.F40)
+		i++
+	}
+	if v.IsSetF41() {
+		fields[i] = fmt.Sprintf("F41: %v", v.F41)
+		i++
+	}
+	if v.IsSetF42() {
+		fields[i] = fmt.Sprintf("F42: %v", v.F42)
+		i++
+	}
+	if v.IsSetF43() {
+		fields[i] = fmt.Sprintf("F43: %v", v.F43)
+		i++
+	}
+	if v.IsSetF44() {
+		fields[i] = fmt.Sprintf("F44: %v", v.F44)
+		i++
+	}
+	if v.IsSetF45() {
+		fields[i] = fmt.Sprintf("F45: %v", v.F45)
+		i++
+	}
+	if v.IsSetF46() {
+		fields[i] = fmt.Sprintf("F46: %v", v.F46)
+		i++
+	}
+	if v.IsSetF47() {
+		fields[i] = fmt.Sprintf("F47: %v", v.F47)
+		i++
+	}
+	if v.IsSetF48() {
+		fields[i] = fmt.Sprintf("F48: %v", v.F48)
+		i++
+	}
+	if v.IsSetF49() {
+		fields[i] = fmt.Sprintf("F49: %v", v.F49)
+		i++
+	}
+	if v.IsSetF50() {
+		fields[i] = fmt.Sprintf("F50: %v", v.F50)
+		i++
+	}
+	if v.IsSetF51() {
+		fields[i] = fmt.Sprintf("F51: %v", v.F51)
+		i++
+	}
+	if v.IsSetF52() {
+		fields[i] = fmt.Sprintf("F52: %v", v.F52)
+		i++
+	}
+	if v.IsSetF53() {
+		fields[i] = fmt.Sprintf("F53: %v", v.F53)
+		i++
+	}
+	if v.IsSetF54() {
+		fields[i] = fmt.Sprintf("F54: %v", v.F54)
+		i++
+	}
+	if v.IsSetF55() {
+		fields[i] = fmt.Sprintf("F55: %v", v.F55)
+		i++
+	}
+	if v.IsSetF56() {
+		fields[i] = fmt.Sprintf("F56: %v", v.F56)
+		i++
+	}
+	if v.IsSetF57() {
+		fields[i] = fmt.Sprintf("F57: %v", v.F57)
+		i++
+	}
+	if v.IsSetF58() {
+		fields[i] = fmt.Sprintf("F58: %v", v.F58)
+		i++
+	}
+	if v.IsSetF59() {
+		fields[i] = fmt.Sprintf("F59: %v", v.F59)
+		i++
+	}
+	if v.IsSetF60() {
+		fields[i] = fmt.Sprintf("F60: %v", v.F60)
+		i++
+	}
+	if v.IsSetF61() {
+		fields[i] = fmt.Sprintf("F61: %v", v.F61)
+		i++
+	}
+	if v.IsSetF62() {
+		fields[i] = fmt.Sprintf("F62: %v", v.F62)
+		i++
+	}
+	if v.IsSetF63() {
+		fields[i] = fmt.Sprintf("F63: %v", v.F63)
+		i++
+	}
+	if v.IsSetF64() {
+		fields[i] = fmt.Sprintf("F64: %v", v.F64)
+		i++
+	}
+	if v.IsSetF65() {
+		fields[i] = fmt.Sprintf("F65: %v", v.F65)
+		i++
+	}
+
+	return fmt.Sprintf("ManySettings{%v}", strings.Join(fields[:i], ", "))
+}
+
+// MarshalJSON implements json.Marshaler, omitting unset
+// fields of ManySettings.
+//
+// MarshalJSON has a value receiver so that it is also used for
+// ManySettings values that are not addressable.
+func (v ManySettings) MarshalJSON() ([]byte, error) {
+	type alias ManySettings
+	x := struct {
+		*alias
+		F1  *int32 `json:"f1,omitempty"`
+		F2  *int32 `json:"f2,omitempty"`
+		F3  *int32 `json:"f3,omitempty"`
+		F4  *int32 `json:"f4,omitempty"`
+		F5  *int32 `json:"f5,omitempty"`
+		F6  *int32 `json:"f6,omitempty"`
+		F7  *int32 `json:"f7,omitempty"`
+		F8  *int32 `json:"f8,omitempty"`
+		F9  *int32 `json:"f9,omitempty"`
+		F10 *int32 `json:"f10,omitempty"`
+		F11 *int32 `json:"f11,omitempty"`
+		F12 *int32 `json:"f12,omitempty"`
+		F13 *int32 `json:"f13,omitempty"`
+		F14 *int32 `json:"f14,omitempty"`
+		F15 *int32 `json:"f15,omitempty"`
+		F16 *int32 `json:"f16,omitempty"`
+		F17 *int32 `json:"f17,omitempty"`
+		F18 *int32 `json:"f18,omitempty"`
+		F19 *int32 `json:"f19,omitempty"`
+		F20 *int32 `json:"f20,omitempty"`
+		F21 *int32 `json:"f21,omitempty"`
+		F22 *int32 `json:"f22,omitempty"`
+		F23 *int32 `json:"f23,omitempty"`
+		F24 *int32 `json:"f24,omitempty"`
+		F25 *int32 `json:"f25,omitempty"`
+		F26 *int32 `json:"f26,omitempty"`
+		F27 *int32 `json:"f27,omitempty"`
+		F28 *int32 `json:"f28,omitempty"`
+		F29 *int32 `json:"f29,omitempty"`
+		F30 *int32 `json:"f30,omitempty"`
+		F31 *int32 `json:"f31,omitempty"`
+		F32 *int32 `json:"f32,omitempty"`
+		F33 *int32 `json:"f33,omitempty"`
+		F34 *int32 `json:"f34,omitempty"`
+		F35 *int32 `json:"f35,omitempty"`
+		F36 *int32 `json:"f36,omitempty"`
+		F37 *int32 `json:"f37,omitempty"`
+		F38 *int32 `json:"f38,omitempty"`
+		F39 *int32 `json:"f39,omitempty"`
+		F40 *int32 `json:"f40,omitempty"`
+		F41 *int32 `json:"f41,omitempty"`
+		F42 *int32 `json:"f42,omitempty"`
+		F43 *int32 `json:"f43,omitempty"`
+		F44 *int32 `json:"f44,omitempty"`
+		F45 *int32 `json:"f45,omitempty"`
+		F46 *int32 `json:"f46,omitempty"`
+		F47 *int32 `json:"f47,omitempty"`
+		F48 *int32 `json:"f48,omitempty"`
+		F49 *int32 `json:"f49,omitempty"`
+		F50 *int32 `json:"f50,omitempty"`
+		F51 *int32 `json:"f51,omitempty"`
+		F52 *int32 `json:"f52,omitempty"`
+		F53 *int32 `json:"f53,omitempty"`
+		F54 *int32 `json:"f54,omitempty"`
+		F55 *int32 `json:"f55,omitempty"`
+		F56 *int32 `json:"f56,omitempty"`
+		F57 *int32 `json:"f57,omitempty"`
+		F58 *int32 `json:"f58,omitempty"`
+		F59 *int32 `json:"f59,omitempty"`
+		F60 *int32 `json:"f60,omitempty"`
+		F61 *int32 `json:"f61,omitempty"`
+		F62 *int32 `json:"f62,omitempty"`
+		F63 *int32 `json:"f63,omitempty"`
+		F64 *int32 `json:"f64,omitempty"`
+		F65 *int32 `json:"f65,omitempty"`
+	}{alias: (*alias)(&v)}
+
+	if v.IsSetF1() {
+		x.F1 = &v.F1
+	}
+	if v.IsSetF2() {
+		x.F2 = &v.F2
+	}
+	if v.IsSetF3() {
+		x.F3 = &v.F3
+	}
+	if v.IsSetF4() {
+		x.F4 = &v.F4
+	}
+	if v.IsSetF5() {
+		x.F5 = &v.F5
+	}
+	if v.IsSetF6() {
+		x.F6 = &v.F6
+	}
+	if v.IsSetF7() {
+		x.F7 = &v.F7
+	}
+	if v.IsSetF8() {
+		x.F8 = &v.F8
+	}
+	if v.IsSetF9() {
+		x.F9 = &v.F9
+	}
+	if v.IsSetF10() {
+		x.F10 = &v.F10
+	}
+	if v.IsSetF11() {
+		x.F11 = &v.F11
+	}
+	if v.IsSetF12() {
+		x.F12 = &v.F12
+	}
+	if v.IsSetF13() {
+		x.F13 = &v.F13
+	}
+	if v.IsSetF14() {
+		x.F14 = &v.F14
+	}
+	if v.IsSetF15() {
+		x.F15 = &v.F15
+	}
+	if v.IsSetF16() {
+		x.F16 = &v.F16
+	}
+	if v.IsSetF17() {
+		x.F17 = &v.F17
+	}
+	if v.IsSetF18() {
+		x.F18 = &v.F18
+	}
+	if v.IsSetF19() {
+		x.F19 = &v.F19
+	}
+	if v.IsSetF20() {
+		x.F20 = &v.F20
+	}
+	if v.IsSetF21() {
+		x.F21 = &v.F21
+	}
+	if v.IsSetF22() {
+		x.F22 = &v.F22
+	}
+	if v.IsSetF23() {
+		x.F23 = &v.F23
+	}
+	if v.IsSetF24() {
+		x.F24 = &v.F24
+	}
+	if v.IsSetF25() {
+		x.F25 = &v.F25
+	}
+	if v.IsSetF26() {
+		x.F26 = &v.F26
+	}
+	if v.IsSetF27() {
+		x.F27 = &v.F27
+	}
+	if v.IsSetF28() {
+		x.F28 = &v.F28
+	}
+	if v.IsSetF29() {
+		x.F29 = &v.F29
+	}
+	if v.IsSetF30() {
+		x.F30 = &v.F30
+	}
+	if v.IsSetF31() {
+		x.F31 = &v.F31
+	}
+	if v.IsSetF32() {
+		x.F32 = &v.F32
+	}
+	if v.IsSetF33() {
+		x.F33 = &v.F33
+	}
+	if v.IsSetF34() {
+		x.F34 = &v.F34
+	}
+	if v.IsSetF35() {
+		x.F35 = &v.F35
+	}
+	if v.IsSetF36() {
+		x.F36 = &v.F36
+	}
+	if v.IsSetF37() {
+		x.F37 = &v.F37
+	}
+	if v.IsSetF38() {
+		x.F38 = &v.F38
+	}
+	if v.IsSetF39() {
+		x.F39 = &v.F39
+	}
+	if v.IsSetF40() {
+		x.F40 = &v.F40
+	}
+	if v.IsSetF41() {
+		x.F41 = &v.F41
+	}
+	if v.IsSetF42() {
+		x.F42 = &v.F42
+	}
+	if v.IsSetF43() {
+		x.F43 = &v.F43
+	}
+	if v.IsSetF44() {
+		x.F44 = &v.F44
+	}
+	if v.IsSetF45() {
+		x.F45 = &v.F45
+	}
+	if v.IsSetF46() {
+		x.F46 = &v.F46
+	}
+	if v.IsSetF47() {
+		x.F47 = &v.F47
+	}
+	if v.IsSetF48() {
+		x.F48 = &v.F48
+	}
+	if v.IsSetF49() {
+		x.F49 = &v.F49
+	}
+	if v.IsSetF50() {
+		x.F50 = &v.F50
+	}
+	if v.IsSetF51() {
+		x.F51 = &v.F51
+	}
+	if v.IsSetF52() {
+		x.F52 = &v.F52
+	}
+	if v.IsSetF53() {
+		x.F53 = &v.F53
+	}
+	if v.IsSetF54() {
+		x.F54 = &v.F54
+	}
+	if v.IsSetF55() {
+		x.F55 = &v.F55
+	}
+	if v.IsSetF56() {
+		x.F56 = &v.F56
+	}
+	if v.IsSetF57() {
+		x.F57 = &v.F57
+	}
+	if v.IsSetF58() {
+		x.F58 = &v.F58
+	}
+	if v.IsSetF59() {
+		x.F59 = &v.F59
+	}
+	if v.IsSetF60() {
+		x.F60 = &v.F60
+	}
+	if v.IsSetF61() {
+		x.F61 = &v.F61
+	}
+	if v.IsSetF62() {
+		x.F62 = &v.F62
+	}
+	if v.IsSetF63() {
+		x.F63 = &v.F63
+	}
+	if v.IsSetF64() {
+		x.F64 = &v.F64
+	}
+	if v.IsSetF65() {
+		x.F65 = &v.F65
+	}
+
+	return json.Marshal(x)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, marking fields of
+// ManySettings present in the JSON as set.
+func (v *ManySettings) UnmarshalJSON(b []byte) error {
+	type alias ManySettings
+	x := struct {
+		*alias
+		F1  *int32 `json:"f1,omitempty"`
+		F2  *int32 `json:"f2,omitempty"`
+		F3  *int32 `json:"f3,omitempty"`
+		F4  *int32 `json:"f4,omitempty"`
+		F5  *int32 `json:"f5,omitempty"`
+		F6  *int32 `json:"f6,omitempty"`
+		F7  *int32 `json:"f7,omitempty"`
+		F8  *int32 `json:"f8,omitempty"`
+		F9  *int32 `json:"f9,omitempty"`
+		F10 *int32 `json:"f10,omitempty"`
+		F11 *int32 `json:"f11,omitempty"`
+		F12 *int32 `json:"f12,omitempty"`
+		F13 *int32 `json:"f13,omitempty"`
+		F14 *int32 `json:"f14,omitempty"`
+		F15 *int32 `json:"f15,omitempty"`
+		F16 *int32 `json:"f16,omitempty"`
+		F17 *int32 `json:"f17,omitempty"`
+		F18 *int32 `json:"f18,omitempty"`
+		F19 *int32 `json:"f19,omitempty"`
+		F20 *int32 `json:"f20,omitempty"`
+		F21 *int32 `json:"f21,omitempty"`
+		F22 *int32 `json:"f22,omitempty"`
+		F23 *int32 `json:"f23,omitempty"`
+		F24 *int32 `json:"f24,omitempty"`
+		F25 *int32 `json:"f25,omitempty"`
+		F26 *int32 `json:"f26,omitempty"`
+		F27 *int32 `json:"f27,omitempty"`
+		F28 *int32 `json:"f28,omitempty"`
+		F29 *int32 `json:"f29,omitempty"`
+		F30 *int32 `json:"f30,omitempty"`
+		F31 *int32 `json:"f31,omitempty"`
+		F32 *int32 `json:"f32,omitempty"`
+		F33 *int32 `json:"f33,omitempty"`
+		F34 *int32 `json:"f34,omitempty"`
+		F35 *int32 `json:"f35,omitempty"`
+		F36 *int32 `json:"f36,omitempty"`
+		F37 *int32 `json:"f37,omitempty"`
+		F38 *int32 `json:"f38,omitempty"`
+		F39 *int32 `json:"f39,omitempty"`
+		F40 *int32 `json:"f40,omitempty"`
+		F41 *int32 `json:"f41,omitempty"`
+		F42 *int32 `json:"f42,omitempty"`
+		F43 *int32 `json:"f43,omitempty"`
+		F44 *int32 `json:"f44,omitempty"`
+		F45 *int32 `json:"f45,omitempty"`
+		F46 *int32 `json:"f46,omitempty"`
+		F47 *int32 `json:"f47,omitempty"`
+		F48 *int32 `json:"f48,omitempty"`
+		F49 *int32 `json:"f49,omitempty"`
+		F50 *int32 `json:"f50,omitempty"`
+		F51 *int32 `json:"f51,omitempty"`
+		F52 *int32 `json:"f52,omitempty"`
+		F53 *int32 `json:"f53,omitempty"`
+		F54 *int32 `json:"f54,omitempty"`
+		F55 *int32 `json:"f55,omitempty"`
+		F56 *int32 `json:"f56,omitempty"`
+		F57 *int32 `json:"f57,omitempty"`
+		F58 *int32 `json:"f58,omitempty"`
+		F59 *int32 `json:"f59,omitempty"`
+		F60 *int32 `json:"f60,omitempty"`
+		F61 *int32 `json:"f61,omitempty"`
+		F62 *int32 `json:"f62,omitempty"`
+		F63 *int32 `json:"f63,omitempty"`
+		F64 *int32 `json:"f64,omitempty"`
+		F65 *int32 `json:"f65,omitempty"`
+	}{alias: (*alias)(v)}
+	if err := json.Unmarshal(b, &x); err != nil {
+		return err
+	}
+
+	if x.F1 != nil {
+		v.SetF1(*x.F1)
+	}
+	if x.F2 != nil {
+		v.SetF2(*x.F2)
+	}
+	if x.F3 != nil {
+		v.SetF3(*x.F3)
+	}
+	if x.F4 != nil {
+		v.SetF4(*x.F4)
+	}
+	if x.F5 != nil {
+		v.SetF5(*x.F5)
+	}
+	if x.F6 != nil {
+		v.SetF6(*x.F6)
+	}
+	if x.F7 != nil {
+		v.SetF7(*x.F7)
+	}
+	if x.F8 != nil {
+		v.SetF8(*x.F8)
+	}
+	if x.F9 != nil {
+		v.SetF9(*x.F9)
+	}
+	if x.F10 != nil {
+		v.SetF10(*x.F10)
+	}
+	if x.F11 != nil {
+		v.SetF11(*x.F11)
+	}
+	if x.F12 != nil {
+		v.SetF12(*x.F12)
+	}
+	if x.F13 != nil {
+		v.SetF13(*x.F13)
+	}
+	if x.F14 != nil {
+		v.SetF14(*x.F14)
+	}
+	if x.F15 != nil {
+		v.SetF15(*x.F15)
+	}
+	if x.F16 != nil {
+		v.SetF16(*x.F16)
+	}
+	if x.F17 != nil {
+		v.SetF17(*x.F17)
+	}
+	if x.F18 != nil {
+		v.SetF18(*x.F18)
+	}
+	if x.F19 != nil {
+		v.SetF19(*x.F19)
+	}
+	if x.F20 != nil {
+		v.SetF20(*x.F20)
+	}
+	if x.F21 != nil {
+		v.SetF21(*x.F21)
+	}
+	if x.F22 != nil {
+		v.SetF22(*x.F22)
+	}
+	if x.F23 != nil {
+		v.SetF23(*x.F23)
+	}
+	if x.F24 != nil {
+		v.SetF24(*x.F24)
+	}
+	if x.F25 != nil {
+		v.SetF25(*x.F25)
+	}
+	if x.F26 != nil {
+		v.SetF26(*x.F26)
+	}
+	if x.F27 != nil {
+		v.SetF27(*x.F27)
+	}
+	if x.F28 != nil {
+		v.SetF28(*x.F28)
+	}
+	if x.F29 != nil {
+		v.SetF29(*x.F29)
+	}
+	if x.F30 != nil {
+		v.SetF30(*x.F30)
+	}
+	if x.F31 != nil {
+		v.SetF31(*x.F31)
+	}
+	if x.F32 != nil {
+		v.SetF32(*x.F32)
+	}
+	if x.F33 != nil {
+		v.SetF33(*x.F33)
+	}
+	if x.F34 != nil {
+		v.SetF34(*x.F34)
+	}
+	if x.F35 != nil {
+		v.SetF35(*x.F35)
+	}
+	if x.F36 != nil {
+		v.SetF36(*x.F36)
+	}
+	if x.F37 != nil {
+		v.SetF37(*x.F37)
+	}
+	if x.F38 != nil {
+		v.SetF38(*x.F38)
+	}
+	if x.F39 != nil {
+		v.SetF39(*x.F39)
+	}
+	if x.F40 != nil {
+		v.SetF40(*x.F40)
+	}
+	if x.F41 != nil {
+		v.SetF41(*x.F41)
+	}
+	if x.F42 != nil {
+		v.SetF42(*x.F42)
+	}
+	if x.F43 != nil {
+		v.SetF43(*x.F43)
+	}
+	if x.F44 != nil {
+		v.SetF44(*x.F44)
+	}
+	if x.F45 != nil {
+		v.SetF45(*x.F45)
+	}
+	if x.F46 != nil {
+		v.SetF46(*x.F46)
+	}
+	if x.F47 != nil {
+		v.SetF47(*x.F47)
+	}
+	if x.F48 != nil {
+		v.SetF48(*x.F48)
+	}
+	if x.F49 != nil {
+		v.SetF49(*x.F49)
+	}
+	if x.F50 != nil {
+		v.SetF50(*x.F50)
+	}
+	if x.F51 != nil {
+		v.SetF51(*x.F51)
+	}
+	if x.F52 != nil {
+		v.SetF52(*x.F52)
+	}
+	if x.F53 != nil {
+		v.SetF53(*x.F53)
+	}
+	if x.F54 != nil {
+		v.SetF54(*x.F54)
+	}
+	if x.F55 != nil {
+		v.SetF55(*x.F55)
+	}
+	if x.F56 != nil {
+		v.SetF56(*x.F56)
+	}
+	if x.F57 != nil {
+		v.SetF57(*x.F57)
+	}
+	if x.F58 != nil {
+		v.SetF58(*x.F58)
+	}
+	if x.F59 != nil {
+		v.SetF59(*x.F59)
+	}
+	if x.F60 != nil {
+		v.SetF60(*x.F60)
+	}
+	if x.F61 != nil {
+		v.SetF61(*x.F61)
+	}
+	if x.F62 != nil {
+		v.SetF62(*x.F62)
+	}
+	if x.F63 != nil {
+		v.SetF63(*x.F63)
+	}
+	if x.F64 != nil {
+		v.SetF64(*x.F64)
+	}
+	if x.F65 != nil {
+		v.SetF65(*x.F65)
+	}
+
+	return nil
+}
+
+// Equals returns true if all the fields of this ManySettings match the
+// provided ManySettings.
+//
+// This function performs a deep comparison.
+func (v *ManySettings) Equals(rhs *ManySettings) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if v.IsSetF1() != rhs.IsSetF1() {
+		return false
+	}
+	if v.IsSetF1() && !(v.F1 == rhs.F1) {
+		return false
+	}
+	if v.IsSetF2() != rhs.IsSetF2() {
+		return false
+	}
+	if v.IsSetF2() && !(v.F2 == rhs.F2) {
+		return false
+	}
+	if v.IsSetF3() != rhs.IsSetF3() {
+		return false
+	}
+	if v.IsSetF3() && !(v.F3 == rhs.F3) {
+		return false
+	}
+	if v.IsSetF4() != rhs.IsSetF4() {
+		return false
+	}
+	if v.IsSetF4() && !(v.F4 == rhs.F4) {
+		return false
+	}
+	if v.IsSetF5() != rhs.IsSetF5() {
+		return false
+	}
+	if v.IsSetF5() && !(v.F5 == rhs.F5) {
+		return false
+	}
+	if v.IsSetF6() != rhs.IsSetF6() {
+		return false
+	}
+	if v.IsSetF6() && !(v.F6 == rhs.F6) {
+		return false
+	}
+	if v.IsSetF7() != rhs.IsSetF7() {
+		return false
+	}
+	if v.IsSetF7() && !(v.F7 == rhs.F7) {
+		return false
+	}
+	if v.IsSetF8() != rhs.IsSetF8() {
+		return false
+	}
+	if v.IsSetF8() && !(v.F8 == rhs.F8) {
+		return false
+	}
+	if v.IsSetF9() != rhs.IsSetF9() {
+		return false
+	}
+	if v.IsSetF9() && !(v.F9 == rhs.F9) {
+		return false
+	}
+	if v.IsSetF10() != rhs.IsSetF10() {
+		return false
+	}
+	if v.IsSetF10() && !(v.F10 == rhs.F10) {
+		return false
+	}
+	if v.IsSetF11() != rhs.IsSetF11() {
+		return false
+	}
+	if v.IsSetF11() && !(v.F11 == rhs.F11) {
+		return false
+	}
+	if v.IsSetF12() != rhs.IsSetF12() {
+		return false
+	}
+	if v.IsSetF12() && !(v.F12 == rhs.F12) {
+		return false
+	}
+	if v.IsSetF13() != rhs.IsSetF13() {
+		return false
+	}
+	if v.IsSetF13() && !(v.F13 == rhs.F13) {
+		return false
+	}
+	if v.IsSetF14() != rhs.IsSetF14() {
+		return false
+	}
+	if v.IsSetF14() && !(v.F14 == rhs.F14) {
+		return false
+	}
+	if v.IsSetF15() != rhs.IsSetF15() {
+		return false
+	}
+	if v.IsSetF15() && !(v.F15 == rhs.F15) {
+		return false
+	}
+	if v.IsSetF16() != rhs.IsSetF16() {
+		return false
+	}
+	if v.IsSetF16() && !(v.F16 == rhs.F16) {
+		return false
+	}
+	if v.IsSetF17() != rhs.IsSetF17() {
+		return false
+	}
+	if v.IsSetF17() && !(v.F17 == rhs.F17) {
+		return false
+	}
+	if v.IsSetF18() != rhs.IsSetF18() {
+		return false
+	}
+	if v.IsSetF18() && !(v.F18 == rhs.F18) {
+		return false
+	}
+	if v.IsSetF19() != rhs.IsSetF19() {
+		return false
+	}
+	if v.IsSetF19() && !(v.F19 == rhs.F19) {
+		return false
+	}
+	if v.IsSetF20() != rhs.IsSetF20() {
+		return false
+	}
+	if v.IsSetF20() && !(v.F20 == rhs.F20) {
+		return false
+	}
+	if v.IsSetF21() != rhs.IsSetF21() {
+		return false
+	}
+	if v.IsSetF21() && !(v.F21 == rhs.F21) {
+		return false
+	}
+	if v.IsSetF22() != rhs.IsSetF22() {
+		return false
+	}
+	if v.IsSetF22() && !(v.F22 == rhs.F22) {
+		return false
+	}
+	if v.IsSetF23() != rhs.IsSetF23() {
+		return false
+	}
+	if v.IsSetF23() && !(v.F23 == rhs.F23) {
+		return false
+	}
+	if v.IsSetF24() != rhs.IsSetF24() {
+		return false
+	}
+	if v.IsSetF24() && !(v.F24 == rhs.F24) {
+		return false
+	}
+	if v.IsSetF25() != rhs.IsSetF25() {
+		return false
+	}
+	if v.IsSetF25() && !(v.F25 == rhs.F25) {
+		return false
+	}
+	if v.IsSetF26() != rhs.IsSetF26() {
+		return false
+	}
+	if v.IsSetF26() && !(v.F26 == rhs.F26) {
+		return false
+	}
+	if v.IsSetF27() != rhs.IsSetF27() {
+		return false
+	}
+	if v.IsSetF27() && !(v.F27 == rhs.F27) {
+		return false
+	}
+	if v.IsSetF28() != rhs.IsSetF28() {
+		return false
+	}
+	if v.IsSetF28() && !(v.F28 == rhs.F28) {
+		return false
+	}
+	if v.IsSetF29() != rhs.IsSetF29() {
+		return false
+	}
+	if v.IsSetF29() && !(v.F29 == rhs.F29) {
+		return false
+	}
+	if v.IsSetF30() != rhs.IsSetF30() {
+		return false
+	}
+	if v.IsSetF30() && !(v.F30 == rhs.F30) {
+		return false
+	}
+	if v.IsSetF31() != rhs.IsSetF31() {
+		return false
+	}
+	if v.IsSetF31() && !(v.F31 == rhs.F31) {
+		return false
+	}
+	if v.IsSetF32() != rhs.IsSetF32() {
+		return false
+	}
+	if v.IsSetF32() && !(v.F32 == rhs.F32) {
+		return false
+	}
+	if v.IsSetF33() != rhs.IsSetF33() {
+		return false
+	}
+	if v.IsSetF33() && !(v.F33 == rhs.F33) {
+		return false
+	}
+	if v.IsSetF34() != rhs.IsSetF34() {
+		return false
+	}
+	if v.IsSetF34() && !(v.F34 == rhs.F34) {
+		return false
+	}
+	if v.IsSetF35() != rhs.IsSetF35() {
+		return false
+	}
+	if v.IsSetF35() && !(v.F35 == rhs.F35) {
+		return false
+	}
+	if v.IsSetF36() != rhs.IsSetF36() {
+		return false
+	}
+	if v.IsSetF36() && !(v.F36 == rhs.F36) {
+		return false
+	}
+	if v.IsSetF37() != rhs.IsSetF37() {
+		return false
+	}
+	if v.IsSetF37() && !(v.F37 == rhs.F37) {
+		return false
+	}
+	if v.IsSetF38() != rhs.IsSetF38() {
+		return false
+	}
+	if v.IsSetF38() && !(v.F38 == rhs.F38) {
+		return false
+	}
+	if v.IsSetF39() != rhs.IsSetF39() {
+		return false
+	}
+	if v.IsSetF39() && !(v.F39 == rhs.F39) {
+		return false
+	}
+	if v.IsSetF40() != rhs.IsSetF40() {
+		return false
+	}
+	if v.IsSetF40() && !(v.F40 == rhs.F40) {
+		return false
+	}
+	if v.IsSetF41() != rhs.IsSetF41() {
+		return false
+	}
+	if v.IsSetF41() && !(v.F41 == rhs.F41) {
+		return false
+	}
+	if v.IsSetF42() != rhs.IsSetF42() {
+		return false
+	}
+	if v.IsSetF42() && !(v.F42 == rhs.F42) {
+		return false
+	}
+	if v.IsSetF43() != rhs.IsSetF43() {
+		return false
+	}
+	if v.IsSetF43() && !(v.F43 == rhs.F43) {
+		return false
+	}
+	if v.IsSetF44() != rhs.IsSetF44() {
+		return false
+	}
+	if v.IsSetF44() && !(v.F44 == rhs.F44) {
+		return false
+	}
+	if v.IsSetF45() != rhs.IsSetF45() {
+		return false
+	}
+	if v.IsSetF45() && !(v.F45 == rhs.F45) {
+		return false
+	}
+	if v.IsSetF46() != rhs.IsSetF46() {
+		return false
+	}
+	if v.IsSetF46() && !(v.F46 == rhs.F46) {
+		return false
+	}
+	if v.IsSetF47() != rhs.IsSetF47() {
+		return false
+	}
+	if v.IsSetF47() && !(v.F47 == rhs.F47) {
+		return false
+	}
+	if v.IsSetF48() != rhs.IsSetF48() {
+		return false
+	}
+	if v.IsSetF48() && !(v.F48 == rhs.F48) {
+		return false
+	}
+	if v.IsSetF49() != rhs.IsSetF49() {
+		return false
+	}
+	if v.IsSetF49() && !(v.F49 == rhs.F49) {
+		return false
+	}
+	if v.IsSetF50() != rhs.IsSetF50() {
+		return false
+	}
+	if v.IsSetF50() && !(v.F50 == rhs.F50) {
+		return false
+	}
+	if v.IsSetF51() != rhs.IsSetF51() {
+		return false
+	}
+	if v.IsSetF51() && !(v.F51 == rhs.F51) {
+		return false
+	}
+	if v.IsSetF52() != rhs.IsSetF52() {
+		return false
+	}
+	if v.IsSetF52() && !(v.F52 == rhs.F52) {
+		return false
+	}
+	if v.IsSetF53() != rhs.IsSetF53() {
+		return false
+	}
+	if v.IsSetF53() && !(v.F53 == rhs.F53) {
+		return false
+	}
+	if v.IsSetF54() != rhs.IsSetF54() {
+		return false
+	}
+	if v.IsSetF54() && !(v.F54 == rhs.F54) {
+		return false
+	}
+	if v.IsSetF55() != rhs.IsSetF55() {
+		return false
+	}
+	if v.IsSetF55() && !(v.F55 == rhs.F55) {
+		return false
+	}
+	if v.IsSetF56() != rhs.IsSetF56() {
+		return false
+	}
+	if v.IsSetF56() && !(v.F56 == rhs.F56) {
+		return false
+	}
+	if v.IsSetF57() != rhs.IsSetF57() {
+		return false
+	}
+	if v.IsSetF57() && !(v.F57 == rhs.F57) {
+		return false
+	}
+	if v.IsSetF58() != rhs.IsSetF58() {
+		return false
+	}
+	if v.IsSetF58() && !(v.F58 == rhs.F58) {
+		return false
+	}
+	if v.IsSetF59() != rhs.IsSetF59() {
+		return false
+	}
+	if v.IsSetF59() && !(v.F59 == rhs.F59) {
+		return false
+	}
+	if v.IsSetF60() != rhs.IsSetF60() {
+		return false
+	}
+	if v.IsSetF60() && !(v.F60 == rhs.F60) {
+		return false
+	}
+	if v.IsSetF61() != rhs.IsSetF61() {
+		return false
+	}
+	if v.IsSetF61() && !(v.F61 == rhs.F61) {
+		return false
+	}
+	if v.IsSetF62() != rhs.IsSetF62() {
+		return false
+	}
+	if v.IsSetF62() && !(v.F62 == rhs.F62) {
+		return false
+	}
+	if v.IsSetF63() != rhs.IsSetF63() {
+		return false
+	}
+	if v.IsSetF63() && !(v.F63 == rhs.F63) {
+		return false
+	}
+	if v.IsSetF64() != rhs.IsSetF64() {
+		return false
+	}
+	if v.IsSetF64() && !(v.F64 == rhs.F64) {
+		return false
+	}
+	if v.IsSetF65() != rhs.IsSetF65() {
+		return false
+	}
+	if v.IsSetF65() && !(v.F65 == rhs.F65) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of ManySettings.
+func (v *ManySettings) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.IsSetF1() {
+		enc.AddInt32("f1", v.F1)
+	}
+	if v.IsSetF2() {
+		enc.AddInt32("f2", v.F2)
+	}
+	if v.IsSetF3() {
+		enc.AddInt32("f3", v.F3)
+	}
+	if v.IsSetF4() {
+		enc.AddInt32("f4", v.F4)
+	}
+	if v.IsSetF5() {
+		enc.AddInt32("f5", v.F5)
+	}
+	if v.IsSetF6() {
+		enc.AddInt32("f6", v.F6)
+	}
+	if v.IsSetF7() {
+		enc.AddInt32("f7", v.F7)
+	}
+	if v.IsSetF8() {
+		enc.AddInt32("f8", v.F8)
+	}
+	if v.IsSetF9() {
+		enc.AddInt32("f9", v.F9)
+	}
+	if v.IsSetF10() {
+		enc.AddInt32("f10", v.F10)
+	}
+	if v.IsSetF11() {
+		enc.AddInt32("f11", v.F11)
+	}
+	if v.IsSetF12() {
+		enc.AddInt32("f12", v.F12)
+	}
+	if v.IsSetF13() {
+		enc.AddInt32("f13", v.F13)
+	}
+	if v.IsSetF14() {
+		enc.AddInt32("f14", v.F14)
+	}
+	if v.IsSetF15() {
+		enc.AddInt32("f15", v.F15)
+	}
+	if v.IsSetF16() {
+		enc.AddInt32("f16", v.F16)
+	}
+	if v.IsSetF17() {
+		enc.AddInt32("f17", v.F17)
+	}
+	if v.IsSetF18() {
+		enc.AddInt32("f18", v.F18)
+	}
+	if v.IsSetF19() {
+		enc.AddInt32("f19", v.F19)
+	}
+	if v.IsSetF20() {
+		enc.AddInt32("f20", v.F20)
+	}
+	if v.IsSetF21() {
+		enc.AddInt32("f21", v.F21)
+	}
+	if v.IsSetF22() {
+		enc.AddInt32("f22", v.F22)
+	}
+	if v.IsSetF23() {
+		enc.AddInt32("f23", v.F23)
+	}
+	if v.IsSetF24() {
+		enc.AddInt32("f24", v.F24)
+	}
+	if v.IsSetF25() {
+		enc.AddInt32("f25", v.F25)
+	}
+	if v.IsSetF26() {
+		enc.AddInt32("f26", v.F26)
+	}
+	if v.IsSetF27() {
+		enc.AddInt32("f27", v.F27)
+	}
+	if v.IsSetF28() {
+		enc.AddInt32("f28", v.F28)
+	}
+	if v.IsSetF29() {
+		enc.AddInt32("f29", v.F29)
+	}
+	if v.IsSetF30() {
+		enc.AddInt32("f30", v.F30)
+	}
+	if v.IsSetF31() {
+		enc.AddInt32("f31", v.F31)
+	}
+	if v.IsSetF32() {
+		enc.AddInt32("f32", v.F32)
+	}
+	if v.IsSetF33() {
+		enc.AddInt32("f33", v.F33)
+	}
+	if v.IsSetF34() {
+		enc.AddInt32("f34", v.F34)
+	}
+	if v.IsSetF35() {
+		enc.AddInt32("f35", v.F35)
+	}
+	if v.IsSetF36() {
+		enc.AddInt32("f36", v.F36)
+	}
+	if v.IsSetF37() {
+		enc.AddInt32("f37", v.F37)
+	}
+	if v.IsSetF38() {
+		enc.AddInt32("f38", v.F38)
+	}
+	if v.IsSetF39() {
+		enc.AddInt32("f39", v.F39)
+	}
+	if v.IsSetF40() {
+		enc.AddInt32("f40", v.F40)
+	}
+	if v.IsSetF41() {
+		enc.AddInt32("f41", v.F41)
+	}
+	if v.IsSetF42() {
+		enc.AddInt32("f42", v.F42)
+	}
+	if v.IsSetF43() {
+		enc.AddInt32("f43", v.F43)
+	}
+	if v.IsSetF44() {
+		enc.AddInt32("f44", v.F44)
+	}
+	if v.IsSetF45() {
+		enc.AddInt32("f45", v.F45)
+	}
+	if v.IsSetF46() {
+		enc.AddInt32("f46", v.F46)
+	}
+	if v.IsSetF47() {
+		enc.AddInt32("f47", v.F47)
+	}
+	if v.IsSetF48() {
+		enc.AddInt32("f48", v.F48)
+	}
+	if v.IsSetF49() {
+		enc.AddInt32("f49", v.F49)
+	}
+	if v.IsSetF50() {
+		enc.AddInt32("f50", v.F50)
+	}
+	if v.IsSetF51() {
+		enc.AddInt32("f51", v.F51)
+	}
+	if v.IsSetF52() {
+		enc.AddInt32("f52", v.F52)
+	}
+	if v.IsSetF53() {
+		enc.AddInt32("f53", v.F53)
+	}
+	if v.IsSetF54() {
+		enc.AddInt32("f54", v.F54)
+	}
+	if v.IsSetF55() {
+		enc.AddInt32("f55", v.F55)
+	}
+	if v.IsSetF56() {
+		enc.AddInt32("f56", v.F56)
+	}
+	if v.IsSetF57() {
+		enc.AddInt32("f57", v.F57)
+	}
+	if v.IsSetF58() {
+		enc.AddInt32("f58", v.F58)
+	}
+	if v.IsSetF59() {
+		enc.AddInt32("f59", v.F59)
+	}
+	if v.IsSetF60() {
+		enc.AddInt32("f60", v.F60)
+	}
+	if v.IsSetF61() {
+		enc.AddInt32("f61", v.F61)
+	}
+	if v.IsSetF62() {
+		enc.AddInt32("f62", v.F62)
+	}
+	if v.IsSetF63() {
+		enc.AddInt32("f63", v.F63)
+	}
+	if v.IsSetF64() {
+		enc.AddInt32("f64", v.F64)
+	}
+	if v.IsSetF65() {
+		enc.AddInt32("f65", v.F65)
+	}
+	return err
+}
+
+// GetF1 returns the value of F1 if it is set or its
+// zero value if it is unset.
+func (v *ManySettings) GetF1() (o int32) {
+	if v.IsSetF1() {
+		return v.F1
+	}
+
+	return
+}
+
+// IsSetF1 returns true if F1 is set.
+func (v *ManySettings) IsSetF1() bool {
+	return v != nil && v.presence[0]&0x1 != 0
+}
+
+// SetF1 sets the value of F1 and marks it as set.
+func (v *ManySettings) SetF1(x int32) {
+	v.F1 = x
+	v.presence[0] |= 0x1
+}
+
+// ClearF1 unsets F1 and resets it to its zero value.
+func (v *ManySettings) ClearF1() {
+	var x int32
+	v.F1 = x
+	v.presence[0] &^= 0x1
+}
+
+// GetF2 returns the value of F2 if it is set or its
+// zero value if it is unset.
+func (v *ManySettings) GetF2() (o int32) {
+	if v.IsSetF2() {
+		return v.F2
+	}
+
+	return
+}
+
+// IsSetF2 returns true if F2 is set.
+func (v *ManySettings) IsSetF2() bool {
+	return v != nil && v.presence[0]&0x2 != 0
+}
+
+// SetF2 sets the value of F2 and marks it as set.
+func (v *ManySettings) SetF2(x2 int32) {
+	v.F2 = x2
+	v.presence[0] |= 0x2
+}
+
+// ClearF2 unsets F2 and resets it to its zero value.
+func (v *ManySettings) ClearF2() {
+	var x2 int32
+	v.F2 = x2
+	v.presence[0] &^= 0x2
+}
+
+// GetF3 returns the value of F3 if it is set or its
+// zero value if it is unset.
+func (v *ManySettings) GetF3() (o int32) {
+	if v.IsSetF3() {
+		return v.F3
+	}
+
+	return
+}
+
+// IsSetF3 returns true if F3 is set.
+func (v *ManySettings) IsSetF3() bool {
+	return v != nil && v.presence[0]&0x4 != 0
+}
+
+// SetF3 sets the value of F3 and marks it as set.
+func (v *ManySettings) SetF3(x3 int32) {
+	v.F3 = x3
+	v.presence[0] |= 0x4
+}
+
+// ClearF3 unsets F3 and resets it to its zero value.
+func (v *ManySettings) ClearF3() {
+	var x3 int32
+	v.F3 = x3
+	v.presence[0] &^= 0x4
+}
+
+// GetF4 returns the value of F4 if it is set or its
+// zero value if it is unset.
+func (v *ManySettings) GetF4() (o int32) {
+	if v.IsSetF4() {
+		return v.F4
+	}
+
+	return
+}
+
+// IsSetF4 returns true if F4 is set.
+func (v *ManySettings) IsSetF4() bool {
+	return v != nil && v.presence[0]&0x8 != 0
+}
+
+// SetF4 sets the value of F4 and marks it as set.
+func (v *ManySettings) SetF4(x4 int32) {
+	v.F4 = x4
+	v.presence[0] |= 0x8
+}
+
+// ClearF4 unsets F4 and resets it to its zero value.
+func (v *ManySettings) ClearF4() {
+	var x4 int32
+	v.F4 = x4
+	v.presence[0] &^= 0x8
+}
+
+// GetF5 returns the value of F5 if it is set or its
+// zero value if it is unset.
+func (v *ManySettings) GetF5() (o int32) {
+	if v.IsSetF5() {
+		return v.F5
+	}
+
+	return
+}
+
+// IsSetF5 returns true if F5 is set.
+func (v *ManySettings) IsSetF5() bool {
+	return v != nil && v.presence[0]&0x10 != 0
+}
+
+// SetF5 sets the value of F5 and marks it as set.
+func (v *ManySettings) SetF5(x5 int32) {
+	v.F5 = x5
+	v.presence[0] |= 0x10
+}
+
+// ClearF5 unsets F5 and resets it to its zero value.
+func (v *ManySettings) ClearF5() {
+	var x5 int32
+	v.F5 = x5
+	v.presence[0] &^= 0x10
+}
+
+// GetF6 returns the value of F6 if it is set or its
+// zero value if it is unset.
+func (v *ManySettings) GetF6() (o int32) {
+	if v.IsSetF6() {
+		return v.F6
+	}
+
+	return
+}
+
+// IsSetF6 returns true if F6 is set.
+func (v *ManySettings) IsSetF6() bool {
+	return v != nil && v.presence[0]&0x20 != 0
+}
+
+// SetF6 sets the value of F6 and marks it as set.
+func (v *ManySettings) SetF6(x6 int32) {
+	v.F6 = x6
+	v.presence[0] |= 0x20
+}
+
+// ClearF6 unsets F6 and resets it to its zero value.
+func (v *ManySettings) ClearF6() {
+	var x6 int32
+	v.F6 = x6
+	v.presence[0] &^= 0x20
+}
+
+// GetF7 returns the value of F7 if it is set or its
+// zero value if it is unset.
+func (v *ManySettings) GetF7() (o int32) {
+	if v.IsSetF7() {
+		return v.F7
+	}
+
+	return
+}
+
+// IsSetF7 returns true if F7 is set.
+func (v *ManySettings) IsSetF7() bool {
+	return v != nil && v.presence[0]&0x40 != 0
+}
+
+// SetF7 sets the value of F7 and marks it as set.
+func (v *ManySettings) SetF7(x7 int32) {
+	v.F7 = x7
+	v.presence[0] |= 0x40
+}
+
+// ClearF7 unsets F7 and resets it to its zero value.
+func (v *ManySettings) ClearF7() {
+	var x7 int32
+	v.F7 = x7
+	v.presence[0] &^= 0x40
+}
+
+// GetF8 returns the value of F8 if it is set or its
+// zero value if it is unset.
+func (v *ManySettings) GetF8() (o int32) {
+	if v.IsSetF8() {
+		return v.F8
+	}
+
+	return
+}
+
+// IsSetF8 returns true if F8 is set.
+func (v *ManySettings) IsSetF8() bool {
+	return v != nil && v.presence[0]&0x80 != 0
+}
+
+// SetF8 sets the value of F8 and marks it as set.
+func (v *ManySettings) SetF8(x8 int32) {
+	v.F8 = x8
+	v.presence[0] |= 0x80
+}
+
+// ClearF8 unsets F8 and resets it to its zero value.
+func (v *ManySettings) ClearF8() {
+	var x8 int32
+	v.F8 = x8
+	v.presence[0] &^= 0x80
+}
+
+// GetF9 returns the value of F9 if it is set or its
+// zero value if it is unset.
+func (v *ManySettings) GetF9() (o int32) {
+	if v.IsSetF9() {
+		return v.F9
+	}
+
+	return
+}
+
+// IsSetF9 returns true if F9 is set.
+func (v *ManySettings) IsSetF9() bool {
+	return v != nil && v.presence[0]&0x100 != 0
+}
+
+// SetF9 sets the value of F9 and marks it as set.
+func (v *ManySettings) SetF9(x9 int32) {
+	v.F9 = x9
+	v.presence[0] |= 0x100
+}
+
+// ClearF9 unsets F9 and resets it to its zero value.
+func (v *ManySettings) ClearF9() {
+	var x9 int32
+	v.F9 = x9
+	v.presence[0] &^= 0x100
+}
+
+// GetF10 returns the value of F10 if it is set or its
+// zero value if it is unset.
+func (v *ManySettings) GetF10() (o int32) {
+	if v.IsSetF10() {
+		return v.F10
+	}
+
+	return
+}
+
+// IsSetF10 returns true if F10 is set.
+func (v *ManySettings) IsSetF10() bool {
+	return v != nil && v.presence[0]&0x200 != 0
+}
+
+// SetF10 sets the value of F10 and marks it as set.
+func (v *ManySettings) SetF10(x10 int32) {
+	v.F10 = x10
+	v.presence[0] |= 0x200
+}
+
+// ClearF10 unsets F10 and resets it to its zero value.
+func (v *ManySettings) ClearF10() {
+	var x10 int32
+	v.F10 = x10
+	v.presence[0] &^= 0x200
+}
+
+// GetF11 returns the value of F11 if it is set or its
+// zero value if it is unset.
+func (v *ManySettings) GetF11() (o int32) {
+	if v.IsSetF11() {
+		return v.F11
+	}
+
+	return
+}
+
+// IsSetF11 returns true if F11 is set.
+func (v *ManySettings) IsSetF11() bool {
+	return v != nil && v.presence[0]&0x400 != 0
+}
+
+// SetF11 sets the value of F11 and marks it as set.
+func (v *ManySettings) SetF11(x11 int32) {
+	v.F11 = x11
+	v.presence[0] |= 0x400
+}
+
+// ClearF11 unsets F11 and resets it to its zero value.
+func (v *ManySettings) ClearF11() {
+	var x11 int32
+	v.F11 = x11
+	v.presence[0] &^= 0x400
+}
+
+// GetF12 returns the value of F12 if it is set or its
+// zero value if it is unset.
+func (v *ManySettings) GetF12() (o int32) {
+	if v.IsSetF12() {
+		return v.F12
+	}
+
+	return
+}
+
+// IsSetF12 returns true if F12 is set.
+func (v *ManySettings) IsSetF12() bool {
+	return v != nil && v.presence[0]&0x800 != 0
+}
+
+// SetF12 sets the value of F12 and marks it as set.
+func (v *ManySettings) SetF12(x12 int32) {
+	v.F12 = x12
+	v.presence[0] |= 0x800
+}
+
+// ClearF12 unsets F12 and resets it to its zero value.
+func (v *ManySettings) ClearF12() {
+	var x12 int32
+	v.F12 = x12
+	v.presence[0] &^= 0x800
+}
+
+// GetF13 returns the value of F13 if it is set or its
+// zero value if it is unset.
+func (v *ManySettings) GetF13() (o int32) {
+	if v.IsSetF13() {
+		return v.F13
+	}
+
+	return
+}
+
+// IsSetF13 returns true if F13 is set.
+func (v *ManySettings) IsSetF13() bool {
+	return v != nil && v.presence[0]&0x1000 != 0
+}
+
+// SetF13 sets the value of F13 and marks it as set.
+func (v *ManySettings) SetF13(x13 int32) {
+	v.F13 = x13
+	v.presence[0] |= 0x1000
+}
+
+// ClearF13 unsets F13 and resets it to its zero value.
+func (v *ManySettings) ClearF13() {
+	var x13 int32
+	v.F13 = x13
+	v.presence[0] &^= 0x1000
+}
+
+// GetF14 returns the value of F14 if it is set or its
+// zero value if it is unset.
+func (v *ManySettings) GetF14() (o int32) {
+	if v.IsSetF14() {
+		return v.F14
+	}
+
+	return
+}
+
+// IsSetF14 returns true if F14 is set.
+func (v *ManySettings) IsSetF14() bool {
+	return v != nil && v.presence[0]&0x2000 != 0
+}
+
+// SetF14 sets the value of F14 and marks it as set.
+func (v *ManySettings) SetF14(x14 int32) {
+	v.F14 = x14
+	v.presence[0] |= 0x2000
+}
+
+// ClearF14 unsets F14 and resets it to its zero value.
+func (v *ManySettings) ClearF14() {
+	var x14 int32
+	v.F14 = x14
+	v.presence[0] &^= 0x2000
+}
+
+// GetF15 returns the value of F15 if it is set or its
+// zero value if it is unset.
+func (v *ManySettings) GetF15() (o int32) {
+	if v.IsSetF15() {
+		return v.F15
+	}
+
+	return
+}
+
+// IsSetF15 returns true if F15 is set.
+func (v *ManySettings) IsSetF15() bool {
+	return v != nil && v.presence[0]&0x4000 != 0
+}
+
+// SetF15 sets the value of F15 and marks it as set.
+func (v *ManySettings) SetF15(x15 int32) {
+	v.F15 = x15
+	v.presence[0] |= 0x4000
+}
+
+// ClearF15 unsets F15 and resets it to its zero value.
+func (v *ManySettings) ClearF15() {
+	var x15 int32
+	v.F15 = x15
+	v.presence[0] &^= 0x4000
+}
+
+// GetF16 returns the value of F16 if it is set or its
+// zero value if it is unset.
+func (v *ManySettings) GetF16() (o int32) {
+	if v.IsSetF16() {
+		return v.F16
+	}
+
+	return
+}
+
+// IsSetF16 returns true if F16 is set.
+func (v *ManySettings) IsSetF16() bool {
+	return v != nil && v.presence[0]&0x8000 != 0
+}
+
+// SetF16 sets the value of F16 and marks it as set.
+func (v *ManySettings) SetF16(x16 int32) {
+	v.F16 = x16
+	v.presence[0] |= 0x8000
+}
+
+// ClearF16 unsets F16 and resets it to its zero value.
+func (v *ManySettings) ClearF16() {
+	var x16 int32
+	v.F16 = x16
+	v.presence[0] &^= 0x8000
+}
+
+// GetF17 returns the value of F17 if it is set or its
+// zero value if it is unset.
+func (v *ManySettings) GetF17() (o int32) {
+	if v.IsSetF17() {
+		return v.F17
+	}
+
+	return
+}
+
+// IsSetF17 returns true if F17 is set.
+func (v *ManySettings) IsSetF17() bool {
+	return v != nil && v.presence[0]&0x10000 != 0
+}
+
+// SetF17 sets the value of F17 and marks it as set.
+func (v *ManySettings) SetF17(x17 int32) {
+	v.F17 = x17
+	v.presence[0] |= 0x10000
+}
+
+// ClearF17 unsets F17 and resets it to its zero value.
+func (v *ManySettings) ClearF17() {
+	var x17 int32
+	v.F17 = x17
+	v.presence[0] &^= 0x10000
+}
+
+// GetF18 returns the value of F18 if it is set or its
+// zero value if it is unset.
+func (v *ManySettings) GetF18() (o int32) {
+	if v.IsSetF18() {
+		return v.F18
+	}
+
+	return
+}
+
+// IsSetF18 returns true if F18 is set.
+func (v *ManySettings) IsSetF18() bool {
+	return v != nil && v.presence[0]&0x20000 != 0
+}
+
+// SetF18 sets the value of F18 and marks it as set.
+func (v *ManySettings) SetF18(x18 int32) {
+	v.F18 = x18
+	v.presence[0] |= 0x20000
+}
+
+// ClearF18 unsets F18 and resets it to its zero value.
+func (v *ManySettings) ClearF18() {
+	var x18 int32
+	v.F18 = x18
+	v.presence[0] &^= 0x20000
+}
+
+// GetF19 returns the value of F19 if it is set or its
+// zero value if it is unset.
+func (v *ManySettings) GetF19() (o int32) {
+	if v.IsSetF19() {
+		return v.F19
+	}
+
+	return
+}
+
+// IsSetF19 returns true if F19 is set.
+func (v *ManySettings) IsSetF19() bool {
+	return v != nil && v.presence[0]&0x40000 != 0
+}
+
+// SetF19 sets the value of F19 and marks it as set.
+func (v *ManySettings) SetF19(x19 int32) {
+	v.F19 = x19
+	v.presence[0] |= 0x40000
+}
+
+// ClearF19 unsets F19 and resets it to its zero value.
+func (v *ManySettings) ClearF19() {
+	var x19 int32
+	v.F19 = x19
+	v.presence[0] &^= 0x40000
+}
+
+// GetF20 returns the value of F20 if it is set or its
+// zero value if it is unset.
+func (v *ManySettings) GetF20() (o int32) {
+	if v.IsSetF20() {
+		return v.F20
+	}
+
+	return
+}
+
+// IsSetF20 returns true if F20 is set.
+func (v *ManySettings) IsSetF20() bool {
+	return v != nil && v.presence[0]&0x80000 != 0
+}
+
+// SetF20 sets the value of F20 and marks it as set.
+func (v *ManySettings) SetF20(x20 int32) {
+	v.F20 = x20
+	v.presence[0] |= 0x80000
+}
+
+// ClearF20 unsets F20 and resets it to its zero value.
+func (v *ManySettings) ClearF20() {
+	var x20 int32
+	v.F20 = x20
+	v.presence[0] &^= 0x80000
+}
+
+// GetF21 returns the value of F21 if it is set or its
+// zero value if it is unset.
+func (v *ManySettings) GetF21() (o int32) {
+	if v.IsSetF21() {
+		return v.F21
+	}
+
+	return
+}
+
+// IsSetF21 returns true if F21 is set.
+func (v *ManySettings) IsSetF21() bool {
+	return v != nil && v.presence[0]&0x100000 != 0
+}
+
+// SetF21 sets the value of F21 and marks it as set.
+func (v *ManySettings) SetF21(x21 int32) {
+	v.F21 = x21
+	v.presence[0] |= 0x100000
+}
+
+// ClearF21 unsets F21 and resets it to its zero value.
+func (v *ManySettings) ClearF21() {
+	var x21 int32
+	v.F21 = x21
+	v.presence[0] &^= 0x100000
+}
+
+// GetF22 returns the value of F22 if it is set or its
+// zero value if it is unset.
+func (v *ManySettings) GetF22() (o int32) {
+	if v.IsSetF22() {
+		return v.F22
+	}
+
+	return
+}
+
+// IsSetF22 returns true if F22 is set.
+func (v *ManySettings) IsSetF22() bool {
+	return v != nil && v.presence[0]&0x200000 != 0
+}
+
+// SetF22 sets the value of F22 and marks it as set.
+func (v *ManySettings) SetF22(x22 int32) {
+	v.F22 = x22
+	v.presence[0] |= 0x200000
+}
+
+// ClearF22 unsets F22 and resets it to its zero value.
+func (v *ManySettings) ClearF22() {
+	var x22 int32
+	v.F22 = x22
+	v.presence[0] &^= 0x200000
+}
+
+// GetF23 returns the value of F23 if it is set or its
+// zero value if it is unset.
+func (v *ManySettings) GetF23() (o int32) {
+	if v.IsSetF23() {
+		return v.F23
+	}
+
+	return
+}
+
+// IsSetF23 returns true if F23 is set.
+func (v *ManySettings) IsSetF23() bool {
+	return v != nil && v.presence[0]&0x400000 != 0
+}
+
+// SetF23 sets the value of F23 and marks it as set.
+func (v *ManySettings) SetF23(x23 int32) {
+	v.F23 = x23
+	v.presence[0] |= 0x400000
+}
+
+// ClearF23 unsets F23 and resets it to its zero value.
+func (v *ManySettings) ClearF23() {
+	var x23 int32
+	v.F23 = x23
+	v.presence[0] &^= 0x400000
+}
+
+// GetF24 returns the value of F24 if it is set or its
+// zero value if it is unset.
+func (v *ManySettings) GetF24() (o int32) {
+	if v.IsSetF24() {
+		return v.F24
+	}
+
+	return
+}
+
+// IsSetF24 returns true if F24 is set.
+func (v *ManySettings) IsSetF24() bool {
+	return v != nil && v.presence[0]&0x800000 != 0
+}
+
+// SetF24 sets the value of F24 and marks it as set.
+func (v *ManySettings) SetF24(x24 int32) {
+	v.F24 = x24
+	v.presence[0] |= 0x800000
+}
+
+// ClearF24 unsets F24 and resets it to its zero value.
+func (v *ManySettings) ClearF24() {
+	var x24 int32
+	v.F24 = x24
+	v.presence[0] &^= 0x800000
+}
+
+// GetF25 returns the value of F25 if it is set or its
+// zero value if it is unset.
+func (v *ManySettings) GetF25() (o int32) {
+	if v.IsSetF25() {
+		return v.F25
+	}
+
+	return
+}
+
+// IsSetF25 returns true if F25 is set.
+func (v *ManySettings) IsSetF25() bool {
+	return v != nil && v.presence[0]&0x1000000 != 0
+}
+
+// SetF25 sets the value of F25 and marks it as set.
+func (v *ManySettings) SetF25(x25 int32) {
+	v.F25 = x25
+	v.presence[0] |= 0x1000000
+}
+
+// ClearF25 unsets F25 and resets it to its zero value.
+func (v *ManySettings) ClearF25() {
+	var x25 int32
+	v.F25 = x25
+	v.presence[0] &^= 0x1000000
+}
+
+// GetF26 returns the value of F26 if it is set or its
+// zero value if it is unset.
+func (v *ManySettings) GetF26() (o int32) {
+	if v.IsSetF26() {
+		return v.F26
+	}
+
+	return
+}
+
+// IsSetF26 returns true if F26 is set.
+func (v *ManySettings) IsSetF26() bool {
+	return v != nil && v.presence[0]&0x2000000 != 0
+}
+
+// SetF26 sets the value of F26 and marks it as set.
+func (v *ManySettings) SetF26(x26 int32) {
+	v.F26 = x26
+	v.presence[0] |= 0x2000000
+}
+
+// ClearF26 unsets F26 and resets it to its zero value.
+func (v *ManySettings) ClearF26() {
+	var x26 int32
+	v.F26 = x26
+	v.presence[0] &^= 0x2000000
+}
+
+// GetF27 returns the value of F27 if it is set or its
+// zero value if it is unset.
+func (v *ManySettings) GetF27() (o int32) {
+	if v.IsSetF27() {
+		return v.F27
+	}
+
+	return
+}
+
+// IsSetF27 returns true if F27 is set.
+func (v *ManySettings) IsSetF27() bool {
+	return v != nil && v.presence[0]&0x4000000 != 0
+}
+
+// SetF27 sets the value of F27 and marks it as set.
+func (v *ManySettings) SetF27(x27 int32) {
+	v.F27 = x27
+	v.presence[0] |= 0x4000000
+}
+
+// ClearF27 unsets F27 and resets it to its zero value.
+func (v *ManySettings) ClearF27() {
+	var x27 int32
+	v.F27 = x27
+	v.presence[0] &^= 0x4000000
+}
+
+// GetF28 returns the value of F28 if it is set or its
+// zero value if it is unset.
+func (v *ManySettings) GetF28() (o int32) {
+	if v.IsSetF28() {
+		return v.F28
+	}
+
+	return
+}
+
+// IsSetF28 returns true if F28 is set.
+func (v *ManySettings) IsSetF28() bool {
+	return v != nil && v.presence[0]&0x8000000 != 0
+}
+
+// SetF28 sets the value of F28 and marks it as set.
+func (v *ManySettings) SetF28(x28 int32) {
+	v.F28 = x28
+	v.presence[0] |= 0x8000000
+}
+
+// ClearF28 unsets F28 and resets it to its zero value.
+func (v *ManySettings) ClearF28() {
+	var x28 int32
+	v.F28 = x28
+	v.presence[0] &^= 0x8000000
+}
+
+// GetF29 returns the value of F29 if it is set or its
+// zero value if it is unset.
+func (v *ManySettings) GetF29() (o int32) {
+	if v.IsSetF29() {
+		return v.F29
+	}
+
+	return
+}
+
+// IsSetF29 returns true if F29 is set.
+func (v *ManySettings) IsSetF29() bool {
+	return v != nil && v.presence[0]&0x10000000 != 0
+}
+
+// SetF29 sets the value of F29 and marks it as set.
+func (v *ManySettings) SetF29(x29 int32) {
+	v.F29 = x29
+	v.presence[0] |= 0x10000000
+}
+
+// ClearF29 unsets F29 and resets it to its zero value.
+func (v *ManySettings) ClearF29() {
+	var x29 int32
+	v.F29 = x29
+	v.presence[0] &^= 0x10000000
+}
+
+// GetF30 returns the value of F30 if it is set or its
+// zero value if it is unset.
+func (v *ManySettings) GetF30() (o int32) {
+	if v.IsSetF30() {
+		return v.F30
+	}
+
+	return
+}
+
+// IsSetF30 returns true if F30 is set.
+func (v *ManySettings) IsSetF30() bool {
+	return v != nil && v.presence[0]&0x20000000 != 0
+}
+
+// SetF30 sets the value of F30 and marks it as set.
+func (v *ManySettings) SetF30(x30 int32) {
+	v.F30 = x30
+	v.presence[0] |= 0x20000000
+}
+
+// ClearF30 unsets F30 and resets it to its zero value.
+func (v *ManySettings) ClearF30() {
+	var x30 int32
+	v.F30 = x30
+	v.presence[0] &^= 0x20000000
+}
+
+// GetF31 returns the value of F31 if it is set or its
+// zero value if it is unset.
+func (v *ManySettings) GetF31() (o int32) {
+	if v.IsSetF31() {
+		return v.F31
+	}
+
+	return
+}
+
+// IsSetF31 returns true if F31 is set.
+func (v *ManySettings) IsSetF31() bool {
+	return v != nil && v.presence[0]&0x40000000 != 0
+}
+
+// SetF31 sets the value of F31 and marks it as set.
+func (v *ManySettings) SetF31(x31 int32) {
+	v.F31 = x31
+	v.presence[0] |= 0x40000000
+}
+
+// ClearF31 unsets F31 and resets it to its zero value.
+func (v *ManySettings) ClearF31() {
+	var x31 int32
+	v.F31 = x31
+	v.presence[0] &^= 0x40000000
+}
+
+// GetF32 returns the value of F32 if it is set or its
+// zero value if it is unset.
+func (v *ManySettings) GetF32() (o int32) {
+	if v.IsSetF32() {
+		return v.F32
+	}
+
+	return
+}
+
+// IsSetF32 returns true if F32 is set.
+func (v *ManySettings) IsSetF32() bool {
+	return v != nil && v.presence[0]&0x80000000 != 0
+}
+
+// SetF32 sets the value of F32 and marks it as set.
+func (v *ManySettings) SetF32(x32 int32) {
+	v.F32 = x32
+	v.presence[0] |= 0x80000000
+}
+
+// ClearF32 unsets F32 and resets it to its zero value.
+func (v *ManySettings) ClearF32() {
+	var x32 int32
+	v.F32 = x32
+	v.presence[0] &^= 0x80000000
+}
+
+// GetF33 returns the value of F33 if it is set or its
+// zero value if it is unset.
+func (v *ManySettings) GetF33() (o int32) {
+	if v.IsSetF33() {
+		return v.F33
+	}
+
+	return
+}
+
+// IsSetF33 returns true if F33 is set.
+func (v *ManySettings) IsSetF33() bool {
+	return v != nil && v.presence[0]&0x100000000 != 0
+}
+
+// SetF33 sets the value of F33 and marks it as set.
+func (v *ManySettings) SetF33(x33 int32) {
+	v.F33 = x33
+	v.presence[0] |= 0x100000000
+}
+
+// ClearF33 unsets F33 and resets it to its zero value.
+func (v *ManySettings) ClearF33() {
+	var x33 int32
+	v.F33 = x33
+	v.presence[0] &^= 0x100000000
+}
+
+// GetF34 returns the value of F34 if it is set or its
+// zero value if it is unset.
+func (v *ManySettings) GetF34() (o int32) {
+	if v.IsSetF34() {
+		return v.F34
+	}
+
+	return
+}
+
+// IsSetF34 returns true if F34 is set.
+func (v *ManySettings) IsSetF34() bool {
+	return v != nil && v.presence[0]&0x200000000 != 0
+}
+
+// SetF34 sets the value of F34 and marks it as set.
+func (v *ManySettings) SetF34(x34 int32) {
+	v.F34 = x34
+	v.presence[0] |= 0x200000000
+}
+
+// ClearF34 unsets F34 and resets it to its zero value.
+func (v *ManySettings) ClearF34() {
+	var x34 int32
+	v.F34 = x34
+	v.presence[0] &^= 0x200000000
+}
+
+// GetF35 returns the value of F35 if it is set or its
+// zero value if it is unset.
+func (v *ManySettings) GetF35() (o int32) {
+	if v.IsSetF35() {
+		return v.F35
+	}
+
+	return
+}
+
+// IsSetF35 returns true if F35 is set.
+func (v *ManySettings) IsSetF35() bool {
+	return v != nil && v.presence[0]&0x400000000 != 0
+}
+
+// SetF35 sets the value of F35 and marks it as set.
+func (v *ManySettings) SetF35(x35 int32) {
+	v.F35 = x35
+	v.presence[0] |= 0x400000000
+}
+
+// ClearF35 unsets F35 and resets it to its zero value.
+func (v *ManySettings) ClearF35() {
+	var x35 int32
+	v.F35 = x35
+	v.presence[0] &^= 0x400000000
+}
+
+// GetF36 returns the value of F36 if it is set or its
+// zero value if it is unset.
+func (v *ManySettings) GetF36() (o int32) {
+	if v.IsSetF36() {
+		return v.F36
+	}
+
+	return
+}
+
+// IsSetF36 returns true if F36 is set.
+func (v *ManySettings) IsSetF36() bool {
+	return v != nil && v.presence[0]&0x800000000 != 0
+}
+
+// SetF36 sets the value of F36 and marks it as set.
+func (v *ManySettings) SetF36(x36 int32) {
+	v.F36 = x36
+	v.presence[0] |= 0x800000000
+}
+
+// ClearF36 unsets F36 and resets it to its zero value.
+func (v *ManySettings) ClearF36() {
+	var x36 int32
+	v.F36 = x36
+	v.presence[0] &^= 0x800000000
+}
+
+// GetF37 returns the value of F37 if it is set or its
+// zero value if it is unset.
+func (v *ManySettings) GetF37() (o int32) {
+	if v.IsSetF37() {
+		return v.F37
+	}
+
+	return
+}
+
+// IsSetF37 returns true if F37 is set.
+func (v *ManySettings) IsSetF37() bool {
+	return v != nil && v.presence[0]&0x1000000000 != 0
+}
+
+// SetF37 sets the value of F37 and marks it as set.
+func (v *ManySettings) SetF37(x37 int32) {
+	v.F37 = x37
+	v.presence[0] |= 0x1000000000
+}
+
+// ClearF37 unsets F37 and resets it to its zero value.
+func (v *ManySettings) ClearF37() {
+	var x37 int32
+	v.F37 = x37
+	v.presence[0] &^= 0x1000000000
+}
+
+// GetF38 returns the value of F38 if it is set or its
+// zero value if it is unset.
+func (v *ManySettings) GetF38() (o int32) {
+	if v.IsSetF38() {
+		return v.F38
+	}
+
+	return
+}
+
+// IsSetF38 returns true if F38 is set.
+func (v *ManySettings) IsSetF38() bool {
+	return v != nil && v.presence[0]&0x2000000000 != 0
+}
+
+// SetF38 sets the value of F38 and marks it as set.
+func (v *ManySettings) SetF38(x38 int32) {
+	v.F38 = x38
+	v.presence[0] |= 0x2000000000
+}
+
+// ClearF38 unsets F38 and resets it to its zero value.
+func (v *ManySettings) ClearF38() {
+	var x38 int32
+	v.F38 = x38
+	v.presence[0] &^= 0x2000000000
+}
+
+// GetF39 returns the value of F39 if it is set or its
+// zero value if it is unset.
+func (v *ManySettings) GetF39() (o int32) {
+	if v.IsSetF39() {
+		return v.F39
+	}
+
+	return
+}
+
+// IsSetF39 returns true if F39 is set.
+func (v *ManySettings) IsSetF39() bool {
+	return v != nil && v.presence[0]&0x4000000000 != 0
+}
+
+// SetF39 sets the value of F39 and marks it as set.
+func (v *ManySettings) SetF39(x39 int32) {
+	v.F39 = x39
+	v.presence[0] |= 0x4000000000
+}
+
+// ClearF39 unsets F39 and resets it to its zero value.
+func (v *ManySettings) ClearF39() {
+	var x39 int32
+	v.F39 = x39
+	v.presence[0] &^= 0x4000000000
+}
+
+// GetF40 returns the value of F40 if it is set or its
+// zero value if it is unset.
+func (v *ManySettings) GetF40() (o int32) {
+	if v.IsSetF40() {
+		return v.F40
+	}
+
+	return
+}
+
+// IsSetF40 returns true if F40 is set.
+func (v *ManySettings) IsSetF40() bool {
+	return v != nil && v.presence[0]&0x8000000000 != 0
+}
+
+// SetF40 sets the value of F40 and marks it as set.
+func (v *ManySettings) SetF40(x40 int32) {
+	v.F40 = x40
+	v.presence[0] |= 0x8000000000
+}
+
+// ClearF40 unsets F40 and resets it to its zero value.
+func (v *ManySettings) ClearF40() {
+	var x40 int32
+	v.F40 = x40
+	v.presence[0] &^= 0x8000000000
+}
+
+// GetF41 returns the value of F41 if it is set or its
+// zero value if it is unset.
+func (v *ManySettings) GetF41() (o int32) {
+	if v.IsSetF41() {
+		return v.F41
+	}
+
+	return
+}
+
+// IsSetF41 returns true if F41 is set.
+func (v *ManySettings) IsSetF41() bool {
+	return v != nil && v.presence[0]&0x10000000000 != 0
+}
+
+// SetF41 sets the value of F41 and marks it as set.
+func (v *ManySettings) SetF41(x41 int32) {
+	v.F41 = x41
+	v.presence[0] |= 0x10000000000
+}
+
+// ClearF41 unsets F41 and resets it to its zero value.
+func (v *ManySettings) ClearF41() {
+	var x41 int32
+	v.F41 = x41
+	v.presence[0] &^= 0x10000000000
+}
+
+// GetF42 returns the value of F42 if it is set or its
+// zero value if it is unset.
+func (v *ManySettings) GetF42() (o int32) {
+	if v.IsSetF42() {
+		return v.F42
+	}
+
+	return
+}
+
+// IsSetF42 returns true if F42 is set.
+func (v *ManySettings) IsSetF42() bool {
+	return v != nil && v.presence[0]&0x20000000000 != 0
+}
+
+// SetF42 sets the value of F42 and marks it as set.
+func (v *ManySettings) SetF42(x42 int32) {
+	v.F42 = x42
+	v.presence[0] |= 0x20000000000
+}
+
+// ClearF42 unsets F42 and resets it to its zero value.
+func (v *ManySettings) ClearF42() {
+	var x42 int32
+	v.F42 = x42
+	v.presence[0] &^= 0x20000000000
+}
+
+// GetF43 returns the value of F43 if it is set or its
+// zero value if it is unset.
+func (v *ManySettings) GetF43() (o int32) {
+	if v.IsSetF43() {
+		return v.F43
+	}
+
+	return
+}
+
+// IsSetF43 returns true if F43 is set.
+func (v *ManySettings) IsSetF43() bool {
+	return v != nil && v.presence[0]&0x40000000000 != 0
+}
+
+// SetF43 sets the value of F43 and marks it as set.
+func (v *ManySettings) SetF43(x43 int32) {
+	v.F43 = x43
+	v.presence[0] |= 0x40000000000
+}
+
+// ClearF43 unsets F43 and resets it to its zero value.
+func (v *ManySettings) ClearF43() {
+	var x43 int32
+	v.F43 = x43
+	v.presence[0] &^= 0x40000000000
+}
+
+// GetF44 returns the value of F44 if it is set or its
+// zero value if it is unset.
+func (v *ManySettings) GetF44() (o int32) {
+	if v.IsSetF44() {
+		return v.F44
+	}
+
+	return
+}
+
+// IsSetF44 returns true if F44 is set.
+func (v *ManySettings) IsSetF44() bool {
+	return v != nil && v.presence[0]&0x80000000000 != 0
+}
+
+// SetF44 sets the value of F44 and marks it as set.
+func (v *ManySettings) SetF44(x44 int32) {
+	v.F44 = x44
+	v.presence[0] |= 0x80000000000
+}
+
+// ClearF44 unsets F44 and resets it to its zero value.
+func (v *ManySettings) ClearF44() {
+	var x44 int32
+	v.F44 = x44
+	v.presence[0] &^= 0x80000000000
+}
+
+// GetF45 returns the value of F45 if it is set or its
+// zero value if it is unset.
+func (v *ManySettings) GetF45() (o int32) {
+	if v.IsSetF45() {
+		return v.F45
+	}
+
+	return
+}
+
+// IsSetF45 returns true if F45 is set.
+func (v *ManySettings) IsSetF45() bool {
+	return v != nil && v.presence[0]&0x100000000000 != 0
+}
+
+// SetF45 sets the value of F45 and marks it as set.
+func (v *ManySettings) SetF45(x45 int32) {
+	v.F45 = x45
+	v.presence[0] |= 0x100000000000
+}
+
+// ClearF45 unsets F45 and resets it to its zero value.
+func (v *ManySettings) ClearF45() {
+	var x45 int32
+	v.F45 = x45
+	v.presence[0] &^= 0x100000000000
+}
+
+// GetF46 returns the value of F46 if it is set or its
+// zero value if it is unset.
+func (v *ManySettings) GetF46() (o int32) {
+	if v.IsSetF46() {
+		return v.F46
+	}
+
+	return
+}
+
+// IsSetF46 returns true if F46 is set.
+func (v *ManySettings) IsSetF46() bool {
+	return v != nil && v.presence[0]&0x200000000000 != 0
+}
+
+// SetF46 sets the value of F46 and marks it as set.
+func (v *ManySettings) SetF46(x46 int32) {
+	v.F46 = x46
+	v.presence[0] |= 0x200000000000
+}
+
+// ClearF46 unsets F46 and resets it to its zero value.
+func (v *ManySettings) ClearF46() {
+	var x46 int32
+	v.F46 = x46
+	v.presence[0] &^= 0x200000000000
+}
+
+// GetF47 returns the value of F47 if it is set or its
+// zero value if it is unset.
+func (v *ManySettings) GetF47() (o int32) {
+	if v.IsSetF47() {
+		return v.F47
+	}
+
+	return
+}
+
+// IsSetF47 returns true if F47 is set.
+func (v *ManySettings) IsSetF47() bool {
+	return v != nil && v.presence[0]&0x400000000000 != 0
+}
+
+// SetF47 sets the value of F47 and marks it as set.
+func (v *ManySettings) SetF47(x47 int32) {
+	v.F47 = x47
+	v.presence[0] |= 0x400000000000
+}
+
+// ClearF47 unsets F47 and resets it to its zero value.
+func (v *ManySettings) ClearF47() {
+	var x47 int32
+	v.F47 = x47
+	v.presence[0] &^= 0x400000000000
+}
+
+// GetF48 returns the value of F48 if it is set or its
+// zero value if it is unset.
+func (v *ManySettings) GetF48() (o int32) {
+	if v.IsSetF48() {
+		return v.F48
+	}
+
+	return
+}
+
+// IsSetF48 returns true if F48 is set.
+func (v *ManySettings) IsSetF48() bool {
+	return v != nil && v.presence[0]&0x800000000000 != 0
+}
+
+// SetF48 sets the value of F48 and marks it as set.
+func (v *ManySettings) SetF48(x48 int32) {
+	v.F48 = x48
+	v.presence[0] |= 0x800000000000
+}
+
+// ClearF48 unsets F48 and resets it to its zero value.
+func (v *ManySettings) ClearF48() {
+	var x48 int32
+	v.F48 = x48
+	v.presence[0] &^= 0x800000000000
+}
+
+// GetF49 returns the value of F49 if it is set or its
+// zero value if it is unset.
+func (v *ManySettings) GetF49() (o int32) {
+	if v.IsSetF49() {
+		return v.F49
+	}
+
+	return
+}
+
+// IsSetF49 returns true if F49 is set.
+func (v *ManySettings) IsSetF49() bool {
+	return v != nil && v.presence[0]&0x1000000000000 != 0
+}
+
+// SetF49 sets the value of F49 and marks it as set.
+func (v *ManySettings) SetF49(x49 int32) {
+	v.F49 = x49
+	v.presence[0] |= 0x1000000000000
+}
+
+// ClearF49 unsets F49 and resets it to its zero value.
+func (v *ManySettings) ClearF49() {
+	var x49 int32
+	v.F49 = x49
+	v.presence[0] &^= 0x1000000000000
+}
+
+// GetF50 returns the value of F50 if it is set or its
+// zero value if it is unset.
+func (v *ManySettings) GetF50() (o int32) {
+	if v.IsSetF50() {
+		return v.F50
+	}
+
+	return
+}
+
+// IsSetF50 returns true if F50 is set.
+func (v *ManySettings) IsSetF50() bool {
+	return v != nil && v.presence[0]&0x2000000000000 != 0
+}
+
+// SetF50 sets the value of F50 and marks it as set.
+func (v *ManySettings) SetF50(x50 int32) {
+	v.F50 = x50
+	v.presence[0] |= 0x2000000000000
+}
+
+// ClearF50 unsets F50 and resets it to its zero value.
+func (v *ManySettings) ClearF50() {
+	var x50 int32
+	v.F50 = x50
+	v.presence[0] &^= 0x2000000000000
+}
+
+// GetF51 returns the value of F51 if it is set or its
+// zero value if it is unset.
+func (v *ManySettings) GetF51() (o int32) {
+	if v.IsSetF51() {
+		return v.F51
+	}
+
+	return
+}
+
+// IsSetF51 returns true if F51 is set.
+func (v *ManySettings) IsSetF51() bool {
+	return v != nil && v.presence[0]&0x4000000000000 != 0
+}
+
+// SetF51 sets the value of F51 and marks it as set.
+func (v *ManySettings) SetF51(x51 int32) {
+	v.F51 = x51
+	v.presence[0] |= 0x4000000000000
+}
+
+// ClearF51 unsets F51 and resets it to its zero value.
+func (v *ManySettings) ClearF51() {
+	var x51 int32
+	v.F51 = x51
+	v.presence[0] &^= 0x4000000000000
+}
+
+// GetF52 returns the value of F52 if it is set or its
+// zero value if it is unset.
+func (v *ManySettings) GetF52() (o int32) {
+	if v.IsSetF52() {
+		return v.F52
+	}
+
+	return
+}
+
+// IsSetF52 returns true if F52 is set.
+func (v *ManySettings) IsSetF52() bool {
+	return v != nil && v.presence[0]&0x8000000000000 != 0
+}
+
+// SetF52 sets the value of F52 and marks it as set.
+func (v *ManySettings) SetF52(x52 int32) {
+	v.F52 = x52
+	v.presence[0] |= 0x8000000000000
+}
+
+// ClearF52 unsets F52 and resets it to its zero value.
+func (v *ManySettings) ClearF52() {
+	var x52 int32
+	v.F52 = x52
+	v.presence[0] &^= 0x8000000000000
+}
+
+// GetF53 returns the value of F53 if it is set or its
+// zero value if it is unset.
+func (v *ManySettings) GetF53() (o int32) {
+	if v.IsSetF53() {
+		return v.F53
+	}
+
+	return
+}
+
+// IsSetF53 returns true if F53 is set.
+func (v *ManySettings) IsSetF53() bool {
+	return v != nil && v.presence[0]&0x10000000000000 != 0
+}
+
+// SetF53 sets the value of F53 and marks it as set.
+func (v *ManySettings) SetF53(x53 int32) {
+	v.F53 = x53
+	v.presence[0] |= 0x10000000000000
+}
+
+// ClearF53 unsets F53 and resets it to its zero value.
+func (v *ManySettings) ClearF53() {
+	var x53 int32
+	v.F53 = x53
+	v.presence[0] &^= 0x10000000000000
+}
+
+// GetF54 returns the value of F54 if it is set or its
+// zero value if it is unset.
+func (v *ManySettings) GetF54() (o int32) {
+	if v.IsSetF54() {
+		return v.F54
+	}
+
+	return
+}
+
+// IsSetF54 returns true if F54 is set.
+func (v *ManySettings) IsSetF54() bool {
+	return v != nil && v.presence[0]&0x20000000000000 != 0
+}
+
+// SetF54 sets the value of F54 and marks it as set.
+func (v *ManySettings) SetF54(x54 int32) {
+	v.F54 = x54
+	v.presence[0] |= 0x20000000000000
+}
+
+// ClearF54 unsets F54 and resets it to its zero value.
+func (v *ManySettings) ClearF54() {
+	var x54 int32
+	v.F54 = x54
+	v.presence[0] &^= 0x20000000000000
+}
+
+// GetF55 returns the value of F55 if it is set or its
+// zero value if it is unset.
+func (v *ManySettings) GetF55() (o int32) {
+	if v.IsSetF55() {
+		return v.F55
+	}
+
+	return
+}
+
+// IsSetF55 returns true if F55 is set.
+func (v *ManySettings) IsSetF55() bool {
+	return v != nil && v.presence[0]&0x40000000000000 != 0
+}
+
+// SetF55 sets the value of F55 and marks it as set.
+func (v *ManySettings) SetF55(x55 int32) {
+	v.F55 = x55
+	v.presence[0] |= 0x40000000000000
+}
+
+// ClearF55 unsets F55 and resets it to its zero value.
+func (v *ManySettings) ClearF55() {
+	var x55 int32
+	v.F55 = x55
+	v.presence[0] &^= 0x40000000000000
+}
+
+// GetF56 returns the value of F56 if it is set or its
+// zero value if it is unset.
+func (v *ManySettings) GetF56() (o int32) {
+	if v.IsSetF56() {
+		return v.F56
+	}
+
+	return
+}
+
+// IsSetF56 returns true if F56 is set.
+func (v *ManySettings) IsSetF56() bool {
+	return v != nil && v.presence[0]&0x80000000000000 != 0
+}
+
+// SetF56 sets the value of F56 and marks it as set.
+func (v *ManySettings) SetF56(x56 int32) {
+	v.F56 = x56
+	v.presence[0] |= 0x80000000000000
+}
+
+// ClearF56 unsets F56 and resets it to its zero value.
+func (v *ManySettings) ClearF56() {
+	var x56 int32
+	v.F56 = x56
+	v.presence[0] &^= 0x80000000000000
+}
+
+// GetF57 returns the value of F57 if it is set or its
+// zero value if it is unset.
+func (v *ManySettings) GetF57() (o int32) {
+	if v.IsSetF57() {
+		return v.F57
+	}
+
+	return
+}
+
+// IsSetF57 returns true if F57 is set.
+func (v *ManySettings) IsSetF57() bool {
+	return v != nil && v.presence[0]&0x100000000000000 != 0
+}
+
+// SetF57 sets the value of F57 and marks it as set.
+func (v *ManySettings) SetF57(x57 int32) {
+	v.F57 = x57
+	v.presence[0] |= 0x100000000000000
+}
+
+// ClearF57 unsets F57 and resets it to its zero value.
+func (v *ManySettings) ClearF57() {
+	var x57 int32
+	v.F57 = x57
+	v.presence[0] &^= 0x100000000000000
+}
+
+// GetF58 returns the value of F58 if it is set or its
+// zero value if it is unset.
+func (v *ManySettings) GetF58() (o int32) {
+	if v.IsSetF58() {
+		return v.F58
+	}
+
+	return
+}
+
+// IsSetF58 returns true if F58 is set.
+func (v *ManySettings) IsSetF58() bool {
+	return v != nil && v.presence[0]&0x200000000000000 != 0
+}
+
+// SetF58 sets the value of F58 and marks it as set.
+func (v *ManySettings) SetF58(x58 int32) {
+	v.F58 = x58
+	v.presence[0] |= 0x200000000000000
+}
+
+// ClearF58 unsets F58 and resets it to its zero value.
+func (v *ManySettings) ClearF58() {
+	var x58 int32
+	v.F58 = x58
+	v.presence[0] &^= 0x200000000000000
+}
+
+// GetF59 returns the value of F59 if it is set or its
+// zero value if it is unset.
+func (v *ManySettings) GetF59() (o int32) {
+	if v.IsSetF59() {
+		return v.F59
+	}
+
+	return
+}
+
+// IsSetF59 returns true if F59 is set.
+func (v *ManySettings) IsSetF59() bool {
+	return v != nil && v.presence[0]&0x400000000000000 != 0
+}
+
+// SetF59 sets the value of F59 and marks it as set.
+func (v *ManySettings) SetF59(x59 int32) {
+	v.F59 = x59
+	v.presence[0] |= 0x400000000000000
+}
+
+// ClearF59 unsets F59 and resets it to its zero value.
+func (v *ManySettings) ClearF59() {
+	var x59 int32
+	v.F59 = x59
+	v.presence[0] &^= 0x400000000000000
+}
+
+// GetF60 returns the value of F60 if it is set or its
+// zero value if it is unset.
+func (v *ManySettings) GetF60() (o int32) {
+	if v.IsSetF60() {
+		return v.F60
+	}
+
+	return
+}
+
+// IsSetF60 returns true if F60 is set.
+func (v *ManySettings) IsSetF60() bool {
+	return v != nil && v.presence[0]&0x800000000000000 != 0
+}
+
+// SetF60 sets the value of F60 and marks it as set.
+func (v *ManySettings) SetF60(x60 int32) {
+	v.F60 = x60
+	v.presence[0] |= 0x800000000000000
+}
+
+// ClearF60 unsets F60 and resets it to its zero value.
+func (v *ManySettings) ClearF60() {
+	var x60 int32
+	v.F60 = x60
+	v.presence[0] &^= 0x800000000000000
+}
+
+// GetF61 returns the value of F61 if it is set or its
+// zero value if it is unset.
+func (v *ManySettings) GetF61() (o int32) {
+	if v.IsSetF61() {
+		return v.F61
+	}
+
+	return
+}
+
+// IsSetF61 returns true if F61 is set.
+func (v *ManySettings) IsSetF61() bool {
+	return v != nil && v.presence[0]&0x1000000000000000 != 0
+}
+
+// SetF61 sets the value of F61 and marks it as set.
+func (v *ManySettings) SetF61(x61 int32) {
+	v.F61 = x61
+	v.presence[0] |= 0x1000000000000000
+}
+
+// ClearF61 unsets F61 and resets it to its zero value.
+func (v *ManySettings) ClearF61() {
+	var x61 int32
+	v.F61 = x61
+	v.presence[0] &^= 0x1000000000000000
+}
+
+// GetF62 returns the value of F62 if it is set or its
+// zero value if it is unset.
+func (v *ManySettings) GetF62() (o int32) {
+	if v.IsSetF62() {
+		return v.F62
+	}
+
+	return
+}
+
+// IsSetF62 returns true if F62 is set.
+func (v *ManySettings) IsSetF62() bool {
+	return v != nil && v.presence[0]&0x2000000000000000 != 0
+}
+
+// SetF62 sets the value of F62 and marks it as set.
+func (v *ManySettings) SetF62(x62 int32) {
+	v.F62 = x62
+	v.presence[0] |= 0x2000000000000000
+}
+
+// ClearF62 unsets F62 and resets it to its zero value.
+func (v *ManySettings) ClearF62() {
+	var x62 int32
+	v.F62 = x62
+	v.presence[0] &^= 0x2000000000000000
+}
+
+// GetF63 returns the value of F63 if it is set or its
+// zero value if it is unset.
+func (v *ManySettings) GetF63() (o int32) {
+	if v.IsSetF63() {
+		return v.F63
+	}
+
+	return
+}
+
+// IsSetF63 returns true if F63 is set.
+func (v *ManySettings) IsSetF63() bool {
+	return v != nil && v.presence[0]&0x4000000000000000 != 0
+}
+
+// SetF63 sets the value of F63 and marks it as set.
+func (v *ManySettings) SetF63(x63 int32) {
+	v.F63 = x63
+	v.presence[0] |= 0x4000000000000000
+}
+
+// ClearF63 unsets F63 and resets it to its zero value.
+func (v *ManySettings) ClearF63() {
+	var x63 int32
+	v.F63 = x63
+	v.presence[0] &^= 0x4000000000000000
+}
+
+// GetF64 returns the value of F64 if it is set or its
+// zero value if it is unset.
+func (v *ManySettings) GetF64() (o int32) {
+	if v.IsSetF64() {
+		return v.F64
+	}
+
+	return
+}
+
+// IsSetF64 returns true if F64 is set.
+func (v *ManySettings) IsSetF64() bool {
+	return v != nil && v.presence[0]&0x8000000000000000 != 0
+}
+
+// SetF64 sets the value of F64 and marks it as set.
+func (v *ManySettings) SetF64(x64 int32) {
+	v.F64 = x64
+	v.presence[0] |= 0x8000000000000000
+}
+
+// ClearF64 unsets F64 and resets it to its zero value.
+func (v *ManySettings) ClearF64() {
+	var x64 int32
+	v.F64 = x64
+	v.presence[0] &^= 0x8000000000000000
+}
+
+// GetF65 returns the value of F65 if it is set or its
+// zero value if it is unset.
+func (v *ManySettings) GetF65() (o int32) {
+	if v.IsSetF65() {
+		return v.F65
+	}
+
+	return
+}
+
+// IsSetF65 returns true if F65 is set.
+func (v *ManySettings) IsSetF65() bool {
+	return v != nil && v.presence[1]&0x1 != 0
+}
+
+// SetF65 sets the value of F65 and marks it as set.
+func (v *ManySettings) SetF65(x65 int32) {
+	v.F65 = x65
+	v.presence[1] |= 0x1
+}
+
+// ClearF65 unsets F65 and resets it to its zero value.
+func (v *ManySettings) ClearF65() {
+	var x65 int32
+	v.F65 = x65
+	v.presence[1] &^= 0x1
+}
+
+type Settings struct {
+	Name    string            `json:"name,required"`
+	Retries int32             `json:"retries,omitempty"`
+	Enabled bool              `json:"enabled,omitempty"`
+	Comment string            `json:"comment,omitempty"`
+	Mode    enums.EnumDefault `json:"mode,omitempty"`
+	Version Version           `json:"version,omitempty"`
+	Ratio   float64           `json:"ratio,omitempty"`
+	Tags    []string          `json:"tags,omitempty"`
+	Parent  *Settings         `json:"parent,omitempty"`
+	Secret  string            `json:"secret,omitempty"`
+
+	presence [1]uint64
+}
+
+// Default_Settings constructs a new Settings struct,
+// pre-populating any fields with defined default values.
+func Default_Settings() *Settings {
+	var v Settings
+	v.SetEnabled(true)
+	v.SetVersion(Version(1))
+	return &v
+}
+
+type _List_String_ValueList []string
+
+func (v _List_String_ValueList) ForEach(f func(wire.Value) error) error {
+	for _, x := range v {
+		w, err := wire.NewValueString(x), error(nil)
+		if err != nil {
+			return err
+		}
+		err = f(w)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v _List_String_ValueList) Size() int {
+	return len(v)
+}
+
+func (_List_String_ValueList) ValueType() wire.Type {
+	return wire.TBinary
+}
+
+func (_List_String_ValueList) Close() {}
+
+// ToWire translates a Settings struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *Settings) ToWire() (wire.Value, error) {
+	var (
+		fields [10]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	w, err = wire.NewValueString(v.Name), error(nil)
+	if err != nil {
+		return w, err
+	}
+	fields[i] = wire.Field{ID: 1, Value: w}
+	i++
+	if v.IsSetRetries() {
+		w, err = wire.NewValueI32(v.Retries), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 2, Value: w}
+		i++
+	}
+	vEnabled := v.Enabled
+	if !v.IsSetEnabled() {
+		vEnabled = true
+	}
+	{
+		w, err = wire.NewValueBool(vEnabled), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 3, Value: w}
+		i++
+	}
+	if v.IsSetComment() {
+		w, err = wire.NewValueString(v.Comment), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 4, Value: w}
+		i++
+	}
+	if v.IsSetMode() {
+		w, err = v.Mode.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 5, Value: w}
+		i++
+	}
+	vVersion := v.Version
+	if !v.IsSetVersion() {
+		vVersion = Version(1)
+	}
+	{
+		w, err = vVersion.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 6, Value: w}
+		i++
+	}
+	if v.IsSetRatio() {
+		w, err = wire.NewValueDouble(v.Ratio), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 7, Value: w}
+		i++
+	}
+	if v.Tags != nil {
+		w, err = wire.NewValueList(_List_String_ValueList(v.Tags)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 8, Value: w}
+		i++
+	}
+	if v.Parent != nil {
+		w, err = v.Parent.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 9, Value: w}
+		i++
+	}
+	if v.IsSetSecret() {
+		w, err = wire.NewValueString(v.Secret), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _EnumDefault_Read(w wire.Value) (enums.EnumDefault, error) {
+	var v enums.EnumDefault
+	err := v.FromWire(w)
+	return v, err
+}
+
+func _Version_Read(w wire.Value) (Version, error) {
+	var x Version
+	err := x.FromWire(w)
+	return x, err
+}
+
+func _List_String_Read(l wire.ValueList) ([]string, error) {
+	if l.ValueType() != wire.TBinary {
+		return nil, nil
+	}
+
+	o := make([]string, 0, l.Size())
+	err := l.ForEach(func(x wire.Value) error {
+		i, err := x.GetString(), error(nil)
+		if err != nil {
+			return err
+		}
+		o = append(o, i)
+		return nil
+	})
+	l.Close()
+	return o, err
+}
+
+func _Settings_Read(w wire.Value) (*Settings, error) {
+	var v Settings
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// FromWire deserializes a Settings struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a Settings struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v Settings
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *Settings) FromWire(w wire.Value) error {
+	var err error
+
+	nameIsSet := false
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TBinary {
+				v.Name, err = field.Value.GetString(), error(nil)
+				if err != nil {
+					return err
+				}
+				nameIsSet = true
+			}
+		case 2:
+			if field.Value.Type() == wire.TI32 {
+				v.Retries, err = field.Value.GetI32(), error(nil)
+				if err != nil {
+					return err
+				}
+				v.presence[0] |= 0x1
+			}
+		case 3:
+			if field.Value.Type() == wire.TBool {
+				v.Enabled, err = field.Value.GetBool(), error(nil)
+				if err != nil {
+					return err
+				}
+				v.presence[0] |= 0x2
+			}
+		case 4:
+			if field.Value.Type() == wire.TBinary {
+				v.Comment, err = field.Value.GetString(), error(nil)
+				if err != nil {
+					return err
+				}
+				v.presence[0] |= 0x4
+			}
+		case 5:
+			if field.Value.Type() == wire.TI32 {
+				v.Mode, err = _EnumDefault_Read(field.Value)
+				if err != nil {
+					return err
+				}
+				v.presence[0] |= 0x8
+			}
+		case 6:
+			if field.Value.Type() == wire.TI64 {
+				v.Version, err = _Version_Read(field.Value)
+				if err != nil {
+					return err
+				}
+				v.presence[0] |= 0x10
+			}
+		case 7:
+			if field.Value.Type() == wire.TDouble {
+				v.Ratio, err = field.Value.GetDouble(), error(nil)
+				if err != nil {
+					return err
+				}
+				v.presence[0] |= 0x20
+			}
+		case 8:
+			if field.Value.Type() == wire.TList {
+				v.Tags, err = _List_String_Read(field.Value.GetList())
+				if err != nil {
+					return err
+				}
+
+			}
+		case 9:
+			if field.Value.Type() == wire.TStruct {
+				v.Parent, err = _Settings_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 10:
+			if field.Value.Type() == wire.TBinary {
+				v.Secret, err = field.Value.GetString(), error(nil)
+				if err != nil {
+					return err
+				}
+				v.presence[0] |= 0x40
+			}
+		}
+	}
+
+	if !nameIsSet {
+		return errors.New("field Name of Settings is required")
+	}
+
+	if !v.IsSetEnabled() {
+		v.SetEnabled(true)
+	}
+
+	if !v.IsSetVersion() {
+		v.SetVersion(Version(1))
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a Settings
+// struct.
+func (v *Settings) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [10]string
+	i := 0
+	fields[i] = fmt.Sprintf("Name: %v", v.Name)
+	i++
+	if v.IsSetRetries() {
+		fields[i] = fmt.Sprintf("Retries: %v", v.Retries)
+		i++
+	}
+	if v.IsSetEnabled() {
+		fields[i] = fmt.Sprintf("Enabled: %v", v.Enabled)
+		i++
+	}
+	if v.IsSetComment() {
+		fields[i] = fmt.Sprintf("Comment: %v", v.Comment)
+		i++
+	}
+	if v.IsSetMode() {
+		fields[i] = fmt.Sprintf("Mode: %v", v.Mode)
+		i++
+	}
+	if v.IsSetVersion() {
+		fields[i] = fmt.Sprintf("Version: %v", v.Version)
+		i++
+	}
+	if v.IsSetRatio() {
+		fields[i] = fmt.Sprintf("Ratio: %v", v.Ratio)
+		i++
+	}
+	if v.Tags != nil {
+		fields[i] = fmt.Sprintf("Tags: %v", v.Tags)
+		i++
+	}
+	if v.Parent != nil {
+		fields[i] = fmt.Sprintf("Parent: %v", v.Parent)
+		i++
+	}
+	if v.IsSetSecret() {
+		fields[i] = "Secret: " + "<redacted>"
+		i++
+	}
+
+	return fmt.Sprintf("Settings{%v}", strings.Join(fields[:i], ", "))
+}
+
+// MarshalJSON implements json.Marshaler, omitting unset
+// fields of Settings, masking the values of
+// sensitive fields of Settings.
+//
+// MarshalJSON has a value receiver so that it is also used for
+// Settings values that are not addressable.
+func (v Settings) MarshalJSON() ([]byte, error) {
+	type alias Settings
+	x := struct {
+		*alias
+		Retries *int32             `json:"retries,omitempty"`
+		Enabled *bool              `json:"enabled,omitempty"`
+		Comment *string            `json:"comment,omitempty"`
+		Mode    *enums.EnumDefault `json:"mode,omitempty"`
+		Version *Version           `json:"version,omitempty"`
+		Ratio   *float64           `json:"ratio,omitempty"`
+		Secret  *string            `json:"secret,omitempty"`
+	}{alias: (*alias)(&v)}
+
+	if v.IsSetRetries() {
+		x.Retries = &v.Retries
+	}
+	if v.IsSetEnabled() {
+		x.Enabled = &v.Enabled
+	}
+	if v.IsSetComment() {
+		x.Comment = &v.Comment
+	}
+	if v.IsSetMode() {
+		x.Mode = &v.Mode
+	}
+	if v.IsSetVersion() {
+		x.Version = &v.Version
+	}
+	if v.IsSetRatio() {
+		x.Ratio = &v.Ratio
+	}
+	if v.IsSetSecret() {
+		s := "<redacted>"
+		x.Secret = &s
+	}
+
+	return json.Marshal(x)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, marking fields of
+// Settings present in the JSON as set.
+func (v *Settings) UnmarshalJSON(b []byte) error {
+	type alias Settings
+	x := struct {
+		*alias
+		Retries *int32             `json:"retries,omitempty"`
+		Enabled *bool              `json:"enabled,omitempty"`
+		Comment *string            `json:"comment,omitempty"`
+		Mode    *enums.EnumDefault `json:"mode,omitempty"`
+		Version *Version           `json:"version,omitempty"`
+		Ratio   *float64           `json:"ratio,omitempty"`
+		Secret  *string            `json:"secret,omitempty"`
+	}{alias: (*alias)(v)}
+	if err := json.Unmarshal(b, &x); err != nil {
+		return err
+	}
+
+	if x.Retries != nil {
+		v.SetRetries(*x.Retries)
+	}
+	if x.Enabled != nil {
+		v.SetEnabled(*x.Enabled)
+	}
+	if x.Comment != nil {
+		v.SetComment(*x.Comment)
+	}
+	if x.Mode != nil {
+		v.SetMode(*x.Mode)
+	}
+	if x.Version != nil {
+		v.SetVersion(*x.Version)
+	}
+	if x.Ratio != nil {
+		v.SetRatio(*x.Ratio)
+	}
+	if x.Secret != nil {
+		v.SetSecret(*x.Secret)
+	}
+
+	return nil
+}
+
+func _List_String_Equals(lhs, rhs []string) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for i, lv := range lhs {
+		rv := rhs[i]
+		if !(lv == rv) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Equals returns true if all the fields of this Settings match the
+// provided Settings.
+//
+// This function performs a deep comparison.
+func (v *Settings) Equals(rhs *Settings) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !(v.Name == rhs.Name) {
+		return false
+	}
+	if v.IsSetRetries() != rhs.IsSetRetries() {
+		return false
+	}
+	if v.IsSetRetries() && !(v.Retries == rhs.Retries) {
+		return false
+	}
+	if v.IsSetEnabled() != rhs.IsSetEnabled() {
+		return false
+	}
+	if v.IsSetEnabled() && !(v.Enabled == rhs.Enabled) {
+		return false
+	}
+	if v.IsSetComment() != rhs.IsSetComment() {
+		return false
+	}
+	if v.IsSetComment() && !(v.Comment == rhs.Comment) {
+		return false
+	}
+	if v.IsSetMode() != rhs.IsSetMode() {
+		return false
+	}
+	if v.IsSetMode() && !v.Mode.Equals(rhs.Mode) {
+		return false
+	}
+	if v.IsSetVersion() != rhs.IsSetVersion() {
+		return false
+	}
+	if v.IsSetVersion() && !(v.Version == rhs.Version) {
+		return false
+	}
+	if v.IsSetRatio() != rhs.IsSetRatio() {
+		return false
+	}
+	if v.IsSetRatio() && !(v.Ratio == rhs.Ratio) {
+		return false
+	}
+	if !((v.Tags == nil && rhs.Tags == nil) || (v.Tags != nil && rhs.Tags != nil && _List_String_Equals(v.Tags, rhs.Tags))) {
+		return false
+	}
+	if !((v.Parent == nil && rhs.Parent == nil) || (v.Parent != nil && rhs.Parent != nil && v.Parent.Equals(rhs.Parent))) {
+		return false
+	}
+	if v.IsSetSecret() != rhs.IsSetSecret() {
+		return false
+	}
+	if v.IsSetSecret() && !(v.Secret == rhs.Secret) {
+		return false
+	}
+
+	return true
+}
+
+type _List_String_Zapper []string
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _List_String_Zapper.
+func (l _List_String_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
+	for _, v := range l {
+		enc.AppendString(v)
+	}
+	return err
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Settings.
+func (v *Settings) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	enc.AddString("name", v.Name)
+	if v.IsSetRetries() {
+		enc.AddInt32("retries", v.Retries)
+	}
+	if v.IsSetEnabled() {
+		enc.AddBool("enabled", v.Enabled)
+	}
+	if v.IsSetComment() {
+		enc.AddString("comment", v.Comment)
+	}
+	if v.IsSetMode() {
+		err = multierr.Append(err, enc.AddObject("mode", v.Mode))
+	}
+	if v.IsSetVersion() {
+		enc.AddInt64("version", (int64)(v.Version))
+	}
+	if v.IsSetRatio() {
+		enc.AddFloat64("ratio", v.Ratio)
+	}
+	if v.Tags != nil {
+		err = multierr.Append(err, enc.AddArray("tags", (_List_String_Zapper)(v.Tags)))
+	}
+	if v.Parent != nil {
+		err = multierr.Append(err, enc.AddObject("parent", v.Parent))
+	}
+	if v.IsSetSecret() {
+		enc.AddString("secret", "<redacted>")
+	}
+	return err
+}
+
+// GetName returns the value of Name if it is set or its
+// zero value if it is unset.
+func (v *Settings) GetName() (o string) {
+	if v != nil {
+		o = v.Name
+	}
+	return
+}
+
+// GetRetries returns the value of Retries if it is set or its
+// zero value if it is unset.
+func (v *Settings) GetRetries() (o int32) {
+	if v.IsSetRetries() {
+		return v.Retries
+	}
+
+	return
+}
+
+// IsSetRetries returns true if Retries is set.
+func (v *Settings) IsSetRetries() bool {
+	return v != nil && v.presence[0]&0x1 != 0
+}
+
+// SetRetries sets the value of Retries and marks it as set.
+func (v *Settings) SetRetries(x int32) {
+	v.Retries = x
+	v.presence[0] |= 0x1
+}
+
+// ClearRetries unsets Retries and resets it to its zero value.
+func (v *Settings) ClearRetries() {
+	var x int32
+	v.Retries = x
+	v.presence[0] &^= 0x1
+}
+
+// GetEnabled returns the value of Enabled if it is set or its
+// default value if it is unset.
+func (v *Settings) GetEnabled() (o bool) {
+	if v.IsSetEnabled() {
+		return v.Enabled
+	}
+	o = true
+	return
+}
+
+// IsSetEnabled returns true if Enabled is set.
+func (v *Settings) IsSetEnabled() bool {
+	return v != nil && v.presence[0]&0x2 != 0
+}
+
+// SetEnabled sets the value of Enabled and marks it as set.
+func (v *Settings) SetEnabled(x2 bool) {
+	v.Enabled = x2
+	v.presence[0] |= 0x2
+}
+
+// ClearEnabled unsets Enabled and resets it to its zero value.
+func (v *Settings) ClearEnabled() {
+	var x2 bool
+	v.Enabled = x2
+	v.presence[0] &^= 0x2
+}
+
+// GetComment returns the value of Comment if it is set or its
+// zero value if it is unset.
+func (v *Settings) GetComment() (o string) {
+	if v.IsSetComment() {
+		return v.Comment
+	}
+
+	return
+}
+
+// IsSetComment returns true if Comment is set.
+func (v *Settings) IsSetComment() bool {
+	return v != nil && v.presence[0]&0x4 != 0
+}
+
+// SetComment sets the value of Comment and marks it as set.
+func (v *Settings) SetComment(x3 string) {
+	v.Comment = x3
+	v.presence[0] |= 0x4
+}
+
+// ClearComment unsets Comment and resets it to its zero value.
+func (v *Settings) ClearComment() {
+	var x3 string
+	v.Comment = x3
+	v.presence[0] &^= 0x4
+}
+
+// GetMode returns the value of Mode if it is set or its
+// zero value if it is unset.
+func (v *Settings) GetMode() (o enums.EnumDefault) {
+	if v.IsSetMode() {
+		return v.Mode
+	}
+
+	return
+}
+
+// IsSetMode returns true if Mode is set.
+func (v *Settings) IsSetMode() bool {
+	return v != nil && v.presence[0]&0x8 != 0
+}
+
+// SetMode sets the value of Mode and marks it as set.
+func (v *Settings) SetMode(x4 enums.EnumDefault) {
+	v.Mode = x4
+	v.presence[0] |= 0x8
+}
+
+// ClearMode unsets Mode and resets it to its zero value.
+func (v *Settings) ClearMode() {
+	var x4 enums.EnumDefault
+	v.Mode = x4
+	v.presence[0] &^= 0x8
+}
+
+// GetVersion returns the value of Version if it is set or its
+// default value if it is unset.
+func (v *Settings) GetVersion() (o Version) {
+	if v.IsSetVersion() {
+		return v.Version
+	}
+	o = Version(1)
+	return
+}
+
+// IsSetVersion returns true if Version is set.
+func (v *Settings) IsSetVersion() bool {
+	return v != nil && v.presence[0]&0x10 != 0
+}
+
+// SetVersion sets the value of Version and marks it as set.
+func (v *Settings) SetVersion(x5 Version) {
+	v.Version = x5
+	v.presence[0] |= 0x10
+}
+
+// ClearVersion unsets Version and resets it to its zero value.
+func (v *Settings) ClearVersion() {
+	var x5 Version
+	v.Version = x5
+	v.presence[0] &^= 0x10
+}
+
+// GetRatio returns the value of Ratio if it is set or its
+// zero value if it is unset.
+func (v *Settings) GetRatio() (o float64) {
+	if v.IsSetRatio() {
+		return v.Ratio
+	}
+
+	return
+}
+
+// IsSetRatio returns true if Ratio is set.
+func (v *Settings) IsSetRatio() bool {
+	return v != nil && v.presence[0]&0x20 != 0
+}
+
+// SetRatio sets the value of Ratio and marks it as set.
+func (v *Settings) SetRatio(x6 float64) {
+	v.Ratio = x6
+	v.presence[0] |= 0x20
+}
+
+// ClearRatio unsets Ratio and resets it to its zero value.
+func (v *Settings) ClearRatio() {
+	var x6 float64
+	v.Ratio = x6
+	v.presence[0] &^= 0x20
+}
+
+// GetTags returns the value of Tags if it is set or its
+// zero value if it is unset.
+func (v *Settings) GetTags() (o []string) {
+	if v != nil && v.Tags != nil {
+		return v.Tags
+	}
+
+	return
+}
+
+// IsSetTags returns true if Tags is not nil.
+func (v *Settings) IsSetTags() bool {
+	return v != nil && v.Tags != nil
+}
+
+// GetParent returns the value of Parent if it is set or its
+// zero value if it is unset.
+func (v *Settings) GetParent() (o *Settings) {
+	if v != nil && v.Parent != nil {
+		return v.Parent
+	}
+
+	return
+}
+
+// IsSetParent returns true if Parent is not nil.
+func (v *Settings) IsSetParent() bool {
+	return v != nil && v.Parent != nil
+}
+
+// GetSecret returns the value of Secret if it is set or its
+// zero value if it is unset.
+func (v *Settings) GetSecret() (o string) {
+	if v.IsSetSecret() {
+		return v.Secret
+	}
+
+	return
+}
+
+// IsSetSecret returns true if Secret is set.
+func (v *Settings) IsSetSecret() bool {
+	return v != nil && v.presence[0]&0x40 != 0
+}
+
+// SetSecret sets the value of Secret and marks it as set.
+func (v *Settings) SetSecret(x7 string) {
+	v.Secret = x7
+	v.presence[0] |= 0x40
+}
+
+// ClearSecret unsets Secret and resets it to its zero value.
+func (v *Settings) ClearSecret() {
+	var x7 string
+	v.Secret = x7
+	v.presence[0] &^= 0x40
+}
+
+type SettingsError struct {
+	Code    int32  `json:"code,omitempty"`
+	Message string `json:"message,omitempty"`
+
+	presence [1]uint64
+}
+
+// ToWire translates a SettingsError struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *SettingsError) ToWire() (wire.Value, error) {
+	var (
+		fields [2]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.IsSetCode() {
+		w, err = wire.NewValueI32(v.Code), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 1, Value: w}
+		i++
+	}
+	if v.IsSetMessage() {
+		w, err = wire.NewValueString(v.Message), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 2, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a SettingsError struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a SettingsError struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v SettingsError
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *SettingsError) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TI32 {
+				v.Code, err = field.Value.GetI32(), error(nil)
+				if err != nil {
+					return err
+				}
+				v.presence[0] |= 0x1
+			}
+		case 2:
+			if field.Value.Type() == wire.TBinary {
+				v.Message, err = field.Value.GetString(), error(nil)
+				if err != nil {
+					return err
+				}
+				v.presence[0] |= 0x2
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a SettingsError
+// struct.
+func (v *SettingsError) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [2]string
+	i := 0
+	if v.IsSetCode() {
+		fields[i] = fmt.Sprintf("Code: %v", v.Code)
+		i++
+	}
+	if v.IsSetMessage() {
+		fields[i] = fmt.Sprintf("Message: %v", v.Message)
+		i++
+	}
+
+	return fmt.Sprintf("SettingsError{%v}", strings.Join(fields[:i], ", "))
+}
+
+// MarshalJSON implements json.Marshaler, omitting unset
+// fields of SettingsError.
+//
+// MarshalJSON has a value receiver so that it is also used for
+// SettingsError values that are not addressable.
+func (v SettingsError) MarshalJSON() ([]byte, error) {
+	type alias SettingsError
+	x := struct {
+		*alias
+		Code    *int32  `json:"code,omitempty"`
+		Message *string `json:"message,omitempty"`
+	}{alias: (*alias)(&v)}
+
+	if v.IsSetCode() {
+		x.Code = &v.Code
+	}
+	if v.IsSetMessage() {
+		x.Message = &v.Message
+	}
+
+	return json.Marshal(x)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, marking fields of
+// SettingsError present in the JSON as set.
+func (v *SettingsError) UnmarshalJSON(b []byte) error {
+	type alias SettingsError
+	x := struct {
+		*alias
+		Code    *int32  `json:"code,omitempty"`
+		Message *string `json:"message,omitempty"`
+	}{alias: (*alias)(v)}
+	if err := json.Unmarshal(b, &x); err != nil {
+		return err
+	}
+
+	if x.Code != nil {
+		v.SetCode(*x.Code)
+	}
+	if x.Message != nil {
+		v.SetMessage(*x.Message)
+	}
+
+	return nil
+}
+
+// ErrorName is the name of this type as defined in the Thrift
+// file.
+func (*SettingsError) ErrorName() string {
+	return "SettingsError"
+}
+
+// Equals returns true if all the fields of this SettingsError match the
+// provided SettingsError.
+//
+// This function performs a deep comparison.
+func (v *SettingsError) Equals(rhs *SettingsError) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if v.IsSetCode() != rhs.IsSetCode() {
+		return false
+	}
+	if v.IsSetCode() && !(v.Code == rhs.Code) {
+		return false
+	}
+	if v.IsSetMessage() != rhs.IsSetMessage() {
+		return false
+	}
+	if v.IsSetMessage() && !(v.Message == rhs.Message) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of SettingsError.
+func (v *SettingsError) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.IsSetCode() {
+		enc.AddInt32("code", v.Code)
+	}
+	if v.IsSetMessage() {
+		enc.AddString("message", v.Message)
+	}
+	return err
+}
+
+// GetCode returns the value of Code if it is set or its
+// zero value if it is unset.
+func (v *SettingsError) GetCode() (o int32) {
+	if v.IsSetCode() {
+		return v.Code
+	}
+
+	return
+}
+
+// IsSetCode returns true if Code is set.
+func (v *SettingsError) IsSetCode() bool {
+	return v != nil && v.presence[0]&0x1 != 0
+}
+
+// SetCode sets the value of Code and marks it as set.
+func (v *SettingsError) SetCode(x int32) {
+	v.Code = x
+	v.presence[0] |= 0x1
+}
+
+// ClearCode unsets Code and resets it to its zero value.
+func (v *SettingsError) ClearCode() {
+	var x int32
+	v.Code = x
+	v.presence[0] &^= 0x1
+}
+
+// GetMessage returns the value of Message if it is set or its
+// zero value if it is unset.
+func (v *SettingsError) GetMessage() (o string) {
+	if v.IsSetMessage() {
+		return v.Message
+	}
+
+	return
+}
+
+// IsSetMessage returns true if Message is set.
+func (v *SettingsError) IsSetMessage() bool {
+	return v != nil && v.presence[0]&0x2 != 0
+}
+
+// SetMessage sets the value of Message and marks it as set.
+func (v *SettingsError) SetMessage(x2 string) {
+	v.Message = x2
+	v.presence[0] |= 0x2
+}
+
+// ClearMessage unsets Message and resets it to its zero value.
+func (v *SettingsError) ClearMessage() {
+	var x2 string
+	v.Message = x2
+	v.presence[0] &^= 0x2
+}
+
+func (v *SettingsError) Error() string {
+	return v.String()
+}
+
+type Version int64
+
+// VersionPtr returns a pointer to a Version
+func (v Version) Ptr() *Version {
+	return &v
+}
+
+// ToWire translates Version into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+func (v Version) ToWire() (wire.Value, error) {
+	x := (int64)(v)
+	return wire.NewValueI64(x), error(nil)
+}
+
+// String returns a readable string representation of Version.
+func (v Version) String() string {
+	x := (int64)(v)
+	return fmt.Sprint(x)
+}
+
+// FromWire deserializes Version from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+func (v *Version) FromWire(w wire.Value) error {
+	x, err := w.GetI64(), error(nil)
+	*v = (Version)(x)
+	return err
+}
+
+// Equals returns true if this Version is equal to the provided
+// Version.
+func (lhs Version) Equals(rhs Version) bool {
+	return ((int64)(lhs) == (int64)(rhs))
+}
+
+// ExceptionFromWire deserializes the exception defined in this
+// package with the given Thrift name from its Thrift-level
+// representation.
+//
+// ok is false if this package does not define an exception with the
+// given name. err is non-nil if the exception could not be
+// deserialized.
+//
+//   e, ok, err := ExceptionFromWire("SettingsError", value)
+//   if err != nil {
+//     return err
+//   }
+//   if ok {
+//     return e
+//   }
+func ExceptionFromWire(name string, w wire.Value) (exc error, ok bool, err error) {
+	switch name {
+	case "SettingsError":
+		var v SettingsError
+		if err := v.FromWire(w); err != nil {
+			return nil, true, err
+		}
+		return &v, true, nil
+	default:
+		return nil, false, nil
+	}
+}
+
+// ThriftModule represents the IDL file used to generate this package.
+var ThriftModule = &thriftreflect.ThriftModule{
+	Name:     "presence",
+	Package:  "go.uber.org/thriftrw/gen/internal/tests/presence",
+	FilePath: "presence.thrift",
+	SHA1:     "e8fb758365eb7f8f09bc1ac34011c870fc78e8a0",
+	Includes: []*thriftreflect.ThriftModule{
+		enums.ThriftModule,
+	},
+	Raw: rawIDL,
+}
+
+const rawIDL = "include \"./enums.thrift\"\n\ntypedef i64 Version\n\nstruct Settings {\n    1: required string name\n    2: optional i32 retries\n    3: optional bool enabled = true\n    4: optional string comment\n    5: optional enums.EnumDefault mode\n    6: optional Version version = 1\n    7: optional double ratio\n    8: optional list<string> tags\n    9: optional Settings parent\n    10: optional string secret (go.redact)\n} (go.optional = \"value\")\n\nexception SettingsError {\n    1: optional i32 code\n    2: optional string message\n} (go.optional = \"value\")\n\nconst Settings DEFAULT_SETTINGS = {\n    \"name\": \"default\",\n    \"retries\": 3,\n    \"tags\": [\"a\"],\n}\n\nstruct ManySettings {\n    1: optional i32 f1\n    2: optional i32 f2\n    3: optional i32 f3\n    4: optional i32 f4\n    5: optional i32 f5\n    6: optional i32 f6\n    7: optional i32 f7\n    8: optional i32 f8\n    9: optional i32 f9\n    10: optional i32 f10\n    11: optional i32 f11\n    12: optional i32 f12\n    13: optional i32 f13\n    14: optional i32 f14\n    15: optional i32 f15\n    16: optional i32 f16\n    17: optional i32 f17\n    18: optional i32 f18\n    19: optional i32 f19\n    20: optional i32 f20\n    21: optional i32 f21\n    22: optional i32 f22\n    23: optional i32 f23\n    24: optional i32 f24\n    25: optional i32 f25\n    26: optional i32 f26\n    27: optional i32 f27\n    28: optional i32 f28\n    29: optional i32 f29\n    30: optional i32 f30\n    31: optional i32 f31\n    32: optional i32 f32\n    33: optional i32 f33\n    34: optional i32 f34\n    35: optional i32 f35\n    36: optional i32 f36\n    37: optional i32 f37\n    38: optional i32 f38\n    39: optional i32 f39\n    40: optional i32 f40\n    41: optional i32 f41\n    42: optional i32 f42\n    43: optional i32 f43\n    44: optional i32 f44\n    45: optional i32 f45\n    46: optional i32 f46\n    47: optional i32 f47\n    48: optional i32 f48\n    49: optional i32 f49\n    50: optional i32 f50\n    51: optional i32 f51\n    52: optional i32 f52\n    53: optional i32 f53\n    54: optional i32 f54\n    55: optional i32 f55\n    56: optional i32 f56\n    57: optional i32 f57\n    58: optional i32 f58\n    59: optional i32 f59\n    60: optional i32 f60\n    61: optional i32 f61\n    62: optional i32 f62\n    63: optional i32 f63\n    64: optional i32 f64\n    65: optional i32 f65\n} (go.optional = \"value\")\n"
+
+func init() {
+	thriftreflect.Register(ThriftModule, map[string]thriftreflect.Constructor{
+		"ManySettings":  func() interface{} { return new(ManySettings) },
+		"Settings":      func() interface{} { return new(Settings) },
+		"SettingsError": func() interface{} { return new(SettingsError) },
+	})
+}
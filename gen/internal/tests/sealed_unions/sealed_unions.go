@@ -0,0 +1,2728 @@
+// Code generated by thriftrw v1.28.0. DO NOT EDIT.
+// @generated
+
+package sealed_unions
+
+import (
+	bytes "bytes"
+	json "encoding/json"
+	errors "errors"
+	fmt "fmt"
+	multierr "go.uber.org/multierr"
+	structs "go.uber.org/thriftrw/gen/internal/tests/structs"
+	thriftreflect "go.uber.org/thriftrw/thriftreflect"
+	wire "go.uber.org/thriftrw/wire"
+	zapcore "go.uber.org/zap/zapcore"
+	strings "strings"
+)
+
+var UnitCircle Shape = Shape_Circle{Value: &Circle{
+	Radius: 1,
+}}
+
+type Circle struct {
+	Radius float64 `json:"radius,required"`
+}
+
+// ToWire translates a Circle struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *Circle) ToWire() (wire.Value, error) {
+	var (
+		fields [1]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	w, err = wire.NewValueDouble(v.Radius), error(nil)
+	if err != nil {
+		return w, err
+	}
+	fields[i] = wire.Field{ID: 1, Value: w}
+	i++
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a Circle struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a Circle struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v Circle
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *Circle) FromWire(w wire.Value) error {
+	var err error
+
+	radiusIsSet := false
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TDouble {
+				v.Radius, err = field.Value.GetDouble(), error(nil)
+				if err != nil {
+					return err
+				}
+				radiusIsSet = true
+			}
+		}
+	}
+
+	if !radiusIsSet {
+		return errors.New("field Radius of Circle is required")
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a Circle
+// struct.
+func (v *Circle) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [1]string
+	i := 0
+	fields[i] = fmt.Sprintf("Radius: %v", v.Radius)
+	i++
+
+	return fmt.Sprintf("Circle{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this Circle match the
+// provided Circle.
+//
+// This function performs a deep comparison.
+func (v *Circle) Equals(rhs *Circle) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !(v.Radius == rhs.Radius) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Circle.
+func (v *Circle) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	enc.AddFloat64("radius", v.Radius)
+	return err
+}
+
+// GetRadius returns the value of Radius if it is set or its
+// zero value if it is unset.
+func (v *Circle) GetRadius() (o float64) {
+	if v != nil {
+		o = v.Radius
+	}
+	return
+}
+
+type Drawing struct {
+	Primary    Shape            `json:"primary,required"`
+	Secondary  Shape            `json:"secondary,omitempty"`
+	Caption    Value            `json:"caption,omitempty"`
+	Others     []Shape          `json:"others,omitempty"`
+	Attributes map[string]Value `json:"attributes,omitempty"`
+	Tags       []Value          `json:"tags,omitempty"`
+	Labels     []struct {
+		Key   *Circle
+		Value Shape
+	} `json:"labels,omitempty"`
+	Layers Layers `json:"layers,omitempty"`
+}
+
+type _List_Shape_ValueList []Shape
+
+func (v _List_Shape_ValueList) ForEach(f func(wire.Value) error) error {
+	for i, x := range v {
+		if x == nil {
+			return fmt.Errorf("invalid [%v]: value is nil", i)
+		}
+		w, err := x.ToWire()
+		if err != nil {
+			return err
+		}
+		err = f(w)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v _List_Shape_ValueList) Size() int {
+	return len(v)
+}
+
+func (_List_Shape_ValueList) ValueType() wire.Type {
+	return wire.TStruct
+}
+
+func (_List_Shape_ValueList) Close() {}
+
+type _Map_String_Value_MapItemList map[string]Value
+
+func (m _Map_String_Value_MapItemList) ForEach(f func(wire.MapItem) error) error {
+	for k, v := range m {
+		if v == nil {
+			return fmt.Errorf("invalid [%v]: value is nil", k)
+		}
+		kw, err := wire.NewValueString(k), error(nil)
+		if err != nil {
+			return err
+		}
+
+		vw, err := v.ToWire()
+		if err != nil {
+			return err
+		}
+		err = f(wire.MapItem{Key: kw, Value: vw})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m _Map_String_Value_MapItemList) Size() int {
+	return len(m)
+}
+
+func (_Map_String_Value_MapItemList) KeyType() wire.Type {
+	return wire.TBinary
+}
+
+func (_Map_String_Value_MapItemList) ValueType() wire.Type {
+	return wire.TStruct
+}
+
+func (_Map_String_Value_MapItemList) Close() {}
+
+type _Set_Value_sliceType_ValueList []Value
+
+func (v _Set_Value_sliceType_ValueList) ForEach(f func(wire.Value) error) error {
+	for _, x := range v {
+		if x == nil {
+			return fmt.Errorf("invalid set item: value is nil")
+		}
+		w, err := x.ToWire()
+		if err != nil {
+			return err
+		}
+
+		if err := f(w); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v _Set_Value_sliceType_ValueList) Size() int {
+	return len(v)
+}
+
+func (_Set_Value_sliceType_ValueList) ValueType() wire.Type {
+	return wire.TStruct
+}
+
+func (_Set_Value_sliceType_ValueList) Close() {}
+
+type _Map_Circle_Shape_MapItemList []struct {
+	Key   *Circle
+	Value Shape
+}
+
+func (m _Map_Circle_Shape_MapItemList) ForEach(f func(wire.MapItem) error) error {
+	for _, i := range m {
+		k := i.Key
+		v := i.Value
+		if k == nil {
+			return fmt.Errorf("invalid map key: value is nil")
+		}
+		if v == nil {
+			return fmt.Errorf("invalid [%v]: value is nil", k)
+		}
+		kw, err := k.ToWire()
+		if err != nil {
+			return err
+		}
+
+		vw, err := v.ToWire()
+		if err != nil {
+			return err
+		}
+		err = f(wire.MapItem{Key: kw, Value: vw})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m _Map_Circle_Shape_MapItemList) Size() int {
+	return len(m)
+}
+
+func (_Map_Circle_Shape_MapItemList) KeyType() wire.Type {
+	return wire.TStruct
+}
+
+func (_Map_Circle_Shape_MapItemList) ValueType() wire.Type {
+	return wire.TStruct
+}
+
+func (_Map_Circle_Shape_MapItemList) Close() {}
+
+// ToWire translates a Drawing struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *Drawing) ToWire() (wire.Value, error) {
+	var (
+		fields [8]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Primary == nil {
+		return w, errors.New("field Primary of Drawing is required")
+	}
+	w, err = v.Primary.ToWire()
+	if err != nil {
+		return w, err
+	}
+	fields[i] = wire.Field{ID: 1, Value: w}
+	i++
+	if v.Secondary != nil {
+		w, err = v.Secondary.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 2, Value: w}
+		i++
+	}
+	if v.Caption != nil {
+		w, err = v.Caption.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 3, Value: w}
+		i++
+	}
+	if v.Others != nil {
+		w, err = wire.NewValueList(_List_Shape_ValueList(v.Others)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 4, Value: w}
+		i++
+	}
+	if v.Attributes != nil {
+		w, err = wire.NewValueMap(_Map_String_Value_MapItemList(v.Attributes)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 5, Value: w}
+		i++
+	}
+	if v.Tags != nil {
+		w, err = wire.NewValueSet(_Set_Value_sliceType_ValueList(v.Tags)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 6, Value: w}
+		i++
+	}
+	if v.Labels != nil {
+		w, err = wire.NewValueMap(_Map_Circle_Shape_MapItemList(v.Labels)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 7, Value: w}
+		i++
+	}
+	if v.Layers != nil {
+		w, err = v.Layers.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 8, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _List_Shape_Read(l wire.ValueList) ([]Shape, error) {
+	if l.ValueType() != wire.TStruct {
+		return nil, nil
+	}
+
+	o := make([]Shape, 0, l.Size())
+	err := l.ForEach(func(x wire.Value) error {
+		i, err := Shape_FromWire(x)
+		if err != nil {
+			return err
+		}
+		o = append(o, i)
+		return nil
+	})
+	l.Close()
+	return o, err
+}
+
+func _Map_String_Value_Read(m wire.MapItemList) (map[string]Value, error) {
+	if m.KeyType() != wire.TBinary {
+		return nil, nil
+	}
+
+	if m.ValueType() != wire.TStruct {
+		return nil, nil
+	}
+
+	o := make(map[string]Value, m.Size())
+	err := m.ForEach(func(x wire.MapItem) error {
+		k, err := x.Key.GetString(), error(nil)
+		if err != nil {
+			return err
+		}
+
+		v, err := Value_FromWire(x.Value)
+		if err != nil {
+			return err
+		}
+
+		o[k] = v
+		return nil
+	})
+	m.Close()
+	return o, err
+}
+
+func _Set_Value_sliceType_Read(s wire.ValueList) ([]Value, error) {
+	if s.ValueType() != wire.TStruct {
+		return nil, nil
+	}
+
+	o := make([]Value, 0, s.Size())
+	err := s.ForEach(func(x wire.Value) error {
+		i, err := Value_FromWire(x)
+		if err != nil {
+			return err
+		}
+
+		o = append(o, i)
+		return nil
+	})
+	s.Close()
+	return o, err
+}
+
+func _Circle_Read(w wire.Value) (*Circle, error) {
+	var v Circle
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _Map_Circle_Shape_Read(m wire.MapItemList) ([]struct {
+	Key   *Circle
+	Value Shape
+}, error) {
+	if m.KeyType() != wire.TStruct {
+		return nil, nil
+	}
+
+	if m.ValueType() != wire.TStruct {
+		return nil, nil
+	}
+
+	o := make([]struct {
+		Key   *Circle
+		Value Shape
+	}, 0, m.Size())
+	err := m.ForEach(func(x wire.MapItem) error {
+		k, err := _Circle_Read(x.Key)
+		if err != nil {
+			return err
+		}
+
+		v, err := Shape_FromWire(x.Value)
+		if err != nil {
+			return err
+		}
+
+		o = append(o, struct {
+			Key   *Circle
+			Value Shape
+		}{k, v})
+		return nil
+	})
+	m.Close()
+	return o, err
+}
+
+func _Layers_Read(w wire.Value) (Layers, error) {
+	var x Layers
+	err := x.FromWire(w)
+	return x, err
+}
+
+// FromWire deserializes a Drawing struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a Drawing struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v Drawing
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *Drawing) FromWire(w wire.Value) error {
+	var err error
+
+	primaryIsSet := false
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TStruct {
+				v.Primary, err = Shape_FromWire(field.Value)
+				if err != nil {
+					return err
+				}
+				primaryIsSet = true
+			}
+		case 2:
+			if field.Value.Type() == wire.TStruct {
+				v.Secondary, err = Shape_FromWire(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 3:
+			if field.Value.Type() == wire.TStruct {
+				v.Caption, err = Value_FromWire(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 4:
+			if field.Value.Type() == wire.TList {
+				v.Others, err = _List_Shape_Read(field.Value.GetList())
+				if err != nil {
+					return err
+				}
+
+			}
+		case 5:
+			if field.Value.Type() == wire.TMap {
+				v.Attributes, err = _Map_String_Value_Read(field.Value.GetMap())
+				if err != nil {
+					return err
+				}
+
+			}
+		case 6:
+			if field.Value.Type() == wire.TSet {
+				v.Tags, err = _Set_Value_sliceType_Read(field.Value.GetSet())
+				if err != nil {
+					return err
+				}
+
+			}
+		case 7:
+			if field.Value.Type() == wire.TMap {
+				v.Labels, err = _Map_Circle_Shape_Read(field.Value.GetMap())
+				if err != nil {
+					return err
+				}
+
+			}
+		case 8:
+			if field.Value.Type() == wire.TList {
+				v.Layers, err = _Layers_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	if !primaryIsSet {
+		return errors.New("field Primary of Drawing is required")
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a Drawing
+// struct.
+func (v *Drawing) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [8]string
+	i := 0
+	fields[i] = fmt.Sprintf("Primary: %v", v.Primary)
+	i++
+	if v.Secondary != nil {
+		fields[i] = fmt.Sprintf("Secondary: %v", v.Secondary)
+		i++
+	}
+	if v.Caption != nil {
+		fields[i] = fmt.Sprintf("Caption: %v", v.Caption)
+		i++
+	}
+	if v.Others != nil {
+		fields[i] = fmt.Sprintf("Others: %v", v.Others)
+		i++
+	}
+	if v.Attributes != nil {
+		fields[i] = fmt.Sprintf("Attributes: %v", v.Attributes)
+		i++
+	}
+	if v.Tags != nil {
+		fields[i] = fmt.Sprintf("Tags: %v", v.Tags)
+		i++
+	}
+	if v.Labels != nil {
+		fields[i] = fmt.Sprintf("Labels: %v", v.Labels)
+		i++
+	}
+	if v.Layers != nil {
+		fields[i] = fmt.Sprintf("Layers: %v", v.Layers)
+		i++
+	}
+
+	return fmt.Sprintf("Drawing{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _List_Shape_UnmarshalJSON(b []byte) ([]Shape, error) {
+	var raw []json.RawMessage
+	if err := json.Unmarshal(b, &raw); err != nil {
+		return nil, err
+	}
+	if raw == nil {
+		return nil, nil
+	}
+
+	o := make([]Shape, 0, len(raw))
+	for _, r := range raw {
+		x, err := Shape_UnmarshalJSON(r)
+		if err != nil {
+			return nil, err
+		}
+		o = append(o, x)
+	}
+	return o, nil
+}
+
+func _Map_String_Value_UnmarshalJSON(b []byte) (map[string]Value, error) {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(b, &raw); err != nil {
+		return nil, err
+	}
+	if raw == nil {
+		return nil, nil
+	}
+
+	o := make(map[string]Value, len(raw))
+	for k, r := range raw {
+		x, err := Value_UnmarshalJSON(r)
+		if err != nil {
+			return nil, err
+		}
+		o[k] = x
+	}
+	return o, nil
+}
+
+func _Set_Value_sliceType_UnmarshalJSON(b []byte) ([]Value, error) {
+	var raw []json.RawMessage
+	if err := json.Unmarshal(b, &raw); err != nil {
+		return nil, err
+	}
+	if raw == nil {
+		return nil, nil
+	}
+
+	o := make([]Value, 0, len(raw))
+	for _, r := range raw {
+		x, err := Value_UnmarshalJSON(r)
+		if err != nil {
+			return nil, err
+		}
+		o = append(o, x)
+	}
+	return o, nil
+}
+
+func _Circle_UnmarshalJSON(b []byte) (*Circle, error) {
+	var x *Circle
+	err := json.Unmarshal(b, &x)
+	return x, err
+}
+
+func _Map_Circle_Shape_UnmarshalJSON(b []byte) ([]struct {
+	Key   *Circle
+	Value Shape
+}, error) {
+	var raw []struct{ Key, Value json.RawMessage }
+	if err := json.Unmarshal(b, &raw); err != nil {
+		return nil, err
+	}
+	if raw == nil {
+		return nil, nil
+	}
+
+	o := make([]struct {
+		Key   *Circle
+		Value Shape
+	}, 0, len(raw))
+	for _, kv := range raw {
+		k, err := _Circle_UnmarshalJSON(kv.Key)
+		if err != nil {
+			return nil, err
+		}
+		x, err := Shape_UnmarshalJSON(kv.Value)
+		if err != nil {
+			return nil, err
+		}
+		o = append(o, struct {
+			Key   *Circle
+			Value Shape
+		}{Key: k, Value: x})
+	}
+	return o, nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler, decoding unions
+// held by Drawing into their variants.
+func (v *Drawing) UnmarshalJSON(b []byte) error {
+	type alias Drawing
+	x := struct {
+		*alias
+		Primary    json.RawMessage `json:"primary,required"`
+		Secondary  json.RawMessage `json:"secondary,omitempty"`
+		Caption    json.RawMessage `json:"caption,omitempty"`
+		Others     json.RawMessage `json:"others,omitempty"`
+		Attributes json.RawMessage `json:"attributes,omitempty"`
+		Tags       json.RawMessage `json:"tags,omitempty"`
+		Labels     json.RawMessage `json:"labels,omitempty"`
+	}{alias: (*alias)(v)}
+	if err := json.Unmarshal(b, &x); err != nil {
+		return err
+	}
+
+	if len(x.Primary) > 0 && string(x.Primary) != "null" {
+		u, err := Shape_UnmarshalJSON(x.Primary)
+		if err != nil {
+			return err
+		}
+		v.Primary = u
+	}
+	if len(x.Secondary) > 0 && string(x.Secondary) != "null" {
+		u2, err := Shape_UnmarshalJSON(x.Secondary)
+		if err != nil {
+			return err
+		}
+		v.Secondary = u2
+	}
+	if len(x.Caption) > 0 && string(x.Caption) != "null" {
+		u3, err := Value_UnmarshalJSON(x.Caption)
+		if err != nil {
+			return err
+		}
+		v.Caption = u3
+	}
+	if len(x.Others) > 0 && string(x.Others) != "null" {
+		u4, err := _List_Shape_UnmarshalJSON(x.Others)
+		if err != nil {
+			return err
+		}
+		v.Others = u4
+	}
+	if len(x.Attributes) > 0 && string(x.Attributes) != "null" {
+		u5, err := _Map_String_Value_UnmarshalJSON(x.Attributes)
+		if err != nil {
+			return err
+		}
+		v.Attributes = u5
+	}
+	if len(x.Tags) > 0 && string(x.Tags) != "null" {
+		u6, err := _Set_Value_sliceType_UnmarshalJSON(x.Tags)
+		if err != nil {
+			return err
+		}
+		v.Tags = u6
+	}
+	if len(x.Labels) > 0 && string(x.Labels) != "null" {
+		u7, err := _Map_Circle_Shape_UnmarshalJSON(x.Labels)
+		if err != nil {
+			return err
+		}
+		v.Labels = u7
+	}
+
+	return nil
+}
+
+func _List_Shape_Equals(lhs, rhs []Shape) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for i, lv := range lhs {
+		rv := rhs[i]
+		if !Shape_Equals(lv, rv) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func _Map_String_Value_Equals(lhs, rhs map[string]Value) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for lk, lv := range lhs {
+		rv, ok := rhs[lk]
+		if !ok {
+			return false
+		}
+		if !Value_Equals(lv, rv) {
+			return false
+		}
+	}
+	return true
+}
+
+func _Set_Value_sliceType_Equals(lhs, rhs []Value) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for _, x := range lhs {
+		ok := false
+		for _, y := range rhs {
+			if Value_Equals(x, y) {
+				ok = true
+				break
+			}
+		}
+		if !ok {
+			return false
+		}
+	}
+
+	return true
+}
+
+func _Map_Circle_Shape_Equals(lhs, rhs []struct {
+	Key   *Circle
+	Value Shape
+}) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for _, i := range lhs {
+		lk := i.Key
+		lv := i.Value
+		ok := false
+		for _, j := range rhs {
+			rk := j.Key
+			rv := j.Value
+			if !lk.Equals(rk) {
+				continue
+			}
+
+			if !Shape_Equals(lv, rv) {
+				return false
+			}
+			ok = true
+			break
+		}
+
+		if !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// Equals returns true if all the fields of this Drawing match the
+// provided Drawing.
+//
+// This function performs a deep comparison.
+func (v *Drawing) Equals(rhs *Drawing) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !Shape_Equals(v.Primary, rhs.Primary) {
+		return false
+	}
+	if !((v.Secondary == nil && rhs.Secondary == nil) || (v.Secondary != nil && rhs.Secondary != nil && Shape_Equals(v.Secondary, rhs.Secondary))) {
+		return false
+	}
+	if !((v.Caption == nil && rhs.Caption == nil) || (v.Caption != nil && rhs.Caption != nil && Value_Equals(v.Caption, rhs.Caption))) {
+		return false
+	}
+	if !((v.Others == nil && rhs.Others == nil) || (v.Others != nil && rhs.Others != nil && _List_Shape_Equals(v.Others, rhs.Others))) {
+		return false
+	}
+	if !((v.Attributes == nil && rhs.Attributes == nil) || (v.Attributes != nil && rhs.Attributes != nil && _Map_String_Value_Equals(v.Attributes, rhs.Attributes))) {
+		return false
+	}
+	if !((v.Tags == nil && rhs.Tags == nil) || (v.Tags != nil && rhs.Tags != nil && _Set_Value_sliceType_Equals(v.Tags, rhs.Tags))) {
+		return false
+	}
+	if !((v.Labels == nil && rhs.Labels == nil) || (v.Labels != nil && rhs.Labels != nil && _Map_Circle_Shape_Equals(v.Labels, rhs.Labels))) {
+		return false
+	}
+	if !((v.Layers == nil && rhs.Layers == nil) || (v.Layers != nil && rhs.Layers != nil && v.Layers.Equals(rhs.Layers))) {
+		return false
+	}
+
+	return true
+}
+
+type _Shape_Zapper struct{ Value Shape }
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of _Shape_Zapper.
+func (z _Shape_Zapper) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if z.Value == nil {
+		return nil
+	}
+	return z.Value.MarshalLogObject(enc)
+}
+
+type _Value_Zapper struct{ Value Value }
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of _Value_Zapper.
+func (z _Value_Zapper) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if z.Value == nil {
+		return nil
+	}
+	return z.Value.MarshalLogObject(enc)
+}
+
+type _List_Shape_Zapper []Shape
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _List_Shape_Zapper.
+func (l _List_Shape_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
+	for _, v := range l {
+		err = multierr.Append(err, enc.AppendObject(_Shape_Zapper{Value: v}))
+	}
+	return err
+}
+
+type _Map_String_Value_Zapper map[string]Value
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of _Map_String_Value_Zapper.
+func (m _Map_String_Value_Zapper) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	for k, v := range m {
+		err = multierr.Append(err, enc.AddObject((string)(k), _Value_Zapper{Value: v}))
+	}
+	return err
+}
+
+type _Set_Value_sliceType_Zapper []Value
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _Set_Value_sliceType_Zapper.
+func (s _Set_Value_sliceType_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
+	for _, v := range s {
+		err = multierr.Append(err, enc.AppendObject(_Value_Zapper{Value: v}))
+	}
+	return err
+}
+
+type _Map_Circle_Shape_Item_Zapper struct {
+	Key   *Circle
+	Value Shape
+}
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _Map_Circle_Shape_Item_Zapper.
+func (v _Map_Circle_Shape_Item_Zapper) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	err = multierr.Append(err, enc.AddObject("key", v.Key))
+	err = multierr.Append(err, enc.AddObject("value", _Shape_Zapper{Value: v.Value}))
+	return err
+}
+
+type _Map_Circle_Shape_Zapper []struct {
+	Key   *Circle
+	Value Shape
+}
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _Map_Circle_Shape_Zapper.
+func (m _Map_Circle_Shape_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
+	for _, i := range m {
+		k := i.Key
+		v := i.Value
+		err = multierr.Append(err, enc.AppendObject(_Map_Circle_Shape_Item_Zapper{Key: k, Value: v}))
+	}
+	return err
+}
+
+type _List_List_Shape_Zapper [][]Shape
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _List_List_Shape_Zapper.
+func (l _List_List_Shape_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
+	for _, v := range l {
+		err = multierr.Append(err, enc.AppendArray((_List_Shape_Zapper)(v)))
+	}
+	return err
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Drawing.
+func (v *Drawing) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	err = multierr.Append(err, enc.AddObject("primary", _Shape_Zapper{Value: v.Primary}))
+	if v.Secondary != nil {
+		err = multierr.Append(err, enc.AddObject("secondary", _Shape_Zapper{Value: v.Secondary}))
+	}
+	if v.Caption != nil {
+		err = multierr.Append(err, enc.AddObject("caption", _Value_Zapper{Value: v.Caption}))
+	}
+	if v.Others != nil {
+		err = multierr.Append(err, enc.AddArray("others", (_List_Shape_Zapper)(v.Others)))
+	}
+	if v.Attributes != nil {
+		err = multierr.Append(err, enc.AddObject("attributes", (_Map_String_Value_Zapper)(v.Attributes)))
+	}
+	if v.Tags != nil {
+		err = multierr.Append(err, enc.AddArray("tags", (_Set_Value_sliceType_Zapper)(v.Tags)))
+	}
+	if v.Labels != nil {
+		err = multierr.Append(err, enc.AddArray("labels", (_Map_Circle_Shape_Zapper)(v.Labels)))
+	}
+	if v.Layers != nil {
+		err = multierr.Append(err, enc.AddArray("layers", (_List_List_Shape_Zapper)(v.Layers)))
+	}
+	return err
+}
+
+// GetPrimary returns the value of Primary if it is set or its
+// zero value if it is unset.
+func (v *Drawing) GetPrimary() (o Shape) {
+	if v != nil {
+		o = v.Primary
+	}
+	return
+}
+
+// IsSetPrimary returns true if Primary is not nil.
+func (v *Drawing) IsSetPrimary() bool {
+	return v != nil && v.Primary != nil
+}
+
+// GetSecondary returns the value of Secondary if it is set or its
+// zero value if it is unset.
+func (v *Drawing) GetSecondary() (o Shape) {
+	if v != nil && v.Secondary != nil {
+		return v.Secondary
+	}
+
+	return
+}
+
+// IsSetSecondary returns true if Secondary is not nil.
+func (v *Drawing) IsSetSecondary() bool {
+	return v != nil && v.Secondary != nil
+}
+
+// GetCaption returns the value of Caption if it is set or its
+// zero value if it is unset.
+func (v *Drawing) GetCaption() (o Value) {
+	if v != nil && v.Caption != nil {
+		return v.Caption
+	}
+
+	return
+}
+
+// IsSetCaption returns true if Caption is not nil.
+func (v *Drawing) IsSetCaption() bool {
+	return v != nil && v.Caption != nil
+}
+
+// GetOthers returns the value of Others if it is set or its
+// zero value if it is unset.
+func (v *Drawing) GetOthers() (o []Shape) {
+	if v != nil && v.Others != nil {
+		return v.Others
+	}
+
+	return
+}
+
+// IsSetOthers returns true if Others is not nil.
+func (v *Drawing) IsSetOthers() bool {
+	return v != nil && v.Others != nil
+}
+
+// GetAttributes returns the value of Attributes if it is set or its
+// zero value if it is unset.
+func (v *Drawing) GetAttributes() (o map[string]Value) {
+	if v != nil && v.Attributes != nil {
+		return v.Attributes
+	}
+
+	return
+}
+
+// IsSetAttributes returns true if Attributes is not nil.
+func (v *Drawing) IsSetAttributes() bool {
+	return v != nil && v.Attributes != nil
+}
+
+// GetTags returns the value of Tags if it is set or its
+// zero value if it is unset.
+func (v *Drawing) GetTags() (o []Value) {
+	if v != nil && v.Tags != nil {
+		return v.Tags
+	}
+
+	return
+}
+
+// IsSetTags returns true if Tags is not nil.
+func (v *Drawing) IsSetTags() bool {
+	return v != nil && v.Tags != nil
+}
+
+// GetLabels returns the value of Labels if it is set or its
+// zero value if it is unset.
+func (v *Drawing) GetLabels() (o []struct {
+	Key   *Circle
+	Value Shape
+}) {
+	if v != nil && v.Labels != nil {
+		return v.Labels
+	}
+
+	return
+}
+
+// IsSetLabels returns true if Labels is not nil.
+func (v *Drawing) IsSetLabels() bool {
+	return v != nil && v.Labels != nil
+}
+
+// GetLayers returns the value of Layers if it is set or its
+// zero value if it is unset.
+func (v *Drawing) GetLayers() (o Layers) {
+	if v != nil && v.Layers != nil {
+		return v.Layers
+	}
+
+	return
+}
+
+// IsSetLayers returns true if Layers is not nil.
+func (v *Drawing) IsSetLayers() bool {
+	return v != nil && v.Layers != nil
+}
+
+type _List_List_Shape_ValueList [][]Shape
+
+func (v _List_List_Shape_ValueList) ForEach(f func(wire.Value) error) error {
+	for i, x := range v {
+		if x == nil {
+			return fmt.Errorf("invalid [%v]: value is nil", i)
+		}
+		w, err := wire.NewValueList(_List_Shape_ValueList(x)), error(nil)
+		if err != nil {
+			return err
+		}
+		err = f(w)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v _List_List_Shape_ValueList) Size() int {
+	return len(v)
+}
+
+func (_List_List_Shape_ValueList) ValueType() wire.Type {
+	return wire.TList
+}
+
+func (_List_List_Shape_ValueList) Close() {}
+
+func _List_List_Shape_Read(l wire.ValueList) ([][]Shape, error) {
+	if l.ValueType() != wire.TList {
+		return nil, nil
+	}
+
+	o := make([][]Shape, 0, l.Size())
+	err := l.ForEach(func(x wire.Value) error {
+		i, err := _List_Shape_Read(x.GetList())
+		if err != nil {
+			return err
+		}
+		o = append(o, i)
+		return nil
+	})
+	l.Close()
+	return o, err
+}
+
+func _List_List_Shape_Equals(lhs, rhs [][]Shape) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for i, lv := range lhs {
+		rv := rhs[i]
+		if !_List_Shape_Equals(lv, rv) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func _List_List_Shape_UnmarshalJSON(b []byte) ([][]Shape, error) {
+	var raw []json.RawMessage
+	if err := json.Unmarshal(b, &raw); err != nil {
+		return nil, err
+	}
+	if raw == nil {
+		return nil, nil
+	}
+
+	o := make([][]Shape, 0, len(raw))
+	for _, r := range raw {
+		x, err := _List_Shape_UnmarshalJSON(r)
+		if err != nil {
+			return nil, err
+		}
+		o = append(o, x)
+	}
+	return o, nil
+}
+
+type Layers [][]Shape
+
+// ToWire translates Layers into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+func (v Layers) ToWire() (wire.Value, error) {
+	x := ([][]Shape)(v)
+	return wire.NewValueList(_List_List_Shape_ValueList(x)), error(nil)
+}
+
+// String returns a readable string representation of Layers.
+func (v Layers) String() string {
+	x := ([][]Shape)(v)
+	return fmt.Sprint(x)
+}
+
+// FromWire deserializes Layers from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+func (v *Layers) FromWire(w wire.Value) error {
+	x, err := _List_List_Shape_Read(w.GetList())
+	*v = (Layers)(x)
+	return err
+}
+
+// Equals returns true if this Layers is equal to the provided
+// Layers.
+func (lhs Layers) Equals(rhs Layers) bool {
+	return _List_List_Shape_Equals(([][]Shape)(lhs), ([][]Shape)(rhs))
+}
+
+// UnmarshalJSON deserializes Layers from JSON, decoding the
+// unions it holds into their variants.
+func (v *Layers) UnmarshalJSON(b []byte) error {
+	x, err := _List_List_Shape_UnmarshalJSON(b)
+	*v = (Layers)(x)
+	return err
+}
+
+func (v Layers) MarshalLogArray(enc zapcore.ArrayEncoder) error {
+	return ((_List_List_Shape_Zapper)(([][]Shape)(v))).MarshalLogArray(enc)
+}
+
+// Shape_Circle is the circle variant of Shape.
+type Shape_Circle struct {
+	Value *Circle
+}
+
+func (Shape_Circle) isShape() {}
+
+// ToWire translates a Shape_Circle into a Thrift-level intermediate
+// representation of Shape.
+func (v Shape_Circle) ToWire() (wire.Value, error) {
+	if v.Value == nil {
+		return wire.Value{}, errors.New("field Circle of Shape is required")
+	}
+	w, err := v.Value.ToWire()
+	if err != nil {
+		return w, err
+	}
+	return wire.NewValueStruct(wire.Struct{Fields: []wire.Field{
+		{ID: 1, Value: w},
+	}}), nil
+}
+
+// String returns a readable string representation of a Shape_Circle.
+func (v Shape_Circle) String() string {
+	return fmt.Sprintf("Shape{Circle: %v}", v.Value)
+}
+
+// Equals returns true if the provided Shape is a Shape_Circle with
+// the same value.
+func (v Shape_Circle) Equals(rhs Shape) bool {
+	r, ok := rhs.(Shape_Circle)
+	return ok && v.Value.Equals(r.Value)
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Shape_Circle.
+func (v Shape_Circle) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	err = multierr.Append(err, enc.AddObject("circle", v.Value))
+	return err
+}
+
+// MarshalJSON implements json.Marshaler, encoding Shape_Circle as an
+// object with a single "circle" key.
+func (v Shape_Circle) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Value *Circle `json:"circle"`
+	}{v.Value})
+}
+
+// Shape_Square is the square variant of Shape.
+//
+// A square of the given side.
+type Shape_Square struct {
+	Value float64
+}
+
+func (Shape_Square) isShape() {}
+
+// ToWire translates a Shape_Square into a Thrift-level intermediate
+// representation of Shape.
+func (v Shape_Square) ToWire() (wire.Value, error) {
+	w, err := wire.NewValueDouble(v.Value), error(nil)
+	if err != nil {
+		return w, err
+	}
+	return wire.NewValueStruct(wire.Struct{Fields: []wire.Field{
+		{ID: 2, Value: w},
+	}}), nil
+}
+
+// String returns a readable string representation of a Shape_Square.
+func (v Shape_Square) String() string {
+	return fmt.Sprintf("Shape{Square: %v}", v.Value)
+}
+
+// Equals returns true if the provided Shape is a Shape_Square with
+// the same value.
+func (v Shape_Square) Equals(rhs Shape) bool {
+	r, ok := rhs.(Shape_Square)
+	return ok && (v.Value == r.Value)
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Shape_Square.
+func (v Shape_Square) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	enc.AddFloat64("square", v.Value)
+	return err
+}
+
+// MarshalJSON implements json.Marshaler, encoding Shape_Square as an
+// object with a single "square" key.
+func (v Shape_Square) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Value float64 `json:"square"`
+	}{v.Value})
+}
+
+type _List_Point_ValueList []*structs.Point
+
+func (v _List_Point_ValueList) ForEach(f func(wire.Value) error) error {
+	for i, x := range v {
+		if x == nil {
+			return fmt.Errorf("invalid [%v]: value is nil", i)
+		}
+		w, err := x.ToWire()
+		if err != nil {
+			return err
+		}
+		err = f(w)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v _List_Point_ValueList) Size() int {
+	return len(v)
+}
+
+func (_List_Point_ValueList) ValueType() wire.Type {
+	return wire.TStruct
+}
+
+func (_List_Point_ValueList) Close() {}
+
+func _List_Point_Equals(lhs, rhs []*structs.Point) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for i, lv := range lhs {
+		rv := rhs[i]
+		if !lv.Equals(rv) {
+			return false
+		}
+	}
+
+	return true
+}
+
+type _List_Point_Zapper []*structs.Point
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _List_Point_Zapper.
+func (l _List_Point_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
+	for _, v := range l {
+		err = multierr.Append(err, enc.AppendObject(v))
+	}
+	return err
+}
+
+// Shape_Polygon is the polygon variant of Shape.
+type Shape_Polygon struct {
+	Value []*structs.Point
+}
+
+func (Shape_Polygon) isShape() {}
+
+// ToWire translates a Shape_Polygon into a Thrift-level intermediate
+// representation of Shape.
+func (v Shape_Polygon) ToWire() (wire.Value, error) {
+	w, err := wire.NewValueList(_List_Point_ValueList(v.Value)), error(nil)
+	if err != nil {
+		return w, err
+	}
+	return wire.NewValueStruct(wire.Struct{Fields: []wire.Field{
+		{ID: 3, Value: w},
+	}}), nil
+}
+
+// String returns a readable string representation of a Shape_Polygon.
+func (v Shape_Polygon) String() string {
+	return fmt.Sprintf("Shape{Polygon: %v}", v.Value)
+}
+
+// Equals returns true if the provided Shape is a Shape_Polygon with
+// the same value.
+func (v Shape_Polygon) Equals(rhs Shape) bool {
+	r, ok := rhs.(Shape_Polygon)
+	return ok && _List_Point_Equals(v.Value, r.Value)
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Shape_Polygon.
+func (v Shape_Polygon) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	err = multierr.Append(err, enc.AddArray("polygon", (_List_Point_Zapper)(v.Value)))
+	return err
+}
+
+// MarshalJSON implements json.Marshaler, encoding Shape_Polygon as an
+// object with a single "polygon" key.
+func (v Shape_Polygon) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Value []*structs.Point `json:"polygon"`
+	}{v.Value})
+}
+
+// Shape_Label is the label variant of Shape.
+type Shape_Label struct {
+	Value string
+}
+
+func (Shape_Label) isShape() {}
+
+// ToWire translates a Shape_Label into a Thrift-level intermediate
+// representation of Shape.
+func (v Shape_Label) ToWire() (wire.Value, error) {
+	w, err := wire.NewValueString(v.Value), error(nil)
+	if err != nil {
+		return w, err
+	}
+	return wire.NewValueStruct(wire.Struct{Fields: []wire.Field{
+		{ID: 4, Value: w},
+	}}), nil
+}
+
+// String returns a readable string representation of a Shape_Label.
+func (v Shape_Label) String() string {
+	return fmt.Sprintf("Shape{Label: %v}", v.Value)
+}
+
+// Equals returns true if the provided Shape is a Shape_Label with
+// the same value.
+func (v Shape_Label) Equals(rhs Shape) bool {
+	r, ok := rhs.(Shape_Label)
+	return ok && (v.Value == r.Value)
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Shape_Label.
+func (v Shape_Label) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	enc.AddString("name", v.Value)
+	return err
+}
+
+// MarshalJSON implements json.Marshaler, encoding Shape_Label as an
+// object with a single "name" key.
+func (v Shape_Label) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Value string `json:"name"`
+	}{v.Value})
+}
+
+// Shape_Secret is the secret variant of Shape.
+type Shape_Secret struct {
+	Value []byte
+}
+
+func (Shape_Secret) isShape() {}
+
+// ToWire translates a Shape_Secret into a Thrift-level intermediate
+// representation of Shape.
+func (v Shape_Secret) ToWire() (wire.Value, error) {
+	if v.Value == nil {
+		return wire.Value{}, errors.New("field Secret of Shape is required")
+	}
+	w, err := wire.NewValueBinary(v.Value), error(nil)
+	if err != nil {
+		return w, err
+	}
+	return wire.NewValueStruct(wire.Struct{Fields: []wire.Field{
+		{ID: 5, Value: w},
+	}}), nil
+}
+
+// String returns a readable string representation of a Shape_Secret.
+func (v Shape_Secret) String() string {
+	return "Shape{Secret: " + "<redacted>" + "}"
+}
+
+// Equals returns true if the provided Shape is a Shape_Secret with
+// the same value.
+func (v Shape_Secret) Equals(rhs Shape) bool {
+	r, ok := rhs.(Shape_Secret)
+	return ok && bytes.Equal(v.Value, r.Value)
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Shape_Secret.
+func (v Shape_Secret) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	enc.AddString("secret", "<redacted>")
+	return err
+}
+
+// MarshalJSON implements json.Marshaler, encoding Shape_Secret as an
+// object with a single "secret" key.
+func (v Shape_Secret) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Value string `json:"secret"`
+	}{"<redacted>"})
+}
+
+// Shape_Nested is the nested variant of Shape.
+type Shape_Nested struct {
+	Value Shape
+}
+
+func (Shape_Nested) isShape() {}
+
+// ToWire translates a Shape_Nested into a Thrift-level intermediate
+// representation of Shape.
+func (v Shape_Nested) ToWire() (wire.Value, error) {
+	if v.Value == nil {
+		return wire.Value{}, errors.New("field Nested of Shape is required")
+	}
+	w, err := v.Value.ToWire()
+	if err != nil {
+		return w, err
+	}
+	return wire.NewValueStruct(wire.Struct{Fields: []wire.Field{
+		{ID: 6, Value: w},
+	}}), nil
+}
+
+// String returns a readable string representation of a Shape_Nested.
+func (v Shape_Nested) String() string {
+	return fmt.Sprintf("Shape{Nested: %v}", v.Value)
+}
+
+// Equals returns true if the provided Shape is a Shape_Nested with
+// the same value.
+func (v Shape_Nested) Equals(rhs Shape) bool {
+	r, ok := rhs.(Shape_Nested)
+	return ok && Shape_Equals(v.Value, r.Value)
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Shape_Nested.
+func (v Shape_Nested) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	err = multierr.Append(err, enc.AddObject("nested", _Shape_Zapper{Value: v.Value}))
+	return err
+}
+
+// MarshalJSON implements json.Marshaler, encoding Shape_Nested as an
+// object with a single "nested" key.
+func (v Shape_Nested) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Value Shape `json:"nested"`
+	}{v.Value})
+}
+
+// Shape_Hidden is the hidden variant of Shape.
+type Shape_Hidden struct {
+	Value int32
+}
+
+func (Shape_Hidden) isShape() {}
+
+// ToWire translates a Shape_Hidden into a Thrift-level intermediate
+// representation of Shape.
+func (v Shape_Hidden) ToWire() (wire.Value, error) {
+	w, err := wire.NewValueI32(v.Value), error(nil)
+	if err != nil {
+		return w, err
+	}
+	return wire.NewValueStruct(wire.Struct{Fields: []wire.Field{
+		{ID: 7, Value: w},
+	}}), nil
+}
+
+// String returns a readable string representation of a Shape_Hidden.
+func (v Shape_Hidden) String() string {
+	return fmt.Sprintf("Shape{Hidden: %v}", v.Value)
+}
+
+// Equals returns true if the provided Shape is a Shape_Hidden with
+// the same value.
+func (v Shape_Hidden) Equals(rhs Shape) bool {
+	r, ok := rhs.(Shape_Hidden)
+	return ok && (v.Value == r.Value)
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Shape_Hidden.
+func (v Shape_Hidden) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	return err
+}
+
+// MarshalJSON implements json.Marshaler, encoding Shape_Hidden as an
+// object with a single "hidden" key.
+func (v Shape_Hidden) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Value int32 `json:"hidden"`
+	}{v.Value})
+}
+
+// Shape_Group is the group variant of Shape.
+type Shape_Group struct {
+	Value []Shape
+}
+
+func (Shape_Group) isShape() {}
+
+// ToWire translates a Shape_Group into a Thrift-level intermediate
+// representation of Shape.
+func (v Shape_Group) ToWire() (wire.Value, error) {
+	w, err := wire.NewValueList(_List_Shape_ValueList(v.Value)), error(nil)
+	if err != nil {
+		return w, err
+	}
+	return wire.NewValueStruct(wire.Struct{Fields: []wire.Field{
+		{ID: 8, Value: w},
+	}}), nil
+}
+
+// String returns a readable string representation of a Shape_Group.
+func (v Shape_Group) String() string {
+	return fmt.Sprintf("Shape{Group: %v}", v.Value)
+}
+
+// Equals returns true if the provided Shape is a Shape_Group with
+// the same value.
+func (v Shape_Group) Equals(rhs Shape) bool {
+	r, ok := rhs.(Shape_Group)
+	return ok && _List_Shape_Equals(v.Value, r.Value)
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Shape_Group.
+func (v Shape_Group) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	err = multierr.Append(err, enc.AddArray("group", (_List_Shape_Zapper)(v.Value)))
+	return err
+}
+
+// MarshalJSON implements json.Marshaler, encoding Shape_Group as an
+// object with a single "group" key.
+func (v Shape_Group) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Value []Shape `json:"group"`
+	}{v.Value})
+}
+
+// Shape is one of several kinds of shapes.
+//
+// Shape is a union of the following types, exactly one of which is
+// held by any non-nil Shape:
+//
+//  - Shape_Circle
+//  - Shape_Square
+//  - Shape_Polygon
+//  - Shape_Label
+//  - Shape_Secret
+//  - Shape_Nested
+//  - Shape_Hidden
+//  - Shape_Group
+type Shape interface {
+	isShape()
+
+	// ToWire translates this Shape into a Thrift-level
+	// intermediate representation.
+	ToWire() (wire.Value, error)
+
+	// String returns a readable string representation of this
+	// Shape.
+	String() string
+
+	// Equals returns true if the provided Shape holds the same
+	// variant with the same value.
+	Equals(Shape) bool
+
+	// MarshalLogObject implements zapcore.ObjectMarshaler.
+	MarshalLogObject(zapcore.ObjectEncoder) error
+}
+
+func _Point_Read(w wire.Value) (*structs.Point, error) {
+	var v structs.Point
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _List_Point_Read(l wire.ValueList) ([]*structs.Point, error) {
+	if l.ValueType() != wire.TStruct {
+		return nil, nil
+	}
+
+	o := make([]*structs.Point, 0, l.Size())
+	err := l.ForEach(func(x wire.Value) error {
+		i, err := _Point_Read(x)
+		if err != nil {
+			return err
+		}
+		o = append(o, i)
+		return nil
+	})
+	l.Close()
+	return o, err
+}
+
+// Shape_FromWire deserializes a Shape from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned unless exactly one variant of Shape is
+// present in the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   return Shape_FromWire(x)
+func Shape_FromWire(w wire.Value) (Shape, error) {
+	var (
+		v     Shape
+		count int
+		err   error
+	)
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TStruct {
+				var x Shape_Circle
+				x.Value, err = _Circle_Read(field.Value)
+				if err != nil {
+					return nil, err
+				}
+				v = x
+				count++
+			}
+		case 2:
+			if field.Value.Type() == wire.TDouble {
+				var x2 Shape_Square
+				x2.Value, err = field.Value.GetDouble(), error(nil)
+				if err != nil {
+					return nil, err
+				}
+				v = x2
+				count++
+			}
+		case 3:
+			if field.Value.Type() == wire.TList {
+				var x3 Shape_Polygon
+				x3.Value, err = _List_Point_Read(field.Value.GetList())
+				if err != nil {
+					return nil, err
+				}
+				v = x3
+				count++
+			}
+		case 4:
+			if field.Value.Type() == wire.TBinary {
+				var x4 Shape_Label
+				x4.Value, err = field.Value.GetString(), error(nil)
+				if err != nil {
+					return nil, err
+				}
+				v = x4
+				count++
+			}
+		case 5:
+			if field.Value.Type() == wire.TBinary {
+				var x5 Shape_Secret
+				x5.Value, err = field.Value.GetBinary(), error(nil)
+				if err != nil {
+					return nil, err
+				}
+				v = x5
+				count++
+			}
+		case 6:
+			if field.Value.Type() == wire.TStruct {
+				var x6 Shape_Nested
+				x6.Value, err = Shape_FromWire(field.Value)
+				if err != nil {
+					return nil, err
+				}
+				v = x6
+				count++
+			}
+		case 7:
+			if field.Value.Type() == wire.TI32 {
+				var x7 Shape_Hidden
+				x7.Value, err = field.Value.GetI32(), error(nil)
+				if err != nil {
+					return nil, err
+				}
+				v = x7
+				count++
+			}
+		case 8:
+			if field.Value.Type() == wire.TList {
+				var x8 Shape_Group
+				x8.Value, err = _List_Shape_Read(field.Value.GetList())
+				if err != nil {
+					return nil, err
+				}
+				v = x8
+				count++
+			}
+		}
+	}
+
+	if count != 1 {
+		return nil, fmt.Errorf("Shape should have exactly one field: got %v fields", count)
+	}
+	return v, nil
+}
+
+// Shape_Equals returns true if the two provided Shape values hold
+// the same variant with the same value, or if both are nil.
+func Shape_Equals(lhs, rhs Shape) bool {
+	if lhs == nil {
+		return rhs == nil
+	}
+	return lhs.Equals(rhs)
+}
+
+// Shape_UnmarshalJSON decodes a Shape from JSON produced by the
+// MarshalJSON method of one of its variants: an object with exactly
+// one key naming the variant. null is decoded as a nil Shape.
+func Shape_UnmarshalJSON(b []byte) (Shape, error) {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(b, &fields); err != nil {
+		return nil, err
+	}
+	if fields == nil {
+		return nil, nil
+	}
+	if len(fields) != 1 {
+		return nil, fmt.Errorf("Shape should have exactly one field: got %v fields", len(fields))
+	}
+
+	// Pick the only entry of the map.
+	var (
+		k   string
+		raw json.RawMessage
+	)
+	for k, raw = range fields {
+	}
+
+	switch k {
+	case "circle":
+		var x Shape_Circle
+		err := json.Unmarshal(raw, &x.Value)
+		return x, err
+	case "square":
+		var x2 Shape_Square
+		err := json.Unmarshal(raw, &x2.Value)
+		return x2, err
+	case "polygon":
+		var x3 Shape_Polygon
+		err := json.Unmarshal(raw, &x3.Value)
+		return x3, err
+	case "name":
+		var x4 Shape_Label
+		err := json.Unmarshal(raw, &x4.Value)
+		return x4, err
+	case "secret":
+		var x5 Shape_Secret
+		err := json.Unmarshal(raw, &x5.Value)
+		return x5, err
+	case "nested":
+		var x6 Shape_Nested
+		var err error
+		x6.Value, err = Shape_UnmarshalJSON(raw)
+		return x6, err
+	case "hidden":
+		var x7 Shape_Hidden
+		err := json.Unmarshal(raw, &x7.Value)
+		return x7, err
+	case "group":
+		var x8 Shape_Group
+		var err error
+		x8.Value, err = _List_Shape_UnmarshalJSON(raw)
+		return x8, err
+	default:
+		return nil, fmt.Errorf("unknown field %q of Shape", k)
+	}
+}
+
+// Value_Number is the number variant of Value.
+type Value_Number struct {
+	Value int64
+}
+
+func (Value_Number) isValue() {}
+
+// ToWire translates a Value_Number into a Thrift-level intermediate
+// representation of Value.
+func (v Value_Number) ToWire() (wire.Value, error) {
+	w, err := wire.NewValueI64(v.Value), error(nil)
+	if err != nil {
+		return w, err
+	}
+	return wire.NewValueStruct(wire.Struct{Fields: []wire.Field{
+		{ID: 1, Value: w},
+	}}), nil
+}
+
+// String returns a readable string representation of a Value_Number.
+func (v Value_Number) String() string {
+	return fmt.Sprintf("Value{Number: %v}", v.Value)
+}
+
+// Equals returns true if the provided Value is a Value_Number with
+// the same value.
+func (v Value_Number) Equals(rhs Value) bool {
+	r, ok := rhs.(Value_Number)
+	return ok && (v.Value == r.Value)
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Value_Number.
+func (v Value_Number) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	enc.AddInt64("number", v.Value)
+	return err
+}
+
+// MarshalJSON implements json.Marshaler, encoding Value_Number as an
+// object with a single "number" key.
+func (v Value_Number) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Value int64 `json:"number"`
+	}{v.Value})
+}
+
+// Value_Text is the text variant of Value.
+type Value_Text struct {
+	Value string
+}
+
+func (Value_Text) isValue() {}
+
+// ToWire translates a Value_Text into a Thrift-level intermediate
+// representation of Value.
+func (v Value_Text) ToWire() (wire.Value, error) {
+	w, err := wire.NewValueString(v.Value), error(nil)
+	if err != nil {
+		return w, err
+	}
+	return wire.NewValueStruct(wire.Struct{Fields: []wire.Field{
+		{ID: 2, Value: w},
+	}}), nil
+}
+
+// String returns a readable string representation of a Value_Text.
+func (v Value_Text) String() string {
+	return fmt.Sprintf("Value{Text: %v}", v.Value)
+}
+
+// Equals returns true if the provided Value is a Value_Text with
+// the same value.
+func (v Value_Text) Equals(rhs Value) bool {
+	r, ok := rhs.(Value_Text)
+	return ok && (v.Value == r.Value)
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Value_Text.
+func (v Value_Text) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	enc.AddString("text", v.Value)
+	return err
+}
+
+// MarshalJSON implements json.Marshaler, encoding Value_Text as an
+// object with a single "text" key.
+func (v Value_Text) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Value string `json:"text"`
+	}{v.Value})
+}
+
+// Value is a union of the following types, exactly one of which is
+// held by any non-nil Value:
+//
+//  - Value_Number
+//  - Value_Text
+type Value interface {
+	isValue()
+
+	// ToWire translates this Value into a Thrift-level
+	// intermediate representation.
+	ToWire() (wire.Value, error)
+
+	// String returns a readable string representation of this
+	// Value.
+	String() string
+
+	// Equals returns true if the provided Value holds the same
+	// variant with the same value.
+	Equals(Value) bool
+
+	// MarshalLogObject implements zapcore.ObjectMarshaler.
+	MarshalLogObject(zapcore.ObjectEncoder) error
+}
+
+// Value_FromWire deserializes a Value from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned unless exactly one variant of Value is
+// present in the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   return Value_FromWire(x)
+func Value_FromWire(w wire.Value) (Value, error) {
+	var (
+		v     Value
+		count int
+		err   error
+	)
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TI64 {
+				var x Value_Number
+				x.Value, err = field.Value.GetI64(), error(nil)
+				if err != nil {
+					return nil, err
+				}
+				v = x
+				count++
+			}
+		case 2:
+			if field.Value.Type() == wire.TBinary {
+				var x2 Value_Text
+				x2.Value, err = field.Value.GetString(), error(nil)
+				if err != nil {
+					return nil, err
+				}
+				v = x2
+				count++
+			}
+		}
+	}
+
+	if count != 1 {
+		return nil, fmt.Errorf("Value should have exactly one field: got %v fields", count)
+	}
+	return v, nil
+}
+
+// Value_Equals returns true if the two provided Value values hold
+// the same variant with the same value, or if both are nil.
+func Value_Equals(lhs, rhs Value) bool {
+	if lhs == nil {
+		return rhs == nil
+	}
+	return lhs.Equals(rhs)
+}
+
+// Value_UnmarshalJSON decodes a Value from JSON produced by the
+// MarshalJSON method of one of its variants: an object with exactly
+// one key naming the variant. null is decoded as a nil Value.
+func Value_UnmarshalJSON(b []byte) (Value, error) {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(b, &fields); err != nil {
+		return nil, err
+	}
+	if fields == nil {
+		return nil, nil
+	}
+	if len(fields) != 1 {
+		return nil, fmt.Errorf("Value should have exactly one field: got %v fields", len(fields))
+	}
+
+	// Pick the only entry of the map.
+	var (
+		k   string
+		raw json.RawMessage
+	)
+	for k, raw = range fields {
+	}
+
+	switch k {
+	case "number":
+		var x Value_Number
+		err := json.Unmarshal(raw, &x.Value)
+		return x, err
+	case "text":
+		var x2 Value_Text
+		err := json.Unmarshal(raw, &x2.Value)
+		return x2, err
+	default:
+		return nil, fmt.Errorf("unknown field %q of Value", k)
+	}
+}
+
+// ThriftModule represents the IDL file used to generate this package.
+var ThriftModule = &thriftreflect.ThriftModule{
+	Name:     "sealed_unions",
+	Package:  "go.uber.org/thriftrw/gen/internal/tests/sealed_unions",
+	FilePath: "sealed_unions.thrift",
+	SHA1:     "4c6997a65e02545e0f820e2a2420acde8fd6a0bc",
+	Includes: []*thriftreflect.ThriftModule{
+		structs.ThriftModule,
+	},
+	Raw: rawIDL,
+}
+
+const rawIDL = "include \"./structs.thrift\"\n\nstruct Circle {\n    1: required double radius\n}\n\n/**\n * Shape is one of several kinds of shapes.\n */\nunion Shape {\n    1: Circle circle\n    /** A square of the given side. */\n    2: double square\n    3: list<structs.Point> polygon\n    4: string label (go.label = \"name\")\n    5: binary secret (go.redact)\n    6: Shape nested\n    7: i32 hidden (go.nolog)\n    8: list<Shape> group\n} (go.union = \"interface\")\n\nunion Value {\n    1: i64 number\n    2: string text\n} (go.union = \"interface\")\n\ntypedef list<list<Shape>> Layers\n\nconst Shape UNIT_CIRCLE = {\"circle\": {\"radius\": 1.0}}\n\nstruct Drawing {\n    1: required Shape primary\n    2: optional Shape secondary\n    3: optional Value caption\n    4: optional list<Shape> others\n    5: optional map<string, Value> attributes\n    6: optional set<Value> tags\n    7: optional map<Circle, Shape> labels\n    8: optional Layers layers\n}\n\nservice Canvas {\n    Shape draw(1: Shape shape, 2: Drawing drawing)\n}\n"
+
+func init() {
+	thriftreflect.Register(ThriftModule, map[string]thriftreflect.Constructor{
+		"Circle":  func() interface{} { return new(Circle) },
+		"Drawing": func() interface{} { return new(Drawing) },
+	})
+}
+
+// Canvas_Draw_Args represents the arguments for the Canvas.draw function.
+//
+// The arguments for draw are sent and received over the wire as this struct.
+type Canvas_Draw_Args struct {
+	Shape   Shape    `json:"shape,omitempty"`
+	Drawing *Drawing `json:"drawing,omitempty"`
+}
+
+// ToWire translates a Canvas_Draw_Args struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *Canvas_Draw_Args) ToWire() (wire.Value, error) {
+	var (
+		fields [2]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Shape != nil {
+		w, err = v.Shape.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 1, Value: w}
+		i++
+	}
+	if v.Drawing != nil {
+		w, err = v.Drawing.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 2, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _Drawing_Read(w wire.Value) (*Drawing, error) {
+	var v Drawing
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// FromWire deserializes a Canvas_Draw_Args struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a Canvas_Draw_Args struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v Canvas_Draw_Args
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *Canvas_Draw_Args) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TStruct {
+				v.Shape, err = Shape_FromWire(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 2:
+			if field.Value.Type() == wire.TStruct {
+				v.Drawing, err = _Drawing_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a Canvas_Draw_Args
+// struct.
+func (v *Canvas_Draw_Args) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [2]string
+	i := 0
+	if v.Shape != nil {
+		fields[i] = fmt.Sprintf("Shape: %v", v.Shape)
+		i++
+	}
+	if v.Drawing != nil {
+		fields[i] = fmt.Sprintf("Drawing: %v", v.Drawing)
+		i++
+	}
+
+	return fmt.Sprintf("Canvas_Draw_Args{%v}", strings.Join(fields[:i], ", "))
+}
+
+// UnmarshalJSON implements json.Unmarshaler, decoding unions
+// held by Canvas_Draw_Args into their variants.
+func (v *Canvas_Draw_Args) UnmarshalJSON(b []byte) error {
+	type alias Canvas_Draw_Args
+	x := struct {
+		*alias
+		Shape json.RawMessage `json:"shape,omitempty"`
+	}{alias: (*alias)(v)}
+	if err := json.Unmarshal(b, &x); err != nil {
+		return err
+	}
+
+	if len(x.Shape) > 0 && string(x.Shape) != "null" {
+		u, err := Shape_UnmarshalJSON(x.Shape)
+		if err != nil {
+			return err
+		}
+		v.Shape = u
+	}
+
+	return nil
+}
+
+// Equals returns true if all the fields of this Canvas_Draw_Args match the
+// provided Canvas_Draw_Args.
+//
+// This function performs a deep comparison.
+func (v *Canvas_Draw_Args) Equals(rhs *Canvas_Draw_Args) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !((v.Shape == nil && rhs.Shape == nil) || (v.Shape != nil && rhs.Shape != nil && Shape_Equals(v.Shape, rhs.Shape))) {
+		return false
+	}
+	if !((v.Drawing == nil && rhs.Drawing == nil) || (v.Drawing != nil && rhs.Drawing != nil && v.Drawing.Equals(rhs.Drawing))) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Canvas_Draw_Args.
+func (v *Canvas_Draw_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Shape != nil {
+		err = multierr.Append(err, enc.AddObject("shape", _Shape_Zapper{Value: v.Shape}))
+	}
+	if v.Drawing != nil {
+		err = multierr.Append(err, enc.AddObject("drawing", v.Drawing))
+	}
+	return err
+}
+
+// GetShape returns the value of Shape if it is set or its
+// zero value if it is unset.
+func (v *Canvas_Draw_Args) GetShape() (o Shape) {
+	if v != nil && v.Shape != nil {
+		return v.Shape
+	}
+
+	return
+}
+
+// IsSetShape returns true if Shape is not nil.
+func (v *Canvas_Draw_Args) IsSetShape() bool {
+	return v != nil && v.Shape != nil
+}
+
+// GetDrawing returns the value of Drawing if it is set or its
+// zero value if it is unset.
+func (v *Canvas_Draw_Args) GetDrawing() (o *Drawing) {
+	if v != nil && v.Drawing != nil {
+		return v.Drawing
+	}
+
+	return
+}
+
+// IsSetDrawing returns true if Drawing is not nil.
+func (v *Canvas_Draw_Args) IsSetDrawing() bool {
+	return v != nil && v.Drawing != nil
+}
+
+// MethodName returns the name of the Thrift function as specified in
+// the IDL, for which this struct represent the arguments.
+//
+// This will always be "draw" for this struct.
+func (v *Canvas_Draw_Args) MethodName() string {
+	return "draw"
+}
+
+// EnvelopeType returns the kind of value inside this struct.
+//
+// This will always be Call for this struct.
+func (v *Canvas_Draw_Args) EnvelopeType() wire.EnvelopeType {
+	return wire.Call
+}
+
+// Canvas_Draw_Helper provides functions that aid in handling the
+// parameters and return values of the Canvas.draw
+// function.
+var Canvas_Draw_Helper = struct {
+	// Args accepts the parameters of draw in-order and returns
+	// the arguments struct for the function.
+	Args func(
+		shape Shape,
+		drawing *Drawing,
+	) *Canvas_Draw_Args
+
+	// IsException returns true if the given error can be thrown
+	// by draw.
+	//
+	// An error can be thrown by draw only if the
+	// corresponding exception type was mentioned in the 'throws'
+	// section for it in the Thrift file.
+	IsException func(error) bool
+
+	// WrapResponse returns the result struct for draw
+	// given its return value and error.
+	//
+	// This allows mapping values and errors returned by
+	// draw into a serializable result struct.
+	// WrapResponse returns a non-nil error if the provided
+	// error cannot be thrown by draw
+	//
+	//   value, err := draw(args)
+	//   result, err := Canvas_Draw_Helper.WrapResponse(value, err)
+	//   if err != nil {
+	//     return fmt.Errorf("unexpected error from draw: %v", err)
+	//   }
+	//   serialize(result)
+	WrapResponse func(Shape, error) (*Canvas_Draw_Result, error)
+
+	// UnwrapResponse takes the result struct for draw
+	// and returns the value or error returned by it.
+	//
+	// The error is non-nil only if draw threw an
+	// exception.
+	//
+	//   result := deserialize(bytes)
+	//   value, err := Canvas_Draw_Helper.UnwrapResponse(result)
+	UnwrapResponse func(*Canvas_Draw_Result) (Shape, error)
+}{}
+
+func init() {
+	Canvas_Draw_Helper.Args = func(
+		shape Shape,
+		drawing *Drawing,
+	) *Canvas_Draw_Args {
+		return &Canvas_Draw_Args{
+			Shape:   shape,
+			Drawing: drawing,
+		}
+	}
+
+	Canvas_Draw_Helper.IsException = func(err error) bool {
+		switch err.(type) {
+		default:
+			return false
+		}
+	}
+
+	Canvas_Draw_Helper.WrapResponse = func(success Shape, err error) (*Canvas_Draw_Result, error) {
+		if err == nil {
+			return &Canvas_Draw_Result{Success: success}, nil
+		}
+
+		return nil, err
+	}
+	Canvas_Draw_Helper.UnwrapResponse = func(result *Canvas_Draw_Result) (success Shape, err error) {
+
+		if result.Success != nil {
+			success = result.Success
+			return
+		}
+
+		err = errors.New("expected a non-void result")
+		return
+	}
+
+}
+
+// Canvas_Draw_Result represents the result of a Canvas.draw function call.
+//
+// The result of a draw execution is sent and received over the wire as this struct.
+//
+// Success is set only if the function did not throw an exception.
+type Canvas_Draw_Result struct {
+	// Value returned by draw after a successful execution.
+	Success Shape `json:"success,omitempty"`
+}
+
+// ToWire translates a Canvas_Draw_Result struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *Canvas_Draw_Result) ToWire() (wire.Value, error) {
+	var (
+		fields [1]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Success != nil {
+		w, err = v.Success.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 0, Value: w}
+		i++
+	}
+
+	if i != 1 {
+		return wire.Value{}, fmt.Errorf("Canvas_Draw_Result should have exactly one field: got %v fields", i)
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a Canvas_Draw_Result struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a Canvas_Draw_Result struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v Canvas_Draw_Result
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *Canvas_Draw_Result) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 0:
+			if field.Value.Type() == wire.TStruct {
+				v.Success, err = Shape_FromWire(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	count := 0
+	if v.Success != nil {
+		count++
+	}
+	if count != 1 {
+		return fmt.Errorf("Canvas_Draw_Result should have exactly one field: got %v fields", count)
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a Canvas_Draw_Result
+// struct.
+func (v *Canvas_Draw_Result) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [1]string
+	i := 0
+	if v.Success != nil {
+		fields[i] = fmt.Sprintf("Success: %v", v.Success)
+		i++
+	}
+
+	return fmt.Sprintf("Canvas_Draw_Result{%v}", strings.Join(fields[:i], ", "))
+}
+
+// UnmarshalJSON implements json.Unmarshaler, decoding unions
+// held by Canvas_Draw_Result into their variants.
+func (v *Canvas_Draw_Result) UnmarshalJSON(b []byte) error {
+	type alias Canvas_Draw_Result
+	x := struct {
+		*alias
+		Success json.RawMessage `json:"success,omitempty"`
+	}{alias: (*alias)(v)}
+	if err := json.Unmarshal(b, &x); err != nil {
+		return err
+	}
+
+	if len(x.Success) > 0 && string(x.Success) != "null" {
+		u, err := Shape_UnmarshalJSON(x.Success)
+		if err != nil {
+			return err
+		}
+		v.Success = u
+	}
+
+	return nil
+}
+
+// Equals returns true if all the fields of this Canvas_Draw_Result match the
+// provided Canvas_Draw_Result.
+//
+// This function performs a deep comparison.
+func (v *Canvas_Draw_Result) Equals(rhs *Canvas_Draw_Result) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !((v.Success == nil && rhs.Success == nil) || (v.Success != nil && rhs.Success != nil && Shape_Equals(v.Success, rhs.Success))) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Canvas_Draw_Result.
+func (v *Canvas_Draw_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Success != nil {
+		err = multierr.Append(err, enc.AddObject("success", _Shape_Zapper{Value: v.Success}))
+	}
+	return err
+}
+
+// GetSuccess returns the value of Success if it is set or its
+// zero value if it is unset.
+func (v *Canvas_Draw_Result) GetSuccess() (o Shape) {
+	if v != nil && v.Success != nil {
+		return v.Success
+	}
+
+	return
+}
+
+// IsSetSuccess returns true if Success is not nil.
+func (v *Canvas_Draw_Result) IsSetSuccess() bool {
+	return v != nil && v.Success != nil
+}
+
+// MethodName returns the name of the Thrift function as specified in
+// the IDL, for which this struct represent the result.
+//
+// This will always be "draw" for this struct.
+func (v *Canvas_Draw_Result) MethodName() string {
+	return "draw"
+}
+
+// EnvelopeType returns the kind of value inside this struct.
+//
+// This will always be Reply for this struct.
+func (v *Canvas_Draw_Result) EnvelopeType() wire.EnvelopeType {
+	return wire.Reply
+}
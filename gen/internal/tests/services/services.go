@@ -384,6 +384,34 @@ func (lhs Key) Equals(rhs Key) bool {
 	return ((string)(lhs) == (string)(rhs))
 }
 
+// ExceptionFromWire deserializes the exception defined in this
+// package with the given Thrift name from its Thrift-level
+// representation.
+//
+// ok is false if this package does not define an exception with the
+// given name. err is non-nil if the exception could not be
+// deserialized.
+//
+//   e, ok, err := ExceptionFromWire("InternalError", value)
+//   if err != nil {
+//     return err
+//   }
+//   if ok {
+//     return e
+//   }
+func ExceptionFromWire(name string, w wire.Value) (exc error, ok bool, err error) {
+	switch name {
+	case "InternalError":
+		var v InternalError
+		if err := v.FromWire(w); err != nil {
+			return nil, true, err
+		}
+		return &v, true, nil
+	default:
+		return nil, false, nil
+	}
+}
+
 // ThriftModule represents the IDL file used to generate this package.
 var ThriftModule = &thriftreflect.ThriftModule{
 	Name:     "services",
@@ -399,6 +427,13 @@ var ThriftModule = &thriftreflect.ThriftModule{
 
 const rawIDL = "include \"./unions.thrift\"\ninclude \"./exceptions.thrift\"\n\ntypedef string Key\n\nexception InternalError {\n    1: optional string message\n}\n\nservice KeyValue {\n    // void and no exceptions\n    void setValue(1: Key key, 2: unions.ArbitraryValue value)\n\n    void setValueV2(\n        /** Key to change. */\n        1: required Key key,\n        /**\n         * New value for the key.\n         *\n         * If the key already has an existing value, it will be overwritten.\n         */\n        2: required unions.ArbitraryValue value,\n    )\n\n    // Return with exceptions\n    unions.ArbitraryValue getValue(1: Key key)\n        throws (1: exceptions.DoesNotExistException doesNotExist)\n\n    // void with exceptions\n    void deleteValue(1: Key key)\n        throws (\n            /**\n             * Raised if a value with the given key doesn't exist.\n             */\n            1: exceptions.DoesNotExistException doesNotExist,\n            2: InternalError internalError\n        )\n\n    list<unions.ArbitraryValue> getManyValues(\n        1: list<Key> range  // < reserved keyword as an argument\n    ) throws (\n        1: exceptions.DoesNotExistException doesNotExist,\n    )\n\n    i64 size()  // < primitve return value\n}\n\nservice Cache {\n    oneway void clear()\n    oneway void clearAfter(1: i64 durationMS)\n}\n\nstruct ConflictingNames_SetValue_Args {\n    1: required string key\n    2: required binary value\n}\n\nservice ConflictingNames {\n    void setValue(1: ConflictingNames_SetValue_Args request)\n}\n\nservice non_standard_service_name {\n    void non_standard_function_name()\n}\n"
 
+func init() {
+	thriftreflect.Register(ThriftModule, map[string]thriftreflect.Constructor{
+		"ConflictingNames_SetValue_Args": func() interface{} { return new(ConflictingNamesSetValueArgs) },
+		"InternalError":                  func() interface{} { return new(InternalError) },
+	})
+}
+
 // Cache_Clear_Args represents the arguments for the Cache.clear function.
 //
 // The arguments for clear are sent and received over the wire as this struct.
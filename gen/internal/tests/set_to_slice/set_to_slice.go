@@ -1315,3 +1315,10 @@ var ThriftModule = &thriftreflect.ThriftModule{
 }
 
 const rawIDL = "typedef set<string> StringSet\ntypedef set<string> (go.type = \"slice\") StringList\ntypedef set<Foo> (go.type = \"slice\") FooList\ntypedef StringList MyStringList\ntypedef MyStringList AnotherStringList\n\ntypedef set<set<string> (go.type = \"slice\")> (go.type = \"slice\") StringListList\n\nstruct Foo {\n    1: required string stringField\n}\n\nstruct Bar {\n    1: required set<i32> (go.type = \"slice\") requiredInt32ListField\n    2: optional set<string> (go.type = \"slice\") optionalStringListField\n    3: required StringList requiredTypedefStringListField\n    4: optional StringList optionalTypedefStringListField\n    5: required set<Foo> (go.type = \"slice\") requiredFooListField\n    6: optional set<Foo> (go.type = \"slice\") optionalFooListField\n    7: required FooList requiredTypedefFooListField\n    8: optional FooList optionalTypedefFooListField\n    9: required set<set<string> (go.type = \"slice\")> (go.type = \"slice\") requiredStringListListField\n    10: required StringListList requiredTypedefStringListListField\n}\n\nconst set<string> (go.type = \"slice\") ConstStringList = [\"hello\"]\nconst set<set<string>(go.type = \"slice\")> (go.type = \"slice\") ConstListStringList = [[\"hello\"], [\"world\"]]\n"
+
+func init() {
+	thriftreflect.Register(ThriftModule, map[string]thriftreflect.Constructor{
+		"Bar": func() interface{} { return new(Bar) },
+		"Foo": func() interface{} { return new(Foo) },
+	})
+}
@@ -5,7 +5,9 @@ package structs
 
 import (
 	bytes "bytes"
+	sha256 "crypto/sha256"
 	base64 "encoding/base64"
+	json "encoding/json"
 	errors "errors"
 	fmt "fmt"
 	multierr "go.uber.org/multierr"
@@ -4274,6 +4276,381 @@ func (v *PrimitiveRequiredStruct) IsSetBinaryField() bool {
 	return v != nil && v.BinaryField != nil
 }
 
+type RedactedStruct struct {
+	Username string  `json:"username,required"`
+	Token    string  `json:"token,required"`
+	Password *string `json:"password,omitempty"`
+	Secret   []byte  `json:"secret,omitempty"`
+	Pin      *int64  `json:"pin,omitempty"`
+	Location *Point  `json:"location,omitempty"`
+}
+
+// ToWire translates a RedactedStruct struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *RedactedStruct) ToWire() (wire.Value, error) {
+	var (
+		fields [6]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	w, err = wire.NewValueString(v.Username), error(nil)
+	if err != nil {
+		return w, err
+	}
+	fields[i] = wire.Field{ID: 1, Value: w}
+	i++
+
+	w, err = wire.NewValueString(v.Token), error(nil)
+	if err != nil {
+		return w, err
+	}
+	fields[i] = wire.Field{ID: 2, Value: w}
+	i++
+	if v.Password != nil {
+		w, err = wire.NewValueString(*(v.Password)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 3, Value: w}
+		i++
+	}
+	if v.Secret != nil {
+		w, err = wire.NewValueBinary(v.Secret), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 4, Value: w}
+		i++
+	}
+	if v.Pin != nil {
+		w, err = wire.NewValueI64(*(v.Pin)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 5, Value: w}
+		i++
+	}
+	if v.Location != nil {
+		w, err = v.Location.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 6, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a RedactedStruct struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a RedactedStruct struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v RedactedStruct
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *RedactedStruct) FromWire(w wire.Value) error {
+	var err error
+
+	usernameIsSet := false
+	tokenIsSet := false
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TBinary {
+				v.Username, err = field.Value.GetString(), error(nil)
+				if err != nil {
+					return err
+				}
+				usernameIsSet = true
+			}
+		case 2:
+			if field.Value.Type() == wire.TBinary {
+				v.Token, err = field.Value.GetString(), error(nil)
+				if err != nil {
+					return err
+				}
+				tokenIsSet = true
+			}
+		case 3:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Password = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 4:
+			if field.Value.Type() == wire.TBinary {
+				v.Secret, err = field.Value.GetBinary(), error(nil)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 5:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.Pin = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 6:
+			if field.Value.Type() == wire.TStruct {
+				v.Location, err = _Point_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	if !usernameIsSet {
+		return errors.New("field Username of RedactedStruct is required")
+	}
+
+	if !tokenIsSet {
+		return errors.New("field Token of RedactedStruct is required")
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a RedactedStruct
+// struct.
+func (v *RedactedStruct) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [6]string
+	i := 0
+	fields[i] = fmt.Sprintf("Username: %v", v.Username)
+	i++
+	fields[i] = "Token: " + "<redacted>"
+	i++
+	if v.Password != nil {
+		fields[i] = "Password: " + fmt.Sprintf("<redacted len=%d>", len(*v.Password))
+		i++
+	}
+	if v.Secret != nil {
+		fields[i] = "Secret: " + fmt.Sprintf("<redacted sha256=%x>", sha256.Sum256([]byte(v.Secret)))
+		i++
+	}
+	if v.Pin != nil {
+		fields[i] = "Pin: " + "<redacted>"
+		i++
+	}
+	if v.Location != nil {
+		fields[i] = "Location: " + "<redacted>"
+		i++
+	}
+
+	return fmt.Sprintf("RedactedStruct{%v}", strings.Join(fields[:i], ", "))
+}
+
+// MarshalJSON implements json.Marshaler, masking the values of
+// sensitive fields of RedactedStruct.
+//
+// MarshalJSON has a value receiver so that it is also used for
+// RedactedStruct values that are not addressable.
+func (v RedactedStruct) MarshalJSON() ([]byte, error) {
+	type alias RedactedStruct
+	x := struct {
+		*alias
+		Token    string  `json:"token,required"`
+		Password *string `json:"password,omitempty"`
+		Secret   *string `json:"secret,omitempty"`
+		Pin      *string `json:"pin,omitempty"`
+		Location *string `json:"location,omitempty"`
+	}{alias: (*alias)(&v)}
+
+	x.Token = "<redacted>"
+	if v.Password != nil {
+		s := fmt.Sprintf("<redacted len=%d>", len(*v.Password))
+		x.Password = &s
+	}
+	if v.Secret != nil {
+		s2 := fmt.Sprintf("<redacted sha256=%x>", sha256.Sum256([]byte(v.Secret)))
+		x.Secret = &s2
+	}
+	if v.Pin != nil {
+		s3 := "<redacted>"
+		x.Pin = &s3
+	}
+	if v.Location != nil {
+		s4 := "<redacted>"
+		x.Location = &s4
+	}
+
+	return json.Marshal(x)
+}
+
+// Equals returns true if all the fields of this RedactedStruct match the
+// provided RedactedStruct.
+//
+// This function performs a deep comparison.
+func (v *RedactedStruct) Equals(rhs *RedactedStruct) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !(v.Username == rhs.Username) {
+		return false
+	}
+	if !(v.Token == rhs.Token) {
+		return false
+	}
+	if !_String_EqualsPtr(v.Password, rhs.Password) {
+		return false
+	}
+	if !((v.Secret == nil && rhs.Secret == nil) || (v.Secret != nil && rhs.Secret != nil && bytes.Equal(v.Secret, rhs.Secret))) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.Pin, rhs.Pin) {
+		return false
+	}
+	if !((v.Location == nil && rhs.Location == nil) || (v.Location != nil && rhs.Location != nil && v.Location.Equals(rhs.Location))) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of RedactedStruct.
+func (v *RedactedStruct) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	enc.AddString("username", v.Username)
+	enc.AddString("token", "<redacted>")
+	if v.Password != nil {
+		enc.AddString("password", fmt.Sprintf("<redacted len=%d>", len(*v.Password)))
+	}
+	if v.Secret != nil {
+		enc.AddString("secret", fmt.Sprintf("<redacted sha256=%x>", sha256.Sum256([]byte(v.Secret))))
+	}
+	if v.Pin != nil {
+		enc.AddString("pin", "<redacted>")
+	}
+	if v.Location != nil {
+		enc.AddString("location", "<redacted>")
+	}
+	return err
+}
+
+// GetUsername returns the value of Username if it is set or its
+// zero value if it is unset.
+func (v *RedactedStruct) GetUsername() (o string) {
+	if v != nil {
+		o = v.Username
+	}
+	return
+}
+
+// GetToken returns the value of Token if it is set or its
+// zero value if it is unset.
+func (v *RedactedStruct) GetToken() (o string) {
+	if v != nil {
+		o = v.Token
+	}
+	return
+}
+
+// GetPassword returns the value of Password if it is set or its
+// zero value if it is unset.
+func (v *RedactedStruct) GetPassword() (o string) {
+	if v != nil && v.Password != nil {
+		return *v.Password
+	}
+
+	return
+}
+
+// IsSetPassword returns true if Password is not nil.
+func (v *RedactedStruct) IsSetPassword() bool {
+	return v != nil && v.Password != nil
+}
+
+// GetSecret returns the value of Secret if it is set or its
+// zero value if it is unset.
+func (v *RedactedStruct) GetSecret() (o []byte) {
+	if v != nil && v.Secret != nil {
+		return v.Secret
+	}
+
+	return
+}
+
+// IsSetSecret returns true if Secret is not nil.
+func (v *RedactedStruct) IsSetSecret() bool {
+	return v != nil && v.Secret != nil
+}
+
+// GetPin returns the value of Pin if it is set or its
+// zero value if it is unset.
+func (v *RedactedStruct) GetPin() (o int64) {
+	if v != nil && v.Pin != nil {
+		return *v.Pin
+	}
+
+	return
+}
+
+// IsSetPin returns true if Pin is not nil.
+func (v *RedactedStruct) IsSetPin() bool {
+	return v != nil && v.Pin != nil
+}
+
+// GetLocation returns the value of Location if it is set or its
+// zero value if it is unset.
+func (v *RedactedStruct) GetLocation() (o *Point) {
+	if v != nil && v.Location != nil {
+		return v.Location
+	}
+
+	return
+}
+
+// IsSetLocation returns true if Location is not nil.
+func (v *RedactedStruct) IsSetLocation() bool {
+	return v != nil && v.Location != nil
+}
+
 type Rename struct {
 	Default   string `json:"default,required"`
 	CamelCase string `json:"snake_case,required"`
@@ -5411,11 +5788,36 @@ var ThriftModule = &thriftreflect.ThriftModule{
 	Name:     "structs",
 	Package:  "go.uber.org/thriftrw/gen/internal/tests/structs",
 	FilePath: "structs.thrift",
-	SHA1:     "2f43f38d30f0f9e3b089118d7ffc6b4dc532ada6",
+	SHA1:     "f15f066cc6177aa26745bcb43bb42e59b11b02b4",
 	Includes: []*thriftreflect.ThriftModule{
 		enums.ThriftModule,
 	},
 	Raw: rawIDL,
 }
 
-const rawIDL = "include \"./enums.thrift\"\n\nstruct EmptyStruct {}\n\n//////////////////////////////////////////////////////////////////////////////\n// Structs with primitives\n\n/**\n * A struct that contains primitive fields exclusively.\n *\n * All fields are required.\n */\nstruct PrimitiveRequiredStruct {\n    1: required bool boolField\n    2: required byte byteField\n    3: required i16 int16Field\n    4: required i32 int32Field\n    5: required i64 int64Field\n    6: required double doubleField\n    7: required string stringField\n    8: required binary binaryField\n}\n\n/**\n * A struct that contains primitive fields exclusively.\n *\n * All fields are optional.\n */\nstruct PrimitiveOptionalStruct {\n    1: optional bool boolField\n    2: optional byte byteField\n    3: optional i16 int16Field\n    4: optional i32 int32Field\n    5: optional i64 int64Field\n    6: optional double doubleField\n    7: optional string stringField\n    8: optional binary binaryField\n}\n\n//////////////////////////////////////////////////////////////////////////////\n// Nested structs (Required)\n\n/**\n * A point in 2D space.\n */\nstruct Point {\n    1: required double x\n    2: required double y\n}\n\n/**\n * Size of something.\n */\nstruct Size {\n    /**\n     * Width in pixels.\n     */\n    1: required double width\n    /** Height in pixels. */\n    2: required double height\n}\n\nstruct Frame {\n    1: required Point topLeft\n    2: required Size size\n}\n\nstruct Edge {\n    1: required Point startPoint\n    2: required Point endPoint\n}\n\n/**\n * A graph is comprised of zero or more edges.\n */\nstruct Graph {\n    /**\n     * List of edges in the graph.\n     *\n     * May be empty.\n     */\n    1: required list<Edge> edges\n}\n\n//////////////////////////////////////////////////////////////////////////////\n// Nested structs (Optional)\n\nstruct ContactInfo {\n    1: required string emailAddress\n}\n\nstruct PersonalInfo {\n    1: optional i32 age\n}\n\nstruct User {\n    1: required string name\n    2: optional ContactInfo contact\n    3: optional PersonalInfo personal\n}\n\ntypedef map<string, User> UserMap\n\n//////////////////////////////////////////////////////////////////////////////\n// self-referential struct\n\ntypedef Node List\n\n/**\n * Node is linked list of values.\n * All values are 32-bit integers.\n */\nstruct Node {\n    1: required i32 value\n    2: optional List tail\n}\n\n//////////////////////////////////////////////////////////////////////////////\n// JSON tagged structs\n\nstruct Rename {\n    1: required string Default (go.tag = 'json:\"default\"')\n    2: required string camelCase (go.tag = 'json:\"snake_case\"')\n}\n\nstruct Omit {\n    1: required string serialized\n    2: required string hidden (go.tag = 'json:\"-\"')\n}\n\nstruct GoTags {\n        1: required string Foo (go.tag = 'json:\"-\" foo:\"bar\"')\n        2: optional string Bar (go.tag = 'bar:\"foo\"')\n        3: required string FooBar (go.tag = 'json:\"foobar,option1,option2\" bar:\"foo,option1\" foo:\"foobar\"')\n        4: required string FooBarWithSpace (go.tag = 'json:\"foobarWithSpace\" foo:\"foo bar foobar barfoo\"')\n        5: optional string FooBarWithOmitEmpty (go.tag = 'json:\"foobarWithOmitEmpty,omitempty\"')\n        6: required string FooBarWithRequired (go.tag = 'json:\"foobarWithRequired,required\"')\n}\n\nstruct NotOmitEmpty {\n    1: optional string NotOmitEmptyString (go.tag = 'json:\"notOmitEmptyString,!omitempty\"')\n    2: optional string NotOmitEmptyInt (go.tag = 'json:\"notOmitEmptyInt,!omitempty\"')\n    3: optional string NotOmitEmptyBool (go.tag = 'json:\"notOmitEmptyBool,!omitempty\"')\n    4: optional list<string> NotOmitEmptyList (go.tag = 'json:\"notOmitEmptyList,!omitempty\"')\n    5: optional map<string, string> NotOmitEmptyMap (go.tag = 'json:\"notOmitEmptyMap,!omitempty\"')\n    6: optional list<string> NotOmitEmptyListMixedWithOmitEmpty (go.tag = 'json:\"notOmitEmptyListMixedWithOmitEmpty,!omitempty,omitempty\"')\n    7: optional list<string> NotOmitEmptyListMixedWithOmitEmptyV2 (go.tag = 'json:\"notOmitEmptyListMixedWithOmitEmptyV2,omitempty,!omitempty\"')\n    8: optional string OmitEmptyString (go.tag = 'json:\"omitEmptyString,omitempty\"') // to test that there can be a mix of fields that do and don't have !omitempty\n}\n\n//////////////////////////////////////////////////////////////////////////////\n// Default values\n\nstruct DefaultsStruct {\n    1: required i32 requiredPrimitive = 100\n    2: optional i32 optionalPrimitive = 200\n\n    3: required enums.EnumDefault requiredEnum = enums.EnumDefault.Bar\n    4: optional enums.EnumDefault optionalEnum = 2\n\n    5: required list<string> requiredList = [\"hello\", \"world\"]\n    6: optional list<double> optionalList = [1, 2.0, 3]\n\n    7: required Frame requiredStruct = {\n        \"topLeft\": {\"x\": 1, \"y\": 2},\n        \"size\": {\"width\": 100, \"height\": 200},\n    }\n    8: optional Edge optionalStruct = {\n        \"startPoint\": {\"x\": 1, \"y\": 2},\n        \"endPoint\":   {\"x\": 3, \"y\": 4},\n    }\n\n    9:  required bool requiredBoolDefaultTrue = true\n    10: optional bool optionalBoolDefaultTrue = true\n\n    11: required bool requiredBoolDefaultFalse = false\n    12: optional bool optionalBoolDefaultFalse = false\n}\n\n//////////////////////////////////////////////////////////////////////////////\n// Opt-out of Zap\n\nstruct ZapOptOutStruct {\n    1: required string name\n    2: required string optout (go.nolog)\n}\n\n//////////////////////////////////////////////////////////////////////////////\n// Field jabels\n\nstruct StructLabels {\n    // reserved keyword as label\n    1: optional bool isRequired (go.label = \"required\")\n\n    // go.tag's JSON tag takes precedence over go.label\n    2: optional string foo (go.label = \"bar\", go.tag = 'json:\"not_bar\"')\n\n    // Empty label\n    3: optional string qux (go.label = \"\")\n\n    // All-caps label\n    4: optional string quux (go.label = \"QUUX\")\n}\n"
+const rawIDL = "include \"./enums.thrift\"\n\nstruct EmptyStruct {}\n\n//////////////////////////////////////////////////////////////////////////////\n// Structs with primitives\n\n/**\n * A struct that contains primitive fields exclusively.\n *\n * All fields are required.\n */\nstruct PrimitiveRequiredStruct {\n    1: required bool boolField\n    2: required byte byteField\n    3: required i16 int16Field\n    4: required i32 int32Field\n    5: required i64 int64Field\n    6: required double doubleField\n    7: required string stringField\n    8: required binary binaryField\n}\n\n/**\n * A struct that contains primitive fields exclusively.\n *\n * All fields are optional.\n */\nstruct PrimitiveOptionalStruct {\n    1: optional bool boolField\n    2: optional byte byteField\n    3: optional i16 int16Field\n    4: optional i32 int32Field\n    5: optional i64 int64Field\n    6: optional double doubleField\n    7: optional string stringField\n    8: optional binary binaryField\n}\n\n//////////////////////////////////////////////////////////////////////////////\n// Nested structs (Required)\n\n/**\n * A point in 2D space.\n */\nstruct Point {\n    1: required double x\n    2: required double y\n}\n\n/**\n * Size of something.\n */\nstruct Size {\n    /**\n     * Width in pixels.\n     */\n    1: required double width\n    /** Height in pixels. */\n    2: required double height\n}\n\nstruct Frame {\n    1: required Point topLeft\n    2: required Size size\n}\n\nstruct Edge {\n    1: required Point startPoint\n    2: required Point endPoint\n}\n\n/**\n * A graph is comprised of zero or more edges.\n */\nstruct Graph {\n    /**\n     * List of edges in the graph.\n     *\n     * May be empty.\n     */\n    1: required list<Edge> edges\n}\n\n//////////////////////////////////////////////////////////////////////////////\n// Nested structs (Optional)\n\nstruct ContactInfo {\n    1: required string emailAddress\n}\n\nstruct PersonalInfo {\n    1: optional i32 age\n}\n\nstruct User {\n    1: required string name\n    2: optional ContactInfo contact\n    3: optional PersonalInfo personal\n}\n\ntypedef map<string, User> UserMap\n\n//////////////////////////////////////////////////////////////////////////////\n// self-referential struct\n\ntypedef Node List\n\n/**\n * Node is linked list of values.\n * All values are 32-bit integers.\n */\nstruct Node {\n    1: required i32 value\n    2: optional List tail\n}\n\n//////////////////////////////////////////////////////////////////////////////\n// JSON tagged structs\n\nstruct Rename {\n    1: required string Default (go.tag = 'json:\"default\"')\n    2: required string camelCase (go.tag = 'json:\"snake_case\"')\n}\n\nstruct Omit {\n    1: required string serialized\n    2: required string hidden (go.tag = 'json:\"-\"')\n}\n\nstruct GoTags {\n        1: required string Foo (go.tag = 'json:\"-\" foo:\"bar\"')\n        2: optional string Bar (go.tag = 'bar:\"foo\"')\n        3: required string FooBar (go.tag = 'json:\"foobar,option1,option2\" bar:\"foo,option1\" foo:\"foobar\"')\n        4: required string FooBarWithSpace (go.tag = 'json:\"foobarWithSpace\" foo:\"foo bar foobar barfoo\"')\n        5: optional string FooBarWithOmitEmpty (go.tag = 'json:\"foobarWithOmitEmpty,omitempty\"')\n        6: required string FooBarWithRequired (go.tag = 'json:\"foobarWithRequired,required\"')\n}\n\nstruct NotOmitEmpty {\n    1: optional string NotOmitEmptyString (go.tag = 'json:\"notOmitEmptyString,!omitempty\"')\n    2: optional string NotOmitEmptyInt (go.tag = 'json:\"notOmitEmptyInt,!omitempty\"')\n    3: optional string NotOmitEmptyBool (go.tag = 'json:\"notOmitEmptyBool,!omitempty\"')\n    4: optional list<string> NotOmitEmptyList (go.tag = 'json:\"notOmitEmptyList,!omitempty\"')\n    5: optional map<string, string> NotOmitEmptyMap (go.tag = 'json:\"notOmitEmptyMap,!omitempty\"')\n    6: optional list<string> NotOmitEmptyListMixedWithOmitEmpty (go.tag = 'json:\"notOmitEmptyListMixedWithOmitEmpty,!omitempty,omitempty\"')\n    7: optional list<string> NotOmitEmptyListMixedWithOmitEmptyV2 (go.tag = 'json:\"notOmitEmptyListMixedWithOmitEmptyV2,omitempty,!omitempty\"')\n    8: optional string OmitEmptyString (go.tag = 'json:\"omitEmptyString,omitempty\"') // to test that there can be a mix of fields that do and don't have !omitempty\n}\n\n//////////////////////////////////////////////////////////////////////////////\n// Default values\n\nstruct DefaultsStruct {\n    1: required i32 requiredPrimitive = 100\n    2: optional i32 optionalPrimitive = 200\n\n    3: required enums.EnumDefault requiredEnum = enums.EnumDefault.Bar\n    4: optional enums.EnumDefault optionalEnum = 2\n\n    5: required list<string> requiredList = [\"hello\", \"world\"]\n    6: optional list<double> optionalList = [1, 2.0, 3]\n\n    7: required Frame requiredStruct = {\n        \"topLeft\": {\"x\": 1, \"y\": 2},\n        \"size\": {\"width\": 100, \"height\": 200},\n    }\n    8: optional Edge optionalStruct = {\n        \"startPoint\": {\"x\": 1, \"y\": 2},\n        \"endPoint\":   {\"x\": 3, \"y\": 4},\n    }\n\n    9:  required bool requiredBoolDefaultTrue = true\n    10: optional bool optionalBoolDefaultTrue = true\n\n    11: required bool requiredBoolDefaultFalse = false\n    12: optional bool optionalBoolDefaultFalse = false\n}\n\n//////////////////////////////////////////////////////////////////////////////\n// Opt-out of Zap\n\nstruct ZapOptOutStruct {\n    1: required string name\n    2: required string optout (go.nolog)\n}\n\n//////////////////////////////////////////////////////////////////////////////\n// Redacted fields\n\nstruct RedactedStruct {\n    1: required string username\n    2: required string token (go.redact)\n    3: optional string password (go.redact = \"length\")\n    4: optional binary secret (sensitive = \"hash\")\n    5: optional i64 pin (go.redact)\n    6: optional Point location (go.redact)\n}\n\n//////////////////////////////////////////////////////////////////////////////\n// Field jabels\n\nstruct StructLabels {\n    // reserved keyword as label\n    1: optional bool isRequired (go.label = \"required\")\n\n    // go.tag's JSON tag takes precedence over go.label\n    2: optional string foo (go.label = \"bar\", go.tag = 'json:\"not_bar\"')\n\n    // Empty label\n    3: optional string qux (go.label = \"\")\n\n    // All-caps label\n    4: optional string quux (go.label = \"QUUX\")\n}\n"
+
+func init() {
+	thriftreflect.Register(ThriftModule, map[string]thriftreflect.Constructor{
+		"ContactInfo":             func() interface{} { return new(ContactInfo) },
+		"DefaultsStruct":          func() interface{} { return new(DefaultsStruct) },
+		"Edge":                    func() interface{} { return new(Edge) },
+		"EmptyStruct":             func() interface{} { return new(EmptyStruct) },
+		"Frame":                   func() interface{} { return new(Frame) },
+		"GoTags":                  func() interface{} { return new(GoTags) },
+		"Graph":                   func() interface{} { return new(Graph) },
+		"Node":                    func() interface{} { return new(Node) },
+		"NotOmitEmpty":            func() interface{} { return new(NotOmitEmpty) },
+		"Omit":                    func() interface{} { return new(Omit) },
+		"PersonalInfo":            func() interface{} { return new(PersonalInfo) },
+		"Point":                   func() interface{} { return new(Point) },
+		"PrimitiveOptionalStruct": func() interface{} { return new(PrimitiveOptionalStruct) },
+		"PrimitiveRequiredStruct": func() interface{} { return new(PrimitiveRequiredStruct) },
+		"RedactedStruct":          func() interface{} { return new(RedactedStruct) },
+		"Rename":                  func() interface{} { return new(Rename) },
+		"Size":                    func() interface{} { return new(Size) },
+		"StructLabels":            func() interface{} { return new(StructLabels) },
+		"User":                    func() interface{} { return new(User) },
+		"ZapOptOutStruct":         func() interface{} { return new(ZapOptOutStruct) },
+	})
+}
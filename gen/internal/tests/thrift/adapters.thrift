@@ -0,0 +1,27 @@
+typedef i64 (
+    go.type = "time.Time",
+    go.adapter = "go.uber.org/thriftrw/gen/internal/testadapters.UnixNanos",
+) Timestamp
+
+typedef string (
+    go.type = "net/url.URL",
+    go.adapter = "go.uber.org/thriftrw/gen/internal/testadapters.URL",
+) URL
+
+const Timestamp EPOCH = 0
+
+struct Event {
+    1: required i64 (
+        go.type = "time.Time",
+        go.adapter = "go.uber.org/thriftrw/gen/internal/testadapters.UnixNanos",
+    ) createdAt
+    2: optional Timestamp updatedAt = EPOCH
+    3: optional URL link
+    4: optional list<Timestamp> history
+    5: optional set<URL> mirrors
+    6: optional map<URL, i32> hits
+}
+
+service EventStore {
+    Timestamp record(1: Event event, 2: Timestamp at)
+}
@@ -0,0 +1,46 @@
+/**
+ * An old way of identifying users.
+ */
+typedef string LegacyID (deprecated = "Use UserID instead.")
+
+typedef string UserID
+
+enum Status {
+    ACTIVE,
+    /** Users are never suspended anymore. */
+    SUSPENDED (deprecated = "Use BANNED instead."),
+    BANNED,
+}
+
+enum Role {
+    ADMIN,
+    MEMBER,
+} (deprecated)
+
+struct User {
+    1: required UserID id
+    2: optional LegacyID legacyID (deprecated = "Use id instead.")
+    3: optional Status status
+    4: optional Role role
+}
+
+union Contact {
+    1: string email
+    2: string pager (deprecated = "Pagers are no longer supported.")
+} (go.union = "interface")
+
+/**
+ * Old user representation.
+ */
+struct OldUser {
+    1: required LegacyID id
+} (deprecated = "Use User instead.")
+
+service Users {
+    User getUser(1: UserID id)
+    OldUser getOldUser(1: LegacyID id) (deprecated = "Use getUser instead.")
+}
+
+service LegacyUsers {
+    OldUser get(1: LegacyID id)
+} (deprecated)
@@ -14,3 +14,15 @@ exception Does_Not_Exist_Exception_Collision {
     1: required string key
     2: optional string Error (go.name="Error2")
 } (go.name="DoesNotExistException2")
+
+exception TimeoutError {
+    1: required string message
+} (go.error_code = "timeout")
+
+/**
+ * Raised when the storage backend failed.
+ */
+exception StorageError {
+    1: required string message
+    2: optional TimeoutError cause (go.cause)
+} (go.error_code = "storage")
@@ -0,0 +1,44 @@
+include "./structs.thrift"
+
+struct Circle {
+    1: required double radius
+}
+
+/**
+ * Shape is one of several kinds of shapes.
+ */
+union Shape {
+    1: Circle circle
+    /** A square of the given side. */
+    2: double square
+    3: list<structs.Point> polygon
+    4: string label (go.label = "name")
+    5: binary secret (go.redact)
+    6: Shape nested
+    7: i32 hidden (go.nolog)
+    8: list<Shape> group
+} (go.union = "interface")
+
+union Value {
+    1: i64 number
+    2: string text
+} (go.union = "interface")
+
+typedef list<list<Shape>> Layers
+
+const Shape UNIT_CIRCLE = {"circle": {"radius": 1.0}}
+
+struct Drawing {
+    1: required Shape primary
+    2: optional Shape secondary
+    3: optional Value caption
+    4: optional list<Shape> others
+    5: optional map<string, Value> attributes
+    6: optional set<Value> tags
+    7: optional map<Circle, Shape> labels
+    8: optional Layers layers
+}
+
+service Canvas {
+    Shape draw(1: Shape shape, 2: Drawing drawing)
+}
@@ -185,6 +185,18 @@ struct ZapOptOutStruct {
     2: required string optout (go.nolog)
 }
 
+//////////////////////////////////////////////////////////////////////////////
+// Redacted fields
+
+struct RedactedStruct {
+    1: required string username
+    2: required string token (go.redact)
+    3: optional string password (go.redact = "length")
+    4: optional binary secret (sensitive = "hash")
+    5: optional i64 pin (go.redact)
+    6: optional Point location (go.redact)
+}
+
 //////////////////////////////////////////////////////////////////////////////
 // Field jabels
 
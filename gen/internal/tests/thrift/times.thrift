@@ -0,0 +1,20 @@
+typedef i64 (go.time = "unix_millis") Timestamp
+
+typedef i64 (go.duration = "ms") Timeout
+
+const Timeout DEFAULT_TIMEOUT = 1500
+const i64 (go.time = "unix_seconds") LAUNCH = 1577836800
+
+struct Job {
+    1: required Timestamp scheduledAt
+    2: optional Timeout timeout = DEFAULT_TIMEOUT
+    3: optional i64 (go.time = "unix_nanos") startedAt
+    4: optional i64 (go.duration = "s") ttl
+    5: optional i64 (go.time = "unix_micros") finishedAt
+    6: optional list<Timeout> retryDelays
+    7: optional map<string, Timestamp> checkpoints
+}
+
+service Scheduler {
+    Timestamp schedule(1: Job job, 2: i64 (go.duration = "us") delay)
+}
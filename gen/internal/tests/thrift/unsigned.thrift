@@ -0,0 +1,25 @@
+typedef i64 (go.type = "uint64") UserID
+
+typedef i32 (go.type = "uint16") Port
+
+const UserID ROOT = 0
+const Port DEFAULT_PORT = 8080
+const i16 (go.type = "uint8") MAX_HOPS = 255
+
+struct Counters {
+    1: required i8 (go.type = "uint8") flags
+    2: required i16 (go.type = "uint16") checksum
+    3: required i32 (go.type = "uint32") hits
+    4: required i64 (go.type = "uint64") bytes
+    5: optional i32 (go.type = "int8") level
+    6: optional i16 (go.type = "uint8") hops = MAX_HOPS
+    7: optional UserID owner
+    8: optional Port port = DEFAULT_PORT
+    9: optional list<i32 (go.type = "uint32")> samples
+    10: optional set<Port> ports
+    11: optional map<UserID, i64 (go.type = "uint64")> quotas
+}
+
+service CounterStore {
+    UserID lookup(1: Port port, 2: i32 (go.type = "uint32") shard)
+}
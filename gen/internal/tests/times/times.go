@@ -0,0 +1,1252 @@
+// Code generated by thriftrw v1.28.0. DO NOT EDIT.
+// @generated
+
+package times
+
+import (
+	json "encoding/json"
+	errors "errors"
+	fmt "fmt"
+	multierr "go.uber.org/multierr"
+	thriftreflect "go.uber.org/thriftrw/thriftreflect"
+	thrifttime "go.uber.org/thriftrw/thrifttime"
+	wire "go.uber.org/thriftrw/wire"
+	zapcore "go.uber.org/zap/zapcore"
+	strings "strings"
+	time "time"
+)
+
+func _I64_Milliseconds_FromConstant(w wire.Value) time.Duration {
+	x, err := thrifttime.Milliseconds.FromWire(w)
+	if err != nil {
+		panic(err)
+	}
+	return x
+}
+
+var DefaultTimeout Timeout = Timeout(_I64_Milliseconds_FromConstant(wire.NewValueI64(1500)))
+
+func _I64_UnixSeconds_FromConstant(w wire.Value) time.Time {
+	x, err := thrifttime.UnixSeconds.FromWire(w)
+	if err != nil {
+		panic(err)
+	}
+	return x
+}
+
+var Launch time.Time = _I64_UnixSeconds_FromConstant(wire.NewValueI64(1577836800))
+
+type Job struct {
+	ScheduledAt Timestamp            `json:"scheduledAt,required"`
+	Timeout     *Timeout             `json:"timeout,omitempty"`
+	StartedAt   *time.Time           `json:"startedAt,omitempty"`
+	TTL         *time.Duration       `json:"ttl,omitempty"`
+	FinishedAt  *time.Time           `json:"finishedAt,omitempty"`
+	RetryDelays []Timeout            `json:"retryDelays,omitempty"`
+	Checkpoints map[string]Timestamp `json:"checkpoints,omitempty"`
+}
+
+func _Timeout_ptr(v Timeout) *Timeout {
+	return &v
+}
+
+// Default_Job constructs a new Job struct,
+// pre-populating any fields with defined default values.
+func Default_Job() *Job {
+	var v Job
+	v.Timeout = _Timeout_ptr(Timeout(_I64_Milliseconds_FromConstant(wire.NewValueI64(1500))))
+	return &v
+}
+
+type _List_Timeout_ValueList []Timeout
+
+func (v _List_Timeout_ValueList) ForEach(f func(wire.Value) error) error {
+	for _, x := range v {
+		w, err := x.ToWire()
+		if err != nil {
+			return err
+		}
+		err = f(w)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v _List_Timeout_ValueList) Size() int {
+	return len(v)
+}
+
+func (_List_Timeout_ValueList) ValueType() wire.Type {
+	return wire.TI64
+}
+
+func (_List_Timeout_ValueList) Close() {}
+
+type _Map_String_Timestamp_MapItemList map[string]Timestamp
+
+func (m _Map_String_Timestamp_MapItemList) ForEach(f func(wire.MapItem) error) error {
+	for k, v := range m {
+		kw, err := wire.NewValueString(k), error(nil)
+		if err != nil {
+			return err
+		}
+
+		vw, err := v.ToWire()
+		if err != nil {
+			return err
+		}
+		err = f(wire.MapItem{Key: kw, Value: vw})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m _Map_String_Timestamp_MapItemList) Size() int {
+	return len(m)
+}
+
+func (_Map_String_Timestamp_MapItemList) KeyType() wire.Type {
+	return wire.TBinary
+}
+
+func (_Map_String_Timestamp_MapItemList) ValueType() wire.Type {
+	return wire.TI64
+}
+
+func (_Map_String_Timestamp_MapItemList) Close() {}
+
+// ToWire translates a Job struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *Job) ToWire() (wire.Value, error) {
+	var (
+		fields [7]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	w, err = v.ScheduledAt.ToWire()
+	if err != nil {
+		return w, err
+	}
+	fields[i] = wire.Field{ID: 1, Value: w}
+	i++
+	vTimeout := v.Timeout
+	if vTimeout == nil {
+		vTimeout = _Timeout_ptr(Timeout(_I64_Milliseconds_FromConstant(wire.NewValueI64(1500))))
+	}
+	{
+		w, err = vTimeout.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 2, Value: w}
+		i++
+	}
+	if v.StartedAt != nil {
+		w, err = thrifttime.UnixNanos.ToWire(*(v.StartedAt))
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 3, Value: w}
+		i++
+	}
+	if v.TTL != nil {
+		w, err = thrifttime.Seconds.ToWire(*(v.TTL))
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 4, Value: w}
+		i++
+	}
+	if v.FinishedAt != nil {
+		w, err = thrifttime.UnixMicros.ToWire(*(v.FinishedAt))
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 5, Value: w}
+		i++
+	}
+	if v.RetryDelays != nil {
+		w, err = wire.NewValueList(_List_Timeout_ValueList(v.RetryDelays)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 6, Value: w}
+		i++
+	}
+	if v.Checkpoints != nil {
+		w, err = wire.NewValueMap(_Map_String_Timestamp_MapItemList(v.Checkpoints)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 7, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _Timestamp_Read(w wire.Value) (Timestamp, error) {
+	var x Timestamp
+	err := x.FromWire(w)
+	return x, err
+}
+
+func _Timeout_Read(w wire.Value) (Timeout, error) {
+	var x Timeout
+	err := x.FromWire(w)
+	return x, err
+}
+
+func _List_Timeout_Read(l wire.ValueList) ([]Timeout, error) {
+	if l.ValueType() != wire.TI64 {
+		return nil, nil
+	}
+
+	o := make([]Timeout, 0, l.Size())
+	err := l.ForEach(func(x wire.Value) error {
+		i, err := _Timeout_Read(x)
+		if err != nil {
+			return err
+		}
+		o = append(o, i)
+		return nil
+	})
+	l.Close()
+	return o, err
+}
+
+func _Map_String_Timestamp_Read(m wire.MapItemList) (map[string]Timestamp, error) {
+	if m.KeyType() != wire.TBinary {
+		return nil, nil
+	}
+
+	if m.ValueType() != wire.TI64 {
+		return nil, nil
+	}
+
+	o := make(map[string]Timestamp, m.Size())
+	err := m.ForEach(func(x wire.MapItem) error {
+		k, err := x.Key.GetString(), error(nil)
+		if err != nil {
+			return err
+		}
+
+		v, err := _Timestamp_Read(x.Value)
+		if err != nil {
+			return err
+		}
+
+		o[k] = v
+		return nil
+	})
+	m.Close()
+	return o, err
+}
+
+// FromWire deserializes a Job struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a Job struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v Job
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *Job) FromWire(w wire.Value) error {
+	var err error
+
+	scheduledAtIsSet := false
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TI64 {
+				v.ScheduledAt, err = _Timestamp_Read(field.Value)
+				if err != nil {
+					return err
+				}
+				scheduledAtIsSet = true
+			}
+		case 2:
+			if field.Value.Type() == wire.TI64 {
+				var x Timeout
+				x, err = _Timeout_Read(field.Value)
+				v.Timeout = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 3:
+			if field.Value.Type() == wire.TI64 {
+				var x time.Time
+				x, err = thrifttime.UnixNanos.FromWire(field.Value)
+				v.StartedAt = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 4:
+			if field.Value.Type() == wire.TI64 {
+				var x time.Duration
+				x, err = thrifttime.Seconds.FromWire(field.Value)
+				v.TTL = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 5:
+			if field.Value.Type() == wire.TI64 {
+				var x time.Time
+				x, err = thrifttime.UnixMicros.FromWire(field.Value)
+				v.FinishedAt = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 6:
+			if field.Value.Type() == wire.TList {
+				v.RetryDelays, err = _List_Timeout_Read(field.Value.GetList())
+				if err != nil {
+					return err
+				}
+
+			}
+		case 7:
+			if field.Value.Type() == wire.TMap {
+				v.Checkpoints, err = _Map_String_Timestamp_Read(field.Value.GetMap())
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	if !scheduledAtIsSet {
+		return errors.New("field ScheduledAt of Job is required")
+	}
+
+	if v.Timeout == nil {
+		v.Timeout = _Timeout_ptr(Timeout(_I64_Milliseconds_FromConstant(wire.NewValueI64(1500))))
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a Job
+// struct.
+func (v *Job) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [7]string
+	i := 0
+	fields[i] = fmt.Sprintf("ScheduledAt: %v", v.ScheduledAt)
+	i++
+	if v.Timeout != nil {
+		fields[i] = fmt.Sprintf("Timeout: %v", *(v.Timeout))
+		i++
+	}
+	if v.StartedAt != nil {
+		fields[i] = fmt.Sprintf("StartedAt: %v", *(v.StartedAt))
+		i++
+	}
+	if v.TTL != nil {
+		fields[i] = fmt.Sprintf("TTL: %v", *(v.TTL))
+		i++
+	}
+	if v.FinishedAt != nil {
+		fields[i] = fmt.Sprintf("FinishedAt: %v", *(v.FinishedAt))
+		i++
+	}
+	if v.RetryDelays != nil {
+		fields[i] = fmt.Sprintf("RetryDelays: %v", v.RetryDelays)
+		i++
+	}
+	if v.Checkpoints != nil {
+		fields[i] = fmt.Sprintf("Checkpoints: %v", v.Checkpoints)
+		i++
+	}
+
+	return fmt.Sprintf("Job{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _Timeout_EqualsPtr(lhs, rhs *Timeout) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return (x == y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+func _I64_UnixNanos_EqualsPtr(lhs, rhs *time.Time) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return thrifttime.UnixNanos.Equals(x, y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+func _I64_Seconds_EqualsPtr(lhs, rhs *time.Duration) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return thrifttime.Seconds.Equals(x, y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+func _I64_UnixMicros_EqualsPtr(lhs, rhs *time.Time) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return thrifttime.UnixMicros.Equals(x, y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+func _List_Timeout_Equals(lhs, rhs []Timeout) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for i, lv := range lhs {
+		rv := rhs[i]
+		if !(lv == rv) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func _Map_String_Timestamp_Equals(lhs, rhs map[string]Timestamp) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for lk, lv := range lhs {
+		rv, ok := rhs[lk]
+		if !ok {
+			return false
+		}
+		if !(lv == rv) {
+			return false
+		}
+	}
+	return true
+}
+
+// Equals returns true if all the fields of this Job match the
+// provided Job.
+//
+// This function performs a deep comparison.
+func (v *Job) Equals(rhs *Job) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !(v.ScheduledAt == rhs.ScheduledAt) {
+		return false
+	}
+	if !_Timeout_EqualsPtr(v.Timeout, rhs.Timeout) {
+		return false
+	}
+	if !_I64_UnixNanos_EqualsPtr(v.StartedAt, rhs.StartedAt) {
+		return false
+	}
+	if !_I64_Seconds_EqualsPtr(v.TTL, rhs.TTL) {
+		return false
+	}
+	if !_I64_UnixMicros_EqualsPtr(v.FinishedAt, rhs.FinishedAt) {
+		return false
+	}
+	if !((v.RetryDelays == nil && rhs.RetryDelays == nil) || (v.RetryDelays != nil && rhs.RetryDelays != nil && _List_Timeout_Equals(v.RetryDelays, rhs.RetryDelays))) {
+		return false
+	}
+	if !((v.Checkpoints == nil && rhs.Checkpoints == nil) || (v.Checkpoints != nil && rhs.Checkpoints != nil && _Map_String_Timestamp_Equals(v.Checkpoints, rhs.Checkpoints))) {
+		return false
+	}
+
+	return true
+}
+
+type _List_Timeout_Zapper []Timeout
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _List_Timeout_Zapper.
+func (l _List_Timeout_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
+	for _, v := range l {
+		enc.AppendDuration((time.Duration)(v))
+	}
+	return err
+}
+
+type _Map_String_Timestamp_Zapper map[string]Timestamp
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of _Map_String_Timestamp_Zapper.
+func (m _Map_String_Timestamp_Zapper) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	for k, v := range m {
+		enc.AddTime((string)(k), (time.Time)(v))
+	}
+	return err
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Job.
+func (v *Job) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	enc.AddTime("scheduledAt", (time.Time)(v.ScheduledAt))
+	if v.Timeout != nil {
+		enc.AddDuration("timeout", (time.Duration)(*v.Timeout))
+	}
+	if v.StartedAt != nil {
+		enc.AddTime("startedAt", *v.StartedAt)
+	}
+	if v.TTL != nil {
+		enc.AddDuration("ttl", *v.TTL)
+	}
+	if v.FinishedAt != nil {
+		enc.AddTime("finishedAt", *v.FinishedAt)
+	}
+	if v.RetryDelays != nil {
+		err = multierr.Append(err, enc.AddArray("retryDelays", (_List_Timeout_Zapper)(v.RetryDelays)))
+	}
+	if v.Checkpoints != nil {
+		err = multierr.Append(err, enc.AddObject("checkpoints", (_Map_String_Timestamp_Zapper)(v.Checkpoints)))
+	}
+	return err
+}
+
+// GetScheduledAt returns the value of ScheduledAt if it is set or its
+// zero value if it is unset.
+func (v *Job) GetScheduledAt() (o Timestamp) {
+	if v != nil {
+		o = v.ScheduledAt
+	}
+	return
+}
+
+// GetTimeout returns the value of Timeout if it is set or its
+// default value if it is unset.
+func (v *Job) GetTimeout() (o Timeout) {
+	if v != nil && v.Timeout != nil {
+		return *v.Timeout
+	}
+	o = Timeout(_I64_Milliseconds_FromConstant(wire.NewValueI64(1500)))
+	return
+}
+
+// IsSetTimeout returns true if Timeout is not nil.
+func (v *Job) IsSetTimeout() bool {
+	return v != nil && v.Timeout != nil
+}
+
+// GetStartedAt returns the value of StartedAt if it is set or its
+// zero value if it is unset.
+func (v *Job) GetStartedAt() (o time.Time) {
+	if v != nil && v.StartedAt != nil {
+		return *v.StartedAt
+	}
+
+	return
+}
+
+// IsSetStartedAt returns true if StartedAt is not nil.
+func (v *Job) IsSetStartedAt() bool {
+	return v != nil && v.StartedAt != nil
+}
+
+// GetTTL returns the value of TTL if it is set or its
+// zero value if it is unset.
+func (v *Job) GetTTL() (o time.Duration) {
+	if v != nil && v.TTL != nil {
+		return *v.TTL
+	}
+
+	return
+}
+
+// IsSetTTL returns true if TTL is not nil.
+func (v *Job) IsSetTTL() bool {
+	return v != nil && v.TTL != nil
+}
+
+// GetFinishedAt returns the value of FinishedAt if it is set or its
+// zero value if it is unset.
+func (v *Job) GetFinishedAt() (o time.Time) {
+	if v != nil && v.FinishedAt != nil {
+		return *v.FinishedAt
+	}
+
+	return
+}
+
+// IsSetFinishedAt returns true if FinishedAt is not nil.
+func (v *Job) IsSetFinishedAt() bool {
+	return v != nil && v.FinishedAt != nil
+}
+
+// GetRetryDelays returns the value of RetryDelays if it is set or its
+// zero value if it is unset.
+func (v *Job) GetRetryDelays() (o []Timeout) {
+	if v != nil && v.RetryDelays != nil {
+		return v.RetryDelays
+	}
+
+	return
+}
+
+// IsSetRetryDelays returns true if RetryDelays is not nil.
+func (v *Job) IsSetRetryDelays() bool {
+	return v != nil && v.RetryDelays != nil
+}
+
+// GetCheckpoints returns the value of Checkpoints if it is set or its
+// zero value if it is unset.
+func (v *Job) GetCheckpoints() (o map[string]Timestamp) {
+	if v != nil && v.Checkpoints != nil {
+		return v.Checkpoints
+	}
+
+	return
+}
+
+// IsSetCheckpoints returns true if Checkpoints is not nil.
+func (v *Job) IsSetCheckpoints() bool {
+	return v != nil && v.Checkpoints != nil
+}
+
+type Timeout time.Duration
+
+// TimeoutPtr returns a pointer to a Timeout
+func (v Timeout) Ptr() *Timeout {
+	return &v
+}
+
+// ToWire translates Timeout into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+func (v Timeout) ToWire() (wire.Value, error) {
+	x := (time.Duration)(v)
+	return thrifttime.Milliseconds.ToWire(x)
+}
+
+// String returns a readable string representation of Timeout.
+func (v Timeout) String() string {
+	x := (time.Duration)(v)
+	return fmt.Sprint(x)
+}
+
+// FromWire deserializes Timeout from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+func (v *Timeout) FromWire(w wire.Value) error {
+	x, err := thrifttime.Milliseconds.FromWire(w)
+	*v = (Timeout)(x)
+	return err
+}
+
+// Equals returns true if this Timeout is equal to the provided
+// Timeout.
+func (lhs Timeout) Equals(rhs Timeout) bool {
+	return thrifttime.Milliseconds.Equals((time.Duration)(lhs), (time.Duration)(rhs))
+}
+
+// MarshalJSON serializes Timeout into JSON the same way as
+// time.Duration.
+func (v Timeout) MarshalJSON() ([]byte, error) {
+	return json.Marshal((time.Duration)(v))
+}
+
+// UnmarshalJSON deserializes Timeout from JSON the same way as
+// time.Duration.
+func (v *Timeout) UnmarshalJSON(b []byte) error {
+	return json.Unmarshal(b, (*time.Duration)(v))
+}
+
+type Timestamp time.Time
+
+// TimestampPtr returns a pointer to a Timestamp
+func (v Timestamp) Ptr() *Timestamp {
+	return &v
+}
+
+// ToWire translates Timestamp into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+func (v Timestamp) ToWire() (wire.Value, error) {
+	x := (time.Time)(v)
+	return thrifttime.UnixMillis.ToWire(x)
+}
+
+// String returns a readable string representation of Timestamp.
+func (v Timestamp) String() string {
+	x := (time.Time)(v)
+	return fmt.Sprint(x)
+}
+
+// FromWire deserializes Timestamp from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+func (v *Timestamp) FromWire(w wire.Value) error {
+	x, err := thrifttime.UnixMillis.FromWire(w)
+	*v = (Timestamp)(x)
+	return err
+}
+
+// Equals returns true if this Timestamp is equal to the provided
+// Timestamp.
+func (lhs Timestamp) Equals(rhs Timestamp) bool {
+	return thrifttime.UnixMillis.Equals((time.Time)(lhs), (time.Time)(rhs))
+}
+
+// MarshalJSON serializes Timestamp into JSON the same way as
+// time.Time.
+func (v Timestamp) MarshalJSON() ([]byte, error) {
+	return json.Marshal((time.Time)(v))
+}
+
+// UnmarshalJSON deserializes Timestamp from JSON the same way as
+// time.Time.
+func (v *Timestamp) UnmarshalJSON(b []byte) error {
+	return json.Unmarshal(b, (*time.Time)(v))
+}
+
+// ThriftModule represents the IDL file used to generate this package.
+var ThriftModule = &thriftreflect.ThriftModule{
+	Name:     "times",
+	Package:  "go.uber.org/thriftrw/gen/internal/tests/times",
+	FilePath: "times.thrift",
+	SHA1:     "a41235c8567d08bc19e7bcaaf35d49ec19f458f4",
+	Raw:      rawIDL,
+}
+
+const rawIDL = "typedef i64 (go.time = \"unix_millis\") Timestamp\n\ntypedef i64 (go.duration = \"ms\") Timeout\n\nconst Timeout DEFAULT_TIMEOUT = 1500\nconst i64 (go.time = \"unix_seconds\") LAUNCH = 1577836800\n\nstruct Job {\n    1: required Timestamp scheduledAt\n    2: optional Timeout timeout = DEFAULT_TIMEOUT\n    3: optional i64 (go.time = \"unix_nanos\") startedAt\n    4: optional i64 (go.duration = \"s\") ttl\n    5: optional i64 (go.time = \"unix_micros\") finishedAt\n    6: optional list<Timeout> retryDelays\n    7: optional map<string, Timestamp> checkpoints\n}\n\nservice Scheduler {\n    Timestamp schedule(1: Job job, 2: i64 (go.duration = \"us\") delay)\n}\n"
+
+func init() {
+	thriftreflect.Register(ThriftModule, map[string]thriftreflect.Constructor{
+		"Job": func() interface{} { return new(Job) },
+	})
+}
+
+// Scheduler_Schedule_Args represents the arguments for the Scheduler.schedule function.
+//
+// The arguments for schedule are sent and received over the wire as this struct.
+type Scheduler_Schedule_Args struct {
+	Job   *Job           `json:"job,omitempty"`
+	Delay *time.Duration `json:"delay,omitempty"`
+}
+
+// ToWire translates a Scheduler_Schedule_Args struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *Scheduler_Schedule_Args) ToWire() (wire.Value, error) {
+	var (
+		fields [2]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Job != nil {
+		w, err = v.Job.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 1, Value: w}
+		i++
+	}
+	if v.Delay != nil {
+		w, err = thrifttime.Microseconds.ToWire(*(v.Delay))
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 2, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _Job_Read(w wire.Value) (*Job, error) {
+	var v Job
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// FromWire deserializes a Scheduler_Schedule_Args struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a Scheduler_Schedule_Args struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v Scheduler_Schedule_Args
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *Scheduler_Schedule_Args) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TStruct {
+				v.Job, err = _Job_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 2:
+			if field.Value.Type() == wire.TI64 {
+				var x time.Duration
+				x, err = thrifttime.Microseconds.FromWire(field.Value)
+				v.Delay = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a Scheduler_Schedule_Args
+// struct.
+func (v *Scheduler_Schedule_Args) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [2]string
+	i := 0
+	if v.Job != nil {
+		fields[i] = fmt.Sprintf("Job: %v", v.Job)
+		i++
+	}
+	if v.Delay != nil {
+		fields[i] = fmt.Sprintf("Delay: %v", *(v.Delay))
+		i++
+	}
+
+	return fmt.Sprintf("Scheduler_Schedule_Args{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _I64_Microseconds_EqualsPtr(lhs, rhs *time.Duration) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return thrifttime.Microseconds.Equals(x, y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+// Equals returns true if all the fields of this Scheduler_Schedule_Args match the
+// provided Scheduler_Schedule_Args.
+//
+// This function performs a deep comparison.
+func (v *Scheduler_Schedule_Args) Equals(rhs *Scheduler_Schedule_Args) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !((v.Job == nil && rhs.Job == nil) || (v.Job != nil && rhs.Job != nil && v.Job.Equals(rhs.Job))) {
+		return false
+	}
+	if !_I64_Microseconds_EqualsPtr(v.Delay, rhs.Delay) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Scheduler_Schedule_Args.
+func (v *Scheduler_Schedule_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Job != nil {
+		err = multierr.Append(err, enc.AddObject("job", v.Job))
+	}
+	if v.Delay != nil {
+		enc.AddDuration("delay", *v.Delay)
+	}
+	return err
+}
+
+// GetJob returns the value of Job if it is set or its
+// zero value if it is unset.
+func (v *Scheduler_Schedule_Args) GetJob() (o *Job) {
+	if v != nil && v.Job != nil {
+		return v.Job
+	}
+
+	return
+}
+
+// IsSetJob returns true if Job is not nil.
+func (v *Scheduler_Schedule_Args) IsSetJob() bool {
+	return v != nil && v.Job != nil
+}
+
+// GetDelay returns the value of Delay if it is set or its
+// zero value if it is unset.
+func (v *Scheduler_Schedule_Args) GetDelay() (o time.Duration) {
+	if v != nil && v.Delay != nil {
+		return *v.Delay
+	}
+
+	return
+}
+
+// IsSetDelay returns true if Delay is not nil.
+func (v *Scheduler_Schedule_Args) IsSetDelay() bool {
+	return v != nil && v.Delay != nil
+}
+
+// MethodName returns the name of the Thrift function as specified in
+// the IDL, for which this struct represent the arguments.
+//
+// This will always be "schedule" for this struct.
+func (v *Scheduler_Schedule_Args) MethodName() string {
+	return "schedule"
+}
+
+// EnvelopeType returns the kind of value inside this struct.
+//
+// This will always be Call for this struct.
+func (v *Scheduler_Schedule_Args) EnvelopeType() wire.EnvelopeType {
+	return wire.Call
+}
+
+// Scheduler_Schedule_Helper provides functions that aid in handling the
+// parameters and return values of the Scheduler.schedule
+// function.
+var Scheduler_Schedule_Helper = struct {
+	// Args accepts the parameters of schedule in-order and returns
+	// the arguments struct for the function.
+	Args func(
+		job *Job,
+		delay *time.Duration,
+	) *Scheduler_Schedule_Args
+
+	// IsException returns true if the given error can be thrown
+	// by schedule.
+	//
+	// An error can be thrown by schedule only if the
+	// corresponding exception type was mentioned in the 'throws'
+	// section for it in the Thrift file.
+	IsException func(error) bool
+
+	// WrapResponse returns the result struct for schedule
+	// given its return value and error.
+	//
+	// This allows mapping values and errors returned by
+	// schedule into a serializable result struct.
+	// WrapResponse returns a non-nil error if the provided
+	// error cannot be thrown by schedule
+	//
+	//   value, err := schedule(args)
+	//   result, err := Scheduler_Schedule_Helper.WrapResponse(value, err)
+	//   if err != nil {
+	//     return fmt.Errorf("unexpected error from schedule: %v", err)
+	//   }
+	//   serialize(result)
+	WrapResponse func(Timestamp, error) (*Scheduler_Schedule_Result, error)
+
+	// UnwrapResponse takes the result struct for schedule
+	// and returns the value or error returned by it.
+	//
+	// The error is non-nil only if schedule threw an
+	// exception.
+	//
+	//   result := deserialize(bytes)
+	//   value, err := Scheduler_Schedule_Helper.UnwrapResponse(result)
+	UnwrapResponse func(*Scheduler_Schedule_Result) (Timestamp, error)
+}{}
+
+func init() {
+	Scheduler_Schedule_Helper.Args = func(
+		job *Job,
+		delay *time.Duration,
+	) *Scheduler_Schedule_Args {
+		return &Scheduler_Schedule_Args{
+			Job:   job,
+			Delay: delay,
+		}
+	}
+
+	Scheduler_Schedule_Helper.IsException = func(err error) bool {
+		switch err.(type) {
+		default:
+			return false
+		}
+	}
+
+	Scheduler_Schedule_Helper.WrapResponse = func(success Timestamp, err error) (*Scheduler_Schedule_Result, error) {
+		if err == nil {
+			return &Scheduler_Schedule_Result{Success: &success}, nil
+		}
+
+		return nil, err
+	}
+	Scheduler_Schedule_Helper.UnwrapResponse = func(result *Scheduler_Schedule_Result) (success Timestamp, err error) {
+
+		if result.Success != nil {
+			success = *result.Success
+			return
+		}
+
+		err = errors.New("expected a non-void result")
+		return
+	}
+
+}
+
+// Scheduler_Schedule_Result represents the result of a Scheduler.schedule function call.
+//
+// The result of a schedule execution is sent and received over the wire as this struct.
+//
+// Success is set only if the function did not throw an exception.
+type Scheduler_Schedule_Result struct {
+	// Value returned by schedule after a successful execution.
+	Success *Timestamp `json:"success,omitempty"`
+}
+
+// ToWire translates a Scheduler_Schedule_Result struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *Scheduler_Schedule_Result) ToWire() (wire.Value, error) {
+	var (
+		fields [1]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Success != nil {
+		w, err = v.Success.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 0, Value: w}
+		i++
+	}
+
+	if i != 1 {
+		return wire.Value{}, fmt.Errorf("Scheduler_Schedule_Result should have exactly one field: got %v fields", i)
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a Scheduler_Schedule_Result struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a Scheduler_Schedule_Result struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v Scheduler_Schedule_Result
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *Scheduler_Schedule_Result) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 0:
+			if field.Value.Type() == wire.TI64 {
+				var x Timestamp
+				x, err = _Timestamp_Read(field.Value)
+				v.Success = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	count := 0
+	if v.Success != nil {
+		count++
+	}
+	if count != 1 {
+		return fmt.Errorf("Scheduler_Schedule_Result should have exactly one field: got %v fields", count)
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a Scheduler_Schedule_Result
+// struct.
+func (v *Scheduler_Schedule_Result) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [1]string
+	i := 0
+	if v.Success != nil {
+		fields[i] = fmt.Sprintf("Success: %v", *(v.Success))
+		i++
+	}
+
+	return fmt.Sprintf("Scheduler_Schedule_Result{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _Timestamp_EqualsPtr(lhs, rhs *Timestamp) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return (x == y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+// Equals returns true if all the fields of this Scheduler_Schedule_Result match the
+// provided Scheduler_Schedule_Result.
+//
+// This function performs a deep comparison.
+func (v *Scheduler_Schedule_Result) Equals(rhs *Scheduler_Schedule_Result) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_Timestamp_EqualsPtr(v.Success, rhs.Success) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Scheduler_Schedule_Result.
+func (v *Scheduler_Schedule_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Success != nil {
+		enc.AddTime("success", (time.Time)(*v.Success))
+	}
+	return err
+}
+
+// GetSuccess returns the value of Success if it is set or its
+// zero value if it is unset.
+func (v *Scheduler_Schedule_Result) GetSuccess() (o Timestamp) {
+	if v != nil && v.Success != nil {
+		return *v.Success
+	}
+
+	return
+}
+
+// IsSetSuccess returns true if Success is not nil.
+func (v *Scheduler_Schedule_Result) IsSetSuccess() bool {
+	return v != nil && v.Success != nil
+}
+
+// MethodName returns the name of the Thrift function as specified in
+// the IDL, for which this struct represent the result.
+//
+// This will always be "schedule" for this struct.
+func (v *Scheduler_Schedule_Result) MethodName() string {
+	return "schedule"
+}
+
+// EnvelopeType returns the kind of value inside this struct.
+//
+// This will always be Reply for this struct.
+func (v *Scheduler_Schedule_Result) EnvelopeType() wire.EnvelopeType {
+	return wire.Reply
+}
@@ -0,0 +1,1464 @@
+// Code generated by thriftrw v1.28.0. DO NOT EDIT.
+// @generated
+
+package unsigned
+
+import (
+	errors "errors"
+	fmt "fmt"
+	multierr "go.uber.org/multierr"
+	ptr "go.uber.org/thriftrw/ptr"
+	thriftreflect "go.uber.org/thriftrw/thriftreflect"
+	wire "go.uber.org/thriftrw/wire"
+	zapcore "go.uber.org/zap/zapcore"
+	strings "strings"
+)
+
+const DefaultPort Port = Port(8080)
+
+const MaxHops uint8 = 255
+
+const Root UserID = UserID(0)
+
+type Counters struct {
+	Flags    uint8             `json:"flags,required"`
+	Checksum uint16            `json:"checksum,required"`
+	Hits     uint32            `json:"hits,required"`
+	Bytes    uint64            `json:"bytes,required"`
+	Level    *int8             `json:"level,omitempty"`
+	Hops     *uint8            `json:"hops,omitempty"`
+	Owner    *UserID           `json:"owner,omitempty"`
+	Port     *Port             `json:"port,omitempty"`
+	Samples  []uint32          `json:"samples,omitempty"`
+	Ports    map[Port]struct{} `json:"ports,omitempty"`
+	Quotas   map[UserID]uint64 `json:"quotas,omitempty"`
+}
+
+func _Port_ptr(v Port) *Port {
+	return &v
+}
+
+// Default_Counters constructs a new Counters struct,
+// pre-populating any fields with defined default values.
+func Default_Counters() *Counters {
+	var v Counters
+	v.Hops = ptr.Uint8(255)
+	v.Port = _Port_ptr(DefaultPort)
+	return &v
+}
+
+type _List_I32_Uint32_ValueList []uint32
+
+func (v _List_I32_Uint32_ValueList) ForEach(f func(wire.Value) error) error {
+	for _, x := range v {
+		w, err := wire.NewValueI32(int32(x)), error(nil)
+		if err != nil {
+			return err
+		}
+		err = f(w)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v _List_I32_Uint32_ValueList) Size() int {
+	return len(v)
+}
+
+func (_List_I32_Uint32_ValueList) ValueType() wire.Type {
+	return wire.TI32
+}
+
+func (_List_I32_Uint32_ValueList) Close() {}
+
+type _Set_Port_mapType_ValueList map[Port]struct{}
+
+func (v _Set_Port_mapType_ValueList) ForEach(f func(wire.Value) error) error {
+	for x := range v {
+		w, err := x.ToWire()
+		if err != nil {
+			return err
+		}
+
+		if err := f(w); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v _Set_Port_mapType_ValueList) Size() int {
+	return len(v)
+}
+
+func (_Set_Port_mapType_ValueList) ValueType() wire.Type {
+	return wire.TI32
+}
+
+func (_Set_Port_mapType_ValueList) Close() {}
+
+type _Map_UserID_I64_Uint64_MapItemList map[UserID]uint64
+
+func (m _Map_UserID_I64_Uint64_MapItemList) ForEach(f func(wire.MapItem) error) error {
+	for k, v := range m {
+		kw, err := k.ToWire()
+		if err != nil {
+			return err
+		}
+
+		vw, err := wire.NewValueI64(int64(v)), error(nil)
+		if err != nil {
+			return err
+		}
+		err = f(wire.MapItem{Key: kw, Value: vw})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m _Map_UserID_I64_Uint64_MapItemList) Size() int {
+	return len(m)
+}
+
+func (_Map_UserID_I64_Uint64_MapItemList) KeyType() wire.Type {
+	return wire.TI64
+}
+
+func (_Map_UserID_I64_Uint64_MapItemList) ValueType() wire.Type {
+	return wire.TI64
+}
+
+func (_Map_UserID_I64_Uint64_MapItemList) Close() {}
+
+// ToWire translates a Counters struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *Counters) ToWire() (wire.Value, error) {
+	var (
+		fields [11]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	w, err = wire.NewValueI8(int8(v.Flags)), error(nil)
+	if err != nil {
+		return w, err
+	}
+	fields[i] = wire.Field{ID: 1, Value: w}
+	i++
+
+	w, err = wire.NewValueI16(int16(v.Checksum)), error(nil)
+	if err != nil {
+		return w, err
+	}
+	fields[i] = wire.Field{ID: 2, Value: w}
+	i++
+
+	w, err = wire.NewValueI32(int32(v.Hits)), error(nil)
+	if err != nil {
+		return w, err
+	}
+	fields[i] = wire.Field{ID: 3, Value: w}
+	i++
+
+	w, err = wire.NewValueI64(int64(v.Bytes)), error(nil)
+	if err != nil {
+		return w, err
+	}
+	fields[i] = wire.Field{ID: 4, Value: w}
+	i++
+	if v.Level != nil {
+		w, err = wire.NewValueI32(int32(*(v.Level))), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 5, Value: w}
+		i++
+	}
+	vHops := v.Hops
+	if vHops == nil {
+		vHops = ptr.Uint8(255)
+	}
+	{
+		w, err = wire.NewValueI16(int16(*(vHops))), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 6, Value: w}
+		i++
+	}
+	if v.Owner != nil {
+		w, err = v.Owner.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 7, Value: w}
+		i++
+	}
+	vPort := v.Port
+	if vPort == nil {
+		vPort = _Port_ptr(DefaultPort)
+	}
+	{
+		w, err = vPort.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 8, Value: w}
+		i++
+	}
+	if v.Samples != nil {
+		w, err = wire.NewValueList(_List_I32_Uint32_ValueList(v.Samples)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 9, Value: w}
+		i++
+	}
+	if v.Ports != nil {
+		w, err = wire.NewValueSet(_Set_Port_mapType_ValueList(v.Ports)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.Quotas != nil {
+		w, err = wire.NewValueMap(_Map_UserID_I64_Uint64_MapItemList(v.Quotas)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 11, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _I32_Int8_Read(w wire.Value) (int8, error) {
+	x := w.GetI32()
+	if x < -128 || x > 127 {
+		return 0, fmt.Errorf("%v is out of range for int8", x)
+	}
+	return int8(x), nil
+}
+
+func _I16_Uint8_Read(w wire.Value) (uint8, error) {
+	x := w.GetI16()
+	if x < 0 || x > 255 {
+		return 0, fmt.Errorf("%v is out of range for uint8", x)
+	}
+	return uint8(x), nil
+}
+
+func _UserID_Read(w wire.Value) (UserID, error) {
+	var x UserID
+	err := x.FromWire(w)
+	return x, err
+}
+
+func _Port_Read(w wire.Value) (Port, error) {
+	var x Port
+	err := x.FromWire(w)
+	return x, err
+}
+
+func _List_I32_Uint32_Read(l wire.ValueList) ([]uint32, error) {
+	if l.ValueType() != wire.TI32 {
+		return nil, nil
+	}
+
+	o := make([]uint32, 0, l.Size())
+	err := l.ForEach(func(x wire.Value) error {
+		i, err := uint32(x.GetI32()), error(nil)
+		if err != nil {
+			return err
+		}
+		o = append(o, i)
+		return nil
+	})
+	l.Close()
+	return o, err
+}
+
+func _Set_Port_mapType_Read(s wire.ValueList) (map[Port]struct{}, error) {
+	if s.ValueType() != wire.TI32 {
+		return nil, nil
+	}
+
+	o := make(map[Port]struct{}, s.Size())
+	err := s.ForEach(func(x wire.Value) error {
+		i, err := _Port_Read(x)
+		if err != nil {
+			return err
+		}
+
+		o[i] = struct{}{}
+		return nil
+	})
+	s.Close()
+	return o, err
+}
+
+func _Map_UserID_I64_Uint64_Read(m wire.MapItemList) (map[UserID]uint64, error) {
+	if m.KeyType() != wire.TI64 {
+		return nil, nil
+	}
+
+	if m.ValueType() != wire.TI64 {
+		return nil, nil
+	}
+
+	o := make(map[UserID]uint64, m.Size())
+	err := m.ForEach(func(x wire.MapItem) error {
+		k, err := _UserID_Read(x.Key)
+		if err != nil {
+			return err
+		}
+
+		v, err := uint64(x.Value.GetI64()), error(nil)
+		if err != nil {
+			return err
+		}
+
+		o[k] = v
+		return nil
+	})
+	m.Close()
+	return o, err
+}
+
+// FromWire deserializes a Counters struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a Counters struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v Counters
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *Counters) FromWire(w wire.Value) error {
+	var err error
+
+	flagsIsSet := false
+	checksumIsSet := false
+	hitsIsSet := false
+	bytesIsSet := false
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TI8 {
+				v.Flags, err = uint8(field.Value.GetI8()), error(nil)
+				if err != nil {
+					return err
+				}
+				flagsIsSet = true
+			}
+		case 2:
+			if field.Value.Type() == wire.TI16 {
+				v.Checksum, err = uint16(field.Value.GetI16()), error(nil)
+				if err != nil {
+					return err
+				}
+				checksumIsSet = true
+			}
+		case 3:
+			if field.Value.Type() == wire.TI32 {
+				v.Hits, err = uint32(field.Value.GetI32()), error(nil)
+				if err != nil {
+					return err
+				}
+				hitsIsSet = true
+			}
+		case 4:
+			if field.Value.Type() == wire.TI64 {
+				v.Bytes, err = uint64(field.Value.GetI64()), error(nil)
+				if err != nil {
+					return err
+				}
+				bytesIsSet = true
+			}
+		case 5:
+			if field.Value.Type() == wire.TI32 {
+				var x int8
+				x, err = _I32_Int8_Read(field.Value)
+				v.Level = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 6:
+			if field.Value.Type() == wire.TI16 {
+				var x uint8
+				x, err = _I16_Uint8_Read(field.Value)
+				v.Hops = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 7:
+			if field.Value.Type() == wire.TI64 {
+				var x UserID
+				x, err = _UserID_Read(field.Value)
+				v.Owner = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 8:
+			if field.Value.Type() == wire.TI32 {
+				var x Port
+				x, err = _Port_Read(field.Value)
+				v.Port = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 9:
+			if field.Value.Type() == wire.TList {
+				v.Samples, err = _List_I32_Uint32_Read(field.Value.GetList())
+				if err != nil {
+					return err
+				}
+
+			}
+		case 10:
+			if field.Value.Type() == wire.TSet {
+				v.Ports, err = _Set_Port_mapType_Read(field.Value.GetSet())
+				if err != nil {
+					return err
+				}
+
+			}
+		case 11:
+			if field.Value.Type() == wire.TMap {
+				v.Quotas, err = _Map_UserID_I64_Uint64_Read(field.Value.GetMap())
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	if !flagsIsSet {
+		return errors.New("field Flags of Counters is required")
+	}
+
+	if !checksumIsSet {
+		return errors.New("field Checksum of Counters is required")
+	}
+
+	if !hitsIsSet {
+		return errors.New("field Hits of Counters is required")
+	}
+
+	if !bytesIsSet {
+		return errors.New("field Bytes of Counters is required")
+	}
+
+	if v.Hops == nil {
+		v.Hops = ptr.Uint8(255)
+	}
+
+	if v.Port == nil {
+		v.Port = _Port_ptr(DefaultPort)
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a Counters
+// struct.
+func (v *Counters) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [11]string
+	i := 0
+	fields[i] = fmt.Sprintf("Flags: %v", v.Flags)
+	i++
+	fields[i] = fmt.Sprintf("Checksum: %v", v.Checksum)
+	i++
+	fields[i] = fmt.Sprintf("Hits: %v", v.Hits)
+	i++
+	fields[i] = fmt.Sprintf("Bytes: %v", v.Bytes)
+	i++
+	if v.Level != nil {
+		fields[i] = fmt.Sprintf("Level: %v", *(v.Level))
+		i++
+	}
+	if v.Hops != nil {
+		fields[i] = fmt.Sprintf("Hops: %v", *(v.Hops))
+		i++
+	}
+	if v.Owner != nil {
+		fields[i] = fmt.Sprintf("Owner: %v", *(v.Owner))
+		i++
+	}
+	if v.Port != nil {
+		fields[i] = fmt.Sprintf("Port: %v", *(v.Port))
+		i++
+	}
+	if v.Samples != nil {
+		fields[i] = fmt.Sprintf("Samples: %v", v.Samples)
+		i++
+	}
+	if v.Ports != nil {
+		fields[i] = fmt.Sprintf("Ports: %v", v.Ports)
+		i++
+	}
+	if v.Quotas != nil {
+		fields[i] = fmt.Sprintf("Quotas: %v", v.Quotas)
+		i++
+	}
+
+	return fmt.Sprintf("Counters{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _I32_Int8_EqualsPtr(lhs, rhs *int8) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return (x == y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+func _I16_Uint8_EqualsPtr(lhs, rhs *uint8) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return (x == y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+func _UserID_EqualsPtr(lhs, rhs *UserID) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return (x == y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+func _Port_EqualsPtr(lhs, rhs *Port) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return (x == y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+func _List_I32_Uint32_Equals(lhs, rhs []uint32) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for i, lv := range lhs {
+		rv := rhs[i]
+		if !(lv == rv) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func _Set_Port_mapType_Equals(lhs, rhs map[Port]struct{}) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for x := range rhs {
+		if _, ok := lhs[x]; !ok {
+			return false
+		}
+	}
+
+	return true
+}
+
+func _Map_UserID_I64_Uint64_Equals(lhs, rhs map[UserID]uint64) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for lk, lv := range lhs {
+		rv, ok := rhs[lk]
+		if !ok {
+			return false
+		}
+		if !(lv == rv) {
+			return false
+		}
+	}
+	return true
+}
+
+// Equals returns true if all the fields of this Counters match the
+// provided Counters.
+//
+// This function performs a deep comparison.
+func (v *Counters) Equals(rhs *Counters) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !(v.Flags == rhs.Flags) {
+		return false
+	}
+	if !(v.Checksum == rhs.Checksum) {
+		return false
+	}
+	if !(v.Hits == rhs.Hits) {
+		return false
+	}
+	if !(v.Bytes == rhs.Bytes) {
+		return false
+	}
+	if !_I32_Int8_EqualsPtr(v.Level, rhs.Level) {
+		return false
+	}
+	if !_I16_Uint8_EqualsPtr(v.Hops, rhs.Hops) {
+		return false
+	}
+	if !_UserID_EqualsPtr(v.Owner, rhs.Owner) {
+		return false
+	}
+	if !_Port_EqualsPtr(v.Port, rhs.Port) {
+		return false
+	}
+	if !((v.Samples == nil && rhs.Samples == nil) || (v.Samples != nil && rhs.Samples != nil && _List_I32_Uint32_Equals(v.Samples, rhs.Samples))) {
+		return false
+	}
+	if !((v.Ports == nil && rhs.Ports == nil) || (v.Ports != nil && rhs.Ports != nil && _Set_Port_mapType_Equals(v.Ports, rhs.Ports))) {
+		return false
+	}
+	if !((v.Quotas == nil && rhs.Quotas == nil) || (v.Quotas != nil && rhs.Quotas != nil && _Map_UserID_I64_Uint64_Equals(v.Quotas, rhs.Quotas))) {
+		return false
+	}
+
+	return true
+}
+
+type _List_I32_Uint32_Zapper []uint32
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _List_I32_Uint32_Zapper.
+func (l _List_I32_Uint32_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
+	for _, v := range l {
+		enc.AppendUint32(v)
+	}
+	return err
+}
+
+type _Set_Port_mapType_Zapper map[Port]struct{}
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _Set_Port_mapType_Zapper.
+func (s _Set_Port_mapType_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
+	for v := range s {
+		enc.AppendUint16((uint16)(v))
+	}
+	return err
+}
+
+type _Map_UserID_I64_Uint64_Item_Zapper struct {
+	Key   UserID
+	Value uint64
+}
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _Map_UserID_I64_Uint64_Item_Zapper.
+func (v _Map_UserID_I64_Uint64_Item_Zapper) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	enc.AddUint64("key", (uint64)(v.Key))
+	enc.AddUint64("value", v.Value)
+	return err
+}
+
+type _Map_UserID_I64_Uint64_Zapper map[UserID]uint64
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _Map_UserID_I64_Uint64_Zapper.
+func (m _Map_UserID_I64_Uint64_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
+	for k, v := range m {
+		err = multierr.Append(err, enc.AppendObject(_Map_UserID_I64_Uint64_Item_Zapper{Key: k, Value: v}))
+	}
+	return err
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Counters.
+func (v *Counters) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	enc.AddUint8("flags", v.Flags)
+	enc.AddUint16("checksum", v.Checksum)
+	enc.AddUint32("hits", v.Hits)
+	enc.AddUint64("bytes", v.Bytes)
+	if v.Level != nil {
+		enc.AddInt8("level", *v.Level)
+	}
+	if v.Hops != nil {
+		enc.AddUint8("hops", *v.Hops)
+	}
+	if v.Owner != nil {
+		enc.AddUint64("owner", (uint64)(*v.Owner))
+	}
+	if v.Port != nil {
+		enc.AddUint16("port", (uint16)(*v.Port))
+	}
+	if v.Samples != nil {
+		err = multierr.Append(err, enc.AddArray("samples", (_List_I32_Uint32_Zapper)(v.Samples)))
+	}
+	if v.Ports != nil {
+		err = multierr.Append(err, enc.AddArray("ports", (_Set_Port_mapType_Zapper)(v.Ports)))
+	}
+	if v.Quotas != nil {
+		err = multierr.Append(err, enc.AddArray("quotas", (_Map_UserID_I64_Uint64_Zapper)(v.Quotas)))
+	}
+	return err
+}
+
+// GetFlags returns the value of Flags if it is set or its
+// zero value if it is unset.
+func (v *Counters) GetFlags() (o uint8) {
+	if v != nil {
+		o = v.Flags
+	}
+	return
+}
+
+// GetChecksum returns the value of Checksum if it is set or its
+// zero value if it is unset.
+func (v *Counters) GetChecksum() (o uint16) {
+	if v != nil {
+		o = v.Checksum
+	}
+	return
+}
+
+// GetHits returns the value of Hits if it is set or its
+// zero value if it is unset.
+func (v *Counters) GetHits() (o uint32) {
+	if v != nil {
+		o = v.Hits
+	}
+	return
+}
+
+// GetBytes returns the value of Bytes if it is set or its
+// zero value if it is unset.
+func (v *Counters) GetBytes() (o uint64) {
+	if v != nil {
+		o = v.Bytes
+	}
+	return
+}
+
+// GetLevel returns the value of Level if it is set or its
+// zero value if it is unset.
+func (v *Counters) GetLevel() (o int8) {
+	if v != nil && v.Level != nil {
+		return *v.Level
+	}
+
+	return
+}
+
+// IsSetLevel returns true if Level is not nil.
+func (v *Counters) IsSetLevel() bool {
+	return v != nil && v.Level != nil
+}
+
+// GetHops returns the value of Hops if it is set or its
+// default value if it is unset.
+func (v *Counters) GetHops() (o uint8) {
+	if v != nil && v.Hops != nil {
+		return *v.Hops
+	}
+	o = 255
+	return
+}
+
+// IsSetHops returns true if Hops is not nil.
+func (v *Counters) IsSetHops() bool {
+	return v != nil && v.Hops != nil
+}
+
+// GetOwner returns the value of Owner if it is set or its
+// zero value if it is unset.
+func (v *Counters) GetOwner() (o UserID) {
+	if v != nil && v.Owner != nil {
+		return *v.Owner
+	}
+
+	return
+}
+
+// IsSetOwner returns true if Owner is not nil.
+func (v *Counters) IsSetOwner() bool {
+	return v != nil && v.Owner != nil
+}
+
+// GetPort returns the value of Port if it is set or its
+// default value if it is unset.
+func (v *Counters) GetPort() (o Port) {
+	if v != nil && v.Port != nil {
+		return *v.Port
+	}
+	o = DefaultPort
+	return
+}
+
+// IsSetPort returns true if Port is not nil.
+func (v *Counters) IsSetPort() bool {
+	return v != nil && v.Port != nil
+}
+
+// GetSamples returns the value of Samples if it is set or its
+// zero value if it is unset.
+func (v *Counters) GetSamples() (o []uint32) {
+	if v != nil && v.Samples != nil {
+		return v.Samples
+	}
+
+	return
+}
+
+// IsSetSamples returns true if Samples is not nil.
+func (v *Counters) IsSetSamples() bool {
+	return v != nil && v.Samples != nil
+}
+
+// GetPorts returns the value of Ports if it is set or its
+// zero value if it is unset.
+func (v *Counters) GetPorts() (o map[Port]struct{}) {
+	if v != nil && v.Ports != nil {
+		return v.Ports
+	}
+
+	return
+}
+
+// IsSetPorts returns true if Ports is not nil.
+func (v *Counters) IsSetPorts() bool {
+	return v != nil && v.Ports != nil
+}
+
+// GetQuotas returns the value of Quotas if it is set or its
+// zero value if it is unset.
+func (v *Counters) GetQuotas() (o map[UserID]uint64) {
+	if v != nil && v.Quotas != nil {
+		return v.Quotas
+	}
+
+	return
+}
+
+// IsSetQuotas returns true if Quotas is not nil.
+func (v *Counters) IsSetQuotas() bool {
+	return v != nil && v.Quotas != nil
+}
+
+func _I32_Uint16_Read(w wire.Value) (uint16, error) {
+	x := w.GetI32()
+	if x < 0 || x > 65535 {
+		return 0, fmt.Errorf("%v is out of range for uint16", x)
+	}
+	return uint16(x), nil
+}
+
+type Port uint16
+
+// PortPtr returns a pointer to a Port
+func (v Port) Ptr() *Port {
+	return &v
+}
+
+// ToWire translates Port into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+func (v Port) ToWire() (wire.Value, error) {
+	x := (uint16)(v)
+	return wire.NewValueI32(int32(x)), error(nil)
+}
+
+// String returns a readable string representation of Port.
+func (v Port) String() string {
+	x := (uint16)(v)
+	return fmt.Sprint(x)
+}
+
+// FromWire deserializes Port from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+func (v *Port) FromWire(w wire.Value) error {
+	x, err := _I32_Uint16_Read(w)
+	*v = (Port)(x)
+	return err
+}
+
+// Equals returns true if this Port is equal to the provided
+// Port.
+func (lhs Port) Equals(rhs Port) bool {
+	return ((uint16)(lhs) == (uint16)(rhs))
+}
+
+type UserID uint64
+
+// UserIDPtr returns a pointer to a UserID
+func (v UserID) Ptr() *UserID {
+	return &v
+}
+
+// ToWire translates UserID into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+func (v UserID) ToWire() (wire.Value, error) {
+	x := (uint64)(v)
+	return wire.NewValueI64(int64(x)), error(nil)
+}
+
+// String returns a readable string representation of UserID.
+func (v UserID) String() string {
+	x := (uint64)(v)
+	return fmt.Sprint(x)
+}
+
+// FromWire deserializes UserID from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+func (v *UserID) FromWire(w wire.Value) error {
+	x, err := uint64(w.GetI64()), error(nil)
+	*v = (UserID)(x)
+	return err
+}
+
+// Equals returns true if this UserID is equal to the provided
+// UserID.
+func (lhs UserID) Equals(rhs UserID) bool {
+	return ((uint64)(lhs) == (uint64)(rhs))
+}
+
+// ThriftModule represents the IDL file used to generate this package.
+var ThriftModule = &thriftreflect.ThriftModule{
+	Name:     "unsigned",
+	Package:  "go.uber.org/thriftrw/gen/internal/tests/unsigned",
+	FilePath: "unsigned.thrift",
+	SHA1:     "f8531863b438b6e1dcb6d0c84c16f7c0c71fa879",
+	Raw:      rawIDL,
+}
+
+const rawIDL = "typedef i64 (go.type = \"uint64\") UserID\n\ntypedef i32 (go.type = \"uint16\") Port\n\nconst UserID ROOT = 0\nconst Port DEFAULT_PORT = 8080\nconst i16 (go.type = \"uint8\") MAX_HOPS = 255\n\nstruct Counters {\n    1: required i8 (go.type = \"uint8\") flags\n    2: required i16 (go.type = \"uint16\") checksum\n    3: required i32 (go.type = \"uint32\") hits\n    4: required i64 (go.type = \"uint64\") bytes\n    5: optional i32 (go.type = \"int8\") level\n    6: optional i16 (go.type = \"uint8\") hops = MAX_HOPS\n    7: optional UserID owner\n    8: optional Port port = DEFAULT_PORT\n    9: optional list<i32 (go.type = \"uint32\")> samples\n    10: optional set<Port> ports\n    11: optional map<UserID, i64 (go.type = \"uint64\")> quotas\n}\n\nservice CounterStore {\n    UserID lookup(1: Port port, 2: i32 (go.type = \"uint32\") shard)\n}\n"
+
+func init() {
+	thriftreflect.Register(ThriftModule, map[string]thriftreflect.Constructor{
+		"Counters": func() interface{} { return new(Counters) },
+	})
+}
+
+// CounterStore_Lookup_Args represents the arguments for the CounterStore.lookup function.
+//
+// The arguments for lookup are sent and received over the wire as this struct.
+type CounterStore_Lookup_Args struct {
+	Port  *Port   `json:"port,omitempty"`
+	Shard *uint32 `json:"shard,omitempty"`
+}
+
+// ToWire translates a CounterStore_Lookup_Args struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *CounterStore_Lookup_Args) ToWire() (wire.Value, error) {
+	var (
+		fields [2]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Port != nil {
+		w, err = v.Port.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 1, Value: w}
+		i++
+	}
+	if v.Shard != nil {
+		w, err = wire.NewValueI32(int32(*(v.Shard))), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 2, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a CounterStore_Lookup_Args struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a CounterStore_Lookup_Args struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v CounterStore_Lookup_Args
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *CounterStore_Lookup_Args) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TI32 {
+				var x Port
+				x, err = _Port_Read(field.Value)
+				v.Port = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 2:
+			if field.Value.Type() == wire.TI32 {
+				var x uint32
+				x, err = uint32(field.Value.GetI32()), error(nil)
+				v.Shard = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a CounterStore_Lookup_Args
+// struct.
+func (v *CounterStore_Lookup_Args) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [2]string
+	i := 0
+	if v.Port != nil {
+		fields[i] = fmt.Sprintf("Port: %v", *(v.Port))
+		i++
+	}
+	if v.Shard != nil {
+		fields[i] = fmt.Sprintf("Shard: %v", *(v.Shard))
+		i++
+	}
+
+	return fmt.Sprintf("CounterStore_Lookup_Args{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _I32_Uint32_EqualsPtr(lhs, rhs *uint32) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return (x == y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+// Equals returns true if all the fields of this CounterStore_Lookup_Args match the
+// provided CounterStore_Lookup_Args.
+//
+// This function performs a deep comparison.
+func (v *CounterStore_Lookup_Args) Equals(rhs *CounterStore_Lookup_Args) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_Port_EqualsPtr(v.Port, rhs.Port) {
+		return false
+	}
+	if !_I32_Uint32_EqualsPtr(v.Shard, rhs.Shard) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of CounterStore_Lookup_Args.
+func (v *CounterStore_Lookup_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Port != nil {
+		enc.AddUint16("port", (uint16)(*v.Port))
+	}
+	if v.Shard != nil {
+		enc.AddUint32("shard", *v.Shard)
+	}
+	return err
+}
+
+// GetPort returns the value of Port if it is set or its
+// zero value if it is unset.
+func (v *CounterStore_Lookup_Args) GetPort() (o Port) {
+	if v != nil && v.Port != nil {
+		return *v.Port
+	}
+
+	return
+}
+
+// IsSetPort returns true if Port is not nil.
+func (v *CounterStore_Lookup_Args) IsSetPort() bool {
+	return v != nil && v.Port != nil
+}
+
+// GetShard returns the value of Shard if it is set or its
+// zero value if it is unset.
+func (v *CounterStore_Lookup_Args) GetShard() (o uint32) {
+	if v != nil && v.Shard != nil {
+		return *v.Shard
+	}
+
+	return
+}
+
+// IsSetShard returns true if Shard is not nil.
+func (v *CounterStore_Lookup_Args) IsSetShard() bool {
+	return v != nil && v.Shard != nil
+}
+
+// MethodName returns the name of the Thrift function as specified in
+// the IDL, for which this struct represent the arguments.
+//
+// This will always be "lookup" for this struct.
+func (v *CounterStore_Lookup_Args) MethodName() string {
+	return "lookup"
+}
+
+// EnvelopeType returns the kind of value inside this struct.
+//
+// This will always be Call for this struct.
+func (v *CounterStore_Lookup_Args) EnvelopeType() wire.EnvelopeType {
+	return wire.Call
+}
+
+// CounterStore_Lookup_Helper provides functions that aid in handling the
+// parameters and return values of the CounterStore.lookup
+// function.
+var CounterStore_Lookup_Helper = struct {
+	// Args accepts the parameters of lookup in-order and returns
+	// the arguments struct for the function.
+	Args func(
+		port *Port,
+		shard *uint32,
+	) *CounterStore_Lookup_Args
+
+	// IsException returns true if the given error can be thrown
+	// by lookup.
+	//
+	// An error can be thrown by lookup only if the
+	// corresponding exception type was mentioned in the 'throws'
+	// section for it in the Thrift file.
+	IsException func(error) bool
+
+	// WrapResponse returns the result struct for lookup
+	// given its return value and error.
+	//
+	// This allows mapping values and errors returned by
+	// lookup into a serializable result struct.
+	// WrapResponse returns a non-nil error if the provided
+	// error cannot be thrown by lookup
+	//
+	//   value, err := lookup(args)
+	//   result, err := CounterStore_Lookup_Helper.WrapResponse(value, err)
+	//   if err != nil {
+	//     return fmt.Errorf("unexpected error from lookup: %v", err)
+	//   }
+	//   serialize(result)
+	WrapResponse func(UserID, error) (*CounterStore_Lookup_Result, error)
+
+	// UnwrapResponse takes the result struct for lookup
+	// and returns the value or error returned by it.
+	//
+	// The error is non-nil only if lookup threw an
+	// exception.
+	//
+	//   result := deserialize(bytes)
+	//   value, err := CounterStore_Lookup_Helper.UnwrapResponse(result)
+	UnwrapResponse func(*CounterStore_Lookup_Result) (UserID, error)
+}{}
+
+func init() {
+	CounterStore_Lookup_Helper.Args = func(
+		port *Port,
+		shard *uint32,
+	) *CounterStore_Lookup_Args {
+		return &CounterStore_Lookup_Args{
+			Port:  port,
+			Shard: shard,
+		}
+	}
+
+	CounterStore_Lookup_Helper.IsException = func(err error) bool {
+		switch err.(type) {
+		default:
+			return false
+		}
+	}
+
+	CounterStore_Lookup_Helper.WrapResponse = func(success UserID, err error) (*CounterStore_Lookup_Result, error) {
+		if err == nil {
+			return &CounterStore_Lookup_Result{Success: &success}, nil
+		}
+
+		return nil, err
+	}
+	CounterStore_Lookup_Helper.UnwrapResponse = func(result *CounterStore_Lookup_Result) (success UserID, err error) {
+
+		if result.Success != nil {
+			success = *result.Success
+			return
+		}
+
+		err = errors.New("expected a non-void result")
+		return
+	}
+
+}
+
+// CounterStore_Lookup_Result represents the result of a CounterStore.lookup function call.
+//
+// The result of a lookup execution is sent and received over the wire as this struct.
+//
+// Success is set only if the function did not throw an exception.
+type CounterStore_Lookup_Result struct {
+	// Value returned by lookup after a successful execution.
+	Success *UserID `json:"success,omitempty"`
+}
+
+// ToWire translates a CounterStore_Lookup_Result struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *CounterStore_Lookup_Result) ToWire() (wire.Value, error) {
+	var (
+		fields [1]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Success != nil {
+		w, err = v.Success.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 0, Value: w}
+		i++
+	}
+
+	if i != 1 {
+		return wire.Value{}, fmt.Errorf("CounterStore_Lookup_Result should have exactly one field: got %v fields", i)
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a CounterStore_Lookup_Result struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a CounterStore_Lookup_Result struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v CounterStore_Lookup_Result
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *CounterStore_Lookup_Result) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 0:
+			if field.Value.Type() == wire.TI64 {
+				var x UserID
+				x, err = _UserID_Read(field.Value)
+				v.Success = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	count := 0
+	if v.Success != nil {
+		count++
+	}
+	if count != 1 {
+		return fmt.Errorf("CounterStore_Lookup_Result should have exactly one field: got %v fields", count)
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a CounterStore_Lookup_Result
+// struct.
+func (v *CounterStore_Lookup_Result) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [1]string
+	i := 0
+	if v.Success != nil {
+		fields[i] = fmt.Sprintf("Success: %v", *(v.Success))
+		i++
+	}
+
+	return fmt.Sprintf("CounterStore_Lookup_Result{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this CounterStore_Lookup_Result match the
+// provided CounterStore_Lookup_Result.
+//
+// This function performs a deep comparison.
+func (v *CounterStore_Lookup_Result) Equals(rhs *CounterStore_Lookup_Result) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_UserID_EqualsPtr(v.Success, rhs.Success) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of CounterStore_Lookup_Result.
+func (v *CounterStore_Lookup_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Success != nil {
+		enc.AddUint64("success", (uint64)(*v.Success))
+	}
+	return err
+}
+
+// GetSuccess returns the value of Success if it is set or its
+// zero value if it is unset.
+func (v *CounterStore_Lookup_Result) GetSuccess() (o UserID) {
+	if v != nil && v.Success != nil {
+		return *v.Success
+	}
+
+	return
+}
+
+// IsSetSuccess returns true if Success is not nil.
+func (v *CounterStore_Lookup_Result) IsSetSuccess() bool {
+	return v != nil && v.Success != nil
+}
+
+// MethodName returns the name of the Thrift function as specified in
+// the IDL, for which this struct represent the result.
+//
+// This will always be "lookup" for this struct.
+func (v *CounterStore_Lookup_Result) MethodName() string {
+	return "lookup"
+}
+
+// EnvelopeType returns the kind of value inside this struct.
+//
+// This will always be Reply for this struct.
+func (v *CounterStore_Lookup_Result) EnvelopeType() wire.EnvelopeType {
+	return wire.Reply
+}
@@ -22,6 +22,7 @@ package gen
 
 import (
 	"fmt"
+	"strings"
 
 	"go.uber.org/thriftrw/compile"
 )
@@ -32,12 +33,16 @@ type mangler struct {
 
 	// all names for custom types that have been taken so far
 	taken map[string]struct{}
+
+	// Thrift type name -> go.adapter -> value name
+	adapted map[string]map[string]string
 }
 
 func newMangler() *mangler {
 	return &mangler{
-		names: make(map[string]map[string]string),
-		taken: make(map[string]struct{}),
+		names:   make(map[string]map[string]string),
+		taken:   make(map[string]struct{}),
+		adapted: make(map[string]map[string]string),
 	}
 }
 
@@ -61,6 +66,13 @@ func (m *mangler) MangleType(spec compile.TypeSpec) string {
 	// Native primitive types have unique names
 	thriftFile := spec.ThriftFile()
 	if thriftFile == "" {
+		if a, err := adapterFor(spec); err == nil && a != nil {
+			return m.mangleAdapted(spec, a.Adapter.ImportPath+"."+a.Adapter.Name)
+		}
+		if t, err := intTypeFor(spec); err == nil && t != nil {
+			// "i32 (go.type = "uint32")" becomes "I32_Uint32"
+			return goCase(spec.ThriftName()) + "_" + goCase(t.Name)
+		}
 		return goCase(spec.ThriftName())
 	}
 
@@ -87,3 +99,37 @@ func (m *mangler) MangleType(spec compile.TypeSpec) string {
 	namesForFile[spec.ThriftName()] = name
 	return name
 }
+
+// mangleAdapted generates a unique name for a native type mapped to a custom
+// Go type with the given go.adapter.
+func (m *mangler) mangleAdapted(spec compile.TypeSpec, adapter string) string {
+	namesForType, ok := m.adapted[spec.ThriftName()]
+	if !ok {
+		namesForType = make(map[string]string)
+		m.adapted[spec.ThriftName()] = namesForType
+	}
+
+	if name, ok := namesForType[adapter]; ok {
+		return name
+	}
+
+	// Name the type after the last component of the adapter's name:
+	// "example.com/foo.UnixNanos" becomes "I64_UnixNanos".
+	short := adapter
+	if i := strings.LastIndexAny(short, "./"); i >= 0 {
+		short = short[i+1:]
+	}
+	baseName := goCase(spec.ThriftName()) + "_" + goCase(short)
+
+	i := 0
+	name := baseName
+	for _, taken := m.taken[name]; taken; {
+		i++
+		name = fmt.Sprintf("%s_%d", baseName, i)
+		_, taken = m.taken[name]
+	}
+
+	m.taken[name] = struct{}{}
+	namesForType[adapter] = name
+	return name
+}
@@ -0,0 +1,209 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package gen
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+)
+
+// ManifestFile is the name of the file in which ThriftRW records the files
+// it generated into an output directory when Options.Manifest is set.
+const ManifestFile = ".thriftrw-manifest.json"
+
+// generatedByMarker identifies files generated by ThriftRW or its plugins.
+// Only files that contain this in their first _generatedByLimit bytes are
+// ever removed.
+var generatedByMarker = []byte("Code generated by thriftrw")
+
+const _generatedByLimit = 1024
+
+// manifest records the files generated into an output directory.
+//
+// Files are tracked separately for each root Thrift file so that multiple
+// invocations of ThriftRW may share an output directory.
+type manifest struct {
+	// Paths of generated files relative to the output directory, keyed by
+	// the path of the root Thrift file relative to the Thrift root.
+	Roots map[string][]string `json:"roots"`
+}
+
+// readManifest reads the manifest in the given directory. An empty manifest
+// is returned if the directory does not have one.
+func readManifest(dir string) (*manifest, error) {
+	path := filepath.Join(dir, ManifestFile)
+	contents, err := ioutil.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return &manifest{Roots: make(map[string][]string)}, nil
+		}
+		return nil, err
+	}
+
+	var m manifest
+	if err := json.Unmarshal(contents, &m); err != nil {
+		return nil, fmt.Errorf("could not read manifest %q: %v", path, err)
+	}
+	if m.Roots == nil {
+		m.Roots = make(map[string][]string)
+	}
+	return &m, nil
+}
+
+// write writes the manifest to the given directory.
+func (m *manifest) write(dir string) error {
+	contents, err := json.MarshalIndent(m, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return fmt.Errorf("could not create directory %q: %v", dir, err)
+	}
+
+	path := filepath.Join(dir, ManifestFile)
+	if err := ioutil.WriteFile(path, append(contents, '\n'), 0644); err != nil {
+		return fmt.Errorf("failed to write %q: %v", path, err)
+	}
+	return nil
+}
+
+// update records the files generated for the given root and returns the
+// files that were previously generated for it but are no longer generated
+// for any root.
+func (m *manifest) update(root string, paths []string) (stale []string) {
+	current := make(map[string]struct{}, len(paths))
+	for _, p := range paths {
+		current[p] = struct{}{}
+	}
+	for r, ps := range m.Roots {
+		if r == root {
+			continue
+		}
+		for _, p := range ps {
+			current[p] = struct{}{}
+		}
+	}
+
+	for _, p := range m.Roots[root] {
+		if _, ok := current[p]; !ok {
+			stale = append(stale, p)
+		}
+	}
+
+	if len(paths) == 0 {
+		delete(m.Roots, root)
+	} else {
+		m.Roots[root] = paths
+	}
+	return stale
+}
+
+// cleanStale updates the manifest in dir with the files generated for root
+// at the given paths, and removes files that were generated by a previous run
+// but not this one.
+//
+// Files that don't bear a "Code generated by thriftrw" header are left
+// alone. If dryRun is set, the files that would be removed are listed on w
+// instead.
+func cleanStale(dir, root string, paths []string, dryRun bool, w io.Writer) error {
+	m, err := readManifest(dir)
+	if err != nil {
+		return err
+	}
+
+	// The manifest is shared across platforms so it records paths separated
+	// by slashes.
+	slashPaths := make([]string, len(paths))
+	for i, p := range paths {
+		slashPaths[i] = filepath.ToSlash(filepath.Clean(p))
+	}
+	sort.Strings(slashPaths)
+
+	for _, relPath := range m.update(root, slashPaths) {
+		// Don't trust paths from the manifest blindly.
+		if err := checkRelativePath(relPath); err != nil {
+			continue
+		}
+
+		fullPath := filepath.Join(dir, filepath.FromSlash(relPath))
+		ok, err := isGenerated(fullPath)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			continue
+		}
+
+		if dryRun {
+			fmt.Fprintf(w, "remove %v\n", fullPath)
+			continue
+		}
+
+		if err := os.Remove(fullPath); err != nil {
+			return fmt.Errorf("failed to remove %q: %v", fullPath, err)
+		}
+		removeEmptyDirs(dir, filepath.Dir(fullPath))
+	}
+
+	if dryRun {
+		return nil
+	}
+	return m.write(dir)
+}
+
+// isGenerated reports whether the given file exists and was generated by
+// ThriftRW or one of its plugins.
+func isGenerated(path string) (bool, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return false, nil
+		}
+		return false, err
+	}
+	defer f.Close()
+
+	head := make([]byte, _generatedByLimit)
+	n, err := io.ReadFull(f, head)
+	if err != nil && err != io.EOF && err != io.ErrUnexpectedEOF {
+		return false, fmt.Errorf("failed to read %q: %v", path, err)
+	}
+	return bytes.Contains(head[:n], generatedByMarker), nil
+}
+
+// removeEmptyDirs removes dir and its parents up to, but excluding, root as
+// long as they are empty.
+func removeEmptyDirs(root, dir string) {
+	for dir != root && len(dir) > len(root) {
+		// os.Remove fails on directories that aren't empty.
+		if err := os.Remove(dir); err != nil {
+			return
+		}
+		dir = filepath.Dir(dir)
+	}
+}
@@ -0,0 +1,221 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package gen
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"go.uber.org/thriftrw/compile"
+	thriftrwplugin "go.uber.org/thriftrw/plugin"
+	"go.uber.org/thriftrw/plugin/api"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// artifactGenerator is a ServiceGenerator that generates an artifact for
+// each root service.
+type artifactGenerator struct{}
+
+func (artifactGenerator) Generate(req *api.GenerateServiceRequest) (*api.GenerateServiceResponse, error) {
+	artifacts := make(map[string][]byte)
+	for _, id := range req.RootServices {
+		name := req.Services[id].Name
+		artifacts[name+".md"] = []byte(fmt.Sprintf("<!-- Code generated by thriftrw-plugin-docs. -->\n# %v\n", name))
+	}
+	return &api.GenerateServiceResponse{Artifacts: artifacts}, nil
+}
+
+func TestGenerateManifest(t *testing.T) {
+	dir, err := ioutil.TempDir("", "test-generate-manifest")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	var (
+		thriftRoot  = filepath.Join(dir, "thrift")
+		outputDir   = filepath.Join(dir, "gen")
+		artifactDir = filepath.Join(dir, "docs")
+	)
+
+	writeFile := func(path, contents string) {
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0755))
+		require.NoError(t, ioutil.WriteFile(path, []byte(contents), 0644))
+	}
+
+	exists := func(path string) bool {
+		_, err := os.Stat(path)
+		return err == nil
+	}
+
+	generate := func(root string, dryRun bool) string {
+		module, err := compile.Compile(filepath.Join(thriftRoot, root))
+		require.NoError(t, err)
+
+		var out bytes.Buffer
+		require.NoError(t, Generate(module, &Options{
+			OutputDir:        outputDir,
+			ArtifactDir:      artifactDir,
+			PackagePrefix:    "example.com/gen",
+			ThriftRoot:       thriftRoot,
+			NoEmbedIDL:       true,
+			InProcessPlugins: []*thriftrwplugin.Plugin{{Name: "docs", ServiceGenerator: artifactGenerator{}}},
+			Manifest:         true,
+			DryRun:           dryRun,
+			DryRunOutput:     &out,
+		}))
+		return out.String()
+	}
+
+	writeFile(filepath.Join(thriftRoot, "shared.thrift"), "struct Shared {}")
+	writeFile(filepath.Join(thriftRoot, "a.thrift"), `
+		include "./shared.thrift"
+		service Foo {}
+		service Bar {}
+	`)
+	writeFile(filepath.Join(thriftRoot, "b.thrift"), `
+		include "./shared.thrift"
+		struct B {}
+	`)
+
+	generate("a.thrift", false)
+	generate("b.thrift", false)
+	for _, path := range []string{
+		filepath.Join(outputDir, "a/a.go"),
+		filepath.Join(outputDir, "b/b.go"),
+		filepath.Join(outputDir, "shared/shared.go"),
+		filepath.Join(outputDir, ManifestFile),
+		filepath.Join(artifactDir, "Foo.md"),
+		filepath.Join(artifactDir, "Bar.md"),
+		filepath.Join(artifactDir, ManifestFile),
+	} {
+		assert.True(t, exists(path), "%v must exist", path)
+	}
+
+	m, err := readManifest(outputDir)
+	require.NoError(t, err)
+	assert.Equal(t, map[string][]string{
+		"a.thrift": {"a/a.go", "shared/shared.go"},
+		"b.thrift": {"b/b.go", "shared/shared.go"},
+	}, m.Roots)
+
+	// Drop the include and a service from a.thrift. shared.go is still
+	// generated for b.thrift.
+	writeFile(filepath.Join(thriftRoot, "a.thrift"), "service Foo {}")
+	writeFile(filepath.Join(outputDir, "a/custom.go"), "package a\n")
+
+	out := generate("a.thrift", true)
+	assert.Equal(t, fmt.Sprintf("write %v\nwrite %v\nremove %v\n",
+		filepath.Join(outputDir, "a/a.go"),
+		filepath.Join(artifactDir, "Foo.md"),
+		filepath.Join(artifactDir, "Bar.md"),
+	), out)
+	assert.True(t, exists(filepath.Join(artifactDir, "Bar.md")), "dry run must not remove files")
+
+	generate("a.thrift", false)
+	assert.False(t, exists(filepath.Join(artifactDir, "Bar.md")), "Bar.md must be removed")
+	assert.True(t, exists(filepath.Join(outputDir, "shared/shared.go")), "shared.go is still generated for b.thrift")
+
+	// Drop the include from b.thrift. shared.go is not generated anymore
+	// and its empty directory is removed too.
+	writeFile(filepath.Join(thriftRoot, "b.thrift"), "struct B {}")
+	generate("b.thrift", false)
+	assert.False(t, exists(filepath.Join(outputDir, "shared")), "shared/ must be removed")
+	assert.True(t, exists(filepath.Join(outputDir, "a/custom.go")), "a/custom.go must be left alone")
+
+	// Files without a header are never removed.
+	writeFile(filepath.Join(thriftRoot, "b.thrift"), `include "./shared.thrift"`)
+	generate("b.thrift", false)
+	writeFile(filepath.Join(outputDir, "shared/shared.go"), "package shared\n")
+	writeFile(filepath.Join(thriftRoot, "b.thrift"), "")
+	generate("b.thrift", false)
+	assert.True(t, exists(filepath.Join(outputDir, "shared/shared.go")), "shared.go was not generated by thriftrw")
+}
+
+func TestManifestUpdate(t *testing.T) {
+	tests := []struct {
+		desc      string
+		roots     map[string][]string
+		root      string
+		paths     []string
+		wantStale []string
+		wantRoots map[string][]string
+	}{
+		{
+			desc:      "empty",
+			roots:     map[string][]string{},
+			root:      "a.thrift",
+			paths:     []string{"a/a.go"},
+			wantRoots: map[string][]string{"a.thrift": {"a/a.go"}},
+		},
+		{
+			desc:      "removed file",
+			roots:     map[string][]string{"a.thrift": {"a/a.go", "b/b.go"}},
+			root:      "a.thrift",
+			paths:     []string{"a/a.go"},
+			wantStale: []string{"b/b.go"},
+			wantRoots: map[string][]string{"a.thrift": {"a/a.go"}},
+		},
+		{
+			desc: "file generated for another root",
+			roots: map[string][]string{
+				"a.thrift": {"a/a.go", "b/b.go"},
+				"c.thrift": {"b/b.go", "c/c.go"},
+			},
+			root:  "a.thrift",
+			paths: []string{"a/a.go"},
+			wantRoots: map[string][]string{
+				"a.thrift": {"a/a.go"},
+				"c.thrift": {"b/b.go", "c/c.go"},
+			},
+		},
+		{
+			desc:      "nothing generated",
+			roots:     map[string][]string{"a.thrift": {"a/a.go"}},
+			root:      "a.thrift",
+			wantStale: []string{"a/a.go"},
+			wantRoots: map[string][]string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			m := manifest{Roots: tt.roots}
+			assert.Equal(t, tt.wantStale, m.update(tt.root, tt.paths))
+			assert.Equal(t, tt.wantRoots, m.Roots)
+		})
+	}
+}
+
+func TestReadManifestInvalid(t *testing.T) {
+	dir, err := ioutil.TempDir("", "test-read-manifest")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dir, ManifestFile), []byte("{"), 0644))
+	_, err = readManifest(dir)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "could not read manifest")
+}
@@ -298,12 +298,17 @@ func (m *mapGenerator) zapMarshaler(
 	fieldValue string,
 ) (string, error) {
 	name := zapperName(g, root)
-	switch compile.RootTypeSpec(root.KeySpec).(type) {
-	case *compile.StringSpec:
+	if isStringKeyed(root) {
 		return m.zapStringKeyMarshaler(g, name, root, fieldValue)
-	default:
-		return m.zapNonstringKeyMarshaler(g, name, root, fieldValue)
 	}
+	return m.zapNonstringKeyMarshaler(g, name, root, fieldValue)
+}
+
+// isStringKeyed returns true if the given map is a Go map keyed by strings
+// or typedefs of strings.
+func isStringKeyed(spec *compile.MapSpec) bool {
+	_, isString := compile.RootTypeSpec(spec.KeySpec).(*compile.StringSpec)
+	return isString && isHashable(spec.KeySpec)
 }
 
 func (m *mapGenerator) zapStringKeyMarshaler(
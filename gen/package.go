@@ -0,0 +1,215 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+package gen
+
+import (
+	"errors"
+	"fmt"
+	"go/token"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"go.uber.org/thriftrw/compile"
+)
+
+// goPackageKey is the annotation on a `namespace go` header which specifies
+// the full import path of the generated package.
+//
+// 	namespace go users (go.package = "example.com/idl/users/v2")
+const goPackageKey = "go.package"
+
+// goPackage is the Go package generated for a Thrift file.
+type goPackage struct {
+	// RelPath is the path to the package relative to the import prefix and
+	// the output directory.
+	RelPath string
+
+	// Name is the name of the package.
+	Name string
+}
+
+// newThriftPackageImporter builds a thriftPackageImporter for the module
+// tree rooted at the given module.
+//
+// If Options.UseGoNamespace is set, Thrift files which declare a
+// `namespace go` are placed in the package named by it rather than the one
+// implied by their location under ThriftRoot. Thrift files listed in Options.ImportPaths use the packages
+// they are mapped to. It is an error for two Thrift files to map to the same
+// Go package.
+func newThriftPackageImporter(m *compile.Module, o *Options) (thriftPackageImporter, error) {
+	i := thriftPackageImporter{
+		ImportPrefix: o.PackagePrefix,
+		ThriftRoot:   o.ThriftRoot,
+		Packages:     make(map[string]goPackage),
+		ImportPaths:  o.ImportPaths,
+	}
+
+	for file, importPath := range o.ImportPaths {
+		if !filepath.IsAbs(file) {
+			return i, fmt.Errorf(
+				"cannot map %q to %q: Thrift file paths must be absolute", file, importPath)
+		}
+		if err := checkImportPath(importPath); err != nil {
+			return i, fmt.Errorf("cannot map %q to %q: %v", file, importPath, err)
+		}
+	}
+
+	if _, ok := o.ImportPaths[m.ThriftPath]; ok {
+		return i, fmt.Errorf(
+			"cannot map %q to an import path: code is being generated for it", m.ThriftPath)
+	}
+
+	var paths []string
+	err := WalkModules(m, o.ImportPaths, func(m *compile.Module) error {
+		paths = append(paths, m.ThriftPath)
+		if _, ok := o.ImportPaths[m.ThriftPath]; ok || !o.UseGoNamespace {
+			return nil
+		}
+
+		pkg, ok, err := namespacePackage(m, o.PackagePrefix)
+		if err != nil {
+			return generateError{Name: m.ThriftPath, Reason: err}
+		}
+		if ok {
+			i.Packages[m.ThriftPath] = pkg
+		}
+		return nil
+	})
+	if err != nil {
+		return i, err
+	}
+
+	// Walk visits modules in an unspecified order so we sort them to report
+	// collisions deterministically.
+	sort.Strings(paths)
+
+	owners := make(map[string]string, len(paths))
+	for _, p := range paths {
+		importPath, err := i.Package(p)
+		if err != nil {
+			return i, err
+		}
+		importPath = filepath.ToSlash(importPath)
+
+		if other, ok := owners[importPath]; ok {
+			return i, fmt.Errorf(
+				"package collision: %q and %q both map to Go package %q",
+				other, p, importPath)
+		}
+		owners[importPath] = p
+	}
+
+	return i, nil
+}
+
+// WalkModules walks the module tree rooted at the given module like
+// compile.Module.Walk, except that it does not descend into modules with
+// an entry in importPaths, the Thrift files mapped to existing packages with
+// Options.ImportPaths. Those modules are visited but the modules they
+// include are only visited if they are reachable some other way.
+func WalkModules(m *compile.Module, importPaths map[string]string, f func(*compile.Module) error) error {
+	visited := make(map[string]struct{})
+	toVisit := []*compile.Module{m}
+
+	for len(toVisit) > 0 {
+		m := toVisit[0]
+		toVisit = toVisit[1:]
+
+		if _, ok := visited[m.ThriftPath]; ok {
+			continue
+		}
+		visited[m.ThriftPath] = struct{}{}
+
+		if _, ok := importPaths[m.ThriftPath]; !ok {
+			for _, inc := range m.Includes {
+				toVisit = append(toVisit, inc.Module)
+			}
+		}
+
+		if err := f(m); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// namespacePackage determines the Go package for the given module from its
+// `namespace go` header. Returns false if the module does not declare one.
+func namespacePackage(m *compile.Module, prefix string) (_ goPackage, ok bool, err error) {
+	ns, ok := m.Namespaces["go"]
+	if !ok {
+		return goPackage{}, false, nil
+	}
+
+	parts := strings.Split(ns.Name, ".")
+	pkg := goPackage{
+		RelPath: path.Join(parts...),
+		Name:    normalizePackageName(parts[len(parts)-1]),
+	}
+
+	if importPath, ok := ns.Annotations[goPackageKey]; ok {
+		pkg.RelPath, err = relativeImportPath(prefix, importPath)
+		if err != nil {
+			return goPackage{}, false, fmt.Errorf(
+				"invalid %v annotation %q: %v", goPackageKey, importPath, err)
+		}
+	}
+
+	if !token.IsIdentifier(pkg.Name) || token.IsKeyword(pkg.Name) {
+		return goPackage{}, false, fmt.Errorf(
+			"namespace go %q: %q is not a valid Go package name", ns.Name, pkg.Name)
+	}
+
+	return pkg, true, nil
+}
+
+// relativeImportPath returns the given import path relative to the import
+// prefix. Generated packages must be inside the prefix so that they are
+// written to the output directory.
+func relativeImportPath(prefix, importPath string) (string, error) {
+	if err := checkImportPath(importPath); err != nil {
+		return "", err
+	}
+
+	rel := importPath
+	if prefix != "" {
+		rel = strings.TrimPrefix(importPath, prefix+"/")
+		if rel == importPath {
+			return "", fmt.Errorf("import path must be inside the package prefix %q", prefix)
+		}
+	}
+
+	if rel == "." || rel == ".." || strings.HasPrefix(rel, "../") {
+		return "", fmt.Errorf("import path must be inside the package prefix %q", prefix)
+	}
+	return filepath.FromSlash(rel), nil
+}
+
+// checkImportPath verifies that the given string may be used as an import
+// path.
+func checkImportPath(importPath string) error {
+	if importPath == "" || path.Clean(importPath) != importPath || path.IsAbs(importPath) {
+		return errors.New("import path must be clean and relative")
+	}
+	return nil
+}
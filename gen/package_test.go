@@ -0,0 +1,364 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+package gen
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/thriftrw/compile"
+)
+
+func TestGeneratePackages(t *testing.T) {
+	tests := []struct {
+		desc  string
+		files map[string]string
+
+		// Options.ImportPaths, with paths relative to the Thrift root.
+		importPaths map[string]string
+
+		// Disables Options.UseGoNamespace, which is set by default.
+		noGoNamespace bool
+
+		// Expected files relative to the output directory, and strings
+		// each of them must contain.
+		want      map[string][]string
+		notWant   []string
+		wantError string
+	}{
+		{
+			desc: "namespace",
+			files: map[string]string{
+				"root.thrift": `
+					namespace go example.users
+					include "./shared/common.thrift"
+
+					struct User {
+						1: required common.UUID id
+					}
+				`,
+				"shared/common.thrift": `
+					namespace go example.common
+					typedef string UUID
+				`,
+			},
+			want: map[string][]string{
+				"example/users/users.go": {
+					"package users",
+					`"example.com/idl/example/common"`,
+				},
+				"example/common/common.go": {"package common"},
+			},
+		},
+		{
+			desc: "namespace without UseGoNamespace",
+			files: map[string]string{
+				"root.thrift": `
+					namespace go example.users
+					namespace go example.people
+					struct User {}
+				`,
+			},
+			noGoNamespace: true,
+			want: map[string][]string{
+				"root/root.go": {"package root"},
+			},
+			notWant: []string{"example"},
+		},
+		{
+			desc: "go.package",
+			files: map[string]string{
+				"root.thrift": `
+					namespace go users (go.package = "example.com/idl/users/v2")
+					include "./shared/common.thrift"
+
+					struct User {
+						1: required common.UUID id
+					}
+				`,
+				"shared/common.thrift": `
+					typedef string UUID
+				`,
+			},
+			want: map[string][]string{
+				"users/v2/users.go": {
+					"package users",
+					`"example.com/idl/shared/common"`,
+				},
+				"shared/common/common.go": {"package common"},
+			},
+		},
+		{
+			desc: "hyphenated package name",
+			files: map[string]string{
+				"root.thrift": `namespace go foo (go.package = "example.com/idl/foo-bar")`,
+			},
+			want: map[string][]string{
+				"foo-bar/foo.go": {"package foo"},
+			},
+		},
+		{
+			desc: "collision between namespaces",
+			files: map[string]string{
+				"root.thrift": `
+					namespace go shared
+					include "./other.thrift"
+				`,
+				"other.thrift": `namespace go shared`,
+			},
+			wantError: `both map to Go package "example.com/idl/shared"`,
+		},
+		{
+			desc: "collision with default package",
+			files: map[string]string{
+				"root.thrift": `
+					include "./shared.thrift"
+					include "./other.thrift"
+				`,
+				"shared.thrift": ``,
+				"other.thrift":  `namespace go shared`,
+			},
+			wantError: `both map to Go package "example.com/idl/shared"`,
+		},
+		{
+			desc: "go.package outside prefix",
+			files: map[string]string{
+				"root.thrift": `namespace go foo (go.package = "example.com/other/foo")`,
+			},
+			wantError: `invalid go.package annotation "example.com/other/foo": ` +
+				`import path must be inside the package prefix "example.com/idl"`,
+		},
+		{
+			desc: "go.package not clean",
+			files: map[string]string{
+				"root.thrift": `namespace go foo (go.package = "example.com/idl/../foo")`,
+			},
+			wantError: "import path must be clean and relative",
+		},
+		{
+			desc: "keyword package name",
+			files: map[string]string{
+				"root.thrift": `namespace go foo.type`,
+			},
+			wantError: `namespace go "foo.type": "type" is not a valid Go package name`,
+		},
+		{
+			desc: "mapped include",
+			files: map[string]string{
+				"root.thrift": `
+					include "./shared/common.thrift"
+
+					struct User {
+						1: required common.UUID id
+						2: optional common.Kind kind = common.Kind.A
+					}
+				`,
+				"shared/common.thrift": `
+					include "../base.thrift"
+
+					typedef base.ID UUID
+					enum Kind { A, B }
+				`,
+				// Files included only by mapped files are not generated
+				// so this invalid namespace is never looked at.
+				"base.thrift": `
+					namespace go foo.type
+					typedef string ID
+				`,
+			},
+			importPaths: map[string]string{
+				"shared/common.thrift": "example.com/shared/gen/common",
+			},
+			want: map[string][]string{
+				"root/root.go": {
+					"package root",
+					`"example.com/shared/gen/common"`,
+					"common.KindA",
+				},
+			},
+			notWant: []string{"shared", "base"},
+		},
+		{
+			desc: "mapped include also included directly",
+			files: map[string]string{
+				"root.thrift": `
+					include "./shared.thrift"
+					include "./base.thrift"
+				`,
+				"shared.thrift": `include "./base.thrift"`,
+				"base.thrift":   ``,
+			},
+			importPaths: map[string]string{
+				"shared.thrift": "example.com/shared/gen/shared",
+			},
+			want: map[string][]string{
+				"root/root.go": {"package root"},
+				"base/base.go": {"package base"},
+			},
+			notWant: []string{"shared"},
+		},
+		{
+			desc: "mapped root",
+			files: map[string]string{
+				"root.thrift": ``,
+			},
+			importPaths: map[string]string{
+				"root.thrift": "example.com/shared/gen/root",
+			},
+			wantError: "code is being generated for it",
+		},
+		{
+			desc: "mapped collision",
+			files: map[string]string{
+				"root.thrift": `
+					include "./shared.thrift"
+					include "./other.thrift"
+				`,
+				"shared.thrift": ``,
+				"other.thrift":  ``,
+			},
+			importPaths: map[string]string{
+				"shared.thrift": "example.com/idl/other",
+			},
+			wantError: `both map to Go package "example.com/idl/other"`,
+		},
+		{
+			desc: "mapped invalid import path",
+			files: map[string]string{
+				"root.thrift":   `include "./shared.thrift"`,
+				"shared.thrift": ``,
+			},
+			importPaths: map[string]string{
+				"shared.thrift": "/shared",
+			},
+			wantError: `to "/shared": import path must be clean and relative`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			thriftRoot, err := ioutil.TempDir("", "thriftrw-namespace-test")
+			require.NoError(t, err)
+			defer os.RemoveAll(thriftRoot)
+
+			outputDir, err := ioutil.TempDir("", "thriftrw-namespace-test")
+			require.NoError(t, err)
+			defer os.RemoveAll(outputDir)
+
+			for name, contents := range tt.files {
+				path := filepath.Join(thriftRoot, name)
+				require.NoError(t, os.MkdirAll(filepath.Dir(path), 0755))
+				require.NoError(t, ioutil.WriteFile(path, []byte(contents), 0644))
+			}
+
+			var compileOpts []compile.Option
+			if !tt.noGoNamespace {
+				compileOpts = append(compileOpts, compile.UniqueNamespace("go"))
+			}
+			module, err := compile.Compile(filepath.Join(thriftRoot, "root.thrift"), compileOpts...)
+			require.NoError(t, err)
+
+			var importPaths map[string]string
+			if len(tt.importPaths) > 0 {
+				importPaths = make(map[string]string, len(tt.importPaths))
+				for name, importPath := range tt.importPaths {
+					importPaths[filepath.Join(thriftRoot, name)] = importPath
+				}
+			}
+
+			err = Generate(module, &Options{
+				OutputDir:      outputDir,
+				PackagePrefix:  "example.com/idl",
+				ThriftRoot:     thriftRoot,
+				NoVersionCheck: true,
+				NoEmbedIDL:     true,
+				UseGoNamespace: !tt.noGoNamespace,
+				ImportPaths:    importPaths,
+			})
+			if tt.wantError != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.wantError)
+				return
+			}
+			require.NoError(t, err)
+
+			for name, wants := range tt.want {
+				contents, err := ioutil.ReadFile(filepath.Join(outputDir, name))
+				require.NoError(t, err, "file %v must exist", name)
+				for _, want := range wants {
+					assert.Contains(t, string(contents), want, "file %v", name)
+				}
+			}
+
+			for _, name := range tt.notWant {
+				_, err := os.Stat(filepath.Join(outputDir, name))
+				assert.True(t, os.IsNotExist(err), "%v must not be generated", name)
+			}
+		})
+	}
+}
+
+func TestThriftPackageImporterPackages(t *testing.T) {
+	importer := thriftPackageImporter{
+		ImportPrefix: "github.com/myteam/myservice",
+		ThriftRoot:   "/src/thrift",
+		Packages: map[string]goPackage{
+			"/src/thrift/foo.thrift": {RelPath: "bar/baz/v2", Name: "baz"},
+		},
+	}
+
+	pkg, err := importer.Package("/src/thrift/foo.thrift")
+	require.NoError(t, err)
+	assert.Equal(t, "github.com/myteam/myservice/bar/baz/v2", pkg)
+
+	name, err := importer.PackageName("/src/thrift/foo.thrift")
+	require.NoError(t, err)
+	assert.Equal(t, "baz", name)
+
+	pkg, err = importer.Package("/src/thrift/hyphenated-file.thrift")
+	require.NoError(t, err)
+	assert.Equal(t, "github.com/myteam/myservice/hyphenated-file", pkg)
+
+	name, err = importer.PackageName("/src/thrift/hyphenated-file.thrift")
+	require.NoError(t, err)
+	assert.Equal(t, "hyphenated_file", name)
+
+	importer.ImportPaths = map[string]string{
+		"/src/thrift/shared.thrift": "github.com/otherteam/idl-go/shared",
+	}
+
+	pkg, err = importer.Package("/src/thrift/shared.thrift")
+	require.NoError(t, err)
+	assert.Equal(t, "github.com/otherteam/idl-go/shared", pkg)
+
+	_, err = importer.RelativePackage("/src/thrift/shared.thrift")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(),
+		`"/src/thrift/shared.thrift" is mapped to "github.com/otherteam/idl-go/shared": `+
+			"code is not generated for it")
+
+	name, err = importer.PackageName("/src/thrift/shared.thrift")
+	require.NoError(t, err)
+	assert.Equal(t, "shared", name)
+}
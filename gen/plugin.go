@@ -22,6 +22,7 @@ package gen
 
 import (
 	"fmt"
+	"path/filepath"
 
 	"go.uber.org/thriftrw/compile"
 	"go.uber.org/thriftrw/plugin/api"
@@ -30,6 +31,18 @@ import (
 
 type serviceName string
 
+// _intSimpleTypes maps Go integer types specified with go.type to their
+// plugin API representation.
+var _intSimpleTypes = map[string]api.SimpleType{
+	"int8":   api.SimpleTypeInt8,
+	"int16":  api.SimpleTypeInt16,
+	"int32":  api.SimpleTypeInt32,
+	"uint8":  api.SimpleTypeUint8,
+	"uint16": api.SimpleTypeUint16,
+	"uint32": api.SimpleTypeUint32,
+	"uint64": api.SimpleTypeUint64,
+}
+
 type generateServiceBuilder struct {
 	api.GenerateServiceRequest
 
@@ -120,9 +133,14 @@ func (g *generateServiceBuilder) AddModule(thriftPath string) (api.ModuleID, err
 		return 0, err
 	}
 
-	dir, err := g.importer.RelativePackage(thriftPath)
-	if err != nil {
-		return 0, err
+	// Code for modules mapped to existing packages is not generated so they
+	// don't have a directory.
+	var dir string
+	if _, ok := g.importer.ImportPaths[thriftPath]; !ok {
+		dir, err = g.importer.RelativePackage(thriftPath)
+		if err != nil {
+			return 0, err
+		}
 	}
 
 	g.Modules[id] = &api.Module{
@@ -177,6 +195,8 @@ func (g *generateServiceBuilder) addService(spec *compile.ServiceSpec) (api.Serv
 		Functions:   functions,
 		ModuleID:    moduleID,
 		Annotations: spec.Annotations,
+		Deprecated:  buildDeprecation(spec.Deprecated),
+		Doc:         buildDoc(spec.Doc),
 	}
 	return serviceID, nil
 }
@@ -192,6 +212,8 @@ func (g *generateServiceBuilder) buildFunction(spec *compile.FunctionSpec) (*api
 		ThriftName:  spec.Name,
 		Arguments:   args,
 		Annotations: spec.Annotations,
+		Deprecated:  buildDeprecation(spec.Deprecated),
+		Doc:         buildDoc(spec.Doc),
 	}
 	if spec.OneWay {
 		function.OneWay = ptr.Bool(spec.OneWay)
@@ -233,17 +255,63 @@ func (g *generateServiceBuilder) buildFieldGroup(fs compile.FieldGroup) ([]*api.
 			Name:        name,
 			Type:        t,
 			Annotations: f.Annotations,
+			Deprecated:  buildDeprecation(f.Deprecated),
+			Doc:         buildDoc(f.Doc),
 		})
 	}
 	return args, nil
 }
 
+func buildDeprecation(d *compile.Deprecation) *api.Deprecation {
+	if d == nil {
+		return nil
+	}
+	return &api.Deprecation{Reason: d.Reason}
+}
+
+// buildDoc returns a pointer to the given docstring, or nil if it's empty.
+func buildDoc(doc string) *string {
+	if doc == "" {
+		return nil
+	}
+	return &doc
+}
+
 func (g *generateServiceBuilder) buildType(spec compile.TypeSpec, required bool) (*api.Type, error) {
 	simpleType := func(t api.SimpleType) *api.SimpleType { return &t }
 
 	// try primitives first since they have to be wrapped inside a pointer if
 	// optional.
 	var t *api.Type
+	if a, err := adapterFor(spec); err != nil {
+		return nil, err
+	} else if a != nil {
+		// Custom Go types are references to types outside ThriftRW's
+		// control. Plugins can use the go.type and go.adapter annotations to
+		// tell them apart from generated types.
+		t = &api.Type{
+			ReferenceType: &api.TypeReference{
+				Name:        a.Type.Name,
+				ImportPath:  a.Type.ImportPath,
+				Annotations: spec.ThriftAnnotations(),
+			},
+		}
+		if !required {
+			t = &api.Type{PointerType: t}
+		}
+		return t, nil
+	}
+
+	if it, err := intTypeFor(spec); err != nil {
+		return nil, err
+	} else if it != nil {
+		t = &api.Type{SimpleType: simpleType(_intSimpleTypes[it.Name])}
+		if !required {
+			t = &api.Type{PointerType: t}
+		}
+		return t, nil
+	}
+
 	switch s := spec.(type) {
 	case *compile.BoolSpec:
 		t = &api.Type{SimpleType: simpleType(api.SimpleTypeBool)}
@@ -273,6 +341,7 @@ func (g *generateServiceBuilder) buildType(spec compile.TypeSpec, required bool)
 				Name:        name,
 				ImportPath:  importPath,
 				Annotations: s.Annotations,
+				Doc:         buildDoc(s.Doc),
 			},
 		}
 	}
@@ -339,15 +408,22 @@ func (g *generateServiceBuilder) buildType(spec compile.TypeSpec, required bool)
 			return nil, err
 		}
 
-		return &api.Type{
-			PointerType: &api.Type{
-				ReferenceType: &api.TypeReference{
-					Name:        name,
-					ImportPath:  importPath,
-					Annotations: s.Annotations,
-				},
+		t = &api.Type{
+			ReferenceType: &api.TypeReference{
+				Name:        name,
+				ImportPath:  importPath,
+				Annotations: s.Annotations,
+				Doc:         buildDoc(s.Doc),
 			},
-		}, nil
+		}
+
+		// Unions represented as sealed interfaces are not referenced by
+		// pointer.
+		if !isUnionInterface(s) {
+			t = &api.Type{PointerType: t}
+		}
+
+		return t, nil
 
 	case *compile.TypedefSpec:
 		importPath, err := g.importer.Package(s.ThriftFile())
@@ -365,6 +441,7 @@ func (g *generateServiceBuilder) buildType(spec compile.TypeSpec, required bool)
 				Name:        name,
 				ImportPath:  importPath,
 				Annotations: s.Annotations,
+				Doc:         buildDoc(s.Doc),
 			},
 		}
 
@@ -378,3 +455,77 @@ func (g *generateServiceBuilder) buildType(spec compile.TypeSpec, required bool)
 		panic(fmt.Sprintf("Unknown type (%T) %v", spec, spec))
 	}
 }
+
+// IntTypeField returns a description of a field in the modules of this
+// request that uses an unsigned or narrower Go integer type with go.type,
+// or an empty string if there are no such fields. m is the root module.
+//
+// Plugins which do not provide the UNSIGNED_TYPES feature cannot generate
+// code for these fields.
+func (g *generateServiceBuilder) IntTypeField(m *compile.Module) (string, error) {
+	var field string
+	err := WalkModules(m, g.importer.ImportPaths, func(m *compile.Module) error {
+		if _, ok := g.moduleIDs[m.ThriftPath]; !ok || field != "" {
+			return nil
+		}
+
+		file := m.ThriftPath
+		if rel, err := filepath.Rel(g.importer.ThriftRoot, file); err == nil {
+			file = rel
+		}
+
+		for _, name := range sortStringKeys(m.Types) {
+			switch spec := m.Types[name].(type) {
+			case *compile.TypedefSpec:
+				if usesIntType(spec.Target) {
+					field = fmt.Sprintf("typedef %v in %v", name, file)
+					return nil
+				}
+			case *compile.StructSpec:
+				for _, f := range spec.Fields {
+					if usesIntType(f.Type) {
+						field = fmt.Sprintf("field %q of %v in %v", f.Name, name, file)
+						return nil
+					}
+				}
+			}
+		}
+
+		for _, svcName := range sortStringKeys(m.Services) {
+			svc := m.Services[svcName]
+			for _, fnName := range sortStringKeys(svc.Functions) {
+				fn := svc.Functions[fnName]
+				for _, arg := range fn.ArgsSpec {
+					if usesIntType(arg.Type) {
+						field = fmt.Sprintf("argument %q of %v.%v in %v", arg.Name, svcName, fnName, file)
+						return nil
+					}
+				}
+				if fn.ResultSpec != nil && fn.ResultSpec.ReturnType != nil && usesIntType(fn.ResultSpec.ReturnType) {
+					field = fmt.Sprintf("result of %v.%v in %v", svcName, fnName, file)
+					return nil
+				}
+			}
+		}
+		return nil
+	})
+	return field, err
+}
+
+// usesIntType returns true if the given TypeSpec uses an unsigned or
+// narrower Go integer type, directly or through containers and typedefs.
+func usesIntType(spec compile.TypeSpec) bool {
+	switch s := spec.(type) {
+	case *compile.TypedefSpec:
+		return usesIntType(s.Target)
+	case *compile.ListSpec:
+		return usesIntType(s.ValueSpec)
+	case *compile.SetSpec:
+		return usesIntType(s.ValueSpec)
+	case *compile.MapSpec:
+		return usesIntType(s.KeySpec) || usesIntType(s.ValueSpec)
+	default:
+		t, err := intTypeFor(spec)
+		return err == nil && t != nil
+	}
+}
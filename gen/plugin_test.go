@@ -1,6 +1,9 @@
 package gen
 
 import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
 	"testing"
 
 	"go.uber.org/thriftrw/ast"
@@ -43,6 +46,112 @@ func TestAddRootModule(t *testing.T) {
 		_, err := g.AddRootModule("/something/wrong")
 		assert.Error(t, err)
 	})
+
+	t.Run("mapped module has no directory", func(t *testing.T) {
+		importer := importer
+		importer.ImportPaths = map[string]string{
+			"/shared/common.thrift": "example.com/idl-go/common",
+		}
+		g := newGenerateServiceBuilder(importer)
+
+		id, err := g.AddModule("/shared/common.thrift")
+		require.NoError(t, err)
+		assert.Equal(t, &api.Module{
+			ImportPath:     "example.com/idl-go/common",
+			ThriftFilePath: "/shared/common.thrift",
+		}, g.Modules[id])
+	})
+}
+
+func TestIntTypeField(t *testing.T) {
+	tests := []struct {
+		desc  string
+		files map[string]string
+		added []string // modules added to the request besides the root
+		want  string
+	}{
+		{
+			desc:  "no integer types",
+			files: map[string]string{"root.thrift": "struct Foo { 1: optional i32 (go.type = \"int32\") x }"},
+		},
+		{
+			desc: "struct field",
+			files: map[string]string{"root.thrift": `
+				struct Foo { 1: optional i32 x }
+				struct Bar { 1: optional list<i32 (go.type = "uint32")> hits }
+			`},
+			want: `field "hits" of Bar in root.thrift`,
+		},
+		{
+			desc: "narrower type through a typedef",
+			files: map[string]string{"root.thrift": `
+				typedef i32 (go.type = "int8") Level
+				struct Foo { 1: optional map<string, Level> levels }
+			`},
+			want: `field "levels" of Foo in root.thrift`,
+		},
+		{
+			desc: "function argument",
+			files: map[string]string{"root.thrift": `
+				service Counters { void reset(1: i64 (go.type = "uint64") id) }
+			`},
+			want: `argument "id" of Counters.reset in root.thrift`,
+		},
+		{
+			desc: "function result",
+			files: map[string]string{"root.thrift": `
+				service Counters { i16 (go.type = "uint16") port() }
+			`},
+			want: "result of Counters.port in root.thrift",
+		},
+		{
+			desc: "included module not in request",
+			files: map[string]string{
+				"root.thrift":  `include "./other.thrift"`,
+				"other.thrift": `struct Foo { 1: optional i8 (go.type = "uint8") x }`,
+			},
+		},
+		{
+			desc: "included module in request",
+			files: map[string]string{
+				"root.thrift":  `include "./other.thrift"`,
+				"other.thrift": `struct Foo { 1: optional i8 (go.type = "uint8") x }`,
+			},
+			added: []string{"other.thrift"},
+			want:  `field "x" of Foo in other.thrift`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			thriftRoot, err := ioutil.TempDir("", "thriftrw-int-type-field-test")
+			require.NoError(t, err)
+			defer os.RemoveAll(thriftRoot)
+
+			for name, contents := range tt.files {
+				path := filepath.Join(thriftRoot, name)
+				require.NoError(t, ioutil.WriteFile(path, []byte(contents), 0644))
+			}
+
+			m, err := compile.Compile(filepath.Join(thriftRoot, "root.thrift"))
+			require.NoError(t, err)
+
+			g := newGenerateServiceBuilder(thriftPackageImporter{
+				ImportPrefix: "example.com/idl",
+				ThriftRoot:   thriftRoot,
+			})
+			_, err = g.AddRootModule(m.ThriftPath)
+			require.NoError(t, err)
+			for _, name := range tt.added {
+				_, err := g.AddModule(filepath.Join(thriftRoot, name))
+				require.NoError(t, err)
+			}
+
+			got, err := g.IntTypeField(m)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
 }
 
 func TestAddRootModulesAndService(t *testing.T) {
@@ -187,6 +296,36 @@ func TestAddRootModulesAndService(t *testing.T) {
 				ThriftRoot:    _testThriftRoot,
 			},
 		},
+		{
+			desc: "service with a docstring",
+			spec: &compile.ServiceSpec{
+				Name: "EmptyService",
+				File: "idl/empty.thrift",
+				Doc:  "EmptyService does nothing.",
+			},
+			want: &api.GenerateServiceRequest{
+				RootModules:  []api.ModuleID{1},
+				RootServices: []api.ServiceID{1},
+				Services: map[api.ServiceID]*api.Service{
+					1: {
+						Name:       "EmptyService",
+						ThriftName: "EmptyService",
+						Functions:  []*api.Function{}, // must be non-nil
+						ModuleID:   1,
+						Doc:        ptr.String("EmptyService does nothing."),
+					},
+				},
+				Modules: map[api.ModuleID]*api.Module{
+					1: {
+						ImportPath:     "go.uber.org/thriftrw/gen/internal/tests/empty",
+						Directory:      "empty",
+						ThriftFilePath: "idl/empty.thrift",
+					},
+				},
+				PackagePrefix: _testPackagePrefix,
+				ThriftRoot:    _testThriftRoot,
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -369,6 +508,91 @@ func TestBuildFunction(t *testing.T) {
 				},
 			},
 		},
+		{
+			desc: "deprecated",
+			spec: &compile.FunctionSpec{
+				Name: "foo",
+				ArgsSpec: compile.ArgsSpec{
+					{
+						ID:         1,
+						Name:       "bar",
+						Type:       &compile.StringSpec{},
+						Deprecated: &compile.Deprecation{},
+					},
+				},
+				ResultSpec: &compile.ResultSpec{},
+				Deprecated: &compile.Deprecation{Reason: "Use baz instead."},
+			},
+			want: &api.Function{
+				Name:       "Foo",
+				ThriftName: "foo",
+				Arguments: []*api.Argument{
+					{
+						Name:       "Bar",
+						Type:       &api.Type{PointerType: &api.Type{SimpleType: simpleType(api.SimpleTypeString)}},
+						Deprecated: &api.Deprecation{},
+					},
+				},
+				Deprecated: &api.Deprecation{Reason: "Use baz instead."},
+			},
+		},
+		{
+			desc: "docstrings",
+			spec: &compile.FunctionSpec{
+				Name: "foo",
+				Doc:  "foo does things.",
+				ArgsSpec: compile.ArgsSpec{
+					{
+						ID:   1,
+						Name: "bar",
+						Type: &compile.StringSpec{},
+						Doc:  "bar is a thing.",
+					},
+				},
+				ResultSpec: &compile.ResultSpec{
+					Exceptions: compile.FieldGroup{
+						{
+							ID:   1,
+							Name: "fooError",
+							Type: &compile.StructSpec{
+								Name: "FooError",
+								File: "idl/foo.thrift",
+								Type: ast.ExceptionType,
+								Doc:  "FooError is raised when foo fails.",
+							},
+							Doc: "Raised if bar is invalid.",
+						},
+					},
+				},
+			},
+			want: &api.Function{
+				Name:       "Foo",
+				ThriftName: "foo",
+				Doc:        ptr.String("foo does things."),
+				Arguments: []*api.Argument{
+					{
+						Name: "Bar",
+						Type: &api.Type{PointerType: &api.Type{SimpleType: simpleType(api.SimpleTypeString)}},
+						Doc:  ptr.String("bar is a thing."),
+					},
+				},
+				Exceptions: []*api.Argument{
+					{
+						Name: "FooError",
+						Type: &api.Type{
+							PointerType: &api.Type{
+								ReferenceType: &api.TypeReference{
+									Name:       "FooError",
+									ImportPath: "go.uber.org/thriftrw/gen/internal/tests/foo",
+									Doc:        ptr.String("FooError is raised when foo fails."),
+								},
+							},
+						},
+						Doc: ptr.String("Raised if bar is invalid."),
+					},
+				},
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -780,6 +1004,59 @@ func TestBuildType(t *testing.T) {
 				},
 			},
 		},
+		{
+			desc: "adapted primitive",
+			spec: &compile.I64Spec{
+				Annotations: compile.Annotations{
+					"go.type":    "time.Time",
+					"go.adapter": "example.com/thriftadapt.UnixNanos",
+				},
+			},
+			want: &api.Type{
+				PointerType: &api.Type{
+					ReferenceType: &api.TypeReference{
+						Name:       "Time",
+						ImportPath: "time",
+						Annotations: map[string]string{
+							"go.type":    "time.Time",
+							"go.adapter": "example.com/thriftadapt.UnixNanos",
+						},
+					},
+				},
+			},
+		},
+		{
+			desc: "unsigned integer",
+			spec: &compile.I32Spec{
+				Annotations: compile.Annotations{"go.type": "uint32"},
+			},
+			want: &api.Type{
+				PointerType: &api.Type{SimpleType: simpleType(api.SimpleTypeUint32)},
+			},
+		},
+		{
+			desc: "union as interface",
+			spec: &compile.StructSpec{
+				Name: "Foo",
+				File: "idl/foo.thrift",
+				Type: ast.UnionType,
+				Fields: compile.FieldGroup{
+					{
+						ID:   1,
+						Name: "value",
+						Type: &compile.StringSpec{},
+					},
+				},
+				Annotations: compile.Annotations{"go.union": "interface"},
+			},
+			want: &api.Type{
+				ReferenceType: &api.TypeReference{
+					Name:        "Foo",
+					ImportPath:  "go.uber.org/thriftrw/gen/internal/tests/foo",
+					Annotations: map[string]string{"go.union": "interface"},
+				},
+			},
+		},
 		{
 			desc: "typedef of struct",
 			spec: &compile.TypedefSpec{
@@ -0,0 +1,108 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package gen
+
+import (
+	"fmt"
+
+	"go.uber.org/thriftrw/ast"
+	"go.uber.org/thriftrw/compile"
+)
+
+const (
+	// goOptionalKey is a Thrift annotation on structs and exceptions that
+	// controls how optional fields are stored.
+	//
+	// 	struct User {
+	// 		1: required string name
+	// 		2: optional i32 age
+	// 	} (go.optional = "value")
+	//
+	// By default ("pointer"), optional fields of primitive types are stored
+	// as pointers and nil indicates an unset field. With "value", they are
+	// stored by value and whether they are set is tracked in a bitmap on the
+	// struct. Such fields must be modified with the generated SetAge and
+	// ClearAge methods; IsSetAge reports whether the field is set.
+	//
+	// Optional fields of reference types (lists, sets, maps, and binary)
+	// and structs are not affected.
+	goOptionalKey = "go.optional"
+
+	optionalPointer = "pointer"
+	optionalValue   = "value"
+
+	// Name of the unexported field holding the presence bitmap.
+	presenceField = "presence"
+)
+
+// storesOptionalsByValue returns true if the given struct stores optional
+// fields by value with a presence bitmap.
+func storesOptionalsByValue(spec *compile.StructSpec) (bool, error) {
+	switch mode := spec.Annotations[goOptionalKey]; mode {
+	case "", optionalPointer:
+		return false, nil
+	case optionalValue:
+		if spec.Type == ast.UnionType {
+			return false, fmt.Errorf(
+				"%v = %q is not supported on unions", goOptionalKey, optionalValue)
+		}
+		return true, nil
+	default:
+		return false, fmt.Errorf("unknown %v %q: expected %q or %q",
+			goOptionalKey, mode, optionalPointer, optionalValue)
+	}
+}
+
+// presenceBits assigns a bit in the presence bitmap to each field of the
+// given group that is optional but stored by value.
+type presenceBits map[*compile.FieldSpec]int
+
+func newPresenceBits(fields compile.FieldGroup) presenceBits {
+	bits := make(presenceBits)
+	for _, f := range fields {
+		if !f.Required && !isReferenceType(f.Type) && !isStructType(f.Type) {
+			bits[f] = len(bits)
+		}
+	}
+	return bits
+}
+
+// Words returns the number of uint64s needed to hold the bitmap.
+func (b presenceBits) Words() int {
+	return (len(b) + 63) / 64
+}
+
+// Has returns true if the given field is tracked in the bitmap.
+func (b presenceBits) Has(f *compile.FieldSpec) bool {
+	_, ok := b[f]
+	return ok
+}
+
+// Word returns a reference to the element of the bitmap holding the bit for
+// the given field, relative to the struct.
+func (b presenceBits) Word(f *compile.FieldSpec) string {
+	return fmt.Sprintf("%v[%d]", presenceField, b[f]/64)
+}
+
+// Mask returns the mask selecting the bit for the given field in its word.
+func (b presenceBits) Mask(f *compile.FieldSpec) string {
+	return fmt.Sprintf("%#x", uint64(1)<<uint(b[f]%64))
+}
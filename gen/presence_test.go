@@ -0,0 +1,200 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package gen
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/thriftrw/ast"
+	"go.uber.org/thriftrw/compile"
+	"go.uber.org/thriftrw/gen/internal/tests/enums"
+	tp "go.uber.org/thriftrw/gen/internal/tests/presence"
+	"go.uber.org/thriftrw/wire"
+	"go.uber.org/zap/zapcore"
+)
+
+func TestOptionalFieldsByValue(t *testing.T) {
+	t.Run("set and clear", func(t *testing.T) {
+		var s tp.Settings
+		assert.False(t, s.IsSetRetries())
+		assert.Equal(t, int32(0), s.GetRetries())
+
+		s.SetRetries(0)
+		assert.True(t, s.IsSetRetries(), "zero values may be set")
+
+		s.SetRetries(5)
+		assert.Equal(t, int32(5), s.GetRetries())
+
+		s.ClearRetries()
+		assert.False(t, s.IsSetRetries())
+		assert.Equal(t, int32(0), s.Retries)
+
+		var nilSettings *tp.Settings
+		assert.False(t, nilSettings.IsSetRetries())
+		assert.Equal(t, int32(0), nilSettings.GetRetries())
+	})
+
+	t.Run("defaults", func(t *testing.T) {
+		var s tp.Settings
+		assert.True(t, s.GetEnabled(), "getter must return default")
+		assert.False(t, s.IsSetEnabled())
+
+		d := tp.Default_Settings()
+		assert.True(t, d.IsSetEnabled())
+		assert.True(t, d.Enabled)
+		assert.Equal(t, tp.Version(1), d.Version)
+
+		assert.True(t, tp.DefaultSettings.IsSetRetries())
+		assert.Equal(t, int32(3), tp.DefaultSettings.Retries)
+		assert.False(t, tp.DefaultSettings.IsSetComment())
+	})
+
+	t.Run("wire", func(t *testing.T) {
+		var give tp.Settings
+		give.Name = "foo"
+		give.SetRetries(0)
+		give.SetMode(enums.EnumDefaultBar)
+		give.Comment = "not set"
+
+		w, err := give.ToWire()
+		require.NoError(t, err)
+		assert.Equal(t, wire.NewValueStruct(wire.Struct{Fields: []wire.Field{
+			{ID: 1, Value: wire.NewValueString("foo")},
+			{ID: 2, Value: wire.NewValueI32(0)},
+			{ID: 3, Value: wire.NewValueBool(true)},
+			{ID: 5, Value: wire.NewValueI32(int32(enums.EnumDefaultBar))},
+			{ID: 6, Value: wire.NewValueI64(1)},
+		}}), w, "fields that weren't set must be skipped")
+
+		var got tp.Settings
+		require.NoError(t, got.FromWire(w))
+		assert.True(t, got.IsSetRetries())
+		assert.False(t, got.IsSetComment())
+		assert.True(t, got.IsSetEnabled(), "defaults must be marked as set")
+
+		give.ClearComment()
+		give.SetEnabled(true)
+		give.SetVersion(1)
+		assert.True(t, give.Equals(&got), "round trip: %v != %v", &give, &got)
+	})
+
+	t.Run("equals", func(t *testing.T) {
+		var a, b tp.Settings
+		a.SetRatio(0)
+		assert.False(t, a.Equals(&b), "set and unset fields must differ")
+
+		b.SetRatio(0)
+		assert.True(t, a.Equals(&b))
+
+		b.Ratio = 1 // not set
+		b.ClearRatio()
+		a.ClearRatio()
+		assert.True(t, a.Equals(&b))
+	})
+
+	t.Run("string and zap", func(t *testing.T) {
+		var s tp.Settings
+		s.Name = "foo"
+		s.SetRetries(0)
+		s.SetSecret("hunter2")
+		assert.Equal(t, "Settings{Name: foo, Retries: 0, Secret: <redacted>}", s.String())
+
+		enc := zapcore.NewMapObjectEncoder()
+		require.NoError(t, s.MarshalLogObject(enc))
+		assert.Equal(t, map[string]interface{}{
+			"name":    "foo",
+			"retries": int32(0),
+			"secret":  "<redacted>",
+		}, enc.Fields)
+	})
+
+	t.Run("json", func(t *testing.T) {
+		var s tp.Settings
+		s.Name = "foo"
+		s.SetRetries(0)
+		s.SetSecret("hunter2")
+		s.Ratio = 0.5 // not set
+
+		b, err := json.Marshal(&s)
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"name": "foo", "retries": 0, "secret": "<redacted>"}`, string(b))
+
+		var got tp.Settings
+		require.NoError(t, json.Unmarshal([]byte(`{"name": "foo", "retries": 0, "comment": ""}`), &got))
+		assert.Equal(t, "foo", got.Name)
+		assert.True(t, got.IsSetRetries())
+		assert.True(t, got.IsSetComment())
+		assert.False(t, got.IsSetRatio())
+	})
+
+	t.Run("more than 64 fields", func(t *testing.T) {
+		var s tp.ManySettings
+		s.SetF65(0)
+		assert.True(t, s.IsSetF65())
+		assert.False(t, s.IsSetF1())
+
+		w, err := s.ToWire()
+		require.NoError(t, err)
+
+		var got tp.ManySettings
+		require.NoError(t, got.FromWire(w))
+		assert.True(t, got.IsSetF65())
+		assert.True(t, s.Equals(&got))
+	})
+}
+
+func TestOptionalFieldsByValueErrors(t *testing.T) {
+	tests := []struct {
+		desc    string
+		spec    *compile.StructSpec
+		wantErr string
+	}{
+		{
+			desc: "unknown mode",
+			spec: &compile.StructSpec{
+				Name:        "Foo",
+				Type:        ast.StructType,
+				Annotations: compile.Annotations{"go.optional": "bitmap"},
+			},
+			wantErr: `unknown go.optional "bitmap": expected "pointer" or "value"`,
+		},
+		{
+			desc: "union",
+			spec: &compile.StructSpec{
+				Name:        "Foo",
+				Type:        ast.UnionType,
+				Annotations: compile.Annotations{"go.optional": "value"},
+			},
+			wantErr: `go.optional = "value" is not supported on unions`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			_, err := storesOptionalsByValue(tt.spec)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+}
@@ -0,0 +1,83 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package gen
+
+import (
+	"fmt"
+
+	"go.uber.org/thriftrw/compile"
+)
+
+// RedactLabel marks a struct field as sensitive. The value of such a field is
+// masked in the generated String(), MarshalLogObject, and MarshalJSON
+// methods. The field is still sent over the wire as-is.
+//
+// 	struct Credentials {
+// 		1: required string username
+// 		2: required string token (go.redact)
+// 		3: optional string password (go.redact = "length")
+// 		4: optional binary key (go.redact = "hash")
+// 	}
+//
+// By default, the value is replaced with "<redacted>" entirely. The "length"
+// and "hash" modes retain the length or a SHA256 hash of the value
+// respectively and may only be used with string and binary fields. The
+// language-neutral "sensitive" annotation may be used in place of go.redact.
+const RedactLabel = "go.redact"
+
+const redactedString = "<redacted>"
+
+// isRedacted returns true if the given field must be masked when rendered.
+func isRedacted(f *compile.FieldSpec) bool {
+	return f.Redaction != compile.RedactNone
+}
+
+// hasRedactedFields returns true if any of the given fields must be masked
+// when rendered.
+func hasRedactedFields(fields compile.FieldGroup) bool {
+	for _, f := range fields {
+		if isRedacted(f) {
+			return true
+		}
+	}
+	return false
+}
+
+// redactedValue returns an expression of type string holding the masked
+// representation of the given field. fieldValue is an expression holding the
+// value of the field, i.e. dereferenced for optional primitives stored as
+// pointers.
+func redactedValue(g Generator, f *compile.FieldSpec, fieldValue string) (string, error) {
+	switch f.Redaction {
+	case compile.RedactValue:
+		return fmt.Sprintf("%q", redactedString), nil
+	case compile.RedactLength:
+		return fmt.Sprintf(
+			`%v.Sprintf("<redacted len=%%d>", len(%v))`,
+			g.Import("fmt"), fieldValue), nil
+	case compile.RedactHash:
+		return fmt.Sprintf(
+			`%v.Sprintf("<redacted sha256=%%x>", %v.Sum256([]byte(%v)))`,
+			g.Import("fmt"), g.Import("crypto/sha256"), fieldValue), nil
+	default:
+		return "", fmt.Errorf("field %q is not redacted", f.Name)
+	}
+}
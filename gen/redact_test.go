@@ -0,0 +1,123 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package gen
+
+import (
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	ts "go.uber.org/thriftrw/gen/internal/tests/structs"
+	"go.uber.org/thriftrw/ptr"
+	"go.uber.org/zap/zapcore"
+)
+
+func TestRedactedFieldsNilJSON(t *testing.T) {
+	got, err := json.Marshal((*ts.RedactedStruct)(nil))
+	require.NoError(t, err)
+	assert.Equal(t, "null", string(got))
+}
+
+func TestRedactedFields(t *testing.T) {
+	secretHash := fmt.Sprintf("<redacted sha256=%x>", sha256.Sum256([]byte("hunter2")))
+
+	tests := []struct {
+		desc       string
+		give       ts.RedactedStruct
+		wantString string
+		wantZap    map[string]interface{}
+		wantJSON   string
+	}{
+		{
+			desc:       "required only",
+			give:       ts.RedactedStruct{Username: "foo", Token: "bar"},
+			wantString: "RedactedStruct{Username: foo, Token: <redacted>}",
+			wantZap: map[string]interface{}{
+				"username": "foo",
+				"token":    "<redacted>",
+			},
+			wantJSON: `{"username":"foo","token":"<redacted>"}`,
+		},
+		{
+			desc: "all fields",
+			give: ts.RedactedStruct{
+				Username: "foo",
+				Token:    "bar",
+				Password: ptr.String("hunter2"),
+				Secret:   []byte("hunter2"),
+				Pin:      ptr.Int64(1234),
+				Location: &ts.Point{X: 1, Y: 2},
+			},
+			wantString: "RedactedStruct{Username: foo, Token: <redacted>, " +
+				"Password: <redacted len=7>, Secret: " + secretHash + ", " +
+				"Pin: <redacted>, Location: <redacted>}",
+			wantZap: map[string]interface{}{
+				"username": "foo",
+				"token":    "<redacted>",
+				"password": "<redacted len=7>",
+				"secret":   secretHash,
+				"pin":      "<redacted>",
+				"location": "<redacted>",
+			},
+			wantJSON: `{"username":"foo","token":"<redacted>",` +
+				`"password":"<redacted len=7>","secret":"` + secretHash + `",` +
+				`"pin":"<redacted>","location":"<redacted>"}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			assert.Equal(t, tt.wantString, tt.give.String(), "String()")
+
+			enc := zapcore.NewMapObjectEncoder()
+			require.NoError(t, tt.give.MarshalLogObject(enc), "MarshalLogObject")
+			assert.Equal(t, tt.wantZap, enc.Fields, "MarshalLogObject")
+
+			got, err := json.Marshal(&tt.give)
+			require.NoError(t, err, "json.Marshal")
+			assert.JSONEq(t, tt.wantJSON, string(got), "json.Marshal")
+
+			// Values that are not addressable must be redacted too.
+			got, err = json.Marshal(tt.give)
+			require.NoError(t, err, "json.Marshal value")
+			assert.JSONEq(t, tt.wantJSON, string(got), "json.Marshal value")
+
+			got, err = json.Marshal(struct{ S ts.RedactedStruct }{tt.give})
+			require.NoError(t, err, "json.Marshal nested value")
+			assert.JSONEq(t, `{"S":`+tt.wantJSON+`}`, string(got), "json.Marshal nested value")
+
+			got, err = json.Marshal(map[string]ts.RedactedStruct{"s": tt.give})
+			require.NoError(t, err, "json.Marshal map value")
+			assert.JSONEq(t, `{"s":`+tt.wantJSON+`}`, string(got), "json.Marshal map value")
+
+			// Redaction must not affect the wire representation.
+			w, err := tt.give.ToWire()
+			require.NoError(t, err, "ToWire")
+
+			var decoded ts.RedactedStruct
+			require.NoError(t, decoded.FromWire(w), "FromWire")
+			assert.True(t, tt.give.Equals(&decoded), "wire round trip")
+		})
+	}
+}
@@ -0,0 +1,333 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package gen
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/thriftrw/ast"
+	"go.uber.org/thriftrw/compile"
+	tsu "go.uber.org/thriftrw/gen/internal/tests/sealed_unions"
+	"go.uber.org/thriftrw/gen/internal/tests/structs"
+	"go.uber.org/thriftrw/wire"
+	"go.uber.org/zap/zapcore"
+)
+
+func TestSealedUnions(t *testing.T) {
+	t.Run("wire", func(t *testing.T) {
+		tests := []struct {
+			desc string
+			give tsu.Shape
+			want wire.Value
+		}{
+			{
+				desc: "struct",
+				give: tsu.Shape_Circle{Value: &tsu.Circle{Radius: 2}},
+				want: wire.NewValueStruct(wire.Struct{Fields: []wire.Field{
+					{ID: 1, Value: wire.NewValueStruct(wire.Struct{Fields: []wire.Field{
+						{ID: 1, Value: wire.NewValueDouble(2)},
+					}})},
+				}}),
+			},
+			{
+				desc: "zero value",
+				give: tsu.Shape_Square{},
+				want: wire.NewValueStruct(wire.Struct{Fields: []wire.Field{
+					{ID: 2, Value: wire.NewValueDouble(0)},
+				}}),
+			},
+			{
+				desc: "nested union",
+				give: tsu.Shape_Nested{Value: tsu.Shape_Label{Value: "foo"}},
+				want: wire.NewValueStruct(wire.Struct{Fields: []wire.Field{
+					{ID: 6, Value: wire.NewValueStruct(wire.Struct{Fields: []wire.Field{
+						{ID: 4, Value: wire.NewValueString("foo")},
+					}})},
+				}}),
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.desc, func(t *testing.T) {
+				w, err := tt.give.ToWire()
+				require.NoError(t, err)
+				assert.True(t, wire.ValuesAreEqual(tt.want, w), "%v != %v", tt.want, w)
+
+				got, err := tsu.Shape_FromWire(w)
+				require.NoError(t, err)
+				assert.Equal(t, tt.give, got)
+			})
+		}
+	})
+
+	t.Run("exactly one field", func(t *testing.T) {
+		_, err := tsu.Shape_FromWire(wire.NewValueStruct(wire.Struct{}))
+		assert.EqualError(t, err, "Shape should have exactly one field: got 0 fields")
+
+		_, err = tsu.Shape_FromWire(wire.NewValueStruct(wire.Struct{Fields: []wire.Field{
+			{ID: 2, Value: wire.NewValueDouble(1)},
+			{ID: 4, Value: wire.NewValueString("foo")},
+		}}))
+		assert.EqualError(t, err, "Shape should have exactly one field: got 2 fields")
+
+		_, err = tsu.Shape_Circle{}.ToWire()
+		assert.EqualError(t, err, "field Circle of Shape is required")
+	})
+
+	t.Run("type switch", func(t *testing.T) {
+		w, err := tsu.Shape_Square{Value: 3}.ToWire()
+		require.NoError(t, err)
+
+		s, err := tsu.Shape_FromWire(w)
+		require.NoError(t, err)
+		switch s := s.(type) {
+		case tsu.Shape_Square:
+			assert.Equal(t, 3.0, s.Value)
+		default:
+			t.Fatalf("unexpected variant %T", s)
+		}
+	})
+
+	t.Run("equals", func(t *testing.T) {
+		assert.True(t, tsu.Shape_Label{Value: "a"}.Equals(tsu.Shape_Label{Value: "a"}))
+		assert.False(t, tsu.Shape_Label{Value: "a"}.Equals(tsu.Shape_Label{Value: "b"}))
+		assert.False(t, tsu.Shape_Hidden{Value: 0}.Equals(tsu.Shape_Square{Value: 0}))
+		assert.False(t, tsu.Shape_Label{}.Equals(nil))
+
+		assert.True(t, tsu.Shape_Equals(nil, nil))
+		assert.False(t, tsu.Shape_Equals(nil, tsu.Shape_Label{}))
+		assert.False(t, tsu.Shape_Equals(tsu.Shape_Label{}, nil))
+
+		a := &tsu.Drawing{Primary: tsu.UnitCircle}
+		b := &tsu.Drawing{Primary: tsu.Shape_Circle{Value: &tsu.Circle{Radius: 1}}}
+		assert.True(t, a.Equals(b))
+
+		b.Secondary = tsu.Shape_Square{Value: 1}
+		assert.False(t, a.Equals(b))
+	})
+
+	t.Run("string", func(t *testing.T) {
+		assert.Equal(t, "Shape{Square: 1.5}", tsu.Shape_Square{Value: 1.5}.String())
+		assert.Equal(t, "Shape{Secret: <redacted>}", tsu.Shape_Secret{Value: []byte("foo")}.String())
+		assert.Equal(t,
+			"Drawing{Primary: Shape{Nested: Shape{Label: foo}}}",
+			(&tsu.Drawing{Primary: tsu.Shape_Nested{Value: tsu.Shape_Label{Value: "foo"}}}).String())
+	})
+
+	t.Run("zap", func(t *testing.T) {
+		enc := zapcore.NewMapObjectEncoder()
+		d := tsu.Drawing{
+			Primary:   tsu.Shape_Secret{Value: []byte("foo")},
+			Secondary: tsu.Shape_Hidden{Value: 1},
+			Others:    []tsu.Shape{tsu.Shape_Hidden{Value: 1}, nil},
+		}
+		require.NoError(t, d.MarshalLogObject(enc))
+		assert.Equal(t, map[string]interface{}{
+			"primary":   map[string]interface{}{"secret": "<redacted>"},
+			"secondary": map[string]interface{}{},
+			"others":    []interface{}{map[string]interface{}{}, map[string]interface{}{}},
+		}, enc.Fields)
+	})
+
+	t.Run("json", func(t *testing.T) {
+		d := tsu.Drawing{
+			Primary:   tsu.Shape_Polygon{Value: []*structs.Point{{X: 1, Y: 2}}},
+			Secondary: tsu.Shape_Label{Value: ""},
+			Caption:   tsu.Value_Text{Value: "square"},
+		}
+
+		b, err := json.Marshal(&d)
+		require.NoError(t, err)
+		assert.JSONEq(t,
+			`{"primary": {"polygon": [{"x": 1, "y": 2}]}, "secondary": {"name": ""}, "caption": {"text": "square"}}`,
+			string(b))
+
+		var got tsu.Drawing
+		require.NoError(t, json.Unmarshal(b, &got))
+		assert.True(t, d.Equals(&got), "%v != %v", &d, &got)
+
+		s, err := tsu.Shape_UnmarshalJSON([]byte(`{"nested": {"square": 2}}`))
+		require.NoError(t, err)
+		assert.Equal(t, tsu.Shape_Nested{Value: tsu.Shape_Square{Value: 2}}, s)
+
+		_, err = tsu.Shape_UnmarshalJSON([]byte(`{"square": 1, "name": "foo"}`))
+		assert.EqualError(t, err, "Shape should have exactly one field: got 2 fields")
+
+		_, err = tsu.Shape_UnmarshalJSON([]byte(`{"triangle": 1}`))
+		assert.EqualError(t, err, `unknown field "triangle" of Shape`)
+
+		s, err = tsu.Shape_UnmarshalJSON([]byte(`null`))
+		require.NoError(t, err)
+		assert.Nil(t, s)
+	})
+
+	t.Run("json containers", func(t *testing.T) {
+		d := tsu.Drawing{
+			Primary: tsu.Shape_Group{Value: []tsu.Shape{
+				tsu.Shape_Square{Value: 1},
+				tsu.Shape_Nested{Value: tsu.Shape_Label{Value: "foo"}},
+			}},
+			Others: []tsu.Shape{tsu.Shape_Square{Value: 2}, nil},
+			Attributes: map[string]tsu.Value{
+				"color": tsu.Value_Text{Value: "red"},
+				"width": tsu.Value_Number{Value: 3},
+			},
+			Tags: []tsu.Value{tsu.Value_Text{Value: "draft"}},
+			Labels: []struct {
+				Key   *tsu.Circle
+				Value tsu.Shape
+			}{
+				{Key: &tsu.Circle{Radius: 1}, Value: tsu.Shape_Label{Value: "unit"}},
+			},
+			Layers: tsu.Layers{
+				{tsu.Shape_Square{Value: 3}},
+				{},
+				nil,
+			},
+		}
+
+		b, err := json.Marshal(&d)
+		require.NoError(t, err)
+		assert.JSONEq(t, `{
+			"primary": {"group": [{"square": 1}, {"nested": {"name": "foo"}}]},
+			"others": [{"square": 2}, null],
+			"attributes": {"color": {"text": "red"}, "width": {"number": 3}},
+			"tags": [{"text": "draft"}],
+			"labels": [{"Key": {"radius": 1}, "Value": {"name": "unit"}}],
+			"layers": [[{"square": 3}], [], null]
+		}`, string(b))
+
+		var got tsu.Drawing
+		require.NoError(t, json.Unmarshal(b, &got))
+		assert.Equal(t, d, got)
+
+		var layers tsu.Layers
+		require.NoError(t, json.Unmarshal([]byte(`null`), &layers))
+		assert.Nil(t, layers)
+
+		tests := []struct {
+			desc    string
+			give    string
+			wantErr string
+		}{
+			{
+				desc:    "list",
+				give:    `{"primary": {"square": 1}, "others": [{"triangle": 1}]}`,
+				wantErr: `unknown field "triangle" of Shape`,
+			},
+			{
+				desc:    "map",
+				give:    `{"primary": {"square": 1}, "attributes": {"a": {"number": 1, "text": ""}}}`,
+				wantErr: "Value should have exactly one field: got 2 fields",
+			},
+			{
+				desc:    "map with struct keys",
+				give:    `{"primary": {"square": 1}, "labels": [{"Key": {"radius": 1}, "Value": {}}]}`,
+				wantErr: "Shape should have exactly one field: got 0 fields",
+			},
+			{
+				desc:    "typedef",
+				give:    `{"primary": {"square": 1}, "layers": [[{"circle": 1}]]}`,
+				wantErr: "json: cannot unmarshal number into Go value of type sealed_unions.Circle",
+			},
+			{
+				desc:    "variant",
+				give:    `{"primary": {"group": {}}}`,
+				wantErr: "json: cannot unmarshal object into Go value of type []",
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.desc, func(t *testing.T) {
+				var got tsu.Drawing
+				err := json.Unmarshal([]byte(tt.give), &got)
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.wantErr)
+			})
+		}
+	})
+}
+
+func TestSealedUnionErrors(t *testing.T) {
+	tests := []struct {
+		desc    string
+		spec    *compile.StructSpec
+		wantErr string
+	}{
+		{
+			desc: "unknown mode",
+			spec: &compile.StructSpec{
+				Name:        "Foo",
+				Type:        ast.UnionType,
+				Annotations: compile.Annotations{"go.union": "enum"},
+			},
+			wantErr: `unknown go.union "enum": expected "struct" or "interface"`,
+		},
+		{
+			desc: "struct",
+			spec: &compile.StructSpec{
+				Name:        "Foo",
+				Type:        ast.StructType,
+				Annotations: compile.Annotations{"go.union": "interface"},
+			},
+			wantErr: `go.union is only supported on unions`,
+		},
+		{
+			desc: "empty union",
+			spec: &compile.StructSpec{
+				Name:        "Foo",
+				Type:        ast.UnionType,
+				Annotations: compile.Annotations{"go.union": "interface"},
+			},
+			wantErr: `go.union = "interface" requires at least one field`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			_, err := unionUsesInterface(tt.spec)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+}
+
+func TestSealedUnionTypedef(t *testing.T) {
+	g := NewGenerator(&GeneratorOptions{
+		Importer:    thriftPackageImporter{},
+		ImportPath:  "go.uber.org/thriftrw/gen/internal/tests/foo",
+		PackageName: "foo",
+	})
+	err := typedef(g, &compile.TypedefSpec{
+		Name: "Alias",
+		Target: &compile.StructSpec{
+			Name:        "Foo",
+			Type:        ast.UnionType,
+			Fields:      compile.FieldGroup{{ID: 1, Name: "bar", Type: &compile.StringSpec{}}},
+			Annotations: compile.Annotations{"go.union": "interface"},
+		},
+	})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `typedefs of unions with go.union = "interface" are not supported`)
+}
@@ -83,11 +83,11 @@ func ServiceFunction(g Generator, s *compile.ServiceSpec, f *compile.FunctionSpe
 		Namespace: NewNamespace(),
 		Name:      argsName,
 		Fields:    compile.FieldGroup(f.ArgsSpec),
-		Doc: fmt.Sprintf(
+		Doc: withDeprecation(fmt.Sprintf(
 			"%v represents the arguments for the %v.%v function.\n\n"+
 				"The arguments for %v are sent and received over the wire as this struct.",
 			argsName, s.Name, f.Name, f.Name,
-		),
+		), functionDeprecation(s, f)),
 	}
 	if err := argsGen.Generate(g); err != nil {
 		return wrapGenerateError(fmt.Sprintf("%s.%s", s.Name, f.Name), err)
@@ -124,6 +124,7 @@ func ServiceFunction(g Generator, s *compile.ServiceSpec, f *compile.FunctionSpe
 	if f.ResultSpec.ReturnType != nil {
 		resultDoc += fmt.Sprintf("\n\nSuccess is set only if the function did not throw an exception.")
 	}
+	resultDoc = withDeprecation(resultDoc, functionDeprecation(s, f))
 
 	resultGen := fieldGroupGenerator{
 		Namespace:       NewNamespace(),
@@ -145,6 +146,15 @@ func ServiceFunction(g Generator, s *compile.ServiceSpec, f *compile.FunctionSpe
 	return nil
 }
 
+// functionDeprecation returns the Deprecation of the given function, or that
+// of its service if only the service is deprecated.
+func functionDeprecation(s *compile.ServiceSpec, f *compile.FunctionSpec) *compile.Deprecation {
+	if f.Deprecated != nil {
+		return f.Deprecated
+	}
+	return s.Deprecated
+}
+
 // functionParams returns a named parameter list for the given function.
 func functionParams(g Generator, f *compile.FunctionSpec) (string, error) {
 	return g.TextTemplate(
@@ -169,6 +179,8 @@ func functionHelper(g Generator, s *compile.ServiceSpec, f *compile.FunctionSpec
 		// <$prefix>Helper provides functions that aid in handling the
 		// parameters and return values of the <.Service.Name>.<$f.Name>
 		// function.
+		<if deprecation .Service $f>//
+		<formatDoc (withDeprecation "" (deprecation .Service $f))><end ->
 		var <$prefix>Helper = struct{
 			// Args accepts the parameters of <$f.Name> in-order and returns
 			// the arguments struct for the function.
@@ -261,6 +273,7 @@ func functionHelper(g Generator, s *compile.ServiceSpec, f *compile.FunctionSpec
 		TemplateFunc("wrapResponse", functionWrapResponse),
 		TemplateFunc("unwrapResponse", functionUnwrapResponse),
 		TemplateFunc("namePrefix", functionNamePrefix),
+		TemplateFunc("deprecation", functionDeprecation),
 	)
 }
 
@@ -29,6 +29,11 @@ import (
 type structGenerator struct{}
 
 func (s *structGenerator) Reader(g Generator, spec *compile.StructSpec) (string, error) {
+	if isUnionInterface(spec) {
+		name, err := unionFuncName(g, spec, "FromWire")
+		return name, wrapGenerateError(spec.ThriftName(), err)
+	}
+
 	name := readerFuncName(g, spec)
 	err := g.EnsureDeclared(
 		`
@@ -57,29 +62,46 @@ func structure(g Generator, spec *compile.StructSpec) error {
 		return err
 	}
 
+	iface, err := unionUsesInterface(spec)
+	if err != nil {
+		return wrapGenerateError(spec.ThriftName(), err)
+	}
+	if iface {
+		return wrapGenerateError(spec.ThriftName(), sealedUnion(g, spec))
+	}
+
+	byValue, err := storesOptionalsByValue(spec)
+	if err != nil {
+		return wrapGenerateError(spec.ThriftName(), err)
+	}
+
+	// Validate exception annotations before generating any code.
+	if _, err := causeField(spec); err != nil {
+		return wrapGenerateError(spec.ThriftName(), err)
+	}
+	if _, err := errorCode(spec); err != nil {
+		return wrapGenerateError(spec.ThriftName(), err)
+	}
+
 	fg := fieldGroupGenerator{
 		Namespace:   NewNamespace(),
 		Name:        name,
 		ThriftName:  spec.ThriftName(),
-		Doc:         spec.Doc,
+		Doc:         withDeprecation(spec.Doc, spec.Deprecated),
 		Fields:      spec.Fields,
 		IsUnion:     spec.Type == ast.UnionType,
 		IsException: spec.Type == ast.ExceptionType,
 	}
+	if byValue {
+		fg.Presence = newPresenceBits(spec.Fields)
+	}
 
 	if err := fg.Generate(g); err != nil {
 		return wrapGenerateError(spec.ThriftName(), err)
 	}
 
 	if spec.Type == ast.ExceptionType {
-		err := g.DeclareFromTemplate(
-			`
-			<$v := newVar "v">
-			func (<$v> *<typeName .>) Error() string {
-				return <$v>.String()
-			}
-			`, spec)
-		if err != nil {
+		if err := exception(g, spec); err != nil {
 			return wrapGenerateError(spec.ThriftName(), err)
 		}
 	}
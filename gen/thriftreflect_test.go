@@ -0,0 +1,152 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package gen
+
+import (
+	"testing"
+
+	"go.uber.org/thriftrw/gen/internal/tests/sealed_unions"
+	"go.uber.org/thriftrw/gen/internal/tests/services"
+	"go.uber.org/thriftrw/gen/internal/tests/structs"
+	"go.uber.org/thriftrw/thriftreflect"
+	"go.uber.org/thriftrw/wire"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestThriftReflectRegistry(t *testing.T) {
+	assert.Equal(t, structs.ThriftModule,
+		thriftreflect.ModuleByPackage("go.uber.org/thriftrw/gen/internal/tests/structs"))
+	assert.Nil(t, thriftreflect.ModuleByPackage("go.uber.org/thriftrw/gen/internal/tests/does_not_exist"))
+
+	modules := thriftreflect.Modules()
+	assert.Contains(t, modules, structs.ThriftModule)
+	assert.Contains(t, modules, services.ThriftModule)
+	// Included modules are registered too.
+	assert.Contains(t, modules, thriftreflect.ModuleByPackage("go.uber.org/thriftrw/gen/internal/tests/unions"))
+	for i := 1; i < len(modules); i++ {
+		assert.True(t, modules[i-1].Package < modules[i].Package, "modules must be sorted")
+	}
+
+	// Registering a module again is a no-op.
+	thriftreflect.Register(structs.ThriftModule, nil)
+	s, err := thriftreflect.LookupStruct(structs.ThriftModule, "Point")
+	require.NoError(t, err)
+	assert.NotNil(t, s.New)
+}
+
+func TestThriftReflectCompileCached(t *testing.T) {
+	m1, err := thriftreflect.Compile(services.ThriftModule)
+	require.NoError(t, err)
+	m2, err := thriftreflect.Compile(services.ThriftModule)
+	require.NoError(t, err)
+	assert.True(t, m1 == m2, "compiled module must be cached")
+}
+
+func TestThriftReflectLookupStruct(t *testing.T) {
+	s, err := thriftreflect.LookupStruct(structs.ThriftModule, "Frame")
+	require.NoError(t, err)
+
+	assert.Equal(t, structs.ThriftModule, s.Module)
+	assert.Equal(t, "Frame", s.Name)
+	require.Len(t, s.Fields, 2)
+	assert.Equal(t, int16(1), s.Fields[0].ID)
+	assert.Equal(t, "topLeft", s.Fields[0].Name)
+	assert.True(t, s.Fields[0].Required)
+	assert.Equal(t, "Point", s.Fields[0].Type.ThriftName())
+	assert.Equal(t, "size", s.Fields[1].Name)
+
+	require.NotNil(t, s.New)
+	assert.IsType(t, &structs.Frame{}, s.New())
+
+	t.Run("included types", func(t *testing.T) {
+		s, err := thriftreflect.LookupStruct(structs.ThriftModule, "DefaultsStruct")
+		require.NoError(t, err)
+		assert.Equal(t, "EnumDefault", s.Fields[2].Type.ThriftName())
+		assert.NotNil(t, s.Fields[2].Default)
+	})
+
+	t.Run("exception", func(t *testing.T) {
+		s, err := thriftreflect.LookupStruct(services.ThriftModule, "InternalError")
+		require.NoError(t, err)
+		assert.True(t, s.Spec.IsExceptionType())
+		assert.IsType(t, &services.InternalError{}, s.New())
+	})
+
+	t.Run("sealed union", func(t *testing.T) {
+		s, err := thriftreflect.LookupStruct(sealed_unions.ThriftModule, "Shape")
+		require.NoError(t, err)
+		assert.Len(t, s.Fields, 8)
+		assert.Nil(t, s.New, "unions generated as interfaces don't have constructors")
+
+		s, err = thriftreflect.LookupStruct(sealed_unions.ThriftModule, "Drawing")
+		require.NoError(t, err)
+		assert.IsType(t, &sealed_unions.Drawing{}, s.New())
+	})
+
+	t.Run("unknown", func(t *testing.T) {
+		_, err := thriftreflect.LookupStruct(structs.ThriftModule, "DoesNotExist")
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), `module "structs.thrift" does not define "DoesNotExist"`)
+	})
+
+	t.Run("not a struct", func(t *testing.T) {
+		_, err := thriftreflect.LookupStruct(structs.ThriftModule, "UserMap")
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), `"UserMap" in module "structs.thrift" is not a struct, union, or exception`)
+	})
+}
+
+func TestThriftReflectLookupService(t *testing.T) {
+	s, err := thriftreflect.LookupService(services.ThriftModule, "KeyValue")
+	require.NoError(t, err)
+
+	assert.Equal(t, "KeyValue", s.Name)
+	var names []string
+	for _, f := range s.Functions {
+		names = append(names, f.Name)
+	}
+	assert.Equal(t, []string{
+		"deleteValue", "getManyValues", "getValue", "setValue", "setValueV2", "size",
+	}, names)
+
+	deleteValue := s.Functions[0]
+	assert.False(t, deleteValue.OneWay)
+	assert.Nil(t, deleteValue.ReturnType)
+	require.Len(t, deleteValue.Args, 1)
+	assert.Equal(t, "key", deleteValue.Args[0].Name)
+	require.Len(t, deleteValue.Exceptions, 2)
+	assert.Equal(t, "doesNotExist", deleteValue.Exceptions[0].Name)
+	assert.Equal(t, "internalError", deleteValue.Exceptions[1].Name)
+
+	size := s.Functions[5]
+	assert.Equal(t, wire.TI64, size.ReturnType.TypeCode())
+
+	cache, err := thriftreflect.LookupService(services.ThriftModule, "Cache")
+	require.NoError(t, err)
+	assert.True(t, cache.Functions[0].OneWay)
+	assert.Nil(t, cache.Functions[0].Exceptions)
+
+	_, err = thriftreflect.LookupService(services.ThriftModule, "DoesNotExist")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `module "services.thrift" does not define service "DoesNotExist"`)
+}
@@ -0,0 +1,151 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package gen
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/thriftrw/compile"
+	tt "go.uber.org/thriftrw/gen/internal/tests/times"
+	"go.uber.org/thriftrw/wire"
+	"go.uber.org/zap/zapcore"
+)
+
+func TestTimeAndDurationTypes(t *testing.T) {
+	scheduledAt := time.Date(2021, 6, 1, 12, 30, 0, int(250*time.Millisecond), time.UTC)
+	startedAt := scheduledAt.Add(time.Nanosecond)
+	ttl := time.Hour
+	timeout := tt.Timeout(2 * time.Second)
+
+	give := &tt.Job{
+		ScheduledAt: tt.Timestamp(scheduledAt),
+		Timeout:     &timeout,
+		StartedAt:   &startedAt,
+		TTL:         &ttl,
+		RetryDelays: []tt.Timeout{tt.Timeout(time.Second)},
+		Checkpoints: map[string]tt.Timestamp{"start": tt.Timestamp(startedAt.Truncate(time.Millisecond))},
+	}
+
+	t.Run("wire", func(t *testing.T) {
+		w, err := give.ToWire()
+		require.NoError(t, err)
+
+		fields := make(map[int16]wire.Value)
+		for _, f := range w.GetStruct().Fields {
+			fields[f.ID] = f.Value
+		}
+		assert.Equal(t, wire.NewValueI64(scheduledAt.UnixNano()/1e6), fields[1])
+		assert.Equal(t, wire.NewValueI64(2000), fields[2])
+		assert.Equal(t, wire.NewValueI64(startedAt.UnixNano()), fields[3])
+		assert.Equal(t, wire.NewValueI64(3600), fields[4])
+
+		var got tt.Job
+		require.NoError(t, got.FromWire(w))
+		assert.True(t, give.Equals(&got), "round trip: %v != %v", give, &got)
+	})
+
+	t.Run("constants", func(t *testing.T) {
+		assert.Equal(t, tt.Timeout(1500*time.Millisecond), tt.DefaultTimeout)
+		assert.True(t, tt.Launch.Equal(time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)))
+		assert.Equal(t, tt.Timeout(1500*time.Millisecond), tt.Default_Job().GetTimeout())
+	})
+
+	t.Run("string", func(t *testing.T) {
+		assert.Equal(t, "1.5s", tt.DefaultTimeout.String())
+		assert.Contains(t, give.String(), "TTL: 1h0m0s")
+	})
+
+	t.Run("zap", func(t *testing.T) {
+		enc := zapcore.NewMapObjectEncoder()
+		require.NoError(t, give.MarshalLogObject(enc))
+		assert.Equal(t, scheduledAt, enc.Fields["scheduledAt"])
+		assert.Equal(t, startedAt, enc.Fields["startedAt"])
+		assert.Equal(t, ttl, enc.Fields["ttl"])
+		assert.Equal(t, []interface{}{time.Second}, enc.Fields["retryDelays"])
+	})
+
+	t.Run("json", func(t *testing.T) {
+		b, err := json.Marshal(give)
+		require.NoError(t, err)
+		assert.Contains(t, string(b), `"scheduledAt":"2021-06-01T12:30:00.25Z"`)
+
+		var got tt.Job
+		require.NoError(t, json.Unmarshal(b, &got))
+		assert.True(t, give.Equals(&got), "round trip: %v != %v", give, &got)
+	})
+}
+
+func TestTimeAnnotationErrors(t *testing.T) {
+	tests := []struct {
+		desc    string
+		spec    compile.TypeSpec
+		wantErr string
+	}{
+		{
+			desc: "unknown precision",
+			spec: &compile.I64Spec{Annotations: compile.Annotations{
+				"go.time": "unix_days",
+			}},
+			wantErr: `unknown go.time "unix_days" on "i64": expected one of ` +
+				"unix_micros, unix_millis, unix_nanos, unix_seconds",
+		},
+		{
+			desc: "not an i64",
+			spec: &compile.I32Spec{Annotations: compile.Annotations{
+				"go.duration": "ms",
+			}},
+			wantErr: `go.duration is not supported on "i32": only i64 is supported`,
+		},
+		{
+			desc: "time and duration",
+			spec: &compile.I64Spec{Annotations: compile.Annotations{
+				"go.time":     "unix_millis",
+				"go.duration": "ms",
+			}},
+			wantErr: `go.time and go.duration cannot be used together on "i64"`,
+		},
+		{
+			desc: "time and go.type",
+			spec: &compile.I64Spec{Annotations: compile.Annotations{
+				"go.time": "unix_millis",
+				"go.type": "uint64",
+			}},
+			wantErr: `go.time and go.type cannot be used together on "i64"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			g := NewGenerator(&GeneratorOptions{
+				Importer:    thriftPackageImporter{},
+				ImportPath:  "go.uber.org/thriftrw/gen/internal/tests/foo",
+				PackageName: "foo",
+			})
+			_, err := typeName(g, tt.spec)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+}
@@ -44,9 +44,10 @@ func TypeDefinition(g Generator, spec compile.TypeSpec) error {
 // thriftrw.
 //
 // Only primitive types, enums, and typedefs of other hashable types are
-// considered hashable.
+// considered hashable. Primitive types mapped to custom Go types with
+// go.adapter are not hashable.
 func isHashable(t compile.TypeSpec) bool {
-	return isPrimitiveType(t)
+	return isPrimitiveType(t) && !hasAdapter(t)
 }
 
 // setUsesMap returns true if the given set type is not annotated with
@@ -76,12 +77,16 @@ func isPrimitiveType(spec compile.TypeSpec) bool {
 
 // isReferenceType checks if the given TypeSpec represents a reference type.
 //
-// Sets, maps, lists, and slices are reference types.
+// Sets, maps, lists, and slices are reference types. So are unions generated
+// as sealed interfaces.
 func isReferenceType(spec compile.TypeSpec) bool {
 	spec = compile.RootTypeSpec(spec)
 	if _, ok := spec.(*compile.BinarySpec); ok {
 		return true
 	}
+	if isUnionInterface(spec) {
+		return true
+	}
 
 	switch spec.(type) {
 	case *compile.MapSpec, *compile.ListSpec, *compile.SetSpec:
@@ -112,7 +117,7 @@ func typeReference(g Generator, spec compile.TypeSpec) (string, error) {
 	if err != nil {
 		return "", err
 	}
-	if isStructType(spec) {
+	if isStructType(spec) && !isUnionInterface(spec) {
 		// Prepend "*" to the result if the field is not required and the type
 		// isn't a reference type.
 		name = "*" + name
@@ -138,6 +143,17 @@ func typeReferencePtr(g Generator, spec compile.TypeSpec) (string, error) {
 // typeName returns the name of the given type, whether it's a custom type or
 // native.
 func typeName(g Generator, spec compile.TypeSpec) (string, error) {
+	if a, err := adapterFor(spec); err != nil {
+		return "", err
+	} else if a != nil {
+		return a.Type.Reference(g), nil
+	}
+	if t, err := intTypeFor(spec); err != nil {
+		return "", err
+	} else if t != nil {
+		return t.Name, nil
+	}
+
 	switch s := spec.(type) {
 	case *compile.BoolSpec:
 		return "bool", nil
@@ -219,5 +235,5 @@ func zapperName(g Generator, spec compile.TypeSpec) string {
 func canBeConstant(t compile.TypeSpec) bool {
 	// Only primitives can use const declarations. Everything else has to be a
 	// `var` declaration.
-	return isPrimitiveType(t)
+	return isPrimitiveType(t) && !hasAdapter(t)
 }
@@ -20,7 +20,11 @@
 
 package gen
 
-import "go.uber.org/thriftrw/compile"
+import (
+	"fmt"
+
+	"go.uber.org/thriftrw/compile"
+)
 
 // typedefGenerator generates code to serialize and deserialize typedefs.
 type typedefGenerator struct{}
@@ -54,13 +58,19 @@ func (t *typedefGenerator) Reader(g Generator, spec *compile.TypedefSpec) (strin
 
 // typedef generates code for the given typedef.
 func typedef(g Generator, spec *compile.TypedefSpec) error {
+	if isUnionInterface(compile.RootTypeSpec(spec.Target)) {
+		return wrapGenerateError(spec.ThriftName(), fmt.Errorf(
+			"typedefs of unions with %v = %q are not supported",
+			goUnionKey, unionInterface))
+	}
+
 	err := g.DeclareFromTemplate(
 		`
 		<$fmt := import "fmt">
 		<$wire := import "go.uber.org/thriftrw/wire">
 		<$typedefType := typeReference .>
 
-		<formatDoc .Doc>type <typeName .> <typeName .Target>
+		<formatDoc (withDeprecation .Doc .Deprecated)>type <typeName .> <typeName .Target>
 
 		<$v := newVar "v">
 		<$x := newVar "x">
@@ -110,6 +120,34 @@ func typedef(g Generator, spec *compile.TypedefSpec) error {
 			return <equals .Target $lhsCast $rhsCast>
 		}
 
+		<if hasAdapter . ->
+		<$json := import "encoding/json">
+		<$rootType := typeReference (rootTypeSpec .)>
+		<$b := newVar "b">
+		// MarshalJSON serializes <typeName .> into JSON the same way as
+		// <$rootType>.
+		func (<$v> <$typedefType>) MarshalJSON() ([]byte, error) {
+			return <$json>.Marshal((<$rootType>)(<$v>))
+		}
+
+		// UnmarshalJSON deserializes <typeName .> from JSON the same way as
+		// <$rootType>.
+		func (<$v> *<typeName .>) UnmarshalJSON(<$b> []byte) error {
+			return <$json>.Unmarshal(<$b>, (*<$rootType>)(<$v>))
+		}
+		<- end>
+
+		<if containsUnionInterface .Target ->
+		<$b := newVar "b">
+		// UnmarshalJSON deserializes <typeName .> from JSON, decoding the
+		// unions it holds into their variants.
+		func (<$v> *<typeName .>) UnmarshalJSON(<$b> []byte) error {
+			<$x>, err := <unmarshalJSON .Target>(<$b>)
+			*<$v> = (<$typedefType>)(<$x>)
+			return err
+		}
+		<- end>
+
 		<if not (checkNoZap) ->
 		</* We want the behavior of the underlying type for typedefs: in the case that
 				they are objects or arrays, we need to cast to the underlying object or array;
@@ -131,6 +169,10 @@ func typedef(g Generator, spec *compile.TypedefSpec) error {
 		`,
 		spec,
 		TemplateFunc("checkNoZap", checkNoZap),
+		TemplateFunc("hasAdapter", hasAdapter),
+		TemplateFunc("rootTypeSpec", compile.RootTypeSpec),
+		TemplateFunc("containsUnionInterface", containsUnionInterface),
+		TemplateFunc("unmarshalJSON", unmarshalJSONFunc),
 	)
 	return wrapGenerateError(spec.Name, err)
 }
@@ -0,0 +1,650 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package gen
+
+import (
+	"fmt"
+
+	"go.uber.org/thriftrw/ast"
+	"go.uber.org/thriftrw/compile"
+)
+
+const (
+	// goUnionKey is a Thrift annotation on unions that controls how they are
+	// represented in Go.
+	//
+	// 	union Shape {
+	// 		1: Circle circle
+	// 		2: Square square
+	// 	} (go.union = "interface")
+	//
+	// By default ("struct"), unions are generated as structs with a pointer
+	// field for each variant, exactly one of which must be non-nil. With
+	// "interface", a sealed Shape interface is generated along with a
+	// concrete type for each variant (Shape_Circle and Shape_Square), each
+	// holding the value of that variant in a Value field. Values of such
+	// unions are inspected with a type switch.
+	//
+	// 	switch s := shape.(type) {
+	// 	case Shape_Circle:
+	// 		...
+	// 	case Shape_Square:
+	// 		...
+	// 	}
+	//
+	// Typedefs of such unions are not supported. Lists, sets, and maps of
+	// them, and typedefs of those, are supported.
+	goUnionKey = "go.union"
+
+	unionStruct    = "struct"
+	unionInterface = "interface"
+)
+
+// unionUsesInterface returns true if the given struct is a union that must
+// be generated as a sealed interface.
+func unionUsesInterface(spec *compile.StructSpec) (bool, error) {
+	switch mode := spec.Annotations[goUnionKey]; mode {
+	case "", unionStruct:
+		return false, nil
+	case unionInterface:
+		if spec.Type != ast.UnionType {
+			return false, fmt.Errorf("%v is only supported on unions", goUnionKey)
+		}
+		if len(spec.Fields) == 0 {
+			return false, fmt.Errorf(
+				"%v = %q requires at least one field", goUnionKey, unionInterface)
+		}
+		return true, nil
+	default:
+		return false, fmt.Errorf("unknown %v %q: expected %q or %q",
+			goUnionKey, mode, unionStruct, unionInterface)
+	}
+}
+
+// isUnionInterface returns true if the given type is a union generated as a
+// sealed interface.
+func isUnionInterface(spec compile.TypeSpec) bool {
+	s, ok := spec.(*compile.StructSpec)
+	if !ok {
+		return false
+	}
+	iface, err := unionUsesInterface(s)
+	return err == nil && iface
+}
+
+// hasUnionInterfaceFields returns true if any of the given fields holds a
+// union generated as a sealed interface, directly or inside lists, sets, or
+// maps.
+func hasUnionInterfaceFields(fields compile.FieldGroup) bool {
+	for _, f := range fields {
+		if needsUnmarshalJSONFunc(f.Type) {
+			return true
+		}
+	}
+	return false
+}
+
+// containsUnionInterface returns true if the given type is or holds a union
+// generated as a sealed interface, through lists, sets, maps, or typedefs.
+func containsUnionInterface(spec compile.TypeSpec) bool {
+	switch s := spec.(type) {
+	case *compile.TypedefSpec:
+		return containsUnionInterface(s.Target)
+	case *compile.ListSpec:
+		return containsUnionInterface(s.ValueSpec)
+	case *compile.SetSpec:
+		return containsUnionInterface(s.ValueSpec)
+	case *compile.MapSpec:
+		return containsUnionInterface(s.KeySpec) || containsUnionInterface(s.ValueSpec)
+	default:
+		return isUnionInterface(spec)
+	}
+}
+
+// needsUnmarshalJSONFunc returns true if encoding/json cannot decode values
+// of the given type on its own because they hold unions generated as sealed
+// interfaces. These values are decoded with unmarshalJSONFunc.
+//
+// Typedefs of such types are decoded by their own UnmarshalJSON methods.
+func needsUnmarshalJSONFunc(spec compile.TypeSpec) bool {
+	if _, ok := spec.(*compile.TypedefSpec); ok {
+		return false
+	}
+	return containsUnionInterface(spec)
+}
+
+// unmarshalJSONFunc returns a reference to a function with the signature,
+//
+// 	func([]byte) (T, error)
+//
+// which decodes a value of the given type from JSON, where T is the Go type
+// referenced by typeReference. Lists, sets, and maps holding unions
+// generated as sealed interfaces are decoded element by element, and the
+// unions with their UnmarshalJSON functions. Other types are decoded with
+// encoding/json.
+func unmarshalJSONFunc(g Generator, spec compile.TypeSpec) (string, error) {
+	if isUnionInterface(spec) {
+		return unionFuncName(g, spec, "UnmarshalJSON")
+	}
+
+	name := fmt.Sprintf("_%s_UnmarshalJSON", g.MangleType(spec))
+	data := struct {
+		Name string
+		Spec compile.TypeSpec
+	}{Name: name, Spec: spec}
+	opts := []TemplateOption{TemplateFunc("unmarshalJSON", unmarshalJSONFunc)}
+
+	if !needsUnmarshalJSONFunc(spec) {
+		return name, g.EnsureDeclared(
+			`
+			<$json := import "encoding/json">
+			<$b := newVar "b">
+			<$x := newVar "x">
+			func <.Name>(<$b> []byte) (<typeReference .Spec>, error) {
+				var <$x> <typeReference .Spec>
+				err := <$json>.Unmarshal(<$b>, &<$x>)
+				return <$x>, err
+			}
+			`, data, opts...)
+	}
+
+	switch s := spec.(type) {
+	case *compile.ListSpec, *compile.SetSpec:
+		// Sets of these values are never represented as maps because the
+		// values are not hashable.
+		return name, g.EnsureDeclared(
+			`
+			<$json := import "encoding/json">
+			<$b := newVar "b">
+			<$raw := newVar "raw">
+			<$o := newVar "o">
+			<$r := newVar "r">
+			<$x := newVar "x">
+			func <.Name>(<$b> []byte) (<typeReference .Spec>, error) {
+				var <$raw> []<$json>.RawMessage
+				if err := <$json>.Unmarshal(<$b>, &<$raw>); err != nil {
+					return nil, err
+				}
+				if <$raw> == nil {
+					return nil, nil
+				}
+
+				<$o> := make(<typeReference .Spec>, 0, len(<$raw>))
+				for _, <$r> := range <$raw> {
+					<$x>, err := <unmarshalJSON .Spec.ValueSpec>(<$r>)
+					if err != nil {
+						return nil, err
+					}
+					<$o> = append(<$o>, <$x>)
+				}
+				return <$o>, nil
+			}
+			`, data, opts...)
+	case *compile.MapSpec:
+		if isHashable(s.KeySpec) {
+			return name, g.EnsureDeclared(
+				`
+				<$json := import "encoding/json">
+				<$b := newVar "b">
+				<$raw := newVar "raw">
+				<$o := newVar "o">
+				<$k := newVar "k">
+				<$r := newVar "r">
+				<$x := newVar "x">
+				func <.Name>(<$b> []byte) (<typeReference .Spec>, error) {
+					var <$raw> map[<typeReference .Spec.KeySpec>]<$json>.RawMessage
+					if err := <$json>.Unmarshal(<$b>, &<$raw>); err != nil {
+						return nil, err
+					}
+					if <$raw> == nil {
+						return nil, nil
+					}
+
+					<$o> := make(<typeReference .Spec>, len(<$raw>))
+					for <$k>, <$r> := range <$raw> {
+						<$x>, err := <unmarshalJSON .Spec.ValueSpec>(<$r>)
+						if err != nil {
+							return nil, err
+						}
+						<$o>[<$k>] = <$x>
+					}
+					return <$o>, nil
+				}
+				`, data, opts...)
+		}
+
+		// Maps with unhashable keys are represented as slices of key-value
+		// pairs.
+		return name, g.EnsureDeclared(
+			`
+			<$json := import "encoding/json">
+			<$b := newVar "b">
+			<$raw := newVar "raw">
+			<$o := newVar "o">
+			<$kv := newVar "kv">
+			<$k := newVar "k">
+			<$x := newVar "x">
+			func <.Name>(<$b> []byte) (<typeReference .Spec>, error) {
+				var <$raw> []struct{ Key, Value <$json>.RawMessage }
+				if err := <$json>.Unmarshal(<$b>, &<$raw>); err != nil {
+					return nil, err
+				}
+				if <$raw> == nil {
+					return nil, nil
+				}
+
+				<$o> := make(<typeReference .Spec>, 0, len(<$raw>))
+				for _, <$kv> := range <$raw> {
+					<$k>, err := <unmarshalJSON .Spec.KeySpec>(<$kv>.Key)
+					if err != nil {
+						return nil, err
+					}
+					<$x>, err := <unmarshalJSON .Spec.ValueSpec>(<$kv>.Value)
+					if err != nil {
+						return nil, err
+					}
+					<$o> = append(<$o>, struct {
+						Key   <typeReference .Spec.KeySpec>
+						Value <typeReference .Spec.ValueSpec>
+					}{Key: <$k>, Value: <$x>})
+				}
+				return <$o>, nil
+			}
+			`, data, opts...)
+	default:
+		panic(fmt.Sprintf("unexpected type %q holding a union", spec.ThriftName()))
+	}
+}
+
+// unionVariantName returns a reference to the Go type representing the given
+// variant of a union generated as a sealed interface.
+func unionVariantName(g Generator, spec compile.TypeSpec, f *compile.FieldSpec) (string, error) {
+	name, err := typeName(g, spec)
+	if err != nil {
+		return "", err
+	}
+	fname, err := goName(f)
+	if err != nil {
+		return "", err
+	}
+	return name + "_" + fname, nil
+}
+
+// unionFuncName returns a reference to the given top-level function generated
+// alongside a union represented as a sealed interface. For example,
+//
+// 	unionFuncName(g, spec, "FromWire") == "Shape_FromWire"
+func unionFuncName(g Generator, spec compile.TypeSpec, fn string) (string, error) {
+	name, err := typeName(g, spec)
+	if err != nil {
+		return "", err
+	}
+	return name + "_" + fn, nil
+}
+
+// unionGenerator generates code for unions represented as sealed interfaces.
+type unionGenerator struct {
+	Name   string
+	Spec   *compile.StructSpec
+	Fields compile.FieldGroup
+}
+
+func sealedUnion(g Generator, spec *compile.StructSpec) error {
+	if err := verifyUniqueFieldLabels(spec.Fields); err != nil {
+		return err
+	}
+
+	name, err := goName(spec)
+	if err != nil {
+		return err
+	}
+
+	u := unionGenerator{Name: name, Spec: spec, Fields: spec.Fields}
+	for _, f := range spec.Fields {
+		if err := u.Variant(g, f); err != nil {
+			return err
+		}
+	}
+
+	if err := u.Interface(g); err != nil {
+		return err
+	}
+
+	if err := u.FromWireFunc(g); err != nil {
+		return err
+	}
+
+	if err := u.EqualsFunc(g); err != nil {
+		return err
+	}
+
+	return u.UnmarshalJSONFunc(g)
+}
+
+func (u unionGenerator) Interface(g Generator) error {
+	return g.DeclareFromTemplate(
+		`
+		<$wire := import "go.uber.org/thriftrw/wire">
+		<$name := .Name>
+
+		<- if .Spec.Doc>
+		<formatDoc .Spec.Doc>//
+		<- end>
+		// <.Name> is a union of the following types, exactly one of which is
+		// held by any non-nil <.Name>:
+		//
+		<range .Fields ->
+		//  - <$name>_<goName .>
+		<end ->
+		<if .Spec.Deprecated>//
+		<formatDoc (withDeprecation "" .Spec.Deprecated)><end ->
+		type <.Name> interface {
+			is<.Name>()
+
+			// ToWire translates this <.Name> into a Thrift-level
+			// intermediate representation.
+			ToWire() (<$wire>.Value, error)
+
+			// String returns a readable string representation of this
+			// <.Name>.
+			String() string
+
+			// Equals returns true if the provided <.Name> holds the same
+			// variant with the same value.
+			Equals(<.Name>) bool
+			<- if not noZap>
+
+			// MarshalLogObject implements zapcore.ObjectMarshaler.
+			MarshalLogObject(<import "go.uber.org/zap/zapcore">.ObjectEncoder) error
+			<- end>
+		}
+		`, u, TemplateFunc("noZap", checkNoZap))
+}
+
+func (u unionGenerator) Variant(g Generator, f *compile.FieldSpec) error {
+	return g.DeclareFromTemplate(
+		`
+		<$wire := import "go.uber.org/thriftrw/wire">
+		<$fmt := import "fmt">
+		<$json := import "encoding/json">
+		<$name := .Name>
+		<$f := .Field>
+		<$fname := goName $f>
+		<$variant := printf "%s_%s" $name $fname>
+
+		<$v := newVar "v">
+		// <$variant> is the <$f.Name> variant of <$name>.
+		<- $doc := withDeprecation $f.Doc $f.Deprecated>
+		<if $doc>//
+		<formatDoc $doc><end>type <$variant> struct {
+			Value <typeReference $f.Type>
+		}
+
+		func (<$variant>) is<$name>() {}
+
+		// ToWire translates a <$variant> into a Thrift-level intermediate
+		// representation of <$name>.
+		func (<$v> <$variant>) ToWire() (<$wire>.Value, error) {
+			<- $value := printf "%s.Value" $v>
+			<- $w := newVar "w">
+			<- if and (not (isPrimitiveType $f.Type)) (not (isListType $f.Type))>
+				if <$value> == nil {
+					return <$wire>.Value{}, <import "errors">.New("field <$fname> of <$name> is required")
+				}
+			<- end>
+			<$w>, err := <toWire $f.Type $value>
+			if err != nil {
+				return <$w>, err
+			}
+			return <$wire>.NewValueStruct(<$wire>.Struct{Fields: []<$wire>.Field{
+				{ID: <$f.ID>, Value: <$w>},
+			}}), nil
+		}
+
+		// String returns a readable string representation of a <$variant>.
+		func (<$v> <$variant>) String() string {
+			<- if isRedacted $f>
+				return "<$name>{<$fname>: " + <redacted $f $value> + "}"
+			<- else>
+				return <$fmt>.Sprintf("<$name>{<$fname>: %v}", <$value>)
+			<- end>
+		}
+
+		<$rhs := newVar "rhs">
+		// Equals returns true if the provided <$name> is a <$variant> with
+		// the same value.
+		func (<$v> <$variant>) Equals(<$rhs> <$name>) bool {
+			<- $r := newVar "r">
+			<- $rvalue := printf "%s.Value" $r>
+			<$r>, ok := <$rhs>.(<$variant>)
+			return ok && <equals $f.Type $value $rvalue>
+		}
+
+		<if not noZap>
+		<$zapcore := import "go.uber.org/zap/zapcore">
+		<$enc := newVar "enc">
+		// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+		// fast logging of <$variant>.
+		func (<$v> <$variant>) MarshalLogObject(<$enc> <$zapcore>.ObjectEncoder) (err error) {
+			<- if not (zapOptOut $f)>
+				<- if isRedacted $f>
+					<$enc>.AddString("<fieldLabel $f>", <redacted $f $value>)
+				<- else>
+					<zapEncodeBegin $f.Type ->
+						<$enc>.Add<zapEncoder $f.Type>("<fieldLabel $f>", <zapMarshaler $f.Type $value>)
+					<- zapEncodeEnd $f.Type>
+				<- end>
+			<- end>
+			return err
+		}
+		<end>
+
+		// MarshalJSON implements json.Marshaler, encoding <$variant> as an
+		// object with a single "<fieldLabel $f>" key.
+		func (<$v> <$variant>) MarshalJSON() ([]byte, error) {
+			return <$json>.Marshal(struct {
+				Value <if isRedacted $f>string<else><typeReference $f.Type><end> <jsonTag $f>
+			}{<if isRedacted $f><redacted $f $value><else><$value><end>})
+		}
+		`,
+		struct {
+			Name  string
+			Field *compile.FieldSpec
+		}{Name: u.Name, Field: f},
+		TemplateFunc("noZap", checkNoZap),
+		TemplateFunc("zapOptOut", zapOptOut),
+		TemplateFunc("fieldLabel", entityLabel),
+		TemplateFunc("isRedacted", isRedacted),
+		TemplateFunc("redacted", redactedValue),
+		TemplateFunc("jsonTag", unionJSONTag),
+	)
+}
+
+// unionJSONTag returns the struct tag holding the JSON key of the given
+// variant. Unlike struct fields, variants are never omitted when empty.
+func unionJSONTag(f *compile.FieldSpec) string {
+	return fmt.Sprintf("`json:%q`", entityLabel(f))
+}
+
+func (u unionGenerator) FromWireFunc(g Generator) error {
+	return g.DeclareFromTemplate(
+		`
+		<$wire := import "go.uber.org/thriftrw/wire">
+		<$name := .Name>
+
+		<$w := newVar "w">
+		// <.Name>_FromWire deserializes a <.Name> from its Thrift-level
+		// representation. The Thrift-level representation may be obtained
+		// from a ThriftRW protocol implementation.
+		//
+		// An error is returned unless exactly one variant of <.Name> is
+		// present in the provided intermediate representation.
+		//
+		//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+		//   if err != nil {
+		//     return nil, err
+		//   }
+		//
+		//   return <.Name>_FromWire(x)
+		func <.Name>_FromWire(<$w> <$wire>.Value) (<.Name>, error) {
+			<- $v := newVar "v">
+			<- $count := newVar "count">
+			<- $f := newVar "field">
+			var (
+				<$v> <.Name>
+				<$count> int
+				err error
+			)
+
+			for _, <$f> := range <$w>.GetStruct().Fields {
+				switch <$f>.ID {
+				<range .Fields ->
+				case <.ID>:
+					if <$f>.Value.Type() == <typeCode .Type> {
+						<- $x := newVar "x">
+						<- $value := printf "%s.Value" $f>
+						var <$x> <$name>_<goName .>
+						<$x>.Value, err = <fromWire .Type $value>
+						if err != nil {
+							return nil, err
+						}
+						<$v> = <$x>
+						<$count>++
+					}
+				<end ->
+				}
+			}
+
+			if <$count> != 1 {
+				return nil, <import "fmt">.Errorf("<.Name> should have exactly one field: got %v fields", <$count>)
+			}
+			return <$v>, nil
+		}
+		`, u)
+}
+
+func (u unionGenerator) EqualsFunc(g Generator) error {
+	return g.DeclareFromTemplate(
+		`
+		<$lhs := newVar "lhs">
+		<$rhs := newVar "rhs">
+		// <.Name>_Equals returns true if the two provided <.Name> values hold
+		// the same variant with the same value, or if both are nil.
+		func <.Name>_Equals(<$lhs>, <$rhs> <.Name>) bool {
+			if <$lhs> == nil {
+				return <$rhs> == nil
+			}
+			return <$lhs>.Equals(<$rhs>)
+		}
+		`, u)
+}
+
+func (u unionGenerator) UnmarshalJSONFunc(g Generator) error {
+	return g.DeclareFromTemplate(
+		`
+		<$json := import "encoding/json">
+		<$fmt := import "fmt">
+		<$name := .Name>
+
+		<$b := newVar "b">
+		// <.Name>_UnmarshalJSON decodes a <.Name> from JSON produced by the
+		// MarshalJSON method of one of its variants: an object with exactly
+		// one key naming the variant. null is decoded as a nil <.Name>.
+		func <.Name>_UnmarshalJSON(<$b> []byte) (<.Name>, error) {
+			<- $fields := newVar "fields">
+			<- $k := newVar "k">
+			<- $raw := newVar "raw">
+			var <$fields> map[string]<$json>.RawMessage
+			if err := <$json>.Unmarshal(<$b>, &<$fields>); err != nil {
+				return nil, err
+			}
+			if <$fields> == nil {
+				return nil, nil
+			}
+			if len(<$fields>) != 1 {
+				return nil, <$fmt>.Errorf("<.Name> should have exactly one field: got %v fields", len(<$fields>))
+			}
+
+			// Pick the only entry of the map.
+			var (
+				<$k> string
+				<$raw> <$json>.RawMessage
+			)
+			for <$k>, <$raw> = range <$fields> {
+			}
+
+			switch <$k> {
+			<range .Fields ->
+			case "<fieldLabel .>":
+				<- $x := newVar "x">
+				var <$x> <$name>_<goName .>
+				<- if needsUnmarshalJSONFunc .Type>
+					var err error
+					<$x>.Value, err = <unmarshalJSON .Type>(<$raw>)
+					return <$x>, err
+				<- else>
+					err := <$json>.Unmarshal(<$raw>, &<$x>.Value)
+					return <$x>, err
+				<- end>
+			<end ->
+			default:
+				return nil, <$fmt>.Errorf("unknown field %q of <.Name>", <$k>)
+			}
+		}
+		`, u,
+		TemplateFunc("fieldLabel", entityLabel),
+		TemplateFunc("needsUnmarshalJSONFunc", needsUnmarshalJSONFunc),
+		TemplateFunc("unmarshalJSON", unmarshalJSONFunc),
+	)
+}
+
+// unionZapper generates an expression wrapping the given union represented as
+// a sealed interface into a zapcore.ObjectMarshaler that tolerates nil
+// values.
+func unionZapper(g Generator, spec *compile.StructSpec, fieldValue string) (string, error) {
+	name := zapperName(g, spec)
+	if err := g.EnsureDeclared(
+		`
+			<$zapcore := import "go.uber.org/zap/zapcore">
+
+			type <.Name> struct{ Value <typeReference .Type> }
+			<$z := newVar "z">
+			<$enc := newVar "enc">
+			// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+			// fast logging of <.Name>.
+			func (<$z> <.Name>) MarshalLogObject(<$enc> <$zapcore>.ObjectEncoder) error {
+				if <$z>.Value == nil {
+					return nil
+				}
+				return <$z>.Value.MarshalLogObject(<$enc>)
+			}
+			`, struct {
+			Name string
+			Type *compile.StructSpec
+		}{
+			Name: name,
+			Type: spec,
+		},
+	); err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%v{Value: %v}", name, fieldValue), nil
+}
@@ -0,0 +1,177 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package gen
+
+import (
+	"encoding/json"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/thriftrw/compile"
+	tu "go.uber.org/thriftrw/gen/internal/tests/unsigned"
+	"go.uber.org/thriftrw/ptr"
+	"go.uber.org/thriftrw/wire"
+	"go.uber.org/zap/zapcore"
+)
+
+func TestUnsignedIntegers(t *testing.T) {
+	owner := tu.UserID(math.MaxUint64)
+	port := tu.Port(math.MaxUint16)
+	give := &tu.Counters{
+		Flags:    math.MaxUint8,
+		Checksum: math.MaxUint16,
+		Hits:     math.MaxUint32,
+		Bytes:    math.MaxUint64,
+		Hops:     ptr.Uint8(0),
+		Owner:    &owner,
+		Port:     &port,
+		Samples:  []uint32{0, math.MaxUint32},
+		Quotas:   map[tu.UserID]uint64{owner: 1 << 63},
+	}
+
+	t.Run("wire preserves bits", func(t *testing.T) {
+		w, err := give.ToWire()
+		require.NoError(t, err)
+
+		fields := make(map[int16]wire.Value)
+		for _, f := range w.GetStruct().Fields {
+			fields[f.ID] = f.Value
+		}
+		assert.Equal(t, wire.NewValueI8(-1), fields[1])
+		assert.Equal(t, wire.NewValueI16(-1), fields[2])
+		assert.Equal(t, wire.NewValueI32(-1), fields[3])
+		assert.Equal(t, wire.NewValueI64(-1), fields[4])
+		assert.Equal(t, wire.NewValueI64(-1), fields[7])
+
+		var got tu.Counters
+		require.NoError(t, got.FromWire(w))
+		assert.True(t, give.Equals(&got), "round trip: %v != %v", give, &got)
+	})
+
+	t.Run("narrower types are range checked", func(t *testing.T) {
+		tests := []struct {
+			desc    string
+			id      int16
+			value   wire.Value
+			wantErr string
+		}{
+			{
+				desc:    "int8 from i32",
+				id:      5,
+				value:   wire.NewValueI32(128),
+				wantErr: "128 is out of range for int8",
+			},
+			{
+				desc:    "uint8 from i16",
+				id:      6,
+				value:   wire.NewValueI16(-1),
+				wantErr: "-1 is out of range for uint8",
+			},
+			{
+				desc:    "uint16 typedef from i32",
+				id:      8,
+				value:   wire.NewValueI32(65536),
+				wantErr: "65536 is out of range for uint16",
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.desc, func(t *testing.T) {
+				w, err := (&tu.Counters{}).ToWire()
+				require.NoError(t, err)
+
+				s := w.GetStruct()
+				s.Fields = append(s.Fields, wire.Field{ID: tt.id, Value: tt.value})
+
+				var got tu.Counters
+				err = got.FromWire(wire.NewValueStruct(s))
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.wantErr)
+			})
+		}
+	})
+
+	t.Run("constants", func(t *testing.T) {
+		assert.Equal(t, uint8(255), tu.MaxHops)
+		assert.Equal(t, tu.Port(8080), tu.DefaultPort)
+
+		c := tu.Default_Counters()
+		assert.Equal(t, uint8(255), c.GetHops())
+		assert.Equal(t, tu.Port(8080), c.GetPort())
+	})
+
+	t.Run("zap", func(t *testing.T) {
+		enc := zapcore.NewMapObjectEncoder()
+		require.NoError(t, give.MarshalLogObject(enc))
+		assert.Equal(t, uint8(math.MaxUint8), enc.Fields["flags"])
+		assert.Equal(t, uint32(math.MaxUint32), enc.Fields["hits"])
+		assert.Equal(t, uint64(math.MaxUint64), enc.Fields["bytes"])
+		assert.Equal(t, uint64(math.MaxUint64), enc.Fields["owner"])
+	})
+
+	t.Run("json", func(t *testing.T) {
+		b, err := json.Marshal(give)
+		require.NoError(t, err)
+		assert.Contains(t, string(b), `"bytes":18446744073709551615`)
+
+		var got tu.Counters
+		require.NoError(t, json.Unmarshal(b, &got))
+		assert.True(t, give.Equals(&got), "round trip: %v != %v", give, &got)
+	})
+}
+
+func TestIntegerGoTypeErrors(t *testing.T) {
+	tests := []struct {
+		desc    string
+		spec    compile.TypeSpec
+		wantErr string
+	}{
+		{
+			desc: "unknown type",
+			spec: &compile.I32Spec{Annotations: compile.Annotations{
+				"go.type": "uint",
+			}},
+			wantErr: `unsupported go.type "uint" on "i32"`,
+		},
+		{
+			desc: "wider type",
+			spec: &compile.I16Spec{Annotations: compile.Annotations{
+				"go.type": "uint32",
+			}},
+			wantErr: `go.type "uint32" on "i16" is wider than 16 bits`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			g := NewGenerator(&GeneratorOptions{
+				Importer:    thriftPackageImporter{},
+				ImportPath:  "go.uber.org/thriftrw/gen/internal/tests/foo",
+				PackageName: "foo",
+			})
+			_, err := typeName(g, tt.spec)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+}
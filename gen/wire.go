@@ -41,6 +41,17 @@ type WireGenerator struct {
 // ToWire generates an expression of type (Value, error) object containing the
 // wire representation of the variable $varName of type $spec or an error.
 func (w *WireGenerator) ToWire(g Generator, spec compile.TypeSpec, varName string) (string, error) {
+	if a, err := adapterFor(spec); err != nil {
+		return "", err
+	} else if a != nil {
+		return fmt.Sprintf("%s.ToWire(%s)", a.Adapter.Reference(g), varName), nil
+	}
+	if t, err := intTypeFor(spec); err != nil {
+		return "", err
+	} else if t != nil {
+		return intToWire(g, spec, t, varName), nil
+	}
+
 	wire := g.Import("go.uber.org/thriftrw/wire")
 	switch s := spec.(type) {
 	case *compile.BoolSpec:
@@ -127,6 +138,17 @@ func (w *WireGenerator) ToWirePtr(g Generator, spec compile.TypeSpec, varName st
 // FromWire generates an expression of type ($spec, error) which reads the Value
 // at $value into a $spec.
 func (w *WireGenerator) FromWire(g Generator, spec compile.TypeSpec, value string) (string, error) {
+	if a, err := adapterFor(spec); err != nil {
+		return "", err
+	} else if a != nil {
+		return fmt.Sprintf("%s.FromWire(%s)", a.Adapter.Reference(g), value), nil
+	}
+	if t, err := intTypeFor(spec); err != nil {
+		return "", err
+	} else if t != nil {
+		return intFromWire(g, spec, t, value)
+	}
+
 	switch s := spec.(type) {
 	case *compile.BoolSpec:
 		return fmt.Sprintf("%s.GetBool(), error(nil)", value), nil
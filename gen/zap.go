@@ -48,6 +48,17 @@ type zapGenerator struct {
 // the Zap marshaler needs to log it as (i.e. AddString, AppendObject, etc.)
 func (z *zapGenerator) zapEncoder(g Generator, spec compile.TypeSpec) string {
 	root := compile.RootTypeSpec(spec)
+	if enc := zapTimeEncoder(root); enc != "" {
+		return enc
+	}
+	if hasAdapter(root) {
+		// Custom Go types are logged with reflection.
+		return "Reflected"
+	}
+	if t, err := intTypeFor(root); err == nil && t != nil {
+		// "uint32" becomes "Uint32"
+		return goCase(t.Name)
+	}
 
 	switch t := root.(type) {
 	// Primitives
@@ -70,12 +81,10 @@ func (z *zapGenerator) zapEncoder(g Generator, spec compile.TypeSpec) string {
 
 	// Containers
 	case *compile.MapSpec:
-		switch compile.RootTypeSpec(t.KeySpec).(type) {
-		case *compile.StringSpec:
+		if isStringKeyed(t) {
 			return "Object"
-		default:
-			return "Array"
 		}
+		return "Array"
 	case *compile.SetSpec, *compile.ListSpec:
 		return "Array"
 
@@ -86,6 +95,20 @@ func (z *zapGenerator) zapEncoder(g Generator, spec compile.TypeSpec) string {
 	panic(root)
 }
 
+// zapTimeEncoder returns the Zap type name for native Thrift types mapped to
+// time.Time or time.Duration with go.time or go.duration, and an empty string
+// for all other types.
+func zapTimeEncoder(spec compile.TypeSpec) string {
+	annotations := spec.ThriftAnnotations()
+	if _, ok := annotations[goTimeKey]; ok {
+		return "Time"
+	}
+	if _, ok := annotations[goDurationKey]; ok {
+		return "Duration"
+	}
+	return ""
+}
+
 // zapTypedefHasGeneratedMarshaler defines if a typedef will have generated code
 // zapcore.ObjectMarshaler or zapcore.ArrayMarshaler.
 func (z *zapGenerator) zapTypedefHasGeneratedMarshaler(g Generator, spec compile.TypeSpec) bool {
@@ -151,6 +174,9 @@ func (z *zapGenerator) zapMarshalerGenerator(g Generator, spec compile.TypeSpec,
 	case *compile.ListSpec:
 		return z.listG.zapMarshaler(g, t, fieldValue)
 	case *compile.StructSpec:
+		if isUnionInterface(t) {
+			return unionZapper(g, t, fieldValue)
+		}
 		return fieldValue, nil
 	}
 	panic(root)
@@ -169,6 +195,9 @@ func (z *zapGenerator) zapMarshalerPtr(g Generator, spec compile.TypeSpec, field
 // Make sure that an `err` variable is declared when this is called.
 func (z *zapGenerator) zapEncodeBegin(g Generator, spec compile.TypeSpec) string {
 	root := compile.RootTypeSpec(spec)
+	if hasAdapter(root) && zapTimeEncoder(root) == "" {
+		return fmt.Sprintf("err = %v.Append(err, ", g.Import("go.uber.org/multierr"))
+	}
 
 	switch root.(type) {
 	// Non-primitives
@@ -181,6 +210,9 @@ func (z *zapGenerator) zapEncodeBegin(g Generator, spec compile.TypeSpec) string
 
 func (z *zapGenerator) zapEncodeEnd(spec compile.TypeSpec) string {
 	root := compile.RootTypeSpec(spec)
+	if hasAdapter(root) && zapTimeEncoder(root) == "" {
+		return ")"
+	}
 
 	switch root.(type) {
 	// Non-primitives
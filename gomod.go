@@ -0,0 +1,111 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// findModule finds the Go module enclosing the given directory by searching
+// it and its parents for a go.mod file. The directory need not exist.
+//
+// Returns the root directory of the module and its module path. Both are
+// empty if the directory is not inside a module.
+func findModule(dir string) (root string, modulePath string, err error) {
+	for {
+		gomod := filepath.Join(dir, "go.mod")
+		contents, err := ioutil.ReadFile(gomod)
+		switch {
+		case err == nil:
+			modulePath, err := parseModulePath(contents)
+			if err != nil {
+				return "", "", fmt.Errorf("could not read %q: %v", gomod, err)
+			}
+			return dir, modulePath, nil
+		case !os.IsNotExist(err):
+			return "", "", err
+		}
+
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return "", "", nil
+		}
+		dir = parent
+	}
+}
+
+// parseModulePath returns the path declared by the module directive of a
+// go.mod file.
+func parseModulePath(gomod []byte) (string, error) {
+	scanner := bufio.NewScanner(bytes.NewReader(gomod))
+	for scanner.Scan() {
+		line := scanner.Text()
+		if i := strings.Index(line, "//"); i >= 0 {
+			line = line[:i]
+		}
+
+		fields := strings.Fields(line)
+		if len(fields) == 0 || fields[0] != "module" {
+			continue
+		}
+		if len(fields) != 2 {
+			return "", fmt.Errorf("invalid module directive: %q", line)
+		}
+
+		modulePath := fields[1]
+		if strings.HasPrefix(modulePath, `"`) || strings.HasPrefix(modulePath, "`") {
+			var err error
+			modulePath, err = strconv.Unquote(modulePath)
+			if err != nil {
+				return "", fmt.Errorf("invalid module path %v: %v", fields[1], err)
+			}
+		}
+		if modulePath == "" {
+			return "", fmt.Errorf("invalid module directive: %q", line)
+		}
+		return modulePath, nil
+	}
+
+	if err := scanner.Err(); err != nil {
+		return "", err
+	}
+	return "", fmt.Errorf("module directive not found")
+}
+
+// modulePackagePath returns the import path of the package in the given
+// directory of a module.
+//
+// dir must be inside root.
+func modulePackagePath(root, modulePath, dir string) (string, error) {
+	rel, err := filepath.Rel(root, dir)
+	if err != nil {
+		return "", err
+	}
+	return path.Join(modulePath, filepath.ToSlash(rel)), nil
+}
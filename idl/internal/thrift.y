@@ -133,19 +133,21 @@ header
                 Line: $1,
             }
         }
-    | lineno NAMESPACE '*' IDENTIFIER
+    | lineno NAMESPACE '*' IDENTIFIER type_annotations
         {
             $$ = &ast.Namespace{
                 Scope: "*",
                 Name: $4,
+                Annotations: $5,
                 Line: $1,
             }
         }
-    | lineno NAMESPACE IDENTIFIER IDENTIFIER
+    | lineno NAMESPACE IDENTIFIER IDENTIFIER type_annotations
         {
             $$ = &ast.Namespace{
                 Scope: $3,
                 Name: $4,
+                Annotations: $5,
                 Line: $1,
             }
         }
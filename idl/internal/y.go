@@ -21,11 +21,9 @@
 // THE SOFTWARE.
 package internal
 
-import (
-	__yyfmt__ "fmt"
+import __yyfmt__ "fmt"
 
-	"go.uber.org/thriftrw/ast"
-)
+import "go.uber.org/thriftrw/ast"
 
 type yySymType struct {
 	yys int
@@ -182,54 +180,56 @@ var yyExca = [...]int{
 
 const yyPrivate = 57344
 
-const yyLast = 180
+const yyLast = 191
 
 var yyAct = [...]int{
 
-	32, 58, 65, 5, 7, 68, 87, 31, 11, 66,
-	125, 73, 69, 70, 14, 12, 89, 12, 94, 13,
-	127, 13, 96, 95, 62, 61, 60, 162, 155, 33,
-	151, 129, 92, 160, 59, 59, 59, 148, 144, 133,
-	135, 123, 71, 72, 85, 82, 73, 69, 70, 79,
-	56, 107, 91, 54, 63, 121, 53, 67, 74, 57,
-	90, 55, 19, 131, 132, 81, 84, 158, 76, 77,
-	78, 106, 10, 8, 9, 140, 93, 71, 72, 118,
-	116, 97, 16, 15, 100, 138, 28, 103, 99, 98,
-	17, 102, 101, 147, 142, 104, 18, 112, 113, 157,
-	114, 110, 111, 88, 52, 37, 36, 35, 34, 74,
-	124, 30, 29, 119, 122, 117, 128, 120, 134, 126,
-	21, 25, 26, 27, 105, 74, 24, 22, 20, 139,
-	137, 136, 75, 109, 108, 3, 6, 143, 141, 64,
-	80, 86, 146, 2, 4, 74, 83, 145, 23, 150,
-	149, 152, 74, 84, 130, 115, 156, 154, 153, 159,
-	42, 38, 84, 161, 1, 0, 0, 0, 43, 44,
-	45, 46, 47, 48, 49, 50, 51, 39, 40, 41,
+	32, 37, 68, 5, 7, 11, 69, 31, 91, 78,
+	74, 75, 73, 111, 12, 94, 14, 130, 13, 99,
+	12, 65, 98, 97, 13, 64, 63, 164, 157, 33,
+	153, 132, 39, 71, 38, 38, 162, 38, 150, 147,
+	76, 77, 136, 62, 78, 74, 75, 138, 128, 89,
+	86, 96, 59, 126, 83, 110, 57, 66, 56, 95,
+	70, 72, 79, 58, 61, 134, 135, 160, 85, 88,
+	19, 80, 81, 82, 109, 76, 77, 10, 8, 9,
+	143, 122, 120, 16, 15, 100, 125, 28, 103, 17,
+	101, 106, 149, 104, 102, 145, 107, 105, 118, 112,
+	116, 117, 93, 92, 18, 115, 55, 40, 36, 35,
+	34, 30, 29, 159, 79, 129, 123, 124, 121, 108,
+	131, 60, 137, 114, 113, 3, 127, 6, 67, 84,
+	79, 139, 142, 140, 90, 2, 4, 87, 23, 133,
+	146, 144, 141, 119, 41, 148, 1, 0, 79, 0,
+	0, 152, 0, 154, 79, 88, 0, 155, 158, 0,
+	151, 161, 0, 0, 88, 163, 156, 21, 25, 26,
+	27, 45, 0, 24, 22, 20, 0, 0, 0, 46,
+	47, 48, 49, 50, 51, 52, 53, 54, 42, 43,
+	44,
 }
 var yyPact = [...]int{
 
-	-1000, -1000, -1000, -1000, -1000, 64, -31, -1000, 78, 85,
-	58, -1000, -1000, -1000, 95, -1000, 81, -1000, 108, 107,
-	-1000, -1000, 104, 103, 102, -1000, -1000, -1000, -1000, -1000,
-	-1000, 101, 156, 100, 16, 13, 21, 20, -7, -19,
-	-20, -21, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -7, -1000, -1000, -1000, -1000, 41, -1000, -1000,
-	-1000, -1000, -1000, -1000, 8, 4, 3, 99, -1000, -1000,
-	-1000, -1000, -1000, -1000, 12, -12, -28, -24, -25, -7,
-	-31, -1000, -7, -31, -1000, -7, -31, 47, 11, -1000,
-	-1000, -1000, -1000, 97, -1000, -7, -7, -1000, -1000, 96,
-	-1000, -1000, 74, -1000, -1000, 68, -1000, -1000, 6, 0,
-	-29, -27, -1000, -1000, -8, 29, -3, -1000, -1000, -1000,
-	-1, -1000, -31, -1000, 41, 80, -1000, -7, -1000, 69,
-	-1000, -1000, -1000, -1000, 90, -7, -1000, -4, -31, -1000,
-	-7, 89, -6, -1000, 41, -1000, -1000, -9, -1000, -31,
-	-1000, 41, -16, -1000, -7, 37, -1000, -7, -10, -1000,
-	-1000, -17, -1000,
+	-1000, -1000, -1000, -1000, -1000, 69, -32, -1000, 79, 84,
+	66, -1000, -1000, -1000, 142, -1000, 82, -1000, 108, 107,
+	-1000, -1000, 106, 105, 104, -1000, -1000, -1000, -1000, -6,
+	-6, 103, 167, 102, 18, 16, 23, -1000, -1000, -1000,
+	25, -6, -19, -20, -24, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -6, -1000, -1000, -1000, -1000,
+	-11, 39, -1000, -1000, -1000, -1000, -1000, 13, 9, 8,
+	99, -1000, 98, -1000, -1000, -1000, -1000, -1000, -1000, 11,
+	-23, -25, -28, -6, -32, -1000, -6, -32, -1000, -6,
+	-32, 50, 15, -26, -1000, -1000, -1000, -1000, -6, -6,
+	-1000, -1000, 94, -1000, -1000, 76, -1000, -1000, 70, -1000,
+	-1000, 81, -1000, 4, 7, -30, -1000, -1000, -8, 31,
+	0, -1000, -1000, -1000, 6, -32, -1000, -32, -1000, 39,
+	-6, -1000, 74, -1000, -1000, -1000, -1000, 91, -6, -1000,
+	-1000, -3, -1000, -6, 88, -5, -1000, 39, -1000, -9,
+	-1000, -32, -1000, 39, -16, -1000, -6, 37, -1000, -6,
+	-7, -1000, -1000, -17, -1000,
 }
 var yyPgo = [...]int{
 
-	0, 0, 6, 164, 7, 161, 155, 154, 148, 146,
-	2, 144, 143, 141, 9, 140, 139, 136, 135, 5,
-	134, 133, 132, 1, 8, 124, 115, 99,
+	0, 0, 8, 146, 7, 144, 143, 139, 138, 137,
+	2, 136, 135, 134, 6, 129, 128, 127, 125, 12,
+	124, 123, 121, 1, 5, 119, 118, 113,
 }
 var yyR1 = [...]int{
 
@@ -244,7 +244,7 @@ var yyR1 = [...]int{
 }
 var yyR2 = [...]int{
 
-	0, 2, 0, 2, 3, 4, 3, 4, 4, 0,
+	0, 2, 0, 2, 3, 4, 3, 5, 5, 0,
 	3, 7, 6, 8, 8, 8, 11, 1, 1, 1,
 	0, 3, 4, 6, 0, 3, 7, 9, 2, 0,
 	1, 1, 0, 0, 3, 10, 1, 0, 1, 1,
@@ -258,40 +258,40 @@ var yyChk = [...]int{
 	-1000, -3, -12, -18, -11, -1, -17, -1, 9, 10,
 	8, -24, 46, 50, -2, 5, 4, 5, 38, 4,
 	33, 25, 32, -8, 31, 26, 27, 28, 5, 4,
-	4, -4, -1, -4, 4, 4, 4, 4, -5, 21,
-	22, 23, 4, 12, 13, 14, 15, 16, 17, 18,
-	19, 20, 4, 40, 40, 40, 29, 39, -23, 43,
-	45, 45, 45, -23, -16, -10, -14, -1, -19, 6,
-	7, 36, 37, 5, -1, -22, -4, -4, -4, 41,
-	-15, -1, 41, -9, -1, 41, -13, -2, 4, 4,
-	48, 40, 44, -1, 46, 47, 47, -23, -24, -2,
-	-23, -24, -2, -23, -24, -25, 24, 40, -20, -21,
-	4, -4, -23, -23, 4, -6, 6, -26, 11, -4,
-	-14, 49, -19, 41, -1, 39, -24, 47, -23, 39,
-	-7, 34, 35, 42, -1, 41, -24, -19, 5, -23,
-	6, -4, 4, -23, 42, -24, -23, 4, 43, -19,
-	-23, 39, -10, -24, -19, 44, -23, -27, 30, -23,
-	43, -10, 44,
+	4, -4, -1, -4, 4, 4, 4, -23, 43, -23,
+	4, -5, 21, 22, 23, 4, 12, 13, 14, 15,
+	16, 17, 18, 19, 20, 4, 40, 40, 40, 29,
+	-22, 39, -23, 45, 45, 45, -23, -16, -10, -14,
+	-1, 44, -1, -19, 6, 7, 36, 37, 5, -1,
+	-4, -4, -4, 41, -15, -1, 41, -9, -1, 41,
+	-13, -2, 4, 4, 4, 48, 40, 46, 47, 47,
+	-23, -24, -2, -23, -24, -2, -23, -24, -25, 24,
+	40, 39, -24, -20, -21, -4, -23, -23, 4, -6,
+	6, -26, 11, -4, -14, 5, 49, -19, 41, -1,
+	47, -23, 39, -7, 34, 35, 42, -1, 41, -24,
+	-24, -19, -23, 6, -4, 4, -23, 42, -23, 4,
+	43, -19, -23, 39, -10, -24, -19, 44, -23, -27,
+	30, -23, 43, -10, 44,
 }
 var yyDef = [...]int{
 
 	2, -2, -2, -2, 3, 0, 77, 74, 0, 0,
 	0, 10, 75, 76, 0, 4, 0, 6, 0, 0,
-	73, 73, 0, 0, 0, 17, 18, 19, 5, 7,
-	8, 0, 0, 0, 0, 0, 0, 0, 68, 0,
-	0, 0, 46, 47, 48, 49, 50, 51, 52, 53,
-	54, 55, 68, 20, 24, 33, 73, 73, 42, 70,
-	73, 73, 73, 12, 73, 73, 74, 0, 11, 56,
-	57, 58, 59, 60, 0, 73, 0, 0, 0, 68,
-	77, 74, 68, 77, 74, 68, 77, 37, 0, 61,
-	64, 66, 69, 0, 73, 68, 68, 13, 21, 0,
-	14, 25, 29, 15, 34, 73, 36, 33, 73, 73,
-	77, 0, 44, 45, 68, 32, 0, 73, 38, 39,
-	74, 62, 77, 63, 73, 0, 72, 68, 22, 0,
-	73, 30, 31, 28, 0, 68, 65, 0, 77, 43,
-	68, 0, 0, 16, 73, 71, 23, 68, 24, 77,
-	26, 73, 73, 67, 68, 40, 27, 68, 0, 35,
-	24, 73, 41,
+	73, 73, 0, 0, 0, 17, 18, 19, 5, 68,
+	68, 0, 0, 0, 0, 0, 0, 7, 70, 8,
+	0, 68, 0, 0, 0, 46, 47, 48, 49, 50,
+	51, 52, 53, 54, 55, 68, 20, 24, 33, 73,
+	73, 73, 42, 73, 73, 73, 12, 73, 73, 74,
+	0, 69, 0, 11, 56, 57, 58, 59, 60, 0,
+	0, 0, 0, 68, 77, 74, 68, 77, 74, 68,
+	77, 37, 0, 77, 61, 64, 66, 73, 68, 68,
+	13, 21, 0, 14, 25, 29, 15, 34, 73, 36,
+	33, 0, 72, 73, 73, 0, 44, 45, 68, 32,
+	0, 73, 38, 39, 74, 77, 62, 77, 63, 73,
+	68, 22, 0, 73, 30, 31, 28, 0, 68, 71,
+	65, 0, 43, 68, 0, 0, 16, 73, 23, 68,
+	24, 77, 26, 73, 73, 67, 68, 40, 27, 68,
+	0, 35, 24, 73, 41,
 }
 var yyTok1 = [...]int{
 
@@ -698,21 +698,23 @@ yydefault:
 			}
 		}
 	case 7:
-		yyDollar = yyS[yypt-4 : yypt+1]
+		yyDollar = yyS[yypt-5 : yypt+1]
 		{
 			yyVAL.header = &ast.Namespace{
-				Scope: "*",
-				Name:  yyDollar[4].str,
-				Line:  yyDollar[1].line,
+				Scope:       "*",
+				Name:        yyDollar[4].str,
+				Annotations: yyDollar[5].typeAnnotations,
+				Line:        yyDollar[1].line,
 			}
 		}
 	case 8:
-		yyDollar = yyS[yypt-4 : yypt+1]
+		yyDollar = yyS[yypt-5 : yypt+1]
 		{
 			yyVAL.header = &ast.Namespace{
-				Scope: yyDollar[3].str,
-				Name:  yyDollar[4].str,
-				Line:  yyDollar[1].line,
+				Scope:       yyDollar[3].str,
+				Name:        yyDollar[4].str,
+				Annotations: yyDollar[5].typeAnnotations,
+				Line:        yyDollar[1].line,
 			}
 		}
 	case 9:
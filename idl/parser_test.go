@@ -214,6 +214,31 @@ func TestParseHeaders(t *testing.T) {
 				},
 			},
 		},
+		{
+			`
+				namespace go foo.bar (go.package = "example.com/foo/bar")
+				namespace * baz (a = "b", c = "d")
+			`,
+			&Program{Headers: []Header{
+				&Namespace{
+					Scope: "go",
+					Name:  "foo.bar",
+					Annotations: []*Annotation{
+						{Name: "go.package", Value: "example.com/foo/bar", Line: 2},
+					},
+					Line: 2,
+				},
+				&Namespace{
+					Scope: "*",
+					Name:  "baz",
+					Annotations: []*Annotation{
+						{Name: "a", Value: "b", Line: 3},
+						{Name: "c", Value: "d", Line: 3},
+					},
+					Line: 3,
+				},
+			}},
+		},
 	}
 	assertParseCases(t, tests)
 }
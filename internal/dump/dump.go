@@ -0,0 +1,200 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Package dump renders Thrift values in a human-readable form, using the
+// types from a Thrift file to name fields and enum items.
+package dump
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"go.uber.org/thriftrw/compile"
+	"go.uber.org/thriftrw/wire"
+)
+
+// Value renders the given value of the given type to w, one field or item
+// per line.
+//
+// Fields that the type doesn't know about or whose values don't match their
+// types are rendered with their raw Thrift-level representation. If t is
+// nil, the whole value is rendered this way.
+func Value(w io.Writer, t compile.TypeSpec, v wire.Value) error {
+	p := printer{}
+	p.value(t, v)
+	p.buff.WriteByte('\n')
+	_, err := p.buff.WriteTo(w)
+	return err
+}
+
+// Envelope renders the given envelope to w, with its value rendered as a
+// value of the given type.
+func Envelope(w io.Writer, t compile.TypeSpec, e wire.Envelope) error {
+	if _, err := fmt.Fprintf(w, "%v %v (seqid %v)\n", e.Type, e.Name, e.SeqID); err != nil {
+		return err
+	}
+	return Value(w, t, e.Value)
+}
+
+type printer struct {
+	buff   bytes.Buffer
+	indent int
+}
+
+func (p *printer) printf(format string, args ...interface{}) {
+	fmt.Fprintf(&p.buff, format, args...)
+}
+
+// newline starts a new line at the current indentation level.
+func (p *printer) newline() {
+	p.buff.WriteByte('\n')
+	p.buff.WriteString(strings.Repeat("  ", p.indent))
+}
+
+func (p *printer) value(t compile.TypeSpec, v wire.Value) {
+	if t == nil {
+		p.printf("%v", v)
+		return
+	}
+	if v.Type() != t.TypeCode() {
+		p.printf("<unexpected %v, want %v> %v", v.Type(), t.ThriftName(), v)
+		return
+	}
+
+	switch spec := compile.RootTypeSpec(t).(type) {
+	case *compile.BoolSpec:
+		p.printf("%v", v.GetBool())
+	case *compile.I8Spec:
+		p.printf("%v", v.GetI8())
+	case *compile.I16Spec:
+		p.printf("%v", v.GetI16())
+	case *compile.I32Spec:
+		p.printf("%v", v.GetI32())
+	case *compile.I64Spec:
+		p.printf("%v", v.GetI64())
+	case *compile.DoubleSpec:
+		p.printf("%v", v.GetDouble())
+	case *compile.StringSpec:
+		p.printf("%q", v.GetString())
+	case *compile.BinarySpec:
+		p.printf("0x%x", v.GetBinary())
+	case *compile.EnumSpec:
+		p.enum(spec, v.GetI32())
+	case *compile.StructSpec:
+		p.structValue(spec, v.GetStruct())
+	case *compile.ListSpec:
+		p.items("[", "]", spec.ValueSpec, v.GetList())
+	case *compile.SetSpec:
+		p.items("{", "}", spec.ValueSpec, v.GetSet())
+	case *compile.MapSpec:
+		p.mapValue(spec, v.GetMap())
+	default:
+		p.printf("%v", v)
+	}
+}
+
+func (p *printer) enum(spec *compile.EnumSpec, v int32) {
+	for _, item := range spec.Items {
+		if item.Value == v {
+			p.printf("%v (%v)", item.Name, v)
+			return
+		}
+	}
+	p.printf("%v(%v)", spec.Name, v)
+}
+
+func (p *printer) structValue(spec *compile.StructSpec, s wire.Struct) {
+	fields := make([]wire.Field, len(s.Fields))
+	copy(fields, s.Fields)
+	sort.SliceStable(fields, func(i, j int) bool {
+		return fields[i].ID < fields[j].ID
+	})
+
+	p.printf("%v{", spec.Name)
+	if len(fields) == 0 {
+		p.printf("}")
+		return
+	}
+
+	p.indent++
+	for _, f := range fields {
+		p.newline()
+		if fs := fieldByID(spec, f.ID); fs != nil {
+			p.printf("%v (%v): ", fs.Name, f.ID)
+			p.value(fs.Type, f.Value)
+		} else {
+			p.printf("%v: ", f.ID)
+			p.value(nil, f.Value)
+		}
+	}
+	p.indent--
+	p.newline()
+	p.printf("}")
+}
+
+func fieldByID(spec *compile.StructSpec, id int16) *compile.FieldSpec {
+	for _, f := range spec.Fields {
+		if f.ID == id {
+			return f
+		}
+	}
+	return nil
+}
+
+func (p *printer) items(open, close string, t compile.TypeSpec, l wire.ValueList) {
+	p.printf("%v", open)
+	if l.Size() == 0 {
+		p.printf("%v", close)
+		return
+	}
+
+	p.indent++
+	_ = l.ForEach(func(v wire.Value) error {
+		p.newline()
+		p.value(t, v)
+		return nil
+	})
+	p.indent--
+	p.newline()
+	p.printf("%v", close)
+}
+
+func (p *printer) mapValue(spec *compile.MapSpec, m wire.MapItemList) {
+	p.printf("{")
+	if m.Size() == 0 {
+		p.printf("}")
+		return
+	}
+
+	p.indent++
+	_ = m.ForEach(func(item wire.MapItem) error {
+		p.newline()
+		p.value(spec.KeySpec, item.Key)
+		p.printf(": ")
+		p.value(spec.ValueSpec, item.Value)
+		return nil
+	})
+	p.indent--
+	p.newline()
+	p.printf("}")
+}
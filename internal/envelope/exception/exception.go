@@ -542,6 +542,34 @@ func (v *TApplicationException) Error() string {
 	return v.String()
 }
 
+// ExceptionFromWire deserializes the exception defined in this
+// package with the given Thrift name from its Thrift-level
+// representation.
+//
+// ok is false if this package does not define an exception with the
+// given name. err is non-nil if the exception could not be
+// deserialized.
+//
+//   e, ok, err := ExceptionFromWire("TApplicationException", value)
+//   if err != nil {
+//     return err
+//   }
+//   if ok {
+//     return e
+//   }
+func ExceptionFromWire(name string, w wire.Value) (exc error, ok bool, err error) {
+	switch name {
+	case "TApplicationException":
+		var v TApplicationException
+		if err := v.FromWire(w); err != nil {
+			return nil, true, err
+		}
+		return &v, true, nil
+	default:
+		return nil, false, nil
+	}
+}
+
 // ThriftModule represents the IDL file used to generate this package.
 var ThriftModule = &thriftreflect.ThriftModule{
 	Name:     "exception",
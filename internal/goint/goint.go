@@ -0,0 +1,75 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Package goint describes the Go integer types which may be used to
+// represent Thrift integer types with the go.type annotation.
+//
+//   i32 (go.type = "uint32")
+package goint
+
+import "math"
+
+// Annotation is the Thrift annotation which names the Go integer type.
+const Annotation = "go.type"
+
+// Type is a Go integer type.
+type Type struct {
+	Name   string
+	Bits   int
+	Signed bool
+}
+
+var _types = map[string]Type{
+	"int8":   {Name: "int8", Bits: 8, Signed: true},
+	"int16":  {Name: "int16", Bits: 16, Signed: true},
+	"int32":  {Name: "int32", Bits: 32, Signed: true},
+	"int64":  {Name: "int64", Bits: 64, Signed: true},
+	"uint8":  {Name: "uint8", Bits: 8},
+	"uint16": {Name: "uint16", Bits: 16},
+	"uint32": {Name: "uint32", Bits: 32},
+	"uint64": {Name: "uint64", Bits: 64},
+}
+
+// Lookup returns the Go integer type with the given name.
+func Lookup(name string) (Type, bool) {
+	t, ok := _types[name]
+	return t, ok
+}
+
+// Min returns the smallest value of this type.
+func (t Type) Min() int64 {
+	if !t.Signed {
+		return 0
+	}
+	return -(int64(1) << uint(t.Bits-1))
+}
+
+// Max returns the largest value of this type which may be expressed as an
+// int64. For uint64, that's math.MaxInt64 because larger values cannot be
+// expressed in Thrift files.
+func (t Type) Max() int64 {
+	if t.Signed {
+		return int64(1)<<uint(t.Bits-1) - 1
+	}
+	if t.Bits == 64 {
+		return math.MaxInt64
+	}
+	return int64(1)<<uint(t.Bits) - 1
+}
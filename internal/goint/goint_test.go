@@ -0,0 +1,58 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package goint
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLookup(t *testing.T) {
+	tests := []struct {
+		name     string
+		min, max int64
+	}{
+		{"int8", math.MinInt8, math.MaxInt8},
+		{"int16", math.MinInt16, math.MaxInt16},
+		{"int32", math.MinInt32, math.MaxInt32},
+		{"int64", math.MinInt64, math.MaxInt64},
+		{"uint8", 0, math.MaxUint8},
+		{"uint16", 0, math.MaxUint16},
+		{"uint32", 0, math.MaxUint32},
+		{"uint64", 0, math.MaxInt64},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			typ, ok := Lookup(tt.name)
+			if assert.True(t, ok) {
+				assert.Equal(t, tt.name, typ.Name)
+				assert.Equal(t, tt.min, typ.Min(), "min")
+				assert.Equal(t, tt.max, typ.Max(), "max")
+			}
+		})
+	}
+
+	_, ok := Lookup("slice")
+	assert.False(t, ok)
+}
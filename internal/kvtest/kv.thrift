@@ -0,0 +1,25 @@
+enum Status {
+    OK = 0
+    STALE = 1
+}
+
+struct Item {
+    1: required string key
+    2: optional binary value
+    3: optional Status status
+    4: optional list<string> tags
+    5: optional map<string, i64> counts
+}
+
+exception NotFound {
+    1: optional string key
+}
+
+service Base {
+    bool healthy()
+}
+
+service KeyValue extends Base {
+    Item getItem(1: string key) throws (1: NotFound notFound)
+    oneway void touch(1: string key)
+}
@@ -0,0 +1,168 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Package kvtest provides the KeyValue Thrift service and helpers shared by
+// the tests of the command line tools which operate on Thrift payloads.
+package kvtest
+
+import (
+	"bytes"
+	"net"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"go.uber.org/thriftrw/internal/transport"
+	"go.uber.org/thriftrw/protocol"
+	"go.uber.org/thriftrw/wire"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// IDL returns the path to kv.thrift, which defines the KeyValue service and
+// its types.
+func IDL() string {
+	_, file, _, _ := runtime.Caller(0)
+	return filepath.Join(filepath.Dir(file), "kv.thrift")
+}
+
+// Encode encodes the given value with the Binary protocol.
+func Encode(t testing.TB, v wire.Value) []byte {
+	var buff bytes.Buffer
+	require.NoError(t, protocol.Binary.Encode(v, &buff))
+	return buff.Bytes()
+}
+
+// EncodeEnveloped encodes the given envelope with the Binary protocol.
+func EncodeEnveloped(t testing.TB, e wire.Envelope) []byte {
+	var buff bytes.Buffer
+	require.NoError(t, protocol.Binary.EncodeEnveloped(e, &buff))
+	return buff.Bytes()
+}
+
+// Decode decodes a struct encoded with the Binary protocol.
+func Decode(t testing.TB, b []byte) wire.Value {
+	v, err := protocol.Binary.Decode(bytes.NewReader(b), wire.TStruct)
+	require.NoError(t, err)
+	return v
+}
+
+// DecodeEnveloped decodes an envelope encoded with the Binary protocol.
+func DecodeEnveloped(t testing.TB, b []byte) wire.Envelope {
+	e, err := protocol.Binary.DecodeEnveloped(bytes.NewReader(b))
+	require.NoError(t, err)
+	return e
+}
+
+// Framed concatenates the given payloads, each prefixed with its length.
+func Framed(t testing.TB, payloads ...[]byte) []byte {
+	var buff bytes.Buffer
+	w := transport.NewWriter(&buff, true)
+	for _, p := range payloads {
+		require.NoError(t, w.Write(p))
+	}
+	return buff.Bytes()
+}
+
+// Call sends the given requests over a single connection to the given
+// address and returns the replies. Replies are not expected for oneway
+// requests.
+func Call(t testing.TB, addr string, framed bool, reqs ...wire.Envelope) []wire.Envelope {
+	conn, err := net.Dial("tcp", addr)
+	require.NoError(t, err)
+	defer conn.Close()
+
+	r := transport.NewReader(conn, framed)
+	w := transport.NewWriter(conn, framed)
+
+	var replies []wire.Envelope
+	for _, req := range reqs {
+		require.NoError(t, w.Write(EncodeEnveloped(t, req)))
+		if req.Type == wire.OneWay {
+			continue
+		}
+
+		res, err := r.Read()
+		require.NoError(t, err)
+		replies = append(replies, DecodeEnveloped(t, res))
+	}
+	return replies
+}
+
+// Handler handles a request received by a server started with StartServer.
+// If no response is returned, none is sent.
+type Handler func(wire.Envelope) *wire.Envelope
+
+// StartServer starts a server which handles a single request on each
+// connection. It returns the address of the server and a function to stop
+// it.
+func StartServer(t testing.TB, framed bool, h Handler) (string, func()) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	go func() {
+		for {
+			conn, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			go serve(t, conn, framed, h)
+		}
+	}()
+
+	return ln.Addr().String(), func() { ln.Close() }
+}
+
+func serve(t testing.TB, conn net.Conn, framed bool, h Handler) {
+	defer conn.Close()
+
+	b, err := transport.NewReader(conn, framed).Read()
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	req, err := protocol.Binary.DecodeEnveloped(bytes.NewReader(b))
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	res := h(req)
+	if res == nil {
+		return
+	}
+
+	var buff bytes.Buffer
+	if !assert.NoError(t, protocol.Binary.EncodeEnveloped(*res, &buff)) {
+		return
+	}
+	assert.NoError(t, transport.NewWriter(conn, framed).Write(buff.Bytes()))
+}
+
+// Reply builds a successful reply to the given request holding the given
+// result fields.
+func Reply(req wire.Envelope, fields ...wire.Field) *wire.Envelope {
+	return &wire.Envelope{
+		Name:  req.Name,
+		Type:  wire.Reply,
+		SeqID: req.SeqID,
+		Value: wire.NewValueStruct(wire.Struct{Fields: fields}),
+	}
+}
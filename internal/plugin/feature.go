@@ -0,0 +1,43 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package plugin
+
+import "go.uber.org/thriftrw/plugin/api"
+
+// MissingFeature returns the names of the plugins behind the given
+// ServiceGenerator which do not provide the given feature.
+//
+// ServiceGenerators which were not built by this package are assumed to
+// provide all features.
+func MissingFeature(sg api.ServiceGenerator, feature api.Feature) []string {
+	var names []string
+	switch sg := sg.(type) {
+	case MultiServiceGenerator:
+		for _, s := range sg {
+			names = append(names, MissingFeature(s, feature)...)
+		}
+	case *serviceGenerator:
+		if _, ok := sg.Features[feature]; !ok {
+			names = append(names, sg.handle.Name())
+		}
+	}
+	return names
+}
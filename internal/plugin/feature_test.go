@@ -0,0 +1,60 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package plugin
+
+import (
+	"testing"
+
+	"go.uber.org/thriftrw/plugin/api"
+	"go.uber.org/thriftrw/plugin/plugintest"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMissingFeature(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	var handles MultiHandle
+	for _, name := range []string{"foo", "bar"} {
+		server := newFakePluginServer(mockCtrl)
+		defer server.Close()
+
+		handle := server.Handshake(t, name, []api.Feature{api.FeatureServiceGenerator})
+		defer func() {
+			server.ExpectGoodbye()
+			require.NoError(t, handle.Close())
+		}()
+		handles = append(handles, handle)
+	}
+
+	inProcess, err := NewInProcessHandle("baz", plugintest.NewMockServiceGenerator(mockCtrl))
+	require.NoError(t, err)
+	defer inProcess.Close()
+	handles = append(handles, inProcess)
+
+	sg := handles.ServiceGenerator()
+	assert.Equal(t, []string{"foo", "bar"}, MissingFeature(sg, api.FeatureUnsignedTypes))
+	assert.Empty(t, MissingFeature(sg, api.FeatureServiceGenerator))
+	assert.Empty(t, MissingFeature(EmptyServiceGenerator, api.FeatureUnsignedTypes))
+}
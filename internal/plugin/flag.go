@@ -24,7 +24,6 @@ import (
 	"fmt"
 	"os"
 	"os/exec"
-	"sync"
 
 	"go.uber.org/thriftrw/internal/concurrent"
 	"go.uber.org/thriftrw/internal/process"
@@ -100,28 +99,29 @@ func (f *Flag) UnmarshalFlag(value string) error {
 type Flags []Flag
 
 // Handle gets a MultiHandle to all the plugins in this list or nil if the
-// list is empty.
+// list is empty. Plugins are started concurrently but the handles are in the
+// same order as the list.
 //
 // The returned handle MUST be closed by the caller if error was nil.
 func (fs Flags) Handle() (MultiHandle, error) {
-	var (
-		lock  sync.Mutex
-		multi MultiHandle
-	)
+	if len(fs) == 0 {
+		return nil, nil
+	}
 
-	err := concurrent.Range(fs, func(_ int, f Flag) error {
+	handles := make([]Handle, len(fs))
+	err := concurrent.Range(fs, func(i int, f Flag) error {
 		h, err := f.Handle()
-		if err != nil {
-			return err
-		}
-
-		lock.Lock()
-		defer lock.Unlock()
-
-		multi = append(multi, h)
-		return nil
+		handles[i] = h
+		return err
 	})
 
+	multi := make(MultiHandle, 0, len(handles))
+	for _, h := range handles {
+		if h != nil {
+			multi = append(multi, h)
+		}
+	}
+
 	if err == nil {
 		return multi, nil
 	}
@@ -0,0 +1,118 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package plugin
+
+import (
+	"errors"
+	"fmt"
+
+	"go.uber.org/thriftrw/plugin/api"
+	"go.uber.org/thriftrw/ptr"
+	"go.uber.org/thriftrw/version"
+
+	"go.uber.org/atomic"
+)
+
+// inProcessHandle is a Handle to a plugin which runs inside the current
+// process.
+type inProcessHandle struct {
+	name string
+
+	Generator api.ServiceGenerator
+	Running   *atomic.Bool
+	Features  map[api.Feature]struct{}
+}
+
+// NewInProcessHandle builds a new Handle to a plugin implemented by Go values
+// inside this process. sg may be nil if the plugin doesn't generate code for
+// services.
+//
+// The plugin goes through the same handshake and its output is subject to
+// the same validation as plugins running in separate processes.
+func NewInProcessHandle(name string, sg api.ServiceGenerator) (Handle, error) {
+	if name == "" {
+		return nil, errors.New("a plugin name must be provided")
+	}
+
+	features, err := handshake(name, inProcessPlugin{name: name, sg: sg})
+	if err != nil {
+		return nil, err
+	}
+
+	return &inProcessHandle{
+		name:      name,
+		Generator: sg,
+		Running:   atomic.NewBool(true),
+		Features:  features,
+	}, nil
+}
+
+func (h *inProcessHandle) Name() string {
+	return h.name
+}
+
+func (h *inProcessHandle) Close() error {
+	h.Running.Store(false)
+	return nil
+}
+
+func (h *inProcessHandle) ServiceGenerator() ServiceGenerator {
+	if !h.Running.Load() {
+		panic(fmt.Sprintf("handle for plugin %q has already been closed", h.name))
+	}
+
+	if _, hasFeature := h.Features[api.FeatureServiceGenerator]; !hasFeature {
+		return nil
+	}
+
+	return &serviceGenerator{
+		handle:           h,
+		Running:          h.Running,
+		ServiceGenerator: h.Generator,
+		Features:         h.Features,
+	}
+}
+
+// inProcessPlugin implements the Plugin service for in-process plugins.
+type inProcessPlugin struct {
+	name string
+	sg   api.ServiceGenerator
+}
+
+var _ api.Plugin = inProcessPlugin{}
+
+func (p inProcessPlugin) Handshake(*api.HandshakeRequest) (*api.HandshakeResponse, error) {
+	features := []api.Feature{api.FeatureUnsignedTypes}
+	if p.sg != nil {
+		features = append(features, api.FeatureServiceGenerator)
+	}
+
+	return &api.HandshakeResponse{
+		Name:           p.name,
+		APIVersion:     api.APIVersion,
+		Features:       features,
+		LibraryVersion: ptr.String(version.Version),
+	}, nil
+}
+
+func (inProcessPlugin) Goodbye() error {
+	return nil
+}
@@ -0,0 +1,114 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package plugin
+
+import (
+	"testing"
+
+	"go.uber.org/thriftrw/plugin/api"
+	"go.uber.org/thriftrw/plugin/plugintest"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInProcessHandle(t *testing.T) {
+	t.Run("no name", func(t *testing.T) {
+		_, err := NewInProcessHandle("", nil)
+		assert.EqualError(t, err, "a plugin name must be provided")
+	})
+
+	t.Run("no service generator", func(t *testing.T) {
+		handle, err := NewInProcessHandle("foo", nil)
+		require.NoError(t, err)
+		defer handle.Close()
+
+		assert.Equal(t, "foo", handle.Name())
+		assert.Nil(t, handle.ServiceGenerator())
+	})
+
+	t.Run("closed", func(t *testing.T) {
+		mockCtrl := gomock.NewController(t)
+		defer mockCtrl.Finish()
+
+		handle, err := NewInProcessHandle("foo", plugintest.NewMockServiceGenerator(mockCtrl))
+		require.NoError(t, err)
+
+		sg := handle.ServiceGenerator()
+		require.NotNil(t, sg)
+		require.NoError(t, handle.Close())
+
+		assert.Panics(t, func() { handle.ServiceGenerator() })
+		assert.Panics(t, func() { sg.Generate(&api.GenerateServiceRequest{}) })
+	})
+}
+
+func TestInProcessServiceGeneratorGenerate(t *testing.T) {
+	tests := []struct {
+		desc      string
+		response  *api.GenerateServiceResponse
+		wantError string
+	}{
+		{
+			desc: "success",
+			response: &api.GenerateServiceResponse{
+				Files:     map[string][]byte{"foo/bar.go": []byte("package foo")},
+				Artifacts: map[string][]byte{"foo/bar.json": []byte("{}")},
+			},
+		},
+		{
+			desc: "parent directory",
+			response: &api.GenerateServiceResponse{
+				Files: map[string][]byte{"../foo/bar.go": []byte("package foo")},
+			},
+			wantError: `plugin "foo" is attempting to write to a parent directory: ` +
+				`path "../foo/bar.go" contains ".."`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			req := &api.GenerateServiceRequest{RootServices: []api.ServiceID{1}}
+			mockSG := plugintest.NewMockServiceGenerator(mockCtrl)
+			mockSG.EXPECT().Generate(req).Return(tt.response, nil)
+
+			handle, err := NewInProcessHandle("foo", mockSG)
+			require.NoError(t, err)
+			defer handle.Close()
+
+			sg := handle.ServiceGenerator()
+			assert.Equal(t, handle, sg.Handle())
+
+			res, err := sg.Generate(req)
+			if tt.wantError != "" {
+				assert.EqualError(t, err, tt.wantError)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.response, res)
+		})
+	}
+}
@@ -22,11 +22,13 @@ package plugin
 
 import (
 	"fmt"
+	"sort"
 	"strings"
-	"sync"
 
 	"go.uber.org/thriftrw/internal/concurrent"
 	"go.uber.org/thriftrw/plugin/api"
+
+	"go.uber.org/multierr"
 )
 
 // MultiHandle wraps a collection of handles into a single handle.
@@ -74,39 +76,70 @@ func (msg MultiServiceGenerator) Handle() Handle {
 	return mh
 }
 
-// Generate calls all the service generators associated with this plugin and
-// consolidates their output.
+// Generate calls all the service generators associated with this plugin
+// concurrently and consolidates their output.
 //
-// Any conflicts in the generated files will result in a failure.
+// Any conflicts in the generated files will result in a failure. Conflicts
+// and errors are reported in the order of the service generators regardless
+// of the order in which they finish: if two plugins write the same file, the
+// later one is at fault.
 func (msg MultiServiceGenerator) Generate(req *api.GenerateServiceRequest) (*api.GenerateServiceResponse, error) {
+	responses := make([]*api.GenerateServiceResponse, len(msg))
+	errs := make([]error, len(msg))
+	_ = concurrent.Range(msg, func(i int, sg ServiceGenerator) {
+		responses[i], errs[i] = sg.Generate(req)
+	})
+
 	var (
-		lock      sync.Mutex
-		files     = make(map[string][]byte)
-		usedPaths = make(map[string]string) // path -> plugin name
+		files         = make(map[string][]byte)
+		artifacts     map[string][]byte         // nil unless plugins produce artifacts
+		usedPaths     = make(map[string]string) // path -> plugin name
+		usedArtifacts = make(map[string]string) // path -> plugin name
 	)
 
-	err := concurrent.Range(msg, func(_ int, sg ServiceGenerator) error {
-		res, err := sg.Generate(req)
-		if err != nil {
-			return err
+	for i, sg := range msg {
+		res := responses[i]
+		if errs[i] != nil || res == nil {
+			continue
 		}
 
-		lock.Lock()
-		defer lock.Unlock()
-
 		pluginName := sg.Handle().Name()
-		for path, contents := range res.Files {
+		for _, path := range sortedPaths(res.Files) {
 			if takenBy, taken := usedPaths[path]; taken {
-				return fmt.Errorf("plugin conflict: cannot write file %q for plugin %q: "+
-					"plugin %q already wrote to that file", path, pluginName, takenBy)
+				errs[i] = multierr.Append(errs[i], fmt.Errorf(
+					"plugin conflict: cannot write file %q for plugin %q: "+
+						"plugin %q already wrote to that file", path, pluginName, takenBy))
+				continue
 			}
 
 			usedPaths[path] = pluginName
-			files[path] = contents
+			files[path] = res.Files[path]
 		}
 
-		return nil
-	})
+		for _, path := range sortedPaths(res.Artifacts) {
+			if takenBy, taken := usedArtifacts[path]; taken {
+				errs[i] = multierr.Append(errs[i], fmt.Errorf(
+					"plugin conflict: cannot write artifact %q for plugin %q: "+
+						"plugin %q already wrote to that file", path, pluginName, takenBy))
+				continue
+			}
 
-	return &api.GenerateServiceResponse{Files: files}, err
+			if artifacts == nil {
+				artifacts = make(map[string][]byte)
+			}
+			usedArtifacts[path] = pluginName
+			artifacts[path] = res.Artifacts[path]
+		}
+	}
+
+	return &api.GenerateServiceResponse{Files: files, Artifacts: artifacts}, multierr.Combine(errs...)
+}
+
+func sortedPaths(files map[string][]byte) []string {
+	paths := make([]string, 0, len(files))
+	for path := range files {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+	return paths
 }
@@ -0,0 +1,98 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package plugin
+
+import (
+	"fmt"
+	"time"
+
+	"go.uber.org/thriftrw/plugin/api"
+
+	"go.uber.org/multierr"
+)
+
+// TimeoutHandle wraps a Handle so that requests to its ServiceGenerator fail
+// if the plugin doesn't respond within the given duration.
+//
+// Plugins running in separate processes are killed if they time out. Requests
+// to in-process plugins cannot be interrupted and continue to run in the
+// background.
+func TimeoutHandle(h Handle, timeout time.Duration) Handle {
+	return &timeoutHandle{Handle: h, timeout: timeout}
+}
+
+type timeoutHandle struct {
+	Handle
+
+	timeout time.Duration
+}
+
+func (h *timeoutHandle) ServiceGenerator() ServiceGenerator {
+	sg := h.Handle.ServiceGenerator()
+	if sg == nil {
+		return nil
+	}
+	return &timeoutServiceGenerator{handle: h, sg: sg}
+}
+
+// kill stops the plugin if it supports that.
+func (h *timeoutHandle) kill() error {
+	if k, ok := h.Handle.(killer); ok {
+		return k.Kill()
+	}
+	return nil
+}
+
+type timeoutServiceGenerator struct {
+	handle *timeoutHandle
+	sg     ServiceGenerator
+}
+
+func (sg *timeoutServiceGenerator) Handle() Handle {
+	return sg.handle
+}
+
+func (sg *timeoutServiceGenerator) Generate(req *api.GenerateServiceRequest) (*api.GenerateServiceResponse, error) {
+	type result struct {
+		res *api.GenerateServiceResponse
+		err error
+	}
+
+	// Buffered so that the goroutine doesn't leak if we time out.
+	results := make(chan result, 1)
+	go func() {
+		res, err := sg.sg.Generate(req)
+		results <- result{res: res, err: err}
+	}()
+
+	timer := time.NewTimer(sg.handle.timeout)
+	defer timer.Stop()
+
+	select {
+	case r := <-results:
+		return r.res, r.err
+	case <-timer.C:
+		return nil, multierr.Append(
+			fmt.Errorf("plugin %q timed out after %v", sg.handle.Name(), sg.handle.timeout),
+			sg.handle.kill(),
+		)
+	}
+}
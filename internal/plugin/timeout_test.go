@@ -0,0 +1,85 @@
+package plugin_test // because import cycle
+
+import (
+	"testing"
+	"time"
+
+	. "go.uber.org/thriftrw/internal/plugin"
+	"go.uber.org/thriftrw/internal/plugin/handletest"
+	"go.uber.org/thriftrw/plugin/api"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// killableHandle is a Handle that records whether it was killed.
+type killableHandle struct {
+	Handle
+
+	killed bool
+}
+
+func (h *killableHandle) Kill() error {
+	h.killed = true
+	return nil
+}
+
+func TestTimeoutHandle(t *testing.T) {
+	req := &api.GenerateServiceRequest{RootServices: []api.ServiceID{1}}
+
+	setup := func(mockCtrl *gomock.Controller) (*killableHandle, *handletest.MockServiceGenerator) {
+		sg := handletest.NewMockServiceGenerator(mockCtrl)
+		h := handletest.NewMockHandle(mockCtrl)
+		h.EXPECT().Name().Return("foo").AnyTimes()
+		h.EXPECT().ServiceGenerator().Return(sg).AnyTimes()
+		return &killableHandle{Handle: h}, sg
+	}
+
+	t.Run("success", func(t *testing.T) {
+		mockCtrl := gomock.NewController(t)
+		defer mockCtrl.Finish()
+
+		h, sg := setup(mockCtrl)
+		want := &api.GenerateServiceResponse{Files: map[string][]byte{"foo.go": {1}}}
+		sg.EXPECT().Generate(req).Return(want, nil)
+
+		handle := TimeoutHandle(h, time.Second)
+		tsg := handle.ServiceGenerator()
+		assert.Equal(t, handle, tsg.Handle())
+		assert.Equal(t, "foo", tsg.Handle().Name())
+
+		got, err := tsg.Generate(req)
+		require.NoError(t, err)
+		assert.Equal(t, want, got)
+		assert.False(t, h.killed)
+	})
+
+	t.Run("timeout", func(t *testing.T) {
+		mockCtrl := gomock.NewController(t)
+		defer mockCtrl.Finish()
+
+		unblock := make(chan struct{})
+		defer close(unblock)
+
+		h, sg := setup(mockCtrl)
+		sg.EXPECT().Generate(req).DoAndReturn(
+			func(*api.GenerateServiceRequest) (*api.GenerateServiceResponse, error) {
+				<-unblock
+				return &api.GenerateServiceResponse{}, nil
+			})
+
+		_, err := TimeoutHandle(h, 10*time.Millisecond).ServiceGenerator().Generate(req)
+		assert.EqualError(t, err, `plugin "foo" timed out after 10ms`)
+		assert.True(t, h.killed, "plugin must be killed")
+	})
+
+	t.Run("no service generator", func(t *testing.T) {
+		mockCtrl := gomock.NewController(t)
+		defer mockCtrl.Finish()
+
+		h := handletest.NewMockHandle(mockCtrl)
+		h.EXPECT().ServiceGenerator().Return(nil)
+		assert.Nil(t, TimeoutHandle(h, time.Second).ServiceGenerator())
+	})
+}
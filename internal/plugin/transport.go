@@ -55,6 +55,23 @@ func NewTransportHandle(name string, t envelope.Transport) (Handle, error) {
 		envelope.NewClient(_proto, t),
 	))
 
+	features, err := handshake(name, client)
+	if err != nil {
+		return nil, err
+	}
+
+	return &transportHandle{
+		name:      name,
+		Transport: t,
+		Client:    client,
+		Running:   atomic.NewBool(true),
+		Features:  features,
+	}, nil
+}
+
+// handshake performs a handshake with the plugin with the given name and
+// returns the features it provides.
+func handshake(name string, client api.Plugin) (map[api.Feature]struct{}, error) {
 	handshake, err := client.Handshake(&api.HandshakeRequest{})
 	if err != nil {
 		return nil, errHandshakeFailed{Name: name, Reason: err}
@@ -78,14 +95,7 @@ func NewTransportHandle(name string, t envelope.Transport) (Handle, error) {
 	for _, feature := range handshake.Features {
 		features[feature] = struct{}{}
 	}
-
-	return &transportHandle{
-		name:      name,
-		Transport: t,
-		Client:    client,
-		Running:   atomic.NewBool(true),
-		Features:  features,
-	}, nil
+	return features, nil
 }
 
 func (h *transportHandle) Name() string {
@@ -104,6 +114,29 @@ func (h *transportHandle) Close() error {
 	return err
 }
 
+// killer is implemented by transports and handles which may be stopped
+// forcefully.
+type killer interface {
+	Kill() error
+}
+
+// Kill stops the plugin without a goodbye. This is used if the plugin stopped
+// responding.
+func (h *transportHandle) Kill() error {
+	if !h.Running.Swap(false) {
+		return nil // already closed
+	}
+
+	switch t := h.Transport.(type) {
+	case killer:
+		return t.Kill()
+	case io.Closer:
+		return t.Close()
+	default:
+		return nil
+	}
+}
+
 func (h *transportHandle) ServiceGenerator() ServiceGenerator {
 	if !h.Running.Load() {
 		panic(fmt.Sprintf("handle for plugin %q has already been closed", h.name))
@@ -114,8 +147,9 @@ func (h *transportHandle) ServiceGenerator() ServiceGenerator {
 	}
 
 	return &serviceGenerator{
-		handle:  h,
-		Running: h.Running,
+		handle:   h,
+		Running:  h.Running,
+		Features: h.Features,
 		ServiceGenerator: api.NewServiceGeneratorClient(multiplex.NewClient(
 			"ServiceGenerator",
 			envelope.NewClient(_proto, h.Transport),
@@ -127,10 +161,13 @@ func (h *transportHandle) ServiceGenerator() ServiceGenerator {
 //
 // It also panics if a request is made to it after it has been closed.
 type serviceGenerator struct {
-	handle *transportHandle
+	handle Handle
 
 	ServiceGenerator api.ServiceGenerator
 	Running          *atomic.Bool
+
+	// Features provided by the plugin.
+	Features map[api.Feature]struct{}
 }
 
 func (sg *serviceGenerator) Handle() Handle {
@@ -138,7 +175,7 @@ func (sg *serviceGenerator) Handle() Handle {
 }
 
 func (sg *serviceGenerator) Generate(req *api.GenerateServiceRequest) (*api.GenerateServiceResponse, error) {
-	name := sg.handle.name
+	name := sg.handle.Name()
 	if !sg.Running.Load() {
 		panic(fmt.Sprintf("handle for plugin %q has already been closed", name))
 	}
@@ -148,13 +185,25 @@ func (sg *serviceGenerator) Generate(req *api.GenerateServiceRequest) (*api.Gene
 		return res, fmt.Errorf("plugin %q failed to generate service code: %v", name, err)
 	}
 
-	for path := range res.Files {
+	if err := checkPaths(name, res.Files); err != nil {
+		return res, err
+	}
+	if err := checkPaths(name, res.Artifacts); err != nil {
+		return res, err
+	}
+
+	return res, nil
+}
+
+// checkPaths verifies that none of the given paths written by the named
+// plugin escape their output directory.
+func checkPaths(name string, files map[string][]byte) error {
+	for path := range files {
 		if strings.Contains(path, "..") {
-			return res, fmt.Errorf(
+			return fmt.Errorf(
 				"plugin %q is attempting to write to a parent directory: "+
 					`path %q contains ".."`, name, path)
 		}
 	}
-
-	return res, nil
+	return nil
 }
@@ -329,6 +329,14 @@ func TestServiceGeneratorGenerate(t *testing.T) {
 			wantError: `plugin "foo" is attempting to write to a parent directory: ` +
 				`path "../foo/bar.go" contains ".."`,
 		},
+		{
+			desc: "artifact in parent directory",
+			generateResponse: &api.GenerateServiceResponse{
+				Artifacts: map[string][]byte{"docs/../../foo.md": []byte("# foo")},
+			},
+			wantError: `plugin "foo" is attempting to write to a parent directory: ` +
+				`path "docs/../../foo.md" contains ".."`,
+		},
 		{
 			desc:          "call error",
 			generateError: errors.New("great sadness"),
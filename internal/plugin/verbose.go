@@ -0,0 +1,107 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package plugin
+
+import (
+	"bytes"
+	"io"
+	"time"
+
+	"go.uber.org/thriftrw/plugin/api"
+)
+
+// VerboseHandle wraps a Handle to report the outcome of each request to its
+// ServiceGenerator and how long it took using logf.
+func VerboseHandle(h Handle, logf func(string, ...interface{})) Handle {
+	return &verboseHandle{Handle: h, logf: logf}
+}
+
+type verboseHandle struct {
+	Handle
+
+	logf func(string, ...interface{})
+}
+
+func (h *verboseHandle) ServiceGenerator() ServiceGenerator {
+	sg := h.Handle.ServiceGenerator()
+	if sg == nil {
+		return nil
+	}
+	return &verboseServiceGenerator{handle: h, sg: sg}
+}
+
+type verboseServiceGenerator struct {
+	handle *verboseHandle
+	sg     ServiceGenerator
+}
+
+func (sg *verboseServiceGenerator) Handle() Handle {
+	return sg.handle
+}
+
+func (sg *verboseServiceGenerator) Generate(req *api.GenerateServiceRequest) (*api.GenerateServiceResponse, error) {
+	start := time.Now()
+	res, err := sg.sg.Generate(req)
+	elapsed := time.Since(start).Round(time.Millisecond)
+
+	name := sg.handle.Name()
+	if err != nil {
+		sg.handle.logf("plugin %q failed after %v", name, elapsed)
+	} else {
+		sg.handle.logf("plugin %q generated %d files and %d artifacts in %v",
+			name, len(res.Files), len(res.Artifacts), elapsed)
+	}
+	return res, err
+}
+
+// NewPrefixWriter returns an io.Writer which writes to w, prefixing each line
+// with the given string. This is used to tell apart the output of different
+// plugins.
+func NewPrefixWriter(w io.Writer, prefix string) io.Writer {
+	return &prefixWriter{w: w, prefix: []byte(prefix), lineStart: true}
+}
+
+type prefixWriter struct {
+	w         io.Writer
+	prefix    []byte
+	lineStart bool
+}
+
+func (pw *prefixWriter) Write(b []byte) (int, error) {
+	var buff bytes.Buffer
+	for _, line := range bytes.SplitAfter(b, []byte{'\n'}) {
+		if len(line) == 0 {
+			continue
+		}
+		if pw.lineStart {
+			buff.Write(pw.prefix)
+		}
+		buff.Write(line)
+		pw.lineStart = line[len(line)-1] == '\n'
+	}
+
+	// Write everything at once so that lines written by different plugins
+	// at the same time don't get mixed up.
+	if _, err := pw.w.Write(buff.Bytes()); err != nil {
+		return 0, err
+	}
+	return len(b), nil
+}
@@ -0,0 +1,83 @@
+package plugin_test // because import cycle
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"testing"
+
+	. "go.uber.org/thriftrw/internal/plugin"
+	"go.uber.org/thriftrw/internal/plugin/handletest"
+	"go.uber.org/thriftrw/plugin/api"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVerboseHandle(t *testing.T) {
+	req := &api.GenerateServiceRequest{RootServices: []api.ServiceID{1}}
+
+	tests := []struct {
+		desc    string
+		res     *api.GenerateServiceResponse
+		err     error
+		wantLog string
+	}{
+		{
+			desc: "success",
+			res: &api.GenerateServiceResponse{
+				Files:     map[string][]byte{"a.go": {1}, "b.go": {2}},
+				Artifacts: map[string][]byte{"a.json": {3}},
+			},
+			wantLog: `plugin "foo" generated 2 files and 1 artifacts in `,
+		},
+		{
+			desc:    "failure",
+			err:     errors.New("great sadness"),
+			wantLog: `plugin "foo" failed after `,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			sg := handletest.NewMockServiceGenerator(mockCtrl)
+			sg.EXPECT().Generate(req).Return(tt.res, tt.err)
+
+			h := handletest.NewMockHandle(mockCtrl)
+			h.EXPECT().Name().Return("foo").AnyTimes()
+			h.EXPECT().ServiceGenerator().Return(sg)
+
+			var logs []string
+			handle := VerboseHandle(h, func(msg string, args ...interface{}) {
+				logs = append(logs, fmt.Sprintf(msg, args...))
+			})
+
+			vsg := handle.ServiceGenerator()
+			assert.Equal(t, handle, vsg.Handle())
+
+			res, err := vsg.Generate(req)
+			assert.Equal(t, tt.res, res)
+			assert.Equal(t, tt.err, err)
+
+			require.Len(t, logs, 1)
+			assert.Contains(t, logs[0], tt.wantLog)
+		})
+	}
+}
+
+func TestPrefixWriter(t *testing.T) {
+	var buff bytes.Buffer
+	w := NewPrefixWriter(&buff, "[foo] ")
+
+	for _, s := range []string{"hello\nwor", "ld\n", "\n", "a\nb\nc"} {
+		n, err := w.Write([]byte(s))
+		require.NoError(t, err)
+		assert.Equal(t, len(s), n)
+	}
+
+	assert.Equal(t, "[foo] hello\n[foo] world\n[foo] \n[foo] a\n[foo] b\n[foo] c", buff.String())
+}
@@ -98,3 +98,20 @@ func (c *Client) Close() error {
 	}
 	return multierr.Combine(errors...)
 }
+
+// Kill stops the process immediately without waiting for it to exit on its
+// own. Requests waiting on the process will fail.
+func (c *Client) Kill() error {
+	if !c.running.Swap(false) {
+		return nil // already stopped
+	}
+
+	if err := c.cmd.Process.Kill(); err != nil {
+		return fmt.Errorf("failed to kill %q: %v", c.cmd.Path, err)
+	}
+
+	// Wait releases the process's resources and closes our ends of its
+	// pipes. It always fails for killed processes so its error is ignored.
+	_ = c.cmd.Wait()
+	return nil
+}
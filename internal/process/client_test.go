@@ -5,6 +5,7 @@ import (
 	"os/exec"
 	"testing"
 	"testing/quick"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -42,6 +43,25 @@ func TestCloseTwice(t *testing.T) {
 	require.NoError(t, client.Close())
 }
 
+func TestKill(t *testing.T) {
+	// sleep never responds to requests.
+	client, err := NewClient(exec.Command("sleep", "60"))
+	require.NoError(t, err)
+
+	errc := make(chan error, 1)
+	go func() {
+		_, err := client.Send([]byte("hello"))
+		errc <- err
+	}()
+
+	time.Sleep(10 * time.Millisecond) // let the request start
+	require.NoError(t, client.Kill())
+	assert.Error(t, <-errc, "pending requests must fail")
+
+	require.NoError(t, client.Kill(), "kill must be idempotent")
+	require.NoError(t, client.Close(), "close after kill must be a no-op")
+}
+
 func TestStartErrors(t *testing.T) {
 	tests := []struct {
 		getCommand      func() *exec.Cmd
@@ -0,0 +1,216 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Package schema looks up types and functions in compiled Thrift modules
+// for tools that work with payloads without generated code.
+package schema
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"go.uber.org/thriftrw/ast"
+	"go.uber.org/thriftrw/compile"
+	"go.uber.org/thriftrw/internal/envelope/exception"
+	"go.uber.org/thriftrw/thriftreflect"
+	"go.uber.org/thriftrw/wire"
+)
+
+// LookupStruct looks up the struct, union, or exception with the given name
+// in the module. Types from included modules are referenced with the name
+// of the include, e.g. "shared.Point".
+func LookupStruct(m *compile.Module, name string) (*compile.StructSpec, error) {
+	mod, typeName := m, name
+	if i := strings.IndexByte(name, '.'); i >= 0 {
+		inc, ok := m.Includes[name[:i]]
+		if !ok {
+			return nil, fmt.Errorf("%q does not include a module named %q", m.ThriftPath, name[:i])
+		}
+		mod, typeName = inc.Module, name[i+1:]
+	}
+
+	t, ok := mod.Types[typeName]
+	if !ok {
+		return nil, fmt.Errorf("%q does not define a type named %q", mod.ThriftPath, typeName)
+	}
+
+	spec, ok := compile.RootTypeSpec(t).(*compile.StructSpec)
+	if !ok {
+		return nil, fmt.Errorf("%q is not a struct, union, or exception", name)
+	}
+	return spec, nil
+}
+
+// Function is a function of a service.
+type Function struct {
+	// Service through which the function was found. The function may be
+	// inherited from one of its parents.
+	Service *compile.ServiceSpec
+	Spec    *compile.FunctionSpec
+}
+
+// LookupFunction looks up a function by a name of the form
+// "Service::method". Functions inherited from parent services may be looked
+// up through the child service.
+func LookupFunction(m *compile.Module, name string) (*Function, error) {
+	i := strings.Index(name, "::")
+	if i < 0 {
+		return nil, fmt.Errorf("invalid function name %q: expected Service::method", name)
+	}
+	serviceName, method := name[:i], name[i+2:]
+
+	service, ok := m.Services[serviceName]
+	if !ok {
+		return nil, fmt.Errorf("%q does not define a service named %q", m.ThriftPath, serviceName)
+	}
+
+	for s := service; s != nil; s = s.Parent {
+		if f, ok := s.Functions[method]; ok {
+			return &Function{Service: service, Spec: f}, nil
+		}
+	}
+	return nil, fmt.Errorf("service %q does not have a function named %q", serviceName, method)
+}
+
+// FindFunction finds the function with the given name among all services
+// of the module. Multiplexed names of the form "Service:method" are
+// supported.
+//
+// Functions are found through the services that declare them, not the
+// services that inherit them. An error is returned if no service or more
+// than one service declares such a function.
+func FindFunction(m *compile.Module, method string) (*Function, error) {
+	if i := strings.IndexByte(method, ':'); i >= 0 {
+		return LookupFunction(m, method[:i]+"::"+method[i+1:])
+	}
+
+	var found []*Function
+	for _, name := range sortedServiceNames(m) {
+		service := m.Services[name]
+		if f, ok := service.Functions[method]; ok {
+			found = append(found, &Function{Service: service, Spec: f})
+		}
+	}
+
+	switch len(found) {
+	case 0:
+		return nil, fmt.Errorf("no service in %q has a function named %q", m.ThriftPath, method)
+	case 1:
+		return found[0], nil
+	default:
+		names := make([]string, len(found))
+		for i, f := range found {
+			names[i] = f.Service.Name
+		}
+		return nil, fmt.Errorf("function %q is ambiguous: it is defined by services %v",
+			method, strings.Join(names, ", "))
+	}
+}
+
+func sortedServiceNames(m *compile.Module) []string {
+	names := make([]string, 0, len(m.Services))
+	for name := range m.Services {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Name returns the name of the function in the form "Service::method".
+func (f *Function) Name() string {
+	return f.Service.Name + "::" + f.Spec.Name
+}
+
+// Declared returns the function as found through the service that declares
+// it, which may be a parent of the service through which it was found.
+func (f *Function) Declared() *Function {
+	for s := f.Service; s != nil; s = s.Parent {
+		if s.Functions[f.Spec.Name] == f.Spec {
+			return &Function{Service: s, Spec: f.Spec}
+		}
+	}
+	return f
+}
+
+// ArgsStruct returns a struct holding the arguments of the function, the
+// same way they're sent in requests.
+func (f *Function) ArgsStruct() *compile.StructSpec {
+	return &compile.StructSpec{
+		Name:   f.Service.Name + "_" + f.Spec.Name + "_Args",
+		File:   f.Service.File,
+		Type:   ast.StructType,
+		Fields: compile.FieldGroup(f.Spec.ArgsSpec),
+	}
+}
+
+// ResultStruct returns a struct holding the result of the function, the
+// same way it's sent in responses. The return value is held in a "success"
+// field with ID 0, followed by the exceptions of the function.
+//
+// Oneway functions have empty results.
+func (f *Function) ResultStruct() *compile.StructSpec {
+	var fields compile.FieldGroup
+	if res := f.Spec.ResultSpec; res != nil {
+		if res.ReturnType != nil {
+			fields = append(fields, &compile.FieldSpec{
+				ID:   0,
+				Name: "success",
+				Type: res.ReturnType,
+			})
+		}
+		fields = append(fields, res.Exceptions...)
+	}
+
+	return &compile.StructSpec{
+		Name:   f.Service.Name + "_" + f.Spec.Name + "_Result",
+		File:   f.Service.File,
+		Type:   ast.StructType,
+		Fields: fields,
+	}
+}
+
+// ApplicationException returns the TApplicationException struct held in
+// responses with the Exception envelope type.
+func ApplicationException() (*compile.StructSpec, error) {
+	s, err := thriftreflect.LookupStruct(exception.ThriftModule, "TApplicationException")
+	if err != nil {
+		return nil, err
+	}
+	return s.Spec, nil
+}
+
+// EnvelopeStruct returns the struct held in the given envelope: the
+// arguments or the result of the function named by the envelope, or a
+// TApplicationException for the Exception envelope type.
+func EnvelopeStruct(m *compile.Module, e wire.Envelope) (*compile.StructSpec, error) {
+	if e.Type == wire.Exception {
+		return ApplicationException()
+	}
+
+	f, err := FindFunction(m, e.Name)
+	if err != nil {
+		return nil, err
+	}
+	if e.Type == wire.Reply {
+		return f.ResultStruct(), nil
+	}
+	return f.ArgsStruct(), nil
+}
@@ -0,0 +1,73 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+package schema
+
+import (
+	"testing"
+
+	"go.uber.org/thriftrw/compile"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFindFunction(t *testing.T) {
+	m, err := compile.Compile("testdata/svc.thrift")
+	require.NoError(t, err)
+
+	tests := []struct {
+		method  string
+		want    string
+		wantErr string
+	}{
+		{method: "healthy", want: "Base::healthy"},
+		{method: "KeyValue:get", want: "KeyValue::get"},
+		{method: "KeyValue:healthy", want: "KeyValue::healthy"},
+		{method: "get", wantErr: `function "get" is ambiguous: it is defined by services Cache, KeyValue`},
+		{method: "put", wantErr: `has a function named "put"`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.method, func(t *testing.T) {
+			f, err := FindFunction(m, tt.method)
+			if tt.wantErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, f.Name())
+		})
+	}
+}
+
+func TestDeclared(t *testing.T) {
+	m, err := compile.Compile("testdata/svc.thrift")
+	require.NoError(t, err)
+
+	f, err := LookupFunction(m, "KeyValue::healthy")
+	require.NoError(t, err)
+	assert.Equal(t, "KeyValue_healthy_Args", f.ArgsStruct().Name)
+	assert.Equal(t, "Base::healthy", f.Declared().Name())
+
+	f, err = LookupFunction(m, "KeyValue::get")
+	require.NoError(t, err)
+	assert.Equal(t, f, f.Declared())
+}
@@ -0,0 +1,11 @@
+service Base {
+    bool healthy()
+}
+
+service KeyValue extends Base {
+    string get(1: string key)
+}
+
+service Cache extends Base {
+    string get(1: string key)
+}
@@ -0,0 +1,119 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+// Package transport reads and writes enveloped Thrift messages over
+// connections that use either the framed or the unframed transport.
+//
+// With the framed transport, each message is prefixed with its 4-byte
+// length. Unframed messages are delimited only by their contents, so they're
+// decoded as they're read.
+package transport
+
+import (
+	"io"
+
+	"go.uber.org/thriftrw/internal/frame"
+	"go.uber.org/thriftrw/protocol"
+)
+
+// Reader reads messages from a connection.
+type Reader interface {
+	// Read reads the next message. io.EOF is returned if the connection was
+	// closed before the start of a message.
+	Read() ([]byte, error)
+}
+
+// Writer writes messages to a connection.
+type Writer interface {
+	Write([]byte) error
+}
+
+// NewReader builds a Reader for messages read from r.
+func NewReader(r io.Reader, framed bool) Reader {
+	if framed {
+		return frame.NewReader(r)
+	}
+	return unframedReader{r: r}
+}
+
+// NewWriter builds a Writer for messages written to w.
+func NewWriter(w io.Writer, framed bool) Writer {
+	if framed {
+		return frame.NewWriter(w)
+	}
+	return unframedWriter{w: w}
+}
+
+type unframedReader struct {
+	r io.Reader
+}
+
+func (r unframedReader) Read() ([]byte, error) {
+	s := &streamReaderAt{r: r.r}
+
+	// Decoding the envelope reads every byte of the message, up to the end
+	// of its struct.
+	if _, err := protocol.Binary.DecodeEnveloped(s); err != nil {
+		if s.err == io.EOF && len(s.buf) == 0 {
+			return nil, io.EOF
+		}
+		if s.err != nil && s.err != io.EOF {
+			return nil, s.err // report network errors as-is
+		}
+		return nil, err
+	}
+	return s.buf, nil
+}
+
+type unframedWriter struct {
+	w io.Writer
+}
+
+func (w unframedWriter) Write(b []byte) error {
+	_, err := w.w.Write(b)
+	return err
+}
+
+// streamReaderAt adapts an io.Reader into an io.ReaderAt for a single
+// message. Bytes are read from the underlying reader only once they're
+// requested, so no bytes past the end of the message are consumed.
+type streamReaderAt struct {
+	r   io.Reader
+	buf []byte
+	err error // error from the underlying reader, if any
+}
+
+func (s *streamReaderAt) ReadAt(p []byte, off int64) (int, error) {
+	if need := off + int64(len(p)) - int64(len(s.buf)); need > 0 {
+		chunk := make([]byte, need)
+		n, err := io.ReadFull(s.r, chunk)
+		s.buf = append(s.buf, chunk[:n]...)
+		if err != nil {
+			if err == io.ErrUnexpectedEOF {
+				err = io.EOF
+			}
+			s.err = err
+			if off >= int64(len(s.buf)) {
+				return 0, err
+			}
+			return copy(p, s.buf[off:]), err
+		}
+	}
+	return copy(p, s.buf[off:]), nil
+}
@@ -0,0 +1,105 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+package transport
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+
+	"go.uber.org/thriftrw/protocol"
+	"go.uber.org/thriftrw/wire"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func envelope(t *testing.T, name string, seqID int32) []byte {
+	var buff bytes.Buffer
+	require.NoError(t, protocol.Binary.EncodeEnveloped(wire.Envelope{
+		Name:  name,
+		Type:  wire.Call,
+		SeqID: seqID,
+		Value: wire.NewValueStruct(wire.Struct{Fields: []wire.Field{
+			{ID: 1, Value: wire.NewValueList(wire.ValueListFromSlice(wire.TI32, []wire.Value{
+				wire.NewValueI32(1), wire.NewValueI32(2),
+			}))},
+		}}),
+	}, &buff))
+	return buff.Bytes()
+}
+
+func TestReadWrite(t *testing.T) {
+	msgs := [][]byte{envelope(t, "foo", 1), envelope(t, "bar", 2)}
+
+	for _, framed := range []bool{true, false} {
+		var buff bytes.Buffer
+		w := NewWriter(&buff, framed)
+		for _, m := range msgs {
+			require.NoError(t, w.Write(m))
+		}
+
+		r := NewReader(&buff, framed)
+		for _, want := range msgs {
+			got, err := r.Read()
+			require.NoError(t, err, "framed: %v", framed)
+			assert.Equal(t, want, got, "framed: %v", framed)
+		}
+
+		_, err := r.Read()
+		assert.Equal(t, io.EOF, err, "framed: %v", framed)
+	}
+}
+
+type errReader struct{ err error }
+
+func (r errReader) Read([]byte) (int, error) { return 0, r.err }
+
+func TestUnframedReadErrors(t *testing.T) {
+	msg := envelope(t, "foo", 1)
+
+	_, err := NewReader(bytes.NewReader(msg[:len(msg)-1]), false).Read()
+	assert.Equal(t, io.ErrUnexpectedEOF, err, "truncated messages must fail")
+
+	_, err = NewReader(errReader{errors.New("great sadness")}, false).Read()
+	assert.EqualError(t, err, "great sadness")
+}
+
+func TestStreamReaderAt(t *testing.T) {
+	r := &streamReaderAt{r: strings.NewReader("hello world")}
+
+	buf := make([]byte, 5)
+	n, err := r.ReadAt(buf, 6)
+	require.NoError(t, err)
+	assert.Equal(t, "world", string(buf[:n]))
+
+	n, err = r.ReadAt(buf, 0)
+	require.NoError(t, err)
+	assert.Equal(t, "hello", string(buf[:n]))
+
+	n, err = r.ReadAt(buf, 9)
+	assert.Equal(t, io.EOF, err)
+	assert.Equal(t, "ld", string(buf[:n]))
+
+	_, err = r.ReadAt(buf, 20)
+	assert.Equal(t, io.EOF, err)
+}
@@ -28,6 +28,7 @@ import (
 	"os"
 	"path/filepath"
 	"strings"
+	"time"
 
 	"go.uber.org/thriftrw/compile"
 	"go.uber.org/thriftrw/gen"
@@ -46,11 +47,17 @@ type options struct {
 
 type genOptions struct {
 	OutputDirectory string `long:"out" short:"o" value-name:"DIR" description:"Directory to which the generated files will be written."`
-	PackagePrefix   string `long:"pkg-prefix" value-name:"PREFIX" description:"Prefix for import paths of generated module. By default, this is based on the output directory's location relative to $GOPATH."`
-	ThriftRoot      string `long:"thrift-root" value-name:"DIR" description:"Directory whose descendants contain all Thrift files. The structure of the generated Go packages mirrors the paths to the Thrift files relative to this directory. By default, this is the deepest common ancestor directory of the Thrift files."`
-
-	NoRecurse bool         `long:"no-recurse" description:"Don't generate code for included Thrift files."`
-	Plugins   plugin.Flags `long:"plugin" short:"p" value-name:"PLUGIN" description:"Code generation plugin for ThriftRW. This option may be provided multiple times to apply multiple plugins."`
+	ArtifactDir     string `long:"artifact-out" value-name:"DIR" description:"Directory to which non-Go artifacts produced by plugins will be written. Defaults to the output directory."`
+	PackagePrefix   string `long:"pkg-prefix" value-name:"PREFIX" description:"Prefix for import paths of generated module. By default, this is based on the output directory's location relative to the enclosing Go module or $GOPATH."`
+	ThriftRoot      string `long:"thrift-root" value-name:"DIR" description:"Directory whose descendants contain all Thrift files. The structure of the generated Go packages mirrors the paths to the Thrift files relative to this directory, unless --use-go-namespace is used. By default, this is the deepest common ancestor directory of the Thrift files."`
+
+	NoRecurse     bool          `long:"no-recurse" description:"Don't generate code for included Thrift files."`
+	ImportPaths   []string      `long:"map" value-name:"FILE=IMPORTPATH" description:"Use the Go package at IMPORTPATH for the Thrift file FILE instead of generating code for it. Use this for included Thrift files whose code is already generated elsewhere. This option may be provided multiple times."`
+	Plugins       plugin.Flags  `long:"plugin" short:"p" value-name:"PLUGIN" description:"Code generation plugin for ThriftRW. This option may be provided multiple times to apply multiple plugins."`
+	PluginTimeout time.Duration `long:"plugin-timeout" value-name:"DURATION" description:"Maximum time each plugin may take to generate code, e.g. 30s. Plugins that take longer are stopped. By default, there is no limit."`
+	Verbose       bool          `long:"verbose" description:"Report how long each plugin took, and prefix output from plugins with their names."`
+	Manifest      bool          `long:"manifest" description:"Record the generated files in a manifest in each output directory, and remove files generated by a previous run that are no longer generated. Only files with a 'Code generated by thriftrw' header are removed."`
+	DryRun        bool          `long:"dry-run" description:"List the files that would be written or removed without changing anything."`
 
 	GeneratePluginAPI bool   `long:"generate-plugin-api" hidden:"true" description:"Generates code for the plugin API"`
 	NoVersionCheck    bool   `long:"no-version-check" hidden:"true" description:"Does not add library version checks to generated code."`
@@ -58,8 +65,11 @@ type genOptions struct {
 	NoConstants       bool   `long:"no-constants" description:"Do not generate code for const declarations."`
 	NoServiceHelpers  bool   `long:"no-service-helpers" description:"Do not generate service helpers."`
 	NoEmbedIDL        bool   `long:"no-embed-idl" description:"Do not embed IDLs into the generated code."`
+	UseGoNamespace    bool   `long:"use-go-namespace" description:"Generate the Go packages for Thrift files which declare a 'namespace go' in the package named by it instead of the one implied by their location relative to --thrift-root. A go.package annotation on the namespace specifies the full import path. It is an error for such a Thrift file to declare more than one 'namespace go'."`
+	RegisterIDL       bool   `long:"register-idl" description:"Register the embedded IDLs with the thriftreflect registry when the generated packages are initialized. Has no effect with --no-embed-idl."`
 	NoZap             bool   `long:"no-zap" description:"Do not generate code for Zap logging."`
 	OutputFile        string `long:"output-file" value-name:"FILENAME" description:"Generates a single .go file as an output. Specifying an OutputFile prevents code generation for included Thrift Files."`
+	WarnDeprecated    bool   `long:"warn-deprecated" description:"Print a warning for each reference from a definition that isn't deprecated to one that is."`
 
 	// TODO(abg): Detailed help with examples of --thrift-root, --pkg-prefix,
 	// and --plugin
@@ -118,6 +128,13 @@ func do() (err error) {
 		return fmt.Errorf("Unable to resolve absolute path for %q: %v", gopts.OutputDirectory, err)
 	}
 
+	if gopts.ArtifactDir != "" {
+		gopts.ArtifactDir, err = filepath.Abs(gopts.ArtifactDir)
+		if err != nil {
+			return fmt.Errorf("Unable to resolve absolute path for %q: %v", gopts.ArtifactDir, err)
+		}
+	}
+
 	if gopts.PackagePrefix == "" {
 		gopts.PackagePrefix, err = determinePackagePrefix(gopts.OutputDirectory)
 		if err != nil {
@@ -128,15 +145,31 @@ func do() (err error) {
 		}
 	}
 
-	module, err := compile.Compile(inputFile)
+	importPaths, err := parseImportPaths(gopts.ImportPaths)
+	if err != nil {
+		return err
+	}
+
+	var compileOpts []compile.Option
+	if gopts.UseGoNamespace {
+		compileOpts = append(compileOpts, compile.UniqueNamespace("go"))
+	}
+
+	module, err := compile.Compile(inputFile, compileOpts...)
 	if err != nil {
 		// TODO(abg): For nested compile errors, split causal chain across
 		// multiple lines.
 		return fmt.Errorf("Failed to compile %q: %+v", inputFile, err)
 	}
 
+	if gopts.WarnDeprecated {
+		for _, ref := range compile.FindDeprecatedReferences(module) {
+			log.Printf("warning: %v", ref)
+		}
+	}
+
 	if gopts.ThriftRoot == "" {
-		gopts.ThriftRoot, err = findCommonAncestor(module)
+		gopts.ThriftRoot, err = findCommonAncestor(module, importPaths)
 		if err != nil {
 			return fmt.Errorf(
 				"Could not find a common parent directory for %q and the Thrift files "+
@@ -149,7 +182,7 @@ func do() (err error) {
 		if err != nil {
 			return fmt.Errorf("Unable to resolve absolute path for %q: %v", gopts.ThriftRoot, err)
 		}
-		if err := verifyAncestry(module, gopts.ThriftRoot); err != nil {
+		if err := verifyAncestry(module, gopts.ThriftRoot, importPaths); err != nil {
 			return fmt.Errorf(
 				"An included Thrift file is not contained in the %q directory tree: %v",
 				gopts.ThriftRoot, err)
@@ -160,11 +193,28 @@ func do() (err error) {
 		return fmt.Errorf("output-file value: %q invalid. A {FILENAME}.go name must be provided", gopts.OutputFile)
 	}
 
+	if gopts.Verbose {
+		for i := range gopts.Plugins {
+			p := &gopts.Plugins[i]
+			p.Command.Stderr = plugin.NewPrefixWriter(os.Stderr, fmt.Sprintf("[%v] ", p.Name))
+		}
+	}
+
 	pluginHandle, err := gopts.Plugins.Handle()
 	if err != nil {
 		return fmt.Errorf("Failed to initialize plugins: %+v", err)
 	}
 
+	for i, h := range pluginHandle {
+		if gopts.PluginTimeout > 0 {
+			h = plugin.TimeoutHandle(h, gopts.PluginTimeout)
+		}
+		if gopts.Verbose {
+			h = plugin.VerboseHandle(h, log.Printf)
+		}
+		pluginHandle[i] = h
+	}
+
 	if gopts.GeneratePluginAPI {
 		pluginHandle = append(pluginHandle, pluginapigen.Handle)
 	}
@@ -181,26 +231,43 @@ func do() (err error) {
 		PackagePrefix:    gopts.PackagePrefix,
 		ThriftRoot:       gopts.ThriftRoot,
 		NoRecurse:        gopts.NoRecurse,
+		UseGoNamespace:   gopts.UseGoNamespace,
+		ImportPaths:      importPaths,
 		NoVersionCheck:   gopts.NoVersionCheck,
 		Plugin:           codeGenerator,
 		NoTypes:          gopts.NoTypes,
 		NoConstants:      gopts.NoConstants,
 		NoServiceHelpers: gopts.NoServiceHelpers || gopts.NoTypes,
 		NoEmbedIDL:       gopts.NoEmbedIDL,
+		RegisterIDL:      gopts.RegisterIDL,
 		NoZap:            gopts.NoZap,
 		OutputFile:       gopts.OutputFile,
+		ArtifactDir:      gopts.ArtifactDir,
+		Manifest:         gopts.Manifest,
+		DryRun:           gopts.DryRun,
+		DryRunOutput:     os.Stdout,
 	}
+	start := time.Now()
 	if err := gen.Generate(module, &generatorOptions); err != nil {
 		return fmt.Errorf("Failed to generate code: %+v", err)
 	}
+	if gopts.Verbose {
+		log.Printf("generated code in %v", time.Since(start).Round(time.Millisecond))
+	}
 	return nil
 }
 
 // verifyAncestry verifies that the Thrift file for the given module and the
 // Thrift files for all imported modules are contained within the directory
 // tree rooted at the given path.
-func verifyAncestry(m *compile.Module, root string) error {
-	return m.Walk(func(m *compile.Module) error {
+func verifyAncestry(m *compile.Module, root string, importPaths map[string]string) error {
+	return gen.WalkModules(m, importPaths, func(m *compile.Module) error {
+		// Code is not generated for mapped modules so they do not have to
+		// be inside the Thrift root.
+		if _, ok := importPaths[m.ThriftPath]; ok {
+			return nil
+		}
+
 		path, err := filepath.Rel(root, m.ThriftPath)
 		if err != nil {
 			return fmt.Errorf(
@@ -217,13 +284,44 @@ func verifyAncestry(m *compile.Module, root string) error {
 	})
 }
 
+// parseImportPaths parses the FILE=IMPORTPATH pairs given with --map into a
+// map from absolute paths to Thrift files to import paths.
+func parseImportPaths(args []string) (map[string]string, error) {
+	if len(args) == 0 {
+		return nil, nil
+	}
+
+	importPaths := make(map[string]string, len(args))
+	for _, arg := range args {
+		i := strings.LastIndex(arg, "=")
+		if i <= 0 || i == len(arg)-1 {
+			return nil, fmt.Errorf("invalid --map %q: expected FILE=IMPORTPATH", arg)
+		}
+
+		file, err := filepath.Abs(arg[:i])
+		if err != nil {
+			return nil, fmt.Errorf("Unable to resolve absolute path for %q: %v", arg[:i], err)
+		}
+
+		if other, ok := importPaths[file]; ok {
+			return nil, fmt.Errorf("invalid --map %q: %q is already mapped to %q", arg, file, other)
+		}
+		importPaths[file] = arg[i+1:]
+	}
+	return importPaths, nil
+}
+
 // findCommonAncestor finds the deepest common ancestor for the given module
 // and all modules imported by it.
-func findCommonAncestor(m *compile.Module) (string, error) {
+func findCommonAncestor(m *compile.Module, importPaths map[string]string) (string, error) {
 	var result []string
 	var lastString string
 
-	err := m.Walk(func(m *compile.Module) error {
+	err := gen.WalkModules(m, importPaths, func(m *compile.Module) error {
+		if _, ok := importPaths[m.ThriftPath]; ok {
+			return nil
+		}
+
 		thriftPath := m.ThriftPath
 		if !filepath.IsAbs(thriftPath) {
 			return fmt.Errorf(
@@ -273,11 +371,25 @@ func commonPrefix(l, r []string) []string {
 // determinePackagePrefix determines the package prefix for Go packages
 // generated in this file.
 //
+// If dir is inside a Go module, the prefix is the import path of dir in
+// that module. Otherwise, dir must be inside $GOPATH/src. Module lookup is
+// skipped if GO111MODULE is set to off.
+//
 // dir must be an absolute path.
 func determinePackagePrefix(dir string) (string, error) {
+	if os.Getenv("GO111MODULE") != "off" {
+		root, modulePath, err := findModule(dir)
+		if err != nil {
+			return "", err
+		}
+		if root != "" {
+			return modulePackagePath(root, modulePath, dir)
+		}
+	}
+
 	gopathList := os.Getenv("GOPATH")
 	if gopathList == "" {
-		return "", errors.New("$GOPATH is not set")
+		return "", fmt.Errorf("directory %q is not inside a Go module and $GOPATH is not set", dir)
 	}
 
 	for _, gopath := range filepath.SplitList(gopathList) {
@@ -288,9 +400,9 @@ func determinePackagePrefix(dir string) (string, error) {
 
 		// The match is valid only if it's within the directory tree.
 		if !strings.HasPrefix(packagePath, "..") {
-			return packagePath, nil
+			return filepath.ToSlash(packagePath), nil
 		}
 	}
 
-	return "", fmt.Errorf("directory %q is not inside $GOPATH/src", dir)
+	return "", fmt.Errorf("directory %q is not inside a Go module or $GOPATH/src", dir)
 }
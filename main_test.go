@@ -59,7 +59,7 @@ func TestDeterminePackagePrefix(t *testing.T) {
 			desc:   "not inside GOPATH",
 			gopath: gopath,
 			dir:    filepath.Join(gopath2, "src/go.uber.org/yarpc"),
-			errMsg: "not inside $GOPATH",
+			errMsg: "is not inside a Go module or $GOPATH/src",
 		},
 		{
 			desc:   "GOPATH is set",
@@ -109,6 +109,120 @@ func TestDeterminePackagePrefix(t *testing.T) {
 	}
 }
 
+func TestDeterminePackagePrefixModules(t *testing.T) {
+	dir, err := ioutil.TempDir("", "thriftrw-main-test")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	writeFile := func(path, contents string) {
+		path = filepath.Join(dir, path)
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0755))
+		require.NoError(t, ioutil.WriteFile(path, []byte(contents), 0644))
+	}
+
+	writeFile("foo/go.mod", "module example.com/foo\n\ngo 1.13\n")
+	writeFile("foo/nested/go.mod", "// Nested module.\nmodule \"example.com/foo/v2\" // comment\n")
+	writeFile("bad/go.mod", "go 1.13\n")
+
+	tests := []struct {
+		desc   string
+		dir    string // directory we're checking
+		gopath string // GOPATH to use
+		result string // expected package prefix
+		errMsg string // error message, if any
+	}{
+		{
+			desc:   "module root",
+			dir:    filepath.Join(dir, "foo"),
+			result: "example.com/foo",
+		},
+		{
+			desc:   "inside module",
+			dir:    filepath.Join(dir, "foo/internal/gen"),
+			result: "example.com/foo/internal/gen",
+		},
+		{
+			desc:   "nested module",
+			dir:    filepath.Join(dir, "foo/nested/gen"),
+			result: "example.com/foo/v2/gen",
+		},
+		{
+			desc:   "module takes precedence over GOPATH",
+			dir:    filepath.Join(dir, "foo/gen"),
+			gopath: dir,
+			result: "example.com/foo/gen",
+		},
+		{
+			desc:   "invalid go.mod",
+			dir:    filepath.Join(dir, "bad/gen"),
+			errMsg: "module directive not found",
+		},
+		{
+			desc:   "outside any module",
+			dir:    filepath.Join(dir, "bar/gen"),
+			gopath: filepath.Join(dir, "gopath"),
+			errMsg: "is not inside a Go module or $GOPATH/src",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			oldGopath := os.Getenv("GOPATH")
+			require.NoError(t, os.Setenv("GOPATH", tt.gopath))
+			defer os.Setenv("GOPATH", oldGopath)
+
+			result, err := determinePackagePrefix(tt.dir)
+			if tt.errMsg != "" {
+				if assert.Error(t, err, "determinePackagePrefix(%q) should fail", tt.dir) {
+					assert.Contains(t, err.Error(), tt.errMsg)
+				}
+				return
+			}
+
+			if assert.NoError(t, err) {
+				assert.Equal(t, tt.result, result)
+			}
+		})
+	}
+}
+
+func TestParseModulePath(t *testing.T) {
+	tests := []struct {
+		desc   string
+		gomod  string
+		result string
+		errMsg string
+	}{
+		{desc: "simple", gomod: "module foo\n", result: "foo"},
+		{desc: "quoted", gomod: `module "example.com/foo"`, result: "example.com/foo"},
+		{desc: "raw quoted", gomod: "module `example.com/foo`", result: "example.com/foo"},
+		{
+			desc:   "after other directives",
+			gomod:  "// comment\n\ngo 1.13\nmodule example.com/foo // trailing\n",
+			result: "example.com/foo",
+		},
+		{desc: "missing", gomod: "go 1.13\n", errMsg: "module directive not found"},
+		{desc: "too many fields", gomod: "module foo bar\n", errMsg: "invalid module directive"},
+		{desc: "bad quotes", gomod: `module "foo`, errMsg: "invalid module path"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			result, err := parseModulePath([]byte(tt.gomod))
+			if tt.errMsg != "" {
+				if assert.Error(t, err) {
+					assert.Contains(t, err.Error(), tt.errMsg)
+				}
+				return
+			}
+
+			if assert.NoError(t, err) {
+				assert.Equal(t, tt.result, result)
+			}
+		})
+	}
+}
+
 func TestCommonPrefix(t *testing.T) {
 	tests := []struct {
 		left     []string
@@ -172,10 +286,11 @@ func TestVerifyAncestry(t *testing.T) {
 		}
 
 	tests := []struct {
-		desc   string
-		module *compile.Module
-		root   string
-		errMsg string
+		desc        string
+		module      *compile.Module
+		root        string
+		importPaths map[string]string
+		errMsg      string
 	}{
 		{
 			desc: "success without includes",
@@ -241,10 +356,37 @@ func TestVerifyAncestry(t *testing.T) {
 			root:   "/tmp/service",
 			errMsg: `"/tmp/service2/bar.thrift" is not contained in the "/tmp/service" directory`,
 		},
+		{
+			desc: "success with mapped include outside root",
+			module: &compile.Module{
+				Name:       "foo",
+				ThriftPath: "/tmp/service/foo.thrift",
+				Includes: map[string]*compile.IncludedModule{
+					"bar": {
+						Name: "bar",
+						Module: &compile.Module{
+							Name:       "bar",
+							ThriftPath: "/tmp/service2/bar.thrift",
+							Includes: map[string]*compile.IncludedModule{
+								"baz": {
+									Name: "baz",
+									Module: &compile.Module{
+										Name:       "baz",
+										ThriftPath: "/tmp/service3/baz.thrift",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			root:        "/tmp/service",
+			importPaths: map[string]string{"/tmp/service2/bar.thrift": "example.com/service2/bar"},
+		},
 	}
 
 	for _, tt := range tests {
-		err := verifyAncestry(tt.module, tt.root)
+		err := verifyAncestry(tt.module, tt.root, tt.importPaths)
 		if tt.errMsg != "" {
 			if assert.Error(t, err, tt.desc) {
 				assert.Contains(t, err.Error(), tt.errMsg, tt.desc)
@@ -278,10 +420,11 @@ func TestFindCommonAncestor(t *testing.T) {
 		}
 
 	tests := []struct {
-		desc     string
-		module   *compile.Module
-		expected string
-		errMsg   string
+		desc        string
+		module      *compile.Module
+		importPaths map[string]string
+		expected    string
+		errMsg      string
 	}{
 		{
 			desc: "success: no includes",
@@ -422,10 +565,37 @@ func TestFindCommonAncestor(t *testing.T) {
 			},
 			errMsg: `"/home/thriftrw/common/shared.thrift" does not share an ancestor with "/tmp/service/foo.thrift"`,
 		},
+		{
+			desc: "success: mapped include in different tree",
+			module: &compile.Module{
+				Name:       "foo",
+				ThriftPath: "/tmp/service/foo.thrift",
+				Includes: map[string]*compile.IncludedModule{
+					"bar": {
+						Name: "bar",
+						Module: &compile.Module{
+							Name:       "bar",
+							ThriftPath: "/home/thriftrw/common/shared.thrift",
+						},
+					},
+					"baz": {
+						Name: "baz",
+						Module: &compile.Module{
+							Name:       "baz",
+							ThriftPath: "/tmp/service/common/baz.thrift",
+						},
+					},
+				},
+			},
+			importPaths: map[string]string{
+				"/home/thriftrw/common/shared.thrift": "example.com/common/shared",
+			},
+			expected: "/tmp/service",
+		},
 	}
 
 	for _, tt := range tests {
-		got, err := findCommonAncestor(tt.module)
+		got, err := findCommonAncestor(tt.module, tt.importPaths)
 		if tt.errMsg != "" {
 			if assert.Error(t, err, "expected failure for %q but got: %v", tt.desc, got) {
 				assert.Contains(t, err.Error(), tt.errMsg, tt.desc)
@@ -437,3 +607,59 @@ func TestFindCommonAncestor(t *testing.T) {
 		}
 	}
 }
+
+func TestParseImportPaths(t *testing.T) {
+	wd, err := os.Getwd()
+	require.NoError(t, err)
+
+	tests := []struct {
+		desc    string
+		give    []string
+		want    map[string]string
+		wantErr string
+	}{
+		{desc: "empty"},
+		{
+			desc: "relative and absolute",
+			give: []string{
+				"idl/shared.thrift=example.com/shared/gen/shared",
+				"/src/common.thrift=example.com/common",
+			},
+			want: map[string]string{
+				filepath.Join(wd, "idl/shared.thrift"): "example.com/shared/gen/shared",
+				"/src/common.thrift":                   "example.com/common",
+			},
+		},
+		{
+			desc:    "missing import path",
+			give:    []string{"shared.thrift="},
+			wantErr: `invalid --map "shared.thrift=": expected FILE=IMPORTPATH`,
+		},
+		{
+			desc:    "missing file",
+			give:    []string{"example.com/shared"},
+			wantErr: `invalid --map "example.com/shared": expected FILE=IMPORTPATH`,
+		},
+		{
+			desc: "duplicate",
+			give: []string{
+				"/src/common.thrift=example.com/common",
+				"/src/../src/common.thrift=example.com/other",
+			},
+			wantErr: `"/src/common.thrift" is already mapped to "example.com/common"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			got, err := parseImportPaths(tt.give)
+			if tt.wantErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,45 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+// Package mockserver provides a fake Thrift server for integration tests,
+// built from just a Thrift file.
+//
+// The server answers calls to the functions of the services defined in the
+// compiled module. Responses come from fixtures configured for each
+// function, optionally matched against the arguments of the call. Calls
+// that don't match any fixture are answered with synthesized values.
+//
+// 	srv := mockserver.New(module, mockserver.Options{})
+// 	err := srv.Add("KeyValue::getItem", mockserver.Fixture{
+// 		Args:   map[string]interface{}{"key": "foo"},
+// 		Result: map[string]interface{}{"key": "foo", "status": "STALE"},
+// 	})
+// 	if err := srv.Start("127.0.0.1:0"); err != nil {
+// 		return err
+// 	}
+// 	defer srv.Stop()
+//
+// 	// Point the client under test at srv.Addr().
+//
+// Every call received by the server is recorded, and may be retrieved with
+// Calls for later assertions.
+//
+// Values in fixtures and recorded calls use the representation of
+// dynamic.Struct.ToMap, which matches that of encoding/json.
+package mockserver
@@ -0,0 +1,214 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+package mockserver
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+
+	"go.uber.org/thriftrw/compile"
+	"go.uber.org/thriftrw/dynamic"
+	"go.uber.org/thriftrw/internal/schema"
+)
+
+// Fixture is a configured response to calls to a function.
+type Fixture struct {
+	// Args matches the arguments of the call. Only the arguments present
+	// here are compared, and objects match objects that have at least the
+	// given entries. A fixture without arguments matches all calls.
+	//
+	// Arguments must be valid values of their types, so structs here must
+	// include their required fields.
+	Args map[string]interface{} `json:"args,omitempty"`
+
+	// Result is the return value of the function. This must be empty for
+	// void functions.
+	Result interface{} `json:"result,omitempty"`
+
+	// Exception is the declared exception raised by the function, keyed by
+	// the name of the exception in the function's throws clause. If set,
+	// Result must be empty.
+	Exception map[string]interface{} `json:"exception,omitempty"`
+}
+
+// Fixtures is a list of fixtures for each function, keyed by names of the
+// form "Service::method".
+type Fixtures map[string][]Fixture
+
+// LoadFixtures reads fixtures from a JSON object of the form,
+//
+// 	{
+// 	  "KeyValue::getItem": [
+// 	    {"args": {"key": "foo"}, "result": {"key": "foo"}},
+// 	    {"exception": {"notFound": {"key": "bar"}}}
+// 	  ]
+// 	}
+func LoadFixtures(r io.Reader) (Fixtures, error) {
+	dec := json.NewDecoder(r)
+	dec.UseNumber() // so that large i64 values aren't rounded
+
+	var f Fixtures
+	if err := dec.Decode(&f); err != nil {
+		return nil, fmt.Errorf("could not decode fixtures: %v", err)
+	}
+	return f, nil
+}
+
+// fixture is a Fixture validated against its function.
+type fixture struct {
+	args   map[string]interface{} // normalized
+	result *dynamic.Struct
+}
+
+func newFixture(f *schema.Function, fx Fixture) (*fixture, error) {
+	if f.Spec.OneWay {
+		return nil, errors.New("oneway functions do not have responses")
+	}
+
+	for name := range fx.Args {
+		if _, err := compile.FieldGroup(f.Spec.ArgsSpec).FindByName(name); err != nil {
+			return nil, fmt.Errorf("unknown argument %q", name)
+		}
+	}
+
+	args, err := fixtureArgs(f, fx)
+	if err != nil {
+		return nil, fmt.Errorf("invalid arguments: %v", err)
+	}
+
+	result, err := fixtureResult(f, fx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &fixture{args: args, result: result}, nil
+}
+
+// fixtureArgs converts the arguments of the fixture into the form in which
+// arguments of calls are matched against them. This fails if the arguments
+// don't fit the function, rather than never matching any call.
+func fixtureArgs(f *schema.Function, fx Fixture) (map[string]interface{}, error) {
+	s, err := dynamic.FromMap(f.ArgsStruct(), fx.Args)
+	if err != nil {
+		return nil, err
+	}
+
+	// Only the arguments present in the fixture are compared, so drop those
+	// that FromMap filled in with defaults.
+	m := s.ToMap()
+	for name := range m {
+		if _, ok := fx.Args[name]; !ok {
+			delete(m, name)
+		}
+	}
+
+	args, err := normalize(m)
+	if err != nil {
+		return nil, err
+	}
+	normalized, _ := args.(map[string]interface{})
+	return normalized, nil
+}
+
+func fixtureResult(f *schema.Function, fx Fixture) (*dynamic.Struct, error) {
+	fields := make(map[string]interface{})
+	switch {
+	case len(fx.Exception) > 0:
+		if fx.Result != nil {
+			return nil, errors.New("fixtures cannot have both a result and an exception")
+		}
+		if len(fx.Exception) != 1 {
+			return nil, fmt.Errorf("expected exactly one exception, got %v", len(fx.Exception))
+		}
+		for name, v := range fx.Exception {
+			if _, err := f.Spec.ResultSpec.Exceptions.FindByName(name); err != nil {
+				return nil, fmt.Errorf("%v does not declare an exception named %q", f.Name(), name)
+			}
+			fields[name] = v
+		}
+	case f.Spec.ResultSpec.ReturnType == nil:
+		if fx.Result != nil {
+			return nil, fmt.Errorf("%v does not return a value", f.Name())
+		}
+	default:
+		if fx.Result == nil {
+			return nil, errors.New("expected a result or an exception")
+		}
+		fields["success"] = fx.Result
+	}
+
+	return dynamic.FromMap(f.ResultStruct(), fields)
+}
+
+// matches reports whether the given normalized arguments match the
+// fixture.
+func (fx *fixture) matches(args map[string]interface{}) bool {
+	return matches(fx.args, args)
+}
+
+func matches(want, got interface{}) bool {
+	switch w := want.(type) {
+	case map[string]interface{}:
+		g, ok := got.(map[string]interface{})
+		if !ok {
+			return false
+		}
+		for k, v := range w {
+			if !matches(v, g[k]) {
+				return false
+			}
+		}
+		return true
+	case []interface{}:
+		g, ok := got.([]interface{})
+		if !ok || len(g) != len(w) {
+			return false
+		}
+		for i := range w {
+			if !matches(w[i], g[i]) {
+				return false
+			}
+		}
+		return true
+	default:
+		return reflect.DeepEqual(want, got)
+	}
+}
+
+// normalize converts a value into the form it would have if it were decoded
+// from JSON, so that values built in Go may be compared with values decoded
+// from fixture files.
+func normalize(v interface{}) (interface{}, error) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(b))
+	dec.UseNumber()
+
+	var out interface{}
+	err = dec.Decode(&out)
+	return out, err
+}
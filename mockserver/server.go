@@ -0,0 +1,406 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+package mockserver
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"math/rand"
+	"net"
+	"sort"
+	"sync"
+
+	"go.uber.org/thriftrw/compile"
+	"go.uber.org/thriftrw/dynamic"
+	"go.uber.org/thriftrw/internal/envelope/exception"
+	"go.uber.org/thriftrw/internal/schema"
+	"go.uber.org/thriftrw/internal/transport"
+	"go.uber.org/thriftrw/protocol"
+	"go.uber.org/thriftrw/wire"
+)
+
+// Fallback determines how calls that don't match any fixture are answered.
+type Fallback int
+
+const (
+	// FallbackDefault answers with the default value of the return type.
+	// Structs hold the default values of their fields, and their required
+	// fields are filled in with zero values.
+	FallbackDefault Fallback = iota
+
+	// FallbackRandom answers with random values of the return type.
+	FallbackRandom
+
+	// FallbackError answers with a TApplicationException.
+	FallbackError
+)
+
+// Options configures a Server.
+type Options struct {
+	// Unframed serves the unframed transport instead of the framed one.
+	Unframed bool
+
+	// Fallback determines how calls that don't match any fixture are
+	// answered.
+	Fallback Fallback
+
+	// Seed seeds the values synthesized with FallbackRandom.
+	Seed int64
+
+	// OnCall, if set, is called with every call received by the server.
+	OnCall func(Call)
+}
+
+// Call is a call received by the server.
+type Call struct {
+	// Method is the name of the function in the form "Service::method",
+	// where Service is the service that declares the function.
+	Method string `json:"method"`
+
+	SeqID int32 `json:"seqid"`
+
+	// Args holds the arguments of the call, in the representation of
+	// dynamic.Struct.ToMap.
+	Args map[string]interface{} `json:"args"`
+}
+
+// Server is a fake Thrift server for the services of a Thrift module.
+type Server struct {
+	module *compile.Module
+	opts   Options
+
+	mu       sync.Mutex
+	fixtures map[string][]*fixture // keyed by Service::method
+	calls    []Call
+	synth    synthesizer
+	ln       net.Listener
+	conns    map[net.Conn]struct{}
+	stopped  bool
+
+	wg sync.WaitGroup
+}
+
+// New builds a new Server for the services of the given module.
+func New(m *compile.Module, opts Options) *Server {
+	s := &Server{
+		module:   m,
+		opts:     opts,
+		fixtures: make(map[string][]*fixture),
+		conns:    make(map[net.Conn]struct{}),
+	}
+	if opts.Fallback == FallbackRandom {
+		s.synth.rand = rand.New(rand.NewSource(opts.Seed))
+	}
+	return s
+}
+
+// Add adds fixtures for the given function, named in the form
+// "Service::method". Fixtures are matched in the order in which they were
+// added.
+func (s *Server) Add(method string, fixtures ...Fixture) error {
+	f, err := schema.LookupFunction(s.module, method)
+	if err != nil {
+		return err
+	}
+
+	fs := make([]*fixture, len(fixtures))
+	for i, fx := range fixtures {
+		fs[i], err = newFixture(f, fx)
+		if err != nil {
+			return fmt.Errorf("invalid fixture %v for %v: %v", i, method, err)
+		}
+	}
+
+	name := f.Declared().Name()
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.fixtures[name] = append(s.fixtures[name], fs...)
+	return nil
+}
+
+// AddFixtures adds fixtures for multiple functions.
+func (s *Server) AddFixtures(fixtures Fixtures) error {
+	methods := make([]string, 0, len(fixtures))
+	for method := range fixtures {
+		methods = append(methods, method)
+	}
+	sort.Strings(methods)
+
+	for _, method := range methods {
+		if err := s.Add(method, fixtures[method]...); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Start starts serving on a new listener for the given TCP address in the
+// background.
+func (s *Server) Start(addr string) error {
+	ln, err := net.Listen("tcp", addr)
+	if err != nil {
+		return err
+	}
+	if err := s.setListener(ln); err != nil {
+		ln.Close()
+		return err
+	}
+
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+		s.accept(ln)
+	}()
+	return nil
+}
+
+// Serve serves connections from the given listener until Stop is called.
+func (s *Server) Serve(ln net.Listener) error {
+	if err := s.setListener(ln); err != nil {
+		return err
+	}
+	return s.accept(ln)
+}
+
+func (s *Server) setListener(ln net.Listener) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	switch {
+	case s.stopped:
+		return errors.New("server was stopped")
+	case s.ln != nil:
+		return errors.New("server is already serving")
+	}
+	s.ln = ln
+	return nil
+}
+
+// Addr returns the address the server is listening on, or nil if it isn't
+// serving yet.
+func (s *Server) Addr() net.Addr {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.ln == nil {
+		return nil
+	}
+	return s.ln.Addr()
+}
+
+// Stop stops the server and closes all open connections.
+func (s *Server) Stop() error {
+	s.mu.Lock()
+	s.stopped = true
+	var err error
+	if s.ln != nil {
+		err = s.ln.Close()
+	}
+	for conn := range s.conns {
+		conn.Close()
+	}
+	s.mu.Unlock()
+
+	s.wg.Wait()
+	return err
+}
+
+// Calls returns the calls received by the server so far, in the order in
+// which they were received.
+func (s *Server) Calls() []Call {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]Call(nil), s.calls...)
+}
+
+// CallsTo returns the calls to the given function, named in the form
+// "Service::method", received by the server so far. Inherited functions may
+// be named through the services that inherit them.
+func (s *Server) CallsTo(method string) []Call {
+	if f, err := schema.LookupFunction(s.module, method); err == nil {
+		method = f.Declared().Name()
+	}
+
+	var calls []Call
+	for _, c := range s.Calls() {
+		if c.Method == method {
+			calls = append(calls, c)
+		}
+	}
+	return calls
+}
+
+// ResetCalls forgets the calls received by the server so far.
+func (s *Server) ResetCalls() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.calls = nil
+}
+
+func (s *Server) accept(ln net.Listener) error {
+	for {
+		conn, err := ln.Accept()
+		if err != nil {
+			s.mu.Lock()
+			stopped := s.stopped
+			s.mu.Unlock()
+
+			if stopped {
+				return nil
+			}
+			return err
+		}
+
+		s.mu.Lock()
+		if s.stopped {
+			s.mu.Unlock()
+			conn.Close()
+			return nil
+		}
+		s.conns[conn] = struct{}{}
+		s.wg.Add(1)
+		s.mu.Unlock()
+
+		go s.serveConn(conn)
+	}
+}
+
+// serveConn answers requests on the given connection until it's closed or
+// a request can't be decoded.
+func (s *Server) serveConn(conn net.Conn) {
+	defer s.wg.Done()
+	defer func() {
+		conn.Close()
+		s.mu.Lock()
+		delete(s.conns, conn)
+		s.mu.Unlock()
+	}()
+
+	r := transport.NewReader(conn, !s.opts.Unframed)
+	w := transport.NewWriter(conn, !s.opts.Unframed)
+	for {
+		b, err := r.Read()
+		if err != nil {
+			return
+		}
+
+		req, err := protocol.Binary.DecodeEnveloped(bytes.NewReader(b))
+		if err != nil {
+			return
+		}
+
+		res, ok := s.handle(req)
+		if !ok {
+			continue
+		}
+
+		var buff bytes.Buffer
+		if err := protocol.Binary.EncodeEnveloped(res, &buff); err != nil {
+			return
+		}
+		if err := w.Write(buff.Bytes()); err != nil {
+			return
+		}
+	}
+}
+
+// handle answers a request. Oneway requests don't have responses.
+func (s *Server) handle(req wire.Envelope) (_ wire.Envelope, ok bool) {
+	f, err := schema.FindFunction(s.module, req.Name)
+	if err != nil {
+		return applicationException(req, exception.ExceptionTypeUnknownMethod, err), true
+	}
+	f = f.Declared() // in case of multiplexed names
+
+	args, err := dynamic.FromWire(f.ArgsStruct(), req.Value)
+	if err != nil {
+		return applicationException(req, exception.ExceptionTypeProtocolError, err), true
+	}
+
+	call := Call{Method: f.Name(), SeqID: req.SeqID, Args: args.ToMap()}
+	s.record(call)
+	if f.Spec.OneWay {
+		return wire.Envelope{}, false
+	}
+
+	result, err := s.result(f, call.Args)
+	if err == nil {
+		var v wire.Value
+		if v, err = result.ToWire(); err == nil {
+			return wire.Envelope{Name: req.Name, Type: wire.Reply, SeqID: req.SeqID, Value: v}, true
+		}
+	}
+	return applicationException(req, exception.ExceptionTypeInternalError, err), true
+}
+
+func (s *Server) record(c Call) {
+	s.mu.Lock()
+	s.calls = append(s.calls, c)
+	s.mu.Unlock()
+
+	if s.opts.OnCall != nil {
+		s.opts.OnCall(c)
+	}
+}
+
+// result builds the result of a call to the given function from the first
+// fixture that matches its arguments, or from the fallback.
+func (s *Server) result(f *schema.Function, args map[string]interface{}) (*dynamic.Struct, error) {
+	normalized, err := normalize(args)
+	if err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, fx := range s.fixtures[f.Name()] {
+		if fx.matches(normalized.(map[string]interface{})) {
+			return fx.result, nil
+		}
+	}
+
+	if s.opts.Fallback == FallbackError {
+		return nil, fmt.Errorf("no fixture for %v matches the call", f.Name())
+	}
+
+	result := dynamic.NewStruct(f.ResultStruct())
+	if t := f.Spec.ResultSpec.ReturnType; t != nil {
+		v, err := s.synth.Value(t, 0)
+		if err != nil {
+			return nil, err
+		}
+		if err := result.Set("success", v); err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+func applicationException(req wire.Envelope, t exception.ExceptionType, err error) wire.Envelope {
+	message := err.Error()
+	v, err := (&exception.TApplicationException{Message: &message, Type: &t}).ToWire()
+	if err != nil {
+		// TApplicationException has no required fields, so this can't fail.
+		panic(err)
+	}
+	return wire.Envelope{Name: req.Name, Type: wire.Exception, SeqID: req.SeqID, Value: v}
+}
@@ -0,0 +1,439 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+package mockserver
+
+import (
+	"bytes"
+	"net"
+	"strings"
+	"testing"
+	"time"
+
+	"go.uber.org/thriftrw/compile"
+	"go.uber.org/thriftrw/dynamic"
+	"go.uber.org/thriftrw/internal/envelope/exception"
+	"go.uber.org/thriftrw/internal/schema"
+	"go.uber.org/thriftrw/internal/transport"
+	"go.uber.org/thriftrw/protocol"
+	"go.uber.org/thriftrw/wire"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func compileTestdata(t *testing.T) *compile.Module {
+	m, err := compile.Compile("testdata/kv.thrift")
+	require.NoError(t, err)
+	return m
+}
+
+// client sends requests to a server and decodes their responses with the
+// Thrift file.
+type client struct {
+	t      *testing.T
+	module *compile.Module
+	conn   net.Conn
+	r      transport.Reader
+	w      transport.Writer
+}
+
+func newClient(t *testing.T, m *compile.Module, srv *Server, framed bool) *client {
+	conn, err := net.Dial("tcp", srv.Addr().String())
+	require.NoError(t, err)
+	return &client{
+		t:      t,
+		module: m,
+		conn:   conn,
+		r:      transport.NewReader(conn, framed),
+		w:      transport.NewWriter(conn, framed),
+	}
+}
+
+func (c *client) Close() { c.conn.Close() }
+
+// Send sends a request with the given arguments.
+func (c *client) Send(method string, args map[string]interface{}) *schema.Function {
+	f, err := schema.LookupFunction(c.module, method)
+	require.NoError(c.t, err)
+
+	s, err := dynamic.FromMap(f.ArgsStruct(), args)
+	require.NoError(c.t, err)
+	v, err := s.ToWire()
+	require.NoError(c.t, err)
+
+	typ := wire.Call
+	if f.Spec.OneWay {
+		typ = wire.OneWay
+	}
+
+	var buff bytes.Buffer
+	require.NoError(c.t, protocol.Binary.EncodeEnveloped(wire.Envelope{
+		Name:  f.Spec.Name,
+		Type:  typ,
+		SeqID: 1,
+		Value: v,
+	}, &buff))
+	require.NoError(c.t, c.w.Write(buff.Bytes()))
+	return f
+}
+
+// Receive reads a response envelope.
+func (c *client) Receive() wire.Envelope {
+	b, err := c.r.Read()
+	require.NoError(c.t, err)
+	e, err := protocol.Binary.DecodeEnveloped(bytes.NewReader(b))
+	require.NoError(c.t, err)
+	return e
+}
+
+// Call calls the given function and returns the fields of its result.
+func (c *client) Call(method string, args map[string]interface{}) map[string]interface{} {
+	f := c.Send(method, args)
+
+	e := c.Receive()
+	require.Equal(c.t, wire.Reply, e.Type, "expected a reply, got %v", e)
+	result, err := dynamic.FromWire(f.ResultStruct(), e.Value)
+	require.NoError(c.t, err)
+	return result.ToMap()
+}
+
+// CallError calls the given function and returns the
+// TApplicationException it failed with.
+func (c *client) CallError(method string, args map[string]interface{}) *exception.TApplicationException {
+	c.Send(method, args)
+
+	e := c.Receive()
+	require.Equal(c.t, wire.Exception, e.Type, "expected an exception, got %v", e)
+	var ex exception.TApplicationException
+	require.NoError(c.t, ex.FromWire(e.Value))
+	return &ex
+}
+
+func startServer(t *testing.T, m *compile.Module, opts Options) *Server {
+	srv := New(m, opts)
+	require.NoError(t, srv.Start("127.0.0.1:0"))
+	return srv
+}
+
+func TestServerFixtures(t *testing.T) {
+	m := compileTestdata(t)
+
+	for _, framed := range []bool{true, false} {
+		srv := startServer(t, m, Options{Unframed: !framed, Fallback: FallbackError})
+
+		require.NoError(t, srv.Add("KeyValue::getItem",
+			Fixture{
+				Args:   map[string]interface{}{"key": "foo"},
+				Result: map[string]interface{}{"key": "foo", "status": "STALE"},
+			},
+			Fixture{
+				Exception: map[string]interface{}{"notFound": map[string]interface{}{"key": "missing"}},
+			},
+		))
+		require.NoError(t, srv.Add("Inventory::listItems",
+			Fixture{
+				Args:   map[string]interface{}{"tags": []interface{}{"red"}},
+				Result: []interface{}{map[string]interface{}{"key": "apple"}},
+			},
+			Fixture{
+				Args:   map[string]interface{}{"limit": 0},
+				Result: []interface{}{},
+			},
+		))
+		require.NoError(t, srv.Add("KeyValue::healthy", Fixture{Result: true}))
+		require.NoError(t, srv.Add("Inventory::clear", Fixture{}))
+
+		c := newClient(t, m, srv, framed)
+
+		assert.Equal(t, map[string]interface{}{
+			"success": map[string]interface{}{"key": "foo", "status": "STALE"},
+		}, c.Call("KeyValue::getItem", map[string]interface{}{"key": "foo"}))
+
+		assert.Equal(t, map[string]interface{}{
+			"notFound": map[string]interface{}{"key": "missing"},
+		}, c.Call("KeyValue::getItem", map[string]interface{}{"key": "bar"}))
+
+		assert.Equal(t, map[string]interface{}{
+			"success": []interface{}{map[string]interface{}{"key": "apple"}},
+		}, c.Call("Inventory::listItems", map[string]interface{}{"limit": 10, "tags": []interface{}{"red"}}))
+
+		assert.Equal(t, map[string]interface{}{
+			"success": []interface{}{},
+		}, c.Call("Inventory::listItems", map[string]interface{}{"limit": 0}))
+
+		assert.Equal(t, map[string]interface{}{"success": true}, c.Call("KeyValue::healthy", nil))
+		assert.Empty(t, c.Call("Inventory::clear", nil))
+
+		ex := c.CallError("Inventory::listItems", map[string]interface{}{"limit": 10})
+		assert.Equal(t, exception.ExceptionTypeInternalError, *ex.Type)
+		assert.Contains(t, *ex.Message, "no fixture for Inventory::listItems matches the call")
+
+		c.Close()
+		require.NoError(t, srv.Stop())
+	}
+}
+
+func TestServerRecordsCalls(t *testing.T) {
+	m := compileTestdata(t)
+
+	var onCall []Call
+	srv := startServer(t, m, Options{OnCall: func(c Call) { onCall = append(onCall, c) }})
+	defer srv.Stop()
+
+	c := newClient(t, m, srv, true)
+	defer c.Close()
+
+	c.Send("KeyValue::touch", map[string]interface{}{"key": "foo"})
+	c.Call("KeyValue::getItem", map[string]interface{}{"key": "bar"})
+
+	want := []Call{
+		{Method: "KeyValue::touch", SeqID: 1, Args: map[string]interface{}{"key": "foo"}},
+		{Method: "KeyValue::getItem", SeqID: 1, Args: map[string]interface{}{"key": "bar"}},
+	}
+	assert.Equal(t, want, srv.Calls()[:2])
+	assert.Equal(t, want, onCall[:2])
+	assert.Equal(t, want[1:], srv.CallsTo("KeyValue::getItem"))
+
+	c.Call("KeyValue::healthy", nil)
+	healthy := []Call{{Method: "Base::healthy", SeqID: 1, Args: map[string]interface{}{}}}
+	assert.Equal(t, healthy, srv.CallsTo("KeyValue::healthy"), "inherited functions are found through children")
+	assert.Equal(t, healthy, srv.CallsTo("Base::healthy"))
+
+	srv.ResetCalls()
+	assert.Empty(t, srv.Calls())
+}
+
+func TestServerFallback(t *testing.T) {
+	m := compileTestdata(t)
+
+	t.Run("default", func(t *testing.T) {
+		srv := startServer(t, m, Options{})
+		defer srv.Stop()
+
+		c := newClient(t, m, srv, true)
+		defer c.Close()
+
+		assert.Equal(t, map[string]interface{}{
+			"success": map[string]interface{}{"key": ""},
+		}, c.Call("KeyValue::getItem", map[string]interface{}{"key": "foo"}))
+		assert.Equal(t, map[string]interface{}{"success": []interface{}{}},
+			c.Call("Inventory::listItems", nil))
+		assert.Equal(t, map[string]interface{}{
+			"success": map[string]interface{}{"tree": map[string]interface{}{"name": ""}},
+		}, c.Call("Inventory::node", nil), "unions must use their first field")
+	})
+
+	t.Run("random", func(t *testing.T) {
+		results := func(seed int64) []map[string]interface{} {
+			srv := startServer(t, m, Options{Fallback: FallbackRandom, Seed: seed})
+			defer srv.Stop()
+
+			c := newClient(t, m, srv, true)
+			defer c.Close()
+
+			var results []map[string]interface{}
+			for i := 0; i < 5; i++ {
+				results = append(results,
+					c.Call("KeyValue::getItem", nil),
+					c.Call("Inventory::listItems", nil),
+					c.Call("Inventory::tree", nil),
+					c.Call("Inventory::node", nil))
+			}
+			return results
+		}
+
+		first := results(42)
+		assert.Equal(t, first, results(42), "results must be deterministic for a seed")
+		assert.NotEqual(t, first, results(43), "results must change with the seed")
+	})
+
+	t.Run("error", func(t *testing.T) {
+		srv := startServer(t, m, Options{Fallback: FallbackError})
+		defer srv.Stop()
+
+		c := newClient(t, m, srv, true)
+		defer c.Close()
+
+		ex := c.CallError("KeyValue::healthy", nil)
+		assert.Equal(t, exception.ExceptionTypeInternalError, *ex.Type)
+	})
+}
+
+func TestServerUnknownMethod(t *testing.T) {
+	m := compileTestdata(t)
+	srv := startServer(t, m, Options{})
+	defer srv.Stop()
+
+	c := newClient(t, m, srv, true)
+	defer c.Close()
+
+	var buff bytes.Buffer
+	require.NoError(t, protocol.Binary.EncodeEnveloped(wire.Envelope{
+		Name:  "putItem",
+		Type:  wire.Call,
+		SeqID: 7,
+		Value: wire.NewValueStruct(wire.Struct{}),
+	}, &buff))
+	require.NoError(t, c.w.Write(buff.Bytes()))
+
+	e := c.Receive()
+	assert.Equal(t, wire.Exception, e.Type)
+	assert.Equal(t, int32(7), e.SeqID)
+
+	var ex exception.TApplicationException
+	require.NoError(t, ex.FromWire(e.Value))
+	assert.Equal(t, exception.ExceptionTypeUnknownMethod, *ex.Type)
+	assert.Empty(t, srv.Calls())
+}
+
+func TestServerStop(t *testing.T) {
+	m := compileTestdata(t)
+	srv := New(m, Options{})
+	assert.Nil(t, srv.Addr())
+
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	done := make(chan error)
+	go func() { done <- srv.Serve(ln) }()
+
+	for srv.Addr() == nil {
+		time.Sleep(time.Millisecond) // wait for Serve to start
+	}
+	c := newClient(t, m, srv, true)
+	defer c.Close()
+	c.Call("KeyValue::healthy", nil)
+
+	require.NoError(t, srv.Stop())
+	assert.NoError(t, <-done)
+	assert.Error(t, srv.Start("127.0.0.1:0"), "stopped servers cannot be restarted")
+}
+
+func TestAddErrors(t *testing.T) {
+	m := compileTestdata(t)
+
+	tests := []struct {
+		desc    string
+		method  string
+		fixture Fixture
+		wantErr string
+	}{
+		{
+			desc:    "unknown function",
+			method:  "KeyValue::putItem",
+			wantErr: `"putItem"`,
+		},
+		{
+			desc:    "oneway",
+			method:  "KeyValue::touch",
+			wantErr: "oneway functions do not have responses",
+		},
+		{
+			desc:    "unknown argument",
+			method:  "KeyValue::getItem",
+			fixture: Fixture{Args: map[string]interface{}{"id": 1}, Result: map[string]interface{}{"key": "foo"}},
+			wantErr: `unknown argument "id"`,
+		},
+		{
+			desc:    "argument of the wrong type",
+			method:  "Inventory::listItems",
+			fixture: Fixture{Args: map[string]interface{}{"limit": "ten"}, Result: []interface{}{}},
+			wantErr: "invalid arguments: Inventory_listItems_Args.limit:",
+		},
+		{
+			desc:    "argument out of range",
+			method:  "Inventory::listItems",
+			fixture: Fixture{Args: map[string]interface{}{"limit": int64(1) << 40}, Result: []interface{}{}},
+			wantErr: "invalid arguments: Inventory_listItems_Args.limit:",
+		},
+		{
+			desc:    "missing result",
+			method:  "KeyValue::getItem",
+			wantErr: "expected a result or an exception",
+		},
+		{
+			desc:    "result of void function",
+			method:  "Inventory::clear",
+			fixture: Fixture{Result: true},
+			wantErr: "Inventory::clear does not return a value",
+		},
+		{
+			desc:   "result and exception",
+			method: "KeyValue::getItem",
+			fixture: Fixture{
+				Result:    map[string]interface{}{"key": "foo"},
+				Exception: map[string]interface{}{"notFound": map[string]interface{}{}},
+			},
+			wantErr: "fixtures cannot have both a result and an exception",
+		},
+		{
+			desc:    "unknown exception",
+			method:  "KeyValue::getItem",
+			fixture: Fixture{Exception: map[string]interface{}{"conflict": map[string]interface{}{}}},
+			wantErr: `KeyValue::getItem does not declare an exception named "conflict"`,
+		},
+		{
+			desc:    "invalid result",
+			method:  "KeyValue::getItem",
+			fixture: Fixture{Result: map[string]interface{}{"status": "OK"}},
+			wantErr: "KeyValue_getItem_Result.success.key: missing required field",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			err := New(m, Options{}).Add(tt.method, tt.fixture)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+}
+
+func TestLoadFixtures(t *testing.T) {
+	m := compileTestdata(t)
+
+	fixtures, err := LoadFixtures(strings.NewReader(`{
+		"KeyValue::getItem": [
+			{"args": {"key": "foo"}, "result": {"key": "foo", "counts": {"hits": 9007199254740993}}},
+			{"exception": {"notFound": {}}}
+		],
+		"KeyValue::healthy": [{"result": false}]
+	}`))
+	require.NoError(t, err)
+	assert.Len(t, fixtures["KeyValue::getItem"], 2)
+
+	srv := startServer(t, m, Options{})
+	defer srv.Stop()
+	require.NoError(t, srv.AddFixtures(fixtures))
+
+	c := newClient(t, m, srv, true)
+	defer c.Close()
+
+	assert.Equal(t, map[string]interface{}{
+		"success": map[string]interface{}{
+			"key":    "foo",
+			"counts": map[string]interface{}{"hits": int64(9007199254740993)},
+		},
+	}, c.Call("KeyValue::getItem", map[string]interface{}{"key": "foo"}))
+
+	_, err = LoadFixtures(strings.NewReader(`[]`))
+	assert.Error(t, err)
+	assert.Error(t, New(m, Options{}).AddFixtures(Fixtures{"KeyValue::nope": nil}))
+}
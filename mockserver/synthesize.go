@@ -0,0 +1,194 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+package mockserver
+
+import (
+	"fmt"
+	"math/rand"
+
+	"go.uber.org/thriftrw/ast"
+	"go.uber.org/thriftrw/compile"
+	"go.uber.org/thriftrw/dynamic"
+)
+
+const (
+	// Beyond this depth, synthesized values leave out optional fields and
+	// collections are left empty, so that values of recursive types
+	// terminate.
+	_maxOptionalDepth = 4
+
+	// Values nested deeper than this can't be synthesized. This is reached
+	// only by types that recurse through required fields.
+	_maxDepth = 64
+)
+
+// synthesizer builds values for types without fixtures.
+type synthesizer struct {
+	// Random values are synthesized if this is non-nil. Otherwise, values
+	// are the default values of their fields, or the zero values of their
+	// types.
+	rand *rand.Rand
+}
+
+func (g *synthesizer) Struct(spec *compile.StructSpec, depth int) (*dynamic.Struct, error) {
+	if depth > _maxDepth {
+		return nil, fmt.Errorf("cannot build a value of recursive type %v", spec.Name)
+	}
+
+	s := dynamic.NewStruct(spec)
+	if spec.Type == ast.UnionType {
+		if len(spec.Fields) == 0 {
+			return s, nil
+		}
+
+		f := spec.Fields[0]
+		if g.rand != nil {
+			f = spec.Fields[g.rand.Intn(len(spec.Fields))]
+		}
+		return s, g.setField(s, f, depth)
+	}
+
+	if err := s.ApplyDefaults(); err != nil {
+		return nil, err
+	}
+	for _, f := range spec.Fields {
+		if _, ok := s.Get(f.Name); ok {
+			continue
+		}
+
+		include := f.Required
+		if !include && g.rand != nil && depth < _maxOptionalDepth {
+			include = g.rand.Intn(2) == 0
+		}
+		if include {
+			if err := g.setField(s, f, depth); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return s, nil
+}
+
+func (g *synthesizer) setField(s *dynamic.Struct, f *compile.FieldSpec, depth int) error {
+	v, err := g.Value(f.Type, depth+1)
+	if err != nil {
+		return err
+	}
+	return s.Set(f.Name, v)
+}
+
+// Value builds a value of the given type in the representation used by
+// the dynamic package.
+func (g *synthesizer) Value(t compile.TypeSpec, depth int) (interface{}, error) {
+	switch spec := compile.RootTypeSpec(t).(type) {
+	case *compile.BoolSpec:
+		return g.rand != nil && g.rand.Intn(2) == 0, nil
+	case *compile.I8Spec:
+		return int8(g.intn(1 << 8)), nil
+	case *compile.I16Spec:
+		return int16(g.intn(1 << 16)), nil
+	case *compile.I32Spec:
+		return int32(g.intn(1 << 31)), nil
+	case *compile.I64Spec:
+		return g.intn(1 << 62), nil
+	case *compile.DoubleSpec:
+		if g.rand == nil {
+			return float64(0), nil
+		}
+		return g.rand.Float64() * 100, nil
+	case *compile.StringSpec:
+		return g.word(), nil
+	case *compile.BinarySpec:
+		return []byte(g.word()), nil
+	case *compile.EnumSpec:
+		if len(spec.Items) == 0 {
+			return int32(0), nil
+		}
+		item := spec.Items[0]
+		if g.rand != nil {
+			item = spec.Items[g.rand.Intn(len(spec.Items))]
+		}
+		return item.Value, nil
+	case *compile.StructSpec:
+		return g.Struct(spec, depth)
+	case *compile.ListSpec:
+		return g.items(spec.ValueSpec, depth)
+	case *compile.SetSpec:
+		return g.items(spec.ValueSpec, depth)
+	case *compile.MapSpec:
+		items := make([]dynamic.MapItem, g.size(depth))
+		for i := range items {
+			k, err := g.Value(spec.KeySpec, depth+1)
+			if err != nil {
+				return nil, err
+			}
+			v, err := g.Value(spec.ValueSpec, depth+1)
+			if err != nil {
+				return nil, err
+			}
+			items[i] = dynamic.MapItem{Key: k, Value: v}
+		}
+		return items, nil
+	default:
+		return nil, fmt.Errorf("unsupported type %v", t.ThriftName())
+	}
+}
+
+func (g *synthesizer) items(t compile.TypeSpec, depth int) ([]interface{}, error) {
+	items := make([]interface{}, g.size(depth))
+	for i := range items {
+		v, err := g.Value(t, depth+1)
+		if err != nil {
+			return nil, err
+		}
+		items[i] = v
+	}
+	return items, nil
+}
+
+// intn returns a non-negative integer less than n, or zero if values
+// aren't random.
+func (g *synthesizer) intn(n int64) int64 {
+	if g.rand == nil {
+		return 0
+	}
+	return g.rand.Int63n(n)
+}
+
+// size returns the number of items in a collection.
+func (g *synthesizer) size(depth int) int {
+	if g.rand == nil || depth >= _maxOptionalDepth {
+		return 0
+	}
+	return 1 + g.rand.Intn(3)
+}
+
+func (g *synthesizer) word() string {
+	if g.rand == nil {
+		return ""
+	}
+
+	const letters = "abcdefghijklmnopqrstuvwxyz"
+	b := make([]byte, 1+g.rand.Intn(8))
+	for i := range b {
+		b[i] = letters[g.rand.Intn(len(letters))]
+	}
+	return string(b)
+}
@@ -0,0 +1,66 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+package mockserver
+
+import (
+	"math/rand"
+	"testing"
+
+	"go.uber.org/thriftrw/compile"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSynthesizeValid(t *testing.T) {
+	m := compileTestdata(t)
+	g := synthesizer{rand: rand.New(rand.NewSource(1))}
+
+	for _, name := range []string{"Item", "Tree", "Node"} {
+		spec := m.Types[name].(*compile.StructSpec)
+		for i := 0; i < 100; i++ {
+			s, err := g.Struct(spec, 0)
+			require.NoError(t, err, name)
+			require.NoError(t, s.Validate(), name)
+
+			_, err = s.ToWire()
+			require.NoError(t, err, name)
+		}
+	}
+}
+
+func TestSynthesizeDefaults(t *testing.T) {
+	m := compileTestdata(t)
+
+	var g synthesizer
+	s, err := g.Struct(m.Types["Tree"].(*compile.StructSpec), 0)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{"name": ""}, s.ToMap(),
+		"only required fields must be set")
+}
+
+func TestSynthesizeRecursiveRequired(t *testing.T) {
+	m := compileTestdata(t)
+
+	var g synthesizer
+	_, err := g.Struct(m.Types["Loop"].(*compile.StructSpec), 0)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "cannot build a value of recursive type Loop")
+}
@@ -0,0 +1,47 @@
+enum Status {
+    OK = 0
+    STALE = 1
+}
+
+struct Item {
+    1: required string key
+    2: optional binary value
+    3: optional Status status
+    4: optional list<string> tags
+    5: optional map<string, i64> counts
+}
+
+exception NotFound {
+    1: optional string key
+}
+
+service Base {
+    bool healthy()
+}
+
+service KeyValue extends Base {
+    Item getItem(1: string key) throws (1: NotFound notFound)
+    oneway void touch(1: string key)
+}
+
+struct Tree {
+    1: required string name
+    2: optional list<Tree> children
+    3: optional map<string, Status> statuses
+}
+
+union Node {
+    1: Tree tree
+    2: i64 leaf
+}
+
+service Inventory {
+    list<Item> listItems(1: i32 limit, 2: optional set<string> tags)
+    Tree tree()
+    Node node()
+    void clear()
+}
+
+struct Loop {
+    1: required Loop loop
+}
@@ -48,6 +48,12 @@ struct TypeReference {
      *   }
      */
     3: optional map<string, string> annotations
+    /**
+     * Docstring of the type, if any, without the comment markers.
+     *
+     * This is not set for types specified with go.type.
+     */
+    4: optional string doc
 
     // TODO(abg): Should this just be using ModuleID instead of a package?
 }
@@ -65,6 +71,12 @@ enum SimpleType {
     FLOAT64,      // float64
     STRING,       // string
     STRUCT_EMPTY, // struct{}
+    // The following are used only with plugins which provide the
+    // UNSIGNED_TYPES feature.
+    UINT8,        // uint8
+    UINT16,       // uint16
+    UINT32,       // uint32
+    UINT64,       // uint64
 }
 
 /**
@@ -108,6 +120,21 @@ union Type {
     6: Type pointerType
 }
 
+/**
+ * Deprecation is attached to entities marked as deprecated with the
+ * deprecated annotation.
+ *
+ *   service KeyValue {
+ *     void setValue(1: SetValueRequest req) (deprecated = "Use put instead.")
+ *   }
+ */
+struct Deprecation {
+    /**
+     * Reason given for the deprecation, if any.
+     */
+    1: required string reason
+}
+
 /**
  * Argument is a single Argument inside a Function.
  * For,
@@ -148,6 +175,17 @@ struct Argument {
      *  }
      */
     3: optional map<string, string> annotations;
+    /**
+     * Set if this argument was marked as deprecated.
+     */
+    4: optional Deprecation deprecated
+    /**
+     * Docstring of the argument, if any, without the comment markers.
+     *
+     * For exceptions, this is the docstring of the exception in the throws
+     * clause, not the exception type.
+     */
+    5: optional string doc
 }
 
 /**
@@ -199,6 +237,15 @@ struct Function {
      *  }
      */
     7: optional map<string, string> annotations;
+    /**
+     * Set if this function was marked as deprecated. Functions of deprecated
+     * services are not marked individually.
+     */
+    8: optional Deprecation deprecated
+    /**
+     * Docstring of the function, if any, without the comment markers.
+     */
+    9: optional string doc
 }
 
 /**
@@ -240,6 +287,14 @@ struct Service {
      *  }
      */
     8: optional map<string, string> annotations;
+    /**
+     * Set if this service was marked as deprecated.
+     */
+    9: optional Deprecation deprecated
+    /**
+     * Docstring of the service, if any, without the comment markers.
+     */
+    10: optional string doc
 }
 
 /**
@@ -258,6 +313,9 @@ struct Module {
      * The path is relative to the output directory into which ThriftRW is
      * generating code. Plugins SHOULD NOT make any assumptions about the
      * absolute location of the directory.
+     *
+     * This is empty for modules mapped to existing packages with --map
+     * because their code is not generated by ThriftRW.
      */
     2: required string directory
     /**
@@ -281,6 +339,17 @@ enum Feature {
      */
     SERVICE_GENERATOR = 1,
 
+    /**
+     * UNSIGNED_TYPES specifies that the plugin understands the UINT8,
+     * UINT16, UINT32, and UINT64 simple types, and that INT8, INT16, and
+     * INT32 may be used for wider Thrift integer types.
+     *
+     * Code generation fails if a plugin which does not provide this is
+     * asked to generate code for modules which use unsigned or narrower Go
+     * integer types with go.type.
+     */
+    UNSIGNED_TYPES = 2,
+
     // TODO: TAGGER for struct-tagging plugins
 }
 
@@ -399,6 +468,19 @@ struct GenerateServiceResponse {
      * The paths MUST NOT contain the string ".." or the request will fail.
      */
     1: optional map<string, binary> files
+    /**
+     * Map of artifact path to artifact contents.
+     *
+     * Artifacts are arbitrary files that are not Go code: JSON schemas,
+     * documentation, stubs for other languages, etc. They are written to the
+     * artifact output directory, which may be different from the directory
+     * into which ThriftRW is generating Go code. ThriftRW does not format or
+     * otherwise process artifacts.
+     *
+     * All paths MUST be relative to the artifact output directory and MUST
+     * NOT contain the string ".." or the request will fail.
+     */
+    2: optional map<string, binary> artifacts
 }
 
 /**
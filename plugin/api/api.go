@@ -75,6 +75,13 @@ type Argument struct {
 	//    "cache": "false",
 	//  }
 	Annotations map[string]string `json:"annotations,omitempty"`
+	// Set if this argument was marked as deprecated.
+	Deprecated *Deprecation `json:"deprecated,omitempty"`
+	// Docstring of the argument, if any, without the comment markers.
+	//
+	// For exceptions, this is the docstring of the exception in the throws
+	// clause, not the exception type.
+	Doc *string `json:"doc,omitempty"`
 }
 
 type _Map_String_String_MapItemList map[string]string
@@ -129,7 +136,7 @@ func (_Map_String_String_MapItemList) Close() {}
 //   }
 func (v *Argument) ToWire() (wire.Value, error) {
 	var (
-		fields [3]wire.Field
+		fields [5]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -158,6 +165,22 @@ func (v *Argument) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 3, Value: w}
 		i++
 	}
+	if v.Deprecated != nil {
+		w, err = v.Deprecated.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 4, Value: w}
+		i++
+	}
+	if v.Doc != nil {
+		w, err = wire.NewValueString(*(v.Doc)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 5, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
@@ -196,6 +219,12 @@ func _Map_String_String_Read(m wire.MapItemList) (map[string]string, error) {
 	return o, err
 }
 
+func _Deprecation_Read(w wire.Value) (*Deprecation, error) {
+	var v Deprecation
+	err := v.FromWire(w)
+	return &v, err
+}
+
 // FromWire deserializes a Argument struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
@@ -244,6 +273,24 @@ func (v *Argument) FromWire(w wire.Value) error {
 					return err
 				}
 
+			}
+		case 4:
+			if field.Value.Type() == wire.TStruct {
+				v.Deprecated, err = _Deprecation_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 5:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Doc = &x
+				if err != nil {
+					return err
+				}
+
 			}
 		}
 	}
@@ -266,7 +313,7 @@ func (v *Argument) String() string {
 		return "<nil>"
 	}
 
-	var fields [3]string
+	var fields [5]string
 	i := 0
 	fields[i] = fmt.Sprintf("Name: %v", v.Name)
 	i++
@@ -276,6 +323,14 @@ func (v *Argument) String() string {
 		fields[i] = fmt.Sprintf("Annotations: %v", v.Annotations)
 		i++
 	}
+	if v.Deprecated != nil {
+		fields[i] = fmt.Sprintf("Deprecated: %v", v.Deprecated)
+		i++
+	}
+	if v.Doc != nil {
+		fields[i] = fmt.Sprintf("Doc: %v", *(v.Doc))
+		i++
+	}
 
 	return fmt.Sprintf("Argument{%v}", strings.Join(fields[:i], ", "))
 }
@@ -297,6 +352,16 @@ func _Map_String_String_Equals(lhs, rhs map[string]string) bool {
 	return true
 }
 
+func _String_EqualsPtr(lhs, rhs *string) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return (x == y)
+	}
+	return lhs == nil && rhs == nil
+}
+
 // Equals returns true if all the fields of this Argument match the
 // provided Argument.
 //
@@ -316,6 +381,12 @@ func (v *Argument) Equals(rhs *Argument) bool {
 	if !((v.Annotations == nil && rhs.Annotations == nil) || (v.Annotations != nil && rhs.Annotations != nil && _Map_String_String_Equals(v.Annotations, rhs.Annotations))) {
 		return false
 	}
+	if !((v.Deprecated == nil && rhs.Deprecated == nil) || (v.Deprecated != nil && rhs.Deprecated != nil && v.Deprecated.Equals(rhs.Deprecated))) {
+		return false
+	}
+	if !_String_EqualsPtr(v.Doc, rhs.Doc) {
+		return false
+	}
 
 	return true
 }
@@ -342,6 +413,12 @@ func (v *Argument) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v.Annotations != nil {
 		err = multierr.Append(err, enc.AddObject("annotations", (_Map_String_String_Zapper)(v.Annotations)))
 	}
+	if v.Deprecated != nil {
+		err = multierr.Append(err, enc.AddObject("deprecated", v.Deprecated))
+	}
+	if v.Doc != nil {
+		enc.AddString("doc", *v.Doc)
+	}
 	return err
 }
 
@@ -383,6 +460,173 @@ func (v *Argument) IsSetAnnotations() bool {
 	return v != nil && v.Annotations != nil
 }
 
+// GetDeprecated returns the value of Deprecated if it is set or its
+// zero value if it is unset.
+func (v *Argument) GetDeprecated() (o *Deprecation) {
+	if v != nil && v.Deprecated != nil {
+		return v.Deprecated
+	}
+
+	return
+}
+
+// IsSetDeprecated returns true if Deprecated is not nil.
+func (v *Argument) IsSetDeprecated() bool {
+	return v != nil && v.Deprecated != nil
+}
+
+// GetDoc returns the value of Doc if it is set or its
+// zero value if it is unset.
+func (v *Argument) GetDoc() (o string) {
+	if v != nil && v.Doc != nil {
+		return *v.Doc
+	}
+
+	return
+}
+
+// IsSetDoc returns true if Doc is not nil.
+func (v *Argument) IsSetDoc() bool {
+	return v != nil && v.Doc != nil
+}
+
+// Deprecation is attached to entities marked as deprecated with the
+// deprecated annotation.
+//
+//	service KeyValue {
+//	  void setValue(1: SetValueRequest req) (deprecated = "Use put instead.")
+//	}
+type Deprecation struct {
+	// Reason given for the deprecation, if any.
+	Reason string `json:"reason,required"`
+}
+
+// ToWire translates a Deprecation struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *Deprecation) ToWire() (wire.Value, error) {
+	var (
+		fields [1]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	w, err = wire.NewValueString(v.Reason), error(nil)
+	if err != nil {
+		return w, err
+	}
+	fields[i] = wire.Field{ID: 1, Value: w}
+	i++
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a Deprecation struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a Deprecation struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v Deprecation
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *Deprecation) FromWire(w wire.Value) error {
+	var err error
+
+	reasonIsSet := false
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TBinary {
+				v.Reason, err = field.Value.GetString(), error(nil)
+				if err != nil {
+					return err
+				}
+				reasonIsSet = true
+			}
+		}
+	}
+
+	if !reasonIsSet {
+		return errors.New("field Reason of Deprecation is required")
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a Deprecation
+// struct.
+func (v *Deprecation) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [1]string
+	i := 0
+	fields[i] = fmt.Sprintf("Reason: %v", v.Reason)
+	i++
+
+	return fmt.Sprintf("Deprecation{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this Deprecation match the
+// provided Deprecation.
+//
+// This function performs a deep comparison.
+func (v *Deprecation) Equals(rhs *Deprecation) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !(v.Reason == rhs.Reason) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Deprecation.
+func (v *Deprecation) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	enc.AddString("reason", v.Reason)
+	return err
+}
+
+// GetReason returns the value of Reason if it is set or its
+// zero value if it is unset.
+func (v *Deprecation) GetReason() (o string) {
+	if v != nil {
+		o = v.Reason
+	}
+	return
+}
+
 // Feature is a functionality offered by a ThriftRW plugin.
 type Feature int32
 
@@ -393,12 +637,21 @@ const (
 	// If a plugin provides this, it MUST implement the ServiceGenerator
 	// service.
 	FeatureServiceGenerator Feature = 1
+	// UNSIGNED_TYPES specifies that the plugin understands the UINT8,
+	// UINT16, UINT32, and UINT64 simple types, and that INT8, INT16, and
+	// INT32 may be used for wider Thrift integer types.
+	//
+	// Code generation fails if a plugin which does not provide this is
+	// asked to generate code for modules which use unsigned or narrower Go
+	// integer types with go.type.
+	FeatureUnsignedTypes Feature = 2
 )
 
 // Feature_Values returns all recognized values of Feature.
 func Feature_Values() []Feature {
 	return []Feature{
 		FeatureServiceGenerator,
+		FeatureUnsignedTypes,
 	}
 }
 
@@ -412,6 +665,9 @@ func (v *Feature) UnmarshalText(value []byte) error {
 	case "SERVICE_GENERATOR":
 		*v = FeatureServiceGenerator
 		return nil
+	case "UNSIGNED_TYPES":
+		*v = FeatureUnsignedTypes
+		return nil
 	default:
 		val, err := strconv.ParseInt(s, 10, 32)
 		if err != nil {
@@ -432,6 +688,8 @@ func (v Feature) MarshalText() ([]byte, error) {
 	switch int32(v) {
 	case 1:
 		return []byte("SERVICE_GENERATOR"), nil
+	case 2:
+		return []byte("UNSIGNED_TYPES"), nil
 	}
 	return []byte(strconv.FormatInt(int64(v), 10)), nil
 }
@@ -445,6 +703,8 @@ func (v Feature) MarshalLogObject(enc zapcore.ObjectEncoder) error {
 	switch int32(v) {
 	case 1:
 		enc.AddString("name", "SERVICE_GENERATOR")
+	case 2:
+		enc.AddString("name", "UNSIGNED_TYPES")
 	}
 	return nil
 }
@@ -487,6 +747,8 @@ func (v Feature) String() string {
 	switch w {
 	case 1:
 		return "SERVICE_GENERATOR"
+	case 2:
+		return "UNSIGNED_TYPES"
 	}
 	return fmt.Sprintf("Feature(%d)", w)
 }
@@ -507,6 +769,8 @@ func (v Feature) MarshalJSON() ([]byte, error) {
 	switch int32(v) {
 	case 1:
 		return ([]byte)("\"SERVICE_GENERATOR\""), nil
+	case 2:
+		return ([]byte)("\"UNSIGNED_TYPES\""), nil
 	}
 	return ([]byte)(strconv.FormatInt(int64(v), 10)), nil
 }
@@ -580,6 +844,11 @@ type Function struct {
 	//    "cache": "false",
 	//  }
 	Annotations map[string]string `json:"annotations,omitempty"`
+	// Set if this function was marked as deprecated. Functions of deprecated
+	// services are not marked individually.
+	Deprecated *Deprecation `json:"deprecated,omitempty"`
+	// Docstring of the function, if any, without the comment markers.
+	Doc *string `json:"doc,omitempty"`
 }
 
 type _List_Argument_ValueList []*Argument
@@ -628,7 +897,7 @@ func (_List_Argument_ValueList) Close() {}
 //   }
 func (v *Function) ToWire() (wire.Value, error) {
 	var (
-		fields [7]wire.Field
+		fields [9]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -686,6 +955,22 @@ func (v *Function) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 7, Value: w}
 		i++
 	}
+	if v.Deprecated != nil {
+		w, err = v.Deprecated.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 8, Value: w}
+		i++
+	}
+	if v.Doc != nil {
+		w, err = wire.NewValueString(*(v.Doc)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 9, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
@@ -797,6 +1082,24 @@ func (v *Function) FromWire(w wire.Value) error {
 					return err
 				}
 
+			}
+		case 8:
+			if field.Value.Type() == wire.TStruct {
+				v.Deprecated, err = _Deprecation_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 9:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Doc = &x
+				if err != nil {
+					return err
+				}
+
 			}
 		}
 	}
@@ -823,7 +1126,7 @@ func (v *Function) String() string {
 		return "<nil>"
 	}
 
-	var fields [7]string
+	var fields [9]string
 	i := 0
 	fields[i] = fmt.Sprintf("Name: %v", v.Name)
 	i++
@@ -847,6 +1150,14 @@ func (v *Function) String() string {
 		fields[i] = fmt.Sprintf("Annotations: %v", v.Annotations)
 		i++
 	}
+	if v.Deprecated != nil {
+		fields[i] = fmt.Sprintf("Deprecated: %v", v.Deprecated)
+		i++
+	}
+	if v.Doc != nil {
+		fields[i] = fmt.Sprintf("Doc: %v", *(v.Doc))
+		i++
+	}
 
 	return fmt.Sprintf("Function{%v}", strings.Join(fields[:i], ", "))
 }
@@ -907,6 +1218,12 @@ func (v *Function) Equals(rhs *Function) bool {
 	if !((v.Annotations == nil && rhs.Annotations == nil) || (v.Annotations != nil && rhs.Annotations != nil && _Map_String_String_Equals(v.Annotations, rhs.Annotations))) {
 		return false
 	}
+	if !((v.Deprecated == nil && rhs.Deprecated == nil) || (v.Deprecated != nil && rhs.Deprecated != nil && v.Deprecated.Equals(rhs.Deprecated))) {
+		return false
+	}
+	if !_String_EqualsPtr(v.Doc, rhs.Doc) {
+		return false
+	}
 
 	return true
 }
@@ -943,6 +1260,12 @@ func (v *Function) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v.Annotations != nil {
 		err = multierr.Append(err, enc.AddObject("annotations", (_Map_String_String_Zapper)(v.Annotations)))
 	}
+	if v.Deprecated != nil {
+		err = multierr.Append(err, enc.AddObject("deprecated", v.Deprecated))
+	}
+	if v.Doc != nil {
+		enc.AddString("doc", *v.Doc)
+	}
 	return err
 }
 
@@ -1038,6 +1361,36 @@ func (v *Function) IsSetAnnotations() bool {
 	return v != nil && v.Annotations != nil
 }
 
+// GetDeprecated returns the value of Deprecated if it is set or its
+// zero value if it is unset.
+func (v *Function) GetDeprecated() (o *Deprecation) {
+	if v != nil && v.Deprecated != nil {
+		return v.Deprecated
+	}
+
+	return
+}
+
+// IsSetDeprecated returns true if Deprecated is not nil.
+func (v *Function) IsSetDeprecated() bool {
+	return v != nil && v.Deprecated != nil
+}
+
+// GetDoc returns the value of Doc if it is set or its
+// zero value if it is unset.
+func (v *Function) GetDoc() (o string) {
+	if v != nil && v.Doc != nil {
+		return *v.Doc
+	}
+
+	return
+}
+
+// IsSetDoc returns true if Doc is not nil.
+func (v *Function) IsSetDoc() bool {
+	return v != nil && v.Doc != nil
+}
+
 // GenerateServiceRequest is a request to generate code for zero or more
 // Thrift services.
 type GenerateServiceRequest struct {
@@ -1788,6 +2141,17 @@ type GenerateServiceResponse struct {
 	//
 	// The paths MUST NOT contain the string ".." or the request will fail.
 	Files map[string][]byte `json:"files,omitempty"`
+	// Map of artifact path to artifact contents.
+	//
+	// Artifacts are arbitrary files that are not Go code: JSON schemas,
+	// documentation, stubs for other languages, etc. They are written to the
+	// artifact output directory, which may be different from the directory
+	// into which ThriftRW is generating Go code. ThriftRW does not format or
+	// otherwise process artifacts.
+	//
+	// All paths MUST be relative to the artifact output directory and MUST
+	// NOT contain the string ".." or the request will fail.
+	Artifacts map[string][]byte `json:"artifacts,omitempty"`
 }
 
 type _Map_String_Binary_MapItemList map[string][]byte
@@ -1845,7 +2209,7 @@ func (_Map_String_Binary_MapItemList) Close() {}
 //   }
 func (v *GenerateServiceResponse) ToWire() (wire.Value, error) {
 	var (
-		fields [1]wire.Field
+		fields [2]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -1859,6 +2223,14 @@ func (v *GenerateServiceResponse) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 1, Value: w}
 		i++
 	}
+	if v.Artifacts != nil {
+		w, err = wire.NewValueMap(_Map_String_Binary_MapItemList(v.Artifacts)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 2, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
@@ -1920,6 +2292,14 @@ func (v *GenerateServiceResponse) FromWire(w wire.Value) error {
 					return err
 				}
 
+			}
+		case 2:
+			if field.Value.Type() == wire.TMap {
+				v.Artifacts, err = _Map_String_Binary_Read(field.Value.GetMap())
+				if err != nil {
+					return err
+				}
+
 			}
 		}
 	}
@@ -1934,12 +2314,16 @@ func (v *GenerateServiceResponse) String() string {
 		return "<nil>"
 	}
 
-	var fields [1]string
+	var fields [2]string
 	i := 0
 	if v.Files != nil {
 		fields[i] = fmt.Sprintf("Files: %v", v.Files)
 		i++
 	}
+	if v.Artifacts != nil {
+		fields[i] = fmt.Sprintf("Artifacts: %v", v.Artifacts)
+		i++
+	}
 
 	return fmt.Sprintf("GenerateServiceResponse{%v}", strings.Join(fields[:i], ", "))
 }
@@ -1974,6 +2358,9 @@ func (v *GenerateServiceResponse) Equals(rhs *GenerateServiceResponse) bool {
 	if !((v.Files == nil && rhs.Files == nil) || (v.Files != nil && rhs.Files != nil && _Map_String_Binary_Equals(v.Files, rhs.Files))) {
 		return false
 	}
+	if !((v.Artifacts == nil && rhs.Artifacts == nil) || (v.Artifacts != nil && rhs.Artifacts != nil && _Map_String_Binary_Equals(v.Artifacts, rhs.Artifacts))) {
+		return false
+	}
 
 	return true
 }
@@ -1998,6 +2385,9 @@ func (v *GenerateServiceResponse) MarshalLogObject(enc zapcore.ObjectEncoder) (e
 	if v.Files != nil {
 		err = multierr.Append(err, enc.AddObject("files", (_Map_String_Binary_Zapper)(v.Files)))
 	}
+	if v.Artifacts != nil {
+		err = multierr.Append(err, enc.AddObject("artifacts", (_Map_String_Binary_Zapper)(v.Artifacts)))
+	}
 	return err
 }
 
@@ -2016,6 +2406,21 @@ func (v *GenerateServiceResponse) IsSetFiles() bool {
 	return v != nil && v.Files != nil
 }
 
+// GetArtifacts returns the value of Artifacts if it is set or its
+// zero value if it is unset.
+func (v *GenerateServiceResponse) GetArtifacts() (o map[string][]byte) {
+	if v != nil && v.Artifacts != nil {
+		return v.Artifacts
+	}
+
+	return
+}
+
+// IsSetArtifacts returns true if Artifacts is not nil.
+func (v *GenerateServiceResponse) IsSetArtifacts() bool {
+	return v != nil && v.Artifacts != nil
+}
+
 // HandshakeRequest is the initial request sent to the plugin as part of
 // establishing communication and feature negotiation.
 type HandshakeRequest struct {
@@ -2347,16 +2752,6 @@ func _List_Feature_Equals(lhs, rhs []Feature) bool {
 	return true
 }
 
-func _String_EqualsPtr(lhs, rhs *string) bool {
-	if lhs != nil && rhs != nil {
-
-		x := *lhs
-		y := *rhs
-		return (x == y)
-	}
-	return lhs == nil && rhs == nil
-}
-
 // Equals returns true if all the fields of this HandshakeResponse match the
 // provided HandshakeResponse.
 //
@@ -2467,6 +2862,9 @@ type Module struct {
 	// The path is relative to the output directory into which ThriftRW is
 	// generating code. Plugins SHOULD NOT make any assumptions about the
 	// absolute location of the directory.
+	//
+	// This is empty for modules mapped to existing packages with --map
+	// because their code is not generated by ThriftRW.
 	Directory string `json:"directory,required"`
 	// Path to the Thrift file from which this module was generated.
 	ThriftFilePath string `json:"thriftFilePath,required"`
@@ -2731,6 +3129,10 @@ type Service struct {
 	//    "private": "true",
 	//  }
 	Annotations map[string]string `json:"annotations,omitempty"`
+	// Set if this service was marked as deprecated.
+	Deprecated *Deprecation `json:"deprecated,omitempty"`
+	// Docstring of the service, if any, without the comment markers.
+	Doc *string `json:"doc,omitempty"`
 }
 
 type _List_Function_ValueList []*Function
@@ -2779,7 +3181,7 @@ func (_List_Function_ValueList) Close() {}
 //   }
 func (v *Service) ToWire() (wire.Value, error) {
 	var (
-		fields [6]wire.Field
+		fields [8]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -2828,6 +3230,22 @@ func (v *Service) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 8, Value: w}
 		i++
 	}
+	if v.Deprecated != nil {
+		w, err = v.Deprecated.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 9, Value: w}
+		i++
+	}
+	if v.Doc != nil {
+		w, err = wire.NewValueString(*(v.Doc)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
@@ -2933,6 +3351,24 @@ func (v *Service) FromWire(w wire.Value) error {
 					return err
 				}
 
+			}
+		case 9:
+			if field.Value.Type() == wire.TStruct {
+				v.Deprecated, err = _Deprecation_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 10:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Doc = &x
+				if err != nil {
+					return err
+				}
+
 			}
 		}
 	}
@@ -2963,7 +3399,7 @@ func (v *Service) String() string {
 		return "<nil>"
 	}
 
-	var fields [6]string
+	var fields [8]string
 	i := 0
 	fields[i] = fmt.Sprintf("Name: %v", v.Name)
 	i++
@@ -2981,6 +3417,14 @@ func (v *Service) String() string {
 		fields[i] = fmt.Sprintf("Annotations: %v", v.Annotations)
 		i++
 	}
+	if v.Deprecated != nil {
+		fields[i] = fmt.Sprintf("Deprecated: %v", v.Deprecated)
+		i++
+	}
+	if v.Doc != nil {
+		fields[i] = fmt.Sprintf("Doc: %v", *(v.Doc))
+		i++
+	}
 
 	return fmt.Sprintf("Service{%v}", strings.Join(fields[:i], ", "))
 }
@@ -3038,6 +3482,12 @@ func (v *Service) Equals(rhs *Service) bool {
 	if !((v.Annotations == nil && rhs.Annotations == nil) || (v.Annotations != nil && rhs.Annotations != nil && _Map_String_String_Equals(v.Annotations, rhs.Annotations))) {
 		return false
 	}
+	if !((v.Deprecated == nil && rhs.Deprecated == nil) || (v.Deprecated != nil && rhs.Deprecated != nil && v.Deprecated.Equals(rhs.Deprecated))) {
+		return false
+	}
+	if !_String_EqualsPtr(v.Doc, rhs.Doc) {
+		return false
+	}
 
 	return true
 }
@@ -3069,6 +3519,12 @@ func (v *Service) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v.Annotations != nil {
 		err = multierr.Append(err, enc.AddObject("annotations", (_Map_String_String_Zapper)(v.Annotations)))
 	}
+	if v.Deprecated != nil {
+		err = multierr.Append(err, enc.AddObject("deprecated", v.Deprecated))
+	}
+	if v.Doc != nil {
+		enc.AddString("doc", *v.Doc)
+	}
 	return err
 }
 
@@ -3143,6 +3599,36 @@ func (v *Service) IsSetAnnotations() bool {
 	return v != nil && v.Annotations != nil
 }
 
+// GetDeprecated returns the value of Deprecated if it is set or its
+// zero value if it is unset.
+func (v *Service) GetDeprecated() (o *Deprecation) {
+	if v != nil && v.Deprecated != nil {
+		return v.Deprecated
+	}
+
+	return
+}
+
+// IsSetDeprecated returns true if Deprecated is not nil.
+func (v *Service) IsSetDeprecated() bool {
+	return v != nil && v.Deprecated != nil
+}
+
+// GetDoc returns the value of Doc if it is set or its
+// zero value if it is unset.
+func (v *Service) GetDoc() (o string) {
+	if v != nil && v.Doc != nil {
+		return *v.Doc
+	}
+
+	return
+}
+
+// IsSetDoc returns true if Doc is not nil.
+func (v *Service) IsSetDoc() bool {
+	return v != nil && v.Doc != nil
+}
+
 // ServiceID is an arbitrary unique identifier to reference the different
 // services in this request.
 type ServiceID int32
@@ -3194,6 +3680,10 @@ const (
 	SimpleTypeFloat64     SimpleType = 7
 	SimpleTypeString      SimpleType = 8
 	SimpleTypeStructEmpty SimpleType = 9
+	SimpleTypeUint8       SimpleType = 10
+	SimpleTypeUint16      SimpleType = 11
+	SimpleTypeUint32      SimpleType = 12
+	SimpleTypeUint64      SimpleType = 13
 )
 
 // SimpleType_Values returns all recognized values of SimpleType.
@@ -3208,6 +3698,10 @@ func SimpleType_Values() []SimpleType {
 		SimpleTypeFloat64,
 		SimpleTypeString,
 		SimpleTypeStructEmpty,
+		SimpleTypeUint8,
+		SimpleTypeUint16,
+		SimpleTypeUint32,
+		SimpleTypeUint64,
 	}
 }
 
@@ -3245,6 +3739,18 @@ func (v *SimpleType) UnmarshalText(value []byte) error {
 	case "STRUCT_EMPTY":
 		*v = SimpleTypeStructEmpty
 		return nil
+	case "UINT8":
+		*v = SimpleTypeUint8
+		return nil
+	case "UINT16":
+		*v = SimpleTypeUint16
+		return nil
+	case "UINT32":
+		*v = SimpleTypeUint32
+		return nil
+	case "UINT64":
+		*v = SimpleTypeUint64
+		return nil
 	default:
 		val, err := strconv.ParseInt(s, 10, 32)
 		if err != nil {
@@ -3281,6 +3787,14 @@ func (v SimpleType) MarshalText() ([]byte, error) {
 		return []byte("STRING"), nil
 	case 9:
 		return []byte("STRUCT_EMPTY"), nil
+	case 10:
+		return []byte("UINT8"), nil
+	case 11:
+		return []byte("UINT16"), nil
+	case 12:
+		return []byte("UINT32"), nil
+	case 13:
+		return []byte("UINT64"), nil
 	}
 	return []byte(strconv.FormatInt(int64(v), 10)), nil
 }
@@ -3310,6 +3824,14 @@ func (v SimpleType) MarshalLogObject(enc zapcore.ObjectEncoder) error {
 		enc.AddString("name", "STRING")
 	case 9:
 		enc.AddString("name", "STRUCT_EMPTY")
+	case 10:
+		enc.AddString("name", "UINT8")
+	case 11:
+		enc.AddString("name", "UINT16")
+	case 12:
+		enc.AddString("name", "UINT32")
+	case 13:
+		enc.AddString("name", "UINT64")
 	}
 	return nil
 }
@@ -3368,6 +3890,14 @@ func (v SimpleType) String() string {
 		return "STRING"
 	case 9:
 		return "STRUCT_EMPTY"
+	case 10:
+		return "UINT8"
+	case 11:
+		return "UINT16"
+	case 12:
+		return "UINT32"
+	case 13:
+		return "UINT64"
 	}
 	return fmt.Sprintf("SimpleType(%d)", w)
 }
@@ -3404,6 +3934,14 @@ func (v SimpleType) MarshalJSON() ([]byte, error) {
 		return ([]byte)("\"STRING\""), nil
 	case 9:
 		return ([]byte)("\"STRUCT_EMPTY\""), nil
+	case 10:
+		return ([]byte)("\"UINT8\""), nil
+	case 11:
+		return ([]byte)("\"UINT16\""), nil
+	case 12:
+		return ([]byte)("\"UINT32\""), nil
+	case 13:
+		return ([]byte)("\"UINT64\""), nil
 	}
 	return ([]byte)(strconv.FormatInt(int64(v), 10)), nil
 }
@@ -4065,6 +4603,10 @@ type TypeReference struct {
 	//     "validate": "",
 	//   }
 	Annotations map[string]string `json:"annotations,omitempty"`
+	// Docstring of the type, if any, without the comment markers.
+	//
+	// This is not set for types specified with go.type.
+	Doc *string `json:"doc,omitempty"`
 }
 
 // ToWire translates a TypeReference struct into a Thrift-level intermediate
@@ -4084,7 +4626,7 @@ type TypeReference struct {
 //   }
 func (v *TypeReference) ToWire() (wire.Value, error) {
 	var (
-		fields [3]wire.Field
+		fields [4]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -4111,6 +4653,14 @@ func (v *TypeReference) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 3, Value: w}
 		i++
 	}
+	if v.Doc != nil {
+		w, err = wire.NewValueString(*(v.Doc)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 4, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
@@ -4163,6 +4713,16 @@ func (v *TypeReference) FromWire(w wire.Value) error {
 					return err
 				}
 
+			}
+		case 4:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Doc = &x
+				if err != nil {
+					return err
+				}
+
 			}
 		}
 	}
@@ -4185,7 +4745,7 @@ func (v *TypeReference) String() string {
 		return "<nil>"
 	}
 
-	var fields [3]string
+	var fields [4]string
 	i := 0
 	fields[i] = fmt.Sprintf("Name: %v", v.Name)
 	i++
@@ -4195,6 +4755,10 @@ func (v *TypeReference) String() string {
 		fields[i] = fmt.Sprintf("Annotations: %v", v.Annotations)
 		i++
 	}
+	if v.Doc != nil {
+		fields[i] = fmt.Sprintf("Doc: %v", *(v.Doc))
+		i++
+	}
 
 	return fmt.Sprintf("TypeReference{%v}", strings.Join(fields[:i], ", "))
 }
@@ -4218,6 +4782,9 @@ func (v *TypeReference) Equals(rhs *TypeReference) bool {
 	if !((v.Annotations == nil && rhs.Annotations == nil) || (v.Annotations != nil && rhs.Annotations != nil && _Map_String_String_Equals(v.Annotations, rhs.Annotations))) {
 		return false
 	}
+	if !_String_EqualsPtr(v.Doc, rhs.Doc) {
+		return false
+	}
 
 	return true
 }
@@ -4233,6 +4800,9 @@ func (v *TypeReference) MarshalLogObject(enc zapcore.ObjectEncoder) (err error)
 	if v.Annotations != nil {
 		err = multierr.Append(err, enc.AddObject("annotations", (_Map_String_String_Zapper)(v.Annotations)))
 	}
+	if v.Doc != nil {
+		enc.AddString("doc", *v.Doc)
+	}
 	return err
 }
 
@@ -4269,16 +4839,31 @@ func (v *TypeReference) IsSetAnnotations() bool {
 	return v != nil && v.Annotations != nil
 }
 
+// GetDoc returns the value of Doc if it is set or its
+// zero value if it is unset.
+func (v *TypeReference) GetDoc() (o string) {
+	if v != nil && v.Doc != nil {
+		return *v.Doc
+	}
+
+	return
+}
+
+// IsSetDoc returns true if Doc is not nil.
+func (v *TypeReference) IsSetDoc() bool {
+	return v != nil && v.Doc != nil
+}
+
 // ThriftModule represents the IDL file used to generate this package.
 var ThriftModule = &thriftreflect.ThriftModule{
 	Name:     "api",
 	Package:  "go.uber.org/thriftrw/plugin/api",
 	FilePath: "api.thrift",
-	SHA1:     "f7eeea00e3002a17188f595c7117b9359ab244d3",
+	SHA1:     "39312533eeb7b3c3747eeb41dcf7c224f6a2680f",
 	Raw:      rawIDL,
 }
 
-const rawIDL = "/**\n * API_VERSION is the version of the plugin API.\n *\n * This MUST be provided in the HandshakeResponse.\n */\nconst i32 API_VERSION = 4\n\n/**\n * ServiceID is an arbitrary unique identifier to reference the different\n * services in this request.\n */\ntypedef i32 ServiceID\n\n/**\n * ModuleID is an arbitrary unique identifier to reference the different\n * modules in this request.\n */\ntypedef i32 ModuleID\n\n/**\n * TypeReference is a reference to a user-defined type.\n */\nstruct TypeReference {\n    1: required string name\n    /**\n     * Import path for the package defining this type.\n     */\n    2: required string importPath\n\n    /**\n     * Annotations defined on this type.\n     *\n     * Note that these are the Thrift annotations listed after the type\n     * declaration in the Thrift file.\n     *\n     * Given,\n     *\n     *   struct User {\n     *     1: required i32 id\n     *     2: required string name\n     *   } (key = \"id\", validate)\n     *\n     * The annotations will be,\n     *\n     *   {\n     *     \"key\": \"id\",\n     *     \"validate\": \"\",\n     *   }\n     */\n    3: optional map<string, string> annotations\n\n    // TODO(abg): Should this just be using ModuleID instead of a package?\n}\n\n/**\n * SimpleType is a standalone native Go type.\n */\nenum SimpleType {\n    BOOL = 1,     // bool\n    BYTE,         // byte\n    INT8,         // int8\n    INT16,        // int16\n    INT32,        // int32\n    INT64,        // int64\n    FLOAT64,      // float64\n    STRING,       // string\n    STRUCT_EMPTY, // struct{}\n}\n\n/**\n * TypePair is a pair of two types.\n */\nstruct TypePair {\n    1: required Type left\n    2: required Type right\n}\n\n/**\n * Type is a reference to a Go type which may be native or user defined.\n */\nunion Type {\n    1: SimpleType simpleType\n    /**\n     * Slice of a type\n     *\n     * []$sliceType\n     */\n    2: Type sliceType\n    /**\n     * Slice of key-value pairs of a pair of types.\n     *\n     * []struct{Key $left, Value $right}\n     */\n    3: TypePair keyValueSliceType\n    /**\n     * Map of a pair of types.\n     *\n     * map[$left]$right\n     */\n    4: TypePair mapType\n    /**\n     * Reference to a user-defined type.\n     */\n    5: TypeReference referenceType\n    /**\n     * Pointer to a type.\n     */\n    6: Type pointerType\n}\n\n/**\n * Argument is a single Argument inside a Function.\n * For,\n *\n *      void setValue(1: string key, 2: string value)\n *\n * You get the arguments,\n *\n *      Argument{Name: \"Key\", Type: Type{SimpleType: SimpleTypeString}}\n *\n *      Argument{Name: \"Value\", Type: Type{SimpleType: SimpleTypeString}}\n */\nstruct Argument {\n    /**\n     * Name of the argument. This is also the name of the argument field\n     * inside the args/result struct for that function.\n     */\n    1: required string name\n    /**\n     * Argument type.\n     */\n    2: required Type type\n    /**\n     * Annotations defined on this argument.\n     *\n     * Given,\n     *\n     *   void setValue(\n     *     1: SetValueRequest req\n     *   ) throws (\n     *     1: BadRequestError badRequestError (cache = \"false\")\n     *   )\n     *\n     * The annotations for the Argument representing badRequestError will be,\n     *\n     *  {\n     *    \"cache\": \"false\",\n     *  }\n     */\n    3: optional map<string, string> annotations;\n}\n\n/**\n * Function is a single function on a Thrift service.\n */\nstruct Function {\n    /**\n     * Name of the Go function.\n     */\n    1: required string name\n    /**\n     * Name of the function as defined in the Thrift file.\n     */\n    2: required string thriftName\n    /**\n     * List of arguments accepted by the function.\n     *\n     * This list is in the order specified by the user in the Thrift file.\n     */\n    3: required list<Argument> arguments\n    /**\n     * Return type of the function, if any. If this is not set, the function\n     * is a void function.\n     */\n    4: optional Type returnType\n    /**\n     * List of exceptions raised by the function.\n     *\n     * This list is in the order specified by the user in the Thrift file.\n     */\n    5: optional list<Argument> exceptions\n    /**\n     * Whether this function is oneway or not. This should be assumed to be\n     * false unless explicitly stated otherwise. If this is true, the\n     * returnType and exceptions will be null or empty.\n     */\n    6: optional bool oneWay\n    /**\n     * Annotations defined on this function.\n     *\n     * Given,\n     *\n     *   void setValue(1: SetValueRequest req) (cache = \"false\")\n     *\n     * The annotations will be,\n     *\n     *  {\n     *    \"cache\": \"false\",\n     *  }\n     */\n    7: optional map<string, string> annotations;\n}\n\n/**\n * Service is a service defined by the user in the Thrift file.\n */\nstruct Service {\n    /**\n     * Name of the Thrift service in Go code.\n     */\n    7: required string name\n    /**\n     * Name of the service as defined in the Thrift file.\n     */\n    1: required string thriftName\n    /**\n     * ID of the parent service.\n     */\n    4: optional ServiceID parentID\n    /**\n     * List of functions defined for this service.\n     */\n    5: required list<Function> functions\n    /**\n     * ID of the module where this service was declared.\n     */\n    6: required ModuleID moduleID\n    /**\n     * Annotations defined on this service.\n     *\n     * Given,\n     *\n     *   service KeyValue {\n     *   } (private = \"true\")\n     *\n     * The annotations will be,\n     *\n     *  {\n     *    \"private\": \"true\",\n     *  }\n     */\n    8: optional map<string, string> annotations;\n}\n\n/**\n * Module is a module generated from a single Thrift file. Each module\n * corresponds to exactly one Thrift file and contains all the types and\n * constants defined in that Thrift file.\n */\nstruct Module {\n    /**\n     * Import path for the package defining the types for this module.\n     */\n    1: required string importPath\n    /**\n     * Path to the directory containing the code for this module.\n     *\n     * The path is relative to the output directory into which ThriftRW is\n     * generating code. Plugins SHOULD NOT make any assumptions about the\n     * absolute location of the directory.\n     */\n    2: required string directory\n    /**\n     * Path to the Thrift file from which this module was generated.\n     */\n    3: required string thriftFilePath\n}\n\n//////////////////////////////////////////////////////////////////////////////\n\n/**\n * Feature is a functionality offered by a ThriftRW plugin.\n */\nenum Feature {\n    /**\n     * SERVICE_GENERATOR specifies that the plugin may generate arbitrary code\n     * for services defined in the Thrift file.\n     *\n     * If a plugin provides this, it MUST implement the ServiceGenerator\n     * service.\n     */\n    SERVICE_GENERATOR = 1,\n\n    // TODO: TAGGER for struct-tagging plugins\n}\n\n/**\n * HandshakeRequest is the initial request sent to the plugin as part of\n * establishing communication and feature negotiation.\n */\nstruct HandshakeRequest {\n}\n\n/**\n * HandshakeResponse is the response from the plugin for a HandshakeRequest.\n */\nstruct HandshakeResponse {\n    /**\n     * Name of the plugin. This MUST match the name of the plugin specified\n     * over the command line or the program will fail.\n     */\n    1: required string name\n    /**\n     * Version of the plugin API.\n     *\n     * This MUST be set to API_VERSION by the plugin.\n     */\n    2: required i32 apiVersion (go.name = \"APIVersion\")\n    /**\n     * List of features the plugin provides.\n     */\n    3: required list<Feature> features\n    /**\n     * Version of ThriftRW with which the plugin was built.\n     *\n     * This MUST be set to go.uber.org/thriftrw/version.Version by the plugin\n     * explicitly.\n     */\n    4: optional string libraryVersion\n}\n\nservice Plugin {\n    /**\n     * handshake performs a handshake with the plugin to negotiate the\n     * features provided by it and the version of the plugin API it expects.\n     */\n    HandshakeResponse handshake(1: HandshakeRequest request)\n\n    /**\n     * Informs the plugin process that it will not receive any more requests\n     * and it is safe for it to exit.\n     */\n    void goodbye()\n}\n\n//////////////////////////////////////////////////////////////////////////////\n\n/**\n * GenerateServiceRequest is a request to generate code for zero or more\n * Thrift services.\n */\nstruct GenerateServiceRequest {\n    /**\n     * IDs of services for which code should be generated.\n     *\n     * Note that the services map contains information about both, the\n     * services being generated and their transitive dependencies. Code should\n     * only be generated for service IDs listed here.\n     */\n    1: required list<ServiceID> rootServices\n    /**\n     * Map of service ID to service.\n     *\n     * Any service IDs present in this request will have a corresponding\n     * service definition in this map, including services for which code does\n     * not need to be generated.\n     */\n    2: required map<ServiceID, Service> services\n    /**\n     * Map of module ID to module.\n     *\n     * Any module IDs present in the request will have a corresponding module\n     * definition in this map.\n     */\n    3: required map<ModuleID, Module> modules\n    /**\n     * Prefix for import paths of generated module. In general, plugins should\n     * not need to use the package prefix unless instantiating a new\n     * Generator for more custom plugin generation.\n     */\n    4: required string packagePrefix\n    /**\n     * Directory whose descendants contain all Thrift files. In general,\n     * plugins should not need to use the thrift root unless instantiating a\n     * new Generator for more custom plugin generation.\n     */\n    5: required string thriftRoot\n    /**\n     *  IDs of Modules for which code should be generated.\n     *\n     *  Note that the modules map contains information about both, the\n     *  modules being generated and their transitive dependencies. Code should\n     *  only be generated for module IDs listed here.\n     */\n    6: optional list<ModuleID> rootModules\n}\n\n/**\n * GenerateServiceResponse is response to a GenerateServiceRequest.\n */\nstruct GenerateServiceResponse {\n    /**\n     * Map of file path to file contents.\n     *\n     * All paths MUST be relative to the output directory into which ThriftRW\n     * is generating code. Plugins SHOULD NOT make any assumptions about the\n     * absolute location of the directory.\n     *\n     * The paths MUST NOT contain the string \"..\" or the request will fail.\n     */\n    1: optional map<string, binary> files\n}\n\n/**\n * ServiceGenerator generates arbitrary code for services.\n *\n * This MUST be implemented if the SERVICE_GENERATOR feature is enabled.\n */\nservice ServiceGenerator {\n    /**\n     * Generates code for requested services.\n     */\n    GenerateServiceResponse generate(1: GenerateServiceRequest request)\n}\n"
+const rawIDL = "/**\n * API_VERSION is the version of the plugin API.\n *\n * This MUST be provided in the HandshakeResponse.\n */\nconst i32 API_VERSION = 4\n\n/**\n * ServiceID is an arbitrary unique identifier to reference the different\n * services in this request.\n */\ntypedef i32 ServiceID\n\n/**\n * ModuleID is an arbitrary unique identifier to reference the different\n * modules in this request.\n */\ntypedef i32 ModuleID\n\n/**\n * TypeReference is a reference to a user-defined type.\n */\nstruct TypeReference {\n    1: required string name\n    /**\n     * Import path for the package defining this type.\n     */\n    2: required string importPath\n\n    /**\n     * Annotations defined on this type.\n     *\n     * Note that these are the Thrift annotations listed after the type\n     * declaration in the Thrift file.\n     *\n     * Given,\n     *\n     *   struct User {\n     *     1: required i32 id\n     *     2: required string name\n     *   } (key = \"id\", validate)\n     *\n     * The annotations will be,\n     *\n     *   {\n     *     \"key\": \"id\",\n     *     \"validate\": \"\",\n     *   }\n     */\n    3: optional map<string, string> annotations\n    /**\n     * Docstring of the type, if any, without the comment markers.\n     *\n     * This is not set for types specified with go.type.\n     */\n    4: optional string doc\n\n    // TODO(abg): Should this just be using ModuleID instead of a package?\n}\n\n/**\n * SimpleType is a standalone native Go type.\n */\nenum SimpleType {\n    BOOL = 1,     // bool\n    BYTE,         // byte\n    INT8,         // int8\n    INT16,        // int16\n    INT32,        // int32\n    INT64,        // int64\n    FLOAT64,      // float64\n    STRING,       // string\n    STRUCT_EMPTY, // struct{}\n    // The following are used only with plugins which provide the\n    // UNSIGNED_TYPES feature.\n    UINT8,        // uint8\n    UINT16,       // uint16\n    UINT32,       // uint32\n    UINT64,       // uint64\n}\n\n/**\n * TypePair is a pair of two types.\n */\nstruct TypePair {\n    1: required Type left\n    2: required Type right\n}\n\n/**\n * Type is a reference to a Go type which may be native or user defined.\n */\nunion Type {\n    1: SimpleType simpleType\n    /**\n     * Slice of a type\n     *\n     * []$sliceType\n     */\n    2: Type sliceType\n    /**\n     * Slice of key-value pairs of a pair of types.\n     *\n     * []struct{Key $left, Value $right}\n     */\n    3: TypePair keyValueSliceType\n    /**\n     * Map of a pair of types.\n     *\n     * map[$left]$right\n     */\n    4: TypePair mapType\n    /**\n     * Reference to a user-defined type.\n     */\n    5: TypeReference referenceType\n    /**\n     * Pointer to a type.\n     */\n    6: Type pointerType\n}\n\n/**\n * Deprecation is attached to entities marked as deprecated with the\n * deprecated annotation.\n *\n *   service KeyValue {\n *     void setValue(1: SetValueRequest req) (deprecated = \"Use put instead.\")\n *   }\n */\nstruct Deprecation {\n    /**\n     * Reason given for the deprecation, if any.\n     */\n    1: required string reason\n}\n\n/**\n * Argument is a single Argument inside a Function.\n * For,\n *\n *      void setValue(1: string key, 2: string value)\n *\n * You get the arguments,\n *\n *      Argument{Name: \"Key\", Type: Type{SimpleType: SimpleTypeString}}\n *\n *      Argument{Name: \"Value\", Type: Type{SimpleType: SimpleTypeString}}\n */\nstruct Argument {\n    /**\n     * Name of the argument. This is also the name of the argument field\n     * inside the args/result struct for that function.\n     */\n    1: required string name\n    /**\n     * Argument type.\n     */\n    2: required Type type\n    /**\n     * Annotations defined on this argument.\n     *\n     * Given,\n     *\n     *   void setValue(\n     *     1: SetValueRequest req\n     *   ) throws (\n     *     1: BadRequestError badRequestError (cache = \"false\")\n     *   )\n     *\n     * The annotations for the Argument representing badRequestError will be,\n     *\n     *  {\n     *    \"cache\": \"false\",\n     *  }\n     */\n    3: optional map<string, string> annotations;\n    /**\n     * Set if this argument was marked as deprecated.\n     */\n    4: optional Deprecation deprecated\n    /**\n     * Docstring of the argument, if any, without the comment markers.\n     *\n     * For exceptions, this is the docstring of the exception in the throws\n     * clause, not the exception type.\n     */\n    5: optional string doc\n}\n\n/**\n * Function is a single function on a Thrift service.\n */\nstruct Function {\n    /**\n     * Name of the Go function.\n     */\n    1: required string name\n    /**\n     * Name of the function as defined in the Thrift file.\n     */\n    2: required string thriftName\n    /**\n     * List of arguments accepted by the function.\n     *\n     * This list is in the order specified by the user in the Thrift file.\n     */\n    3: required list<Argument> arguments\n    /**\n     * Return type of the function, if any. If this is not set, the function\n     * is a void function.\n     */\n    4: optional Type returnType\n    /**\n     * List of exceptions raised by the function.\n     *\n     * This list is in the order specified by the user in the Thrift file.\n     */\n    5: optional list<Argument> exceptions\n    /**\n     * Whether this function is oneway or not. This should be assumed to be\n     * false unless explicitly stated otherwise. If this is true, the\n     * returnType and exceptions will be null or empty.\n     */\n    6: optional bool oneWay\n    /**\n     * Annotations defined on this function.\n     *\n     * Given,\n     *\n     *   void setValue(1: SetValueRequest req) (cache = \"false\")\n     *\n     * The annotations will be,\n     *\n     *  {\n     *    \"cache\": \"false\",\n     *  }\n     */\n    7: optional map<string, string> annotations;\n    /**\n     * Set if this function was marked as deprecated. Functions of deprecated\n     * services are not marked individually.\n     */\n    8: optional Deprecation deprecated\n    /**\n     * Docstring of the function, if any, without the comment markers.\n     */\n    9: optional string doc\n}\n\n/**\n * Service is a service defined by the user in the Thrift file.\n */\nstruct Service {\n    /**\n     * Name of the Thrift service in Go code.\n     */\n    7: required string name\n    /**\n     * Name of the service as defined in the Thrift file.\n     */\n    1: required string thriftName\n    /**\n     * ID of the parent service.\n     */\n    4: optional ServiceID parentID\n    /**\n     * List of functions defined for this service.\n     */\n    5: required list<Function> functions\n    /**\n     * ID of the module where this service was declared.\n     */\n    6: required ModuleID moduleID\n    /**\n     * Annotations defined on this service.\n     *\n     * Given,\n     *\n     *   service KeyValue {\n     *   } (private = \"true\")\n     *\n     * The annotations will be,\n     *\n     *  {\n     *    \"private\": \"true\",\n     *  }\n     */\n    8: optional map<string, string> annotations;\n    /**\n     * Set if this service was marked as deprecated.\n     */\n    9: optional Deprecation deprecated\n    /**\n     * Docstring of the service, if any, without the comment markers.\n     */\n    10: optional string doc\n}\n\n/**\n * Module is a module generated from a single Thrift file. Each module\n * corresponds to exactly one Thrift file and contains all the types and\n * constants defined in that Thrift file.\n */\nstruct Module {\n    /**\n     * Import path for the package defining the types for this module.\n     */\n    1: required string importPath\n    /**\n     * Path to the directory containing the code for this module.\n     *\n     * The path is relative to the output directory into which ThriftRW is\n     * generating code. Plugins SHOULD NOT make any assumptions about the\n     * absolute location of the directory.\n     *\n     * This is empty for modules mapped to existing packages with --map\n     * because their code is not generated by ThriftRW.\n     */\n    2: required string directory\n    /**\n     * Path to the Thrift file from which this module was generated.\n     */\n    3: required string thriftFilePath\n}\n\n//////////////////////////////////////////////////////////////////////////////\n\n/**\n * Feature is a functionality offered by a ThriftRW plugin.\n */\nenum Feature {\n    /**\n     * SERVICE_GENERATOR specifies that the plugin may generate arbitrary code\n     * for services defined in the Thrift file.\n     *\n     * If a plugin provides this, it MUST implement the ServiceGenerator\n     * service.\n     */\n    SERVICE_GENERATOR = 1,\n\n    /**\n     * UNSIGNED_TYPES specifies that the plugin understands the UINT8,\n     * UINT16, UINT32, and UINT64 simple types, and that INT8, INT16, and\n     * INT32 may be used for wider Thrift integer types.\n     *\n     * Code generation fails if a plugin which does not provide this is\n     * asked to generate code for modules which use unsigned or narrower Go\n     * integer types with go.type.\n     */\n    UNSIGNED_TYPES = 2,\n\n    // TODO: TAGGER for struct-tagging plugins\n}\n\n/**\n * HandshakeRequest is the initial request sent to the plugin as part of\n * establishing communication and feature negotiation.\n */\nstruct HandshakeRequest {\n}\n\n/**\n * HandshakeResponse is the response from the plugin for a HandshakeRequest.\n */\nstruct HandshakeResponse {\n    /**\n     * Name of the plugin. This MUST match the name of the plugin specified\n     * over the command line or the program will fail.\n     */\n    1: required string name\n    /**\n     * Version of the plugin API.\n     *\n     * This MUST be set to API_VERSION by the plugin.\n     */\n    2: required i32 apiVersion (go.name = \"APIVersion\")\n    /**\n     * List of features the plugin provides.\n     */\n    3: required list<Feature> features\n    /**\n     * Version of ThriftRW with which the plugin was built.\n     *\n     * This MUST be set to go.uber.org/thriftrw/version.Version by the plugin\n     * explicitly.\n     */\n    4: optional string libraryVersion\n}\n\nservice Plugin {\n    /**\n     * handshake performs a handshake with the plugin to negotiate the\n     * features provided by it and the version of the plugin API it expects.\n     */\n    HandshakeResponse handshake(1: HandshakeRequest request)\n\n    /**\n     * Informs the plugin process that it will not receive any more requests\n     * and it is safe for it to exit.\n     */\n    void goodbye()\n}\n\n//////////////////////////////////////////////////////////////////////////////\n\n/**\n * GenerateServiceRequest is a request to generate code for zero or more\n * Thrift services.\n */\nstruct GenerateServiceRequest {\n    /**\n     * IDs of services for which code should be generated.\n     *\n     * Note that the services map contains information about both, the\n     * services being generated and their transitive dependencies. Code should\n     * only be generated for service IDs listed here.\n     */\n    1: required list<ServiceID> rootServices\n    /**\n     * Map of service ID to service.\n     *\n     * Any service IDs present in this request will have a corresponding\n     * service definition in this map, including services for which code does\n     * not need to be generated.\n     */\n    2: required map<ServiceID, Service> services\n    /**\n     * Map of module ID to module.\n     *\n     * Any module IDs present in the request will have a corresponding module\n     * definition in this map.\n     */\n    3: required map<ModuleID, Module> modules\n    /**\n     * Prefix for import paths of generated module. In general, plugins should\n     * not need to use the package prefix unless instantiating a new\n     * Generator for more custom plugin generation.\n     */\n    4: required string packagePrefix\n    /**\n     * Directory whose descendants contain all Thrift files. In general,\n     * plugins should not need to use the thrift root unless instantiating a\n     * new Generator for more custom plugin generation.\n     */\n    5: required string thriftRoot\n    /**\n     *  IDs of Modules for which code should be generated.\n     *\n     *  Note that the modules map contains information about both, the\n     *  modules being generated and their transitive dependencies. Code should\n     *  only be generated for module IDs listed here.\n     */\n    6: optional list<ModuleID> rootModules\n}\n\n/**\n * GenerateServiceResponse is response to a GenerateServiceRequest.\n */\nstruct GenerateServiceResponse {\n    /**\n     * Map of file path to file contents.\n     *\n     * All paths MUST be relative to the output directory into which ThriftRW\n     * is generating code. Plugins SHOULD NOT make any assumptions about the\n     * absolute location of the directory.\n     *\n     * The paths MUST NOT contain the string \"..\" or the request will fail.\n     */\n    1: optional map<string, binary> files\n    /**\n     * Map of artifact path to artifact contents.\n     *\n     * Artifacts are arbitrary files that are not Go code: JSON schemas,\n     * documentation, stubs for other languages, etc. They are written to the\n     * artifact output directory, which may be different from the directory\n     * into which ThriftRW is generating Go code. ThriftRW does not format or\n     * otherwise process artifacts.\n     *\n     * All paths MUST be relative to the artifact output directory and MUST\n     * NOT contain the string \"..\" or the request will fail.\n     */\n    2: optional map<string, binary> artifacts\n}\n\n/**\n * ServiceGenerator generates arbitrary code for services.\n *\n * This MUST be implemented if the SERVICE_GENERATOR feature is enabled.\n */\nservice ServiceGenerator {\n    /**\n     * Generates code for requested services.\n     */\n    GenerateServiceResponse generate(1: GenerateServiceRequest request)\n}\n"
 
 // Plugin_Goodbye_Args represents the arguments for the Plugin.goodbye function.
 //
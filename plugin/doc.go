@@ -53,4 +53,19 @@
 // 	thriftrw --plugin='myfancyplugin --useContext'
 //
 // Will pass `--useContext` to `thriftrw-plugin-myfancyplugin`.
+//
+// In-process Plugins
+//
+// Programs that use ThriftRW as a library may run plugins inside their own
+// process instead of installing separate executables. Pass the Plugin to
+// gen.Generate with the InProcessPlugins option.
+//
+// 	err := gen.Generate(module, &gen.Options{
+// 		// ...
+// 		InProcessPlugins: []*plugin.Plugin{
+// 			{Name: "myfancyplugin", ServiceGenerator: mySG},
+// 		},
+// 	})
+//
+// In-process plugins are subject to the same checks as plugin executables.
 package plugin
@@ -40,9 +40,14 @@ var _proto = protocol.Binary
 //
 // At minimum, a plugin name must be provided and it MUST match the name of
 // the plugin in the executable.
+//
+// Plugins may also be run inside the ThriftRW process by programs that use
+// ThriftRW as a library. See InProcessPlugins in go.uber.org/thriftrw/gen.
 type Plugin struct {
 	// Name of the plugin. The name of the executable providing this plugin MUST
 	// be thriftrw-plugin-$name.
+	//
+	// In-process plugins don't have executables but must still be named.
 	Name string
 
 	// Plugins can implement a ServiceGenerator to generate arbitrary code for
@@ -52,7 +57,7 @@ type Plugin struct {
 
 	// Reader and Writer may be specified to change the communication channel
 	// this plugin uses. By default, plugins listen on stdin and write to
-	// stdout.
+	// stdout. These are ignored for in-process plugins.
 	Reader io.Reader
 	Writer io.Writer
 }
@@ -75,7 +80,9 @@ func Main(p *Plugin) {
 
 	mainHandler := multiplex.NewHandler()
 
-	features := []api.Feature{}
+	// Plugins built against this version of the API understand unsigned
+	// types.
+	features := []api.Feature{api.FeatureUnsignedTypes}
 
 	if p.ServiceGenerator != nil {
 		features = append(features, api.FeatureServiceGenerator)
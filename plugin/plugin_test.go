@@ -43,7 +43,7 @@ func TestEmptyPlugin(t *testing.T) {
 	assert.Equal(t, api.APIVersion, response.APIVersion)
 	assert.Equal(t, "hello", response.Name)
 	assert.Equal(t, version.Version, *response.LibraryVersion)
-	assert.Empty(t, response.Features)
+	assert.Equal(t, []api.Feature{api.FeatureUnsignedTypes}, response.Features)
 
 	assert.NoError(t, client.Goodbye())
 }
@@ -0,0 +1,304 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Package golden tests ThriftRW plugins against golden files.
+//
+// A golden test compiles a Thrift file, runs the plugin on it in-process with
+// the same request that ThriftRW would send it, and compares the files it
+// generates with those in a directory.
+//
+// 	func TestPlugin(t *testing.T) {
+// 		golden.Run(t, golden.Test{
+// 			Plugin:     myPlugin,
+// 			ThriftFile: "testdata/keyvalue.thrift",
+// 			Dir:        "testdata/golden",
+// 		})
+// 	}
+//
+// Run the tests with the -update flag to write the generated files to the
+// directory instead of comparing them.
+//
+// 	go test -update
+//
+// Test packages using this package must not define their own -update flag.
+// Where flags can't be passed to the test binary, set
+// THRIFTRW_GOLDEN_UPDATE=1 in the environment or set Test.Update instead.
+package golden
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"testing"
+
+	"go.uber.org/thriftrw/compile"
+	"go.uber.org/thriftrw/gen"
+	"go.uber.org/thriftrw/plugin"
+	"go.uber.org/thriftrw/plugin/api"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// UpdateEnv is the environment variable which, if set to a true value,
+// makes Run update golden files instead of comparing against them.
+const UpdateEnv = "THRIFTRW_GOLDEN_UPDATE"
+
+var _update = flag.Bool("update", false, "update golden files instead of comparing against them")
+
+// _defaultPackagePrefix is the import path prefix used for generated code if
+// the Test doesn't specify one.
+const _defaultPackagePrefix = "example.com/golden"
+
+// Test is a single golden test for a plugin.
+type Test struct {
+	// Plugin under test. It must have a name and a ServiceGenerator.
+	Plugin *plugin.Plugin
+
+	// Path to the Thrift file for which code is generated.
+	ThriftFile string
+
+	// Directory containing the golden files. Go files and artifacts generated
+	// by the plugin are compared with files at the same relative paths in
+	// this directory.
+	//
+	// This directory must contain only golden files. Files in it which the
+	// plugin did not generate are reported as errors. They are not removed
+	// when golden files are updated.
+	Dir string
+
+	// Import path prefix for generated packages. Defaults to
+	// "example.com/golden".
+	PackagePrefix string
+
+	// Directory containing all Thrift files. Defaults to the directory
+	// containing ThriftFile.
+	ThriftRoot string
+
+	// Whether code should be generated only for ThriftFile and not the files
+	// it includes.
+	NoRecurse bool
+
+	// Update the golden files with the generated files instead of comparing
+	// them. This is implied by the -update flag and by UpdateEnv.
+	Update bool
+}
+
+// Run runs the given golden test, failing t if the generated files don't
+// match the golden files. If the -update flag, Test.Update, or UpdateEnv is
+// set, the golden files are overwritten with the generated files instead.
+func Run(t testing.TB, tt Test) {
+	t.Helper()
+
+	update, err := shouldUpdate(tt)
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+
+	got, err := generate(tt)
+	if err != nil {
+		t.Fatalf("failed to generate code for %q: %v", tt.ThriftFile, err)
+	}
+
+	want, err := read(tt.Dir)
+	if err != nil {
+		t.Fatalf("failed to read golden files: %v", err)
+	}
+
+	if update {
+		if err := write(tt.Dir, got); err != nil {
+			t.Fatalf("failed to update golden files: %v", err)
+		}
+	}
+
+	for _, path := range sortedPaths(want) {
+		if _, ok := got[path]; !ok {
+			t.Errorf("golden file %q was not generated: delete it", path)
+		}
+	}
+
+	if update {
+		return
+	}
+
+	for _, path := range sortedPaths(got) {
+		w, ok := want[path]
+		if !ok {
+			t.Errorf("generated file %q does not have a golden file: run with -update to add it", path)
+			continue
+		}
+		assert.Equal(t, string(w), string(got[path]),
+			"generated file %q does not match the golden file: run with -update to update it", path)
+	}
+}
+
+// shouldUpdate reports whether golden files should be updated for the given
+// test.
+func shouldUpdate(tt Test) (bool, error) {
+	if tt.Update || *_update {
+		return true, nil
+	}
+
+	v := os.Getenv(UpdateEnv)
+	if v == "" {
+		return false, nil
+	}
+
+	update, err := strconv.ParseBool(v)
+	if err != nil {
+		return false, fmt.Errorf("invalid value %q for %v: %v", v, UpdateEnv, err)
+	}
+	return update, nil
+}
+
+// generate runs the plugin for the given test and returns the files and
+// artifacts it generated.
+func generate(tt Test) (map[string][]byte, error) {
+	if tt.Plugin == nil || tt.Plugin.ServiceGenerator == nil {
+		return nil, errors.New("a plugin with a ServiceGenerator must be provided")
+	}
+
+	module, err := compile.Compile(tt.ThriftFile)
+	if err != nil {
+		return nil, err
+	}
+
+	thriftRoot := tt.ThriftRoot
+	if thriftRoot == "" {
+		thriftRoot = filepath.Dir(module.ThriftPath)
+	}
+	thriftRoot, err = filepath.Abs(thriftRoot)
+	if err != nil {
+		return nil, err
+	}
+
+	packagePrefix := tt.PackagePrefix
+	if packagePrefix == "" {
+		packagePrefix = _defaultPackagePrefix
+	}
+
+	outputDir, err := ioutil.TempDir("", "thriftrw-golden")
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(outputDir)
+
+	// Record the response of the plugin so that we compare only the files it
+	// generated, not those generated by ThriftRW.
+	rec := &recorder{ServiceGenerator: tt.Plugin.ServiceGenerator}
+	p := *tt.Plugin
+	p.ServiceGenerator = rec
+
+	err = gen.Generate(module, &gen.Options{
+		OutputDir:        outputDir,
+		PackagePrefix:    packagePrefix,
+		ThriftRoot:       thriftRoot,
+		NoRecurse:        tt.NoRecurse,
+		InProcessPlugins: []*plugin.Plugin{&p},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	files := make(map[string][]byte)
+	if rec.res == nil {
+		return files, nil
+	}
+	for path, contents := range rec.res.Files {
+		files[filepath.ToSlash(filepath.Clean(path))] = contents
+	}
+	// Artifacts and Go files share a directory so gen.Generate has already
+	// verified that their paths don't conflict.
+	for path, contents := range rec.res.Artifacts {
+		files[filepath.ToSlash(filepath.Clean(path))] = contents
+	}
+	return files, nil
+}
+
+// recorder is an api.ServiceGenerator that records the response of another.
+type recorder struct {
+	api.ServiceGenerator
+
+	res *api.GenerateServiceResponse
+}
+
+func (r *recorder) Generate(req *api.GenerateServiceRequest) (*api.GenerateServiceResponse, error) {
+	res, err := r.ServiceGenerator.Generate(req)
+	r.res = res
+	return res, err
+}
+
+// read reads all files in the given directory, keyed by their slash-separated
+// paths relative to it. A directory that doesn't exist has no files.
+func read(dir string) (map[string][]byte, error) {
+	files := make(map[string][]byte)
+	err := filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			if os.IsNotExist(err) && path == dir {
+				return nil
+			}
+			return err
+		}
+		if info.IsDir() {
+			return nil
+		}
+
+		rel, err := filepath.Rel(dir, path)
+		if err != nil {
+			return err
+		}
+
+		contents, err := ioutil.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		files[filepath.ToSlash(rel)] = contents
+		return nil
+	})
+	return files, err
+}
+
+// write writes the given files to the given directory, overwriting existing
+// files at the same paths. Other files in the directory are left untouched.
+func write(dir string, files map[string][]byte) error {
+	for path, contents := range files {
+		fullPath := filepath.Join(dir, filepath.FromSlash(path))
+		if err := os.MkdirAll(filepath.Dir(fullPath), 0755); err != nil {
+			return err
+		}
+		if err := ioutil.WriteFile(fullPath, contents, 0644); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func sortedPaths(files map[string][]byte) []string {
+	paths := make([]string, 0, len(files))
+	for path := range files {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+	return paths
+}
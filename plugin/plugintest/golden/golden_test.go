@@ -0,0 +1,230 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package golden
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+
+	"go.uber.org/thriftrw/plugin"
+	"go.uber.org/thriftrw/plugin/api"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// namesGenerator generates a Go file listing the functions of each root
+// service, and a Markdown artifact documenting it.
+type namesGenerator struct{}
+
+func (namesGenerator) Generate(req *api.GenerateServiceRequest) (*api.GenerateServiceResponse, error) {
+	res := &api.GenerateServiceResponse{
+		Files:     make(map[string][]byte),
+		Artifacts: make(map[string][]byte),
+	}
+
+	for _, id := range req.RootServices {
+		svc := req.Services[id]
+		module := req.Modules[svc.ModuleID]
+		name := strings.ToLower(svc.Name)
+
+		path := filepath.Join(module.Directory, name+"_names.go")
+		contents, err := plugin.GoFileFromTemplate(path, `
+			package <basename .Module.ImportPath>
+
+			<formatDoc .Service.GetDoc>var <.Service.Name>Functions = []string{
+				<range .Service.Functions>"<.ThriftName>",
+				<end>
+			}
+		`, struct {
+			Module  *api.Module
+			Service *api.Service
+		}{Module: module, Service: svc}, plugin.TemplateFunc("basename", filepath.Base))
+		if err != nil {
+			return nil, err
+		}
+		res.Files[path] = contents
+
+		path = filepath.Join("docs", name+".md")
+		res.Artifacts[path], err = plugin.TextFileFromTemplate(path,
+			"# <.Name>\n<range .Functions>\n- <.ThriftName>: <.GetDoc>\n<end>", svc)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return res, nil
+}
+
+var _namesPlugin = &plugin.Plugin{Name: "names", ServiceGenerator: namesGenerator{}}
+
+func TestRun(t *testing.T) {
+	Run(t, Test{
+		Plugin:     _namesPlugin,
+		ThriftFile: "testdata/keyvalue.thrift",
+		Dir:        "testdata/golden",
+	})
+}
+
+// fakeT is a testing.TB that records failures.
+type fakeT struct {
+	testing.TB
+
+	errors []string
+	fatal  bool
+}
+
+func (t *fakeT) Helper() {}
+
+func (t *fakeT) Name() string { return "fake" }
+
+func (t *fakeT) Errorf(msg string, args ...interface{}) {
+	t.errors = append(t.errors, fmt.Sprintf(msg, args...))
+}
+
+func (t *fakeT) Fatalf(msg string, args ...interface{}) {
+	t.Errorf(msg, args...)
+	t.fatal = true
+	runtime.Goexit()
+}
+
+// runFake runs the given test with a fakeT.
+func runFake(tt Test) *fakeT {
+	t := new(fakeT)
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		Run(t, tt)
+	}()
+	<-done
+	return t
+}
+
+func TestRunFailures(t *testing.T) {
+	dir, err := ioutil.TempDir("", "golden-test")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	copyGolden := func(t *testing.T) string {
+		want, err := read("testdata/golden")
+		require.NoError(t, err)
+
+		goldenDir := filepath.Join(dir, t.Name())
+		require.NoError(t, write(goldenDir, want))
+		return goldenDir
+	}
+
+	t.Run("mismatch", func(t *testing.T) {
+		goldenDir := copyGolden(t)
+		require.NoError(t, ioutil.WriteFile(
+			filepath.Join(goldenDir, "docs", "keyvalue.md"), []byte("# KeyValue\n"), 0644))
+
+		ft := runFake(Test{Plugin: _namesPlugin, ThriftFile: "testdata/keyvalue.thrift", Dir: goldenDir})
+		require.Len(t, ft.errors, 1)
+		assert.Contains(t, ft.errors[0], `generated file "docs/keyvalue.md" does not match the golden file`)
+	})
+
+	t.Run("missing and extra", func(t *testing.T) {
+		goldenDir := copyGolden(t)
+		require.NoError(t, os.Remove(filepath.Join(goldenDir, "docs", "keyvalue.md")))
+		require.NoError(t, ioutil.WriteFile(filepath.Join(goldenDir, "stale.go"), []byte("package stale"), 0644))
+
+		ft := runFake(Test{Plugin: _namesPlugin, ThriftFile: "testdata/keyvalue.thrift", Dir: goldenDir})
+		assert.Equal(t, []string{
+			`golden file "stale.go" was not generated: delete it`,
+			`generated file "docs/keyvalue.md" does not have a golden file: run with -update to add it`,
+		}, ft.errors)
+	})
+
+	t.Run("update", func(t *testing.T) {
+		goldenDir := filepath.Join(dir, "update")
+		require.NoError(t, os.MkdirAll(goldenDir, 0755))
+		require.NoError(t, ioutil.WriteFile(filepath.Join(goldenDir, "stale.go"), []byte("package stale"), 0644))
+		require.NoError(t, os.MkdirAll(filepath.Join(goldenDir, "docs"), 0755))
+		require.NoError(t, ioutil.WriteFile(filepath.Join(goldenDir, "docs", "keyvalue.md"), []byte("# old\n"), 0644))
+
+		ft := runFake(Test{Plugin: _namesPlugin, ThriftFile: "testdata/keyvalue.thrift", Dir: goldenDir, Update: true})
+
+		// Stale files are reported but never deleted.
+		assert.Equal(t, []string{`golden file "stale.go" was not generated: delete it`}, ft.errors)
+
+		got, err := read(goldenDir)
+		require.NoError(t, err)
+		want, err := read("testdata/golden")
+		require.NoError(t, err)
+		want["stale.go"] = []byte("package stale")
+		assert.Equal(t, want, got)
+	})
+
+	t.Run("update from flag", func(t *testing.T) {
+		goldenDir := filepath.Join(dir, "update-flag")
+
+		defer func(update bool) { *_update = update }(*_update)
+		*_update = true
+
+		ft := runFake(Test{Plugin: _namesPlugin, ThriftFile: "testdata/keyvalue.thrift", Dir: goldenDir})
+		require.Empty(t, ft.errors)
+
+		got, err := read(goldenDir)
+		require.NoError(t, err)
+		want, err := read("testdata/golden")
+		require.NoError(t, err)
+		assert.Equal(t, want, got)
+	})
+
+	t.Run("update from environment", func(t *testing.T) {
+		goldenDir := filepath.Join(dir, "update-env")
+
+		defer os.Unsetenv(UpdateEnv)
+		require.NoError(t, os.Setenv(UpdateEnv, "1"))
+
+		ft := runFake(Test{Plugin: _namesPlugin, ThriftFile: "testdata/keyvalue.thrift", Dir: goldenDir})
+		require.Empty(t, ft.errors)
+
+		got, err := read(goldenDir)
+		require.NoError(t, err)
+		want, err := read("testdata/golden")
+		require.NoError(t, err)
+		assert.Equal(t, want, got)
+
+		require.NoError(t, os.Setenv(UpdateEnv, "yes please"))
+		ft = runFake(Test{Plugin: _namesPlugin, ThriftFile: "testdata/keyvalue.thrift", Dir: goldenDir})
+		assert.True(t, ft.fatal)
+		assert.Contains(t, ft.errors[0], `invalid value "yes please" for THRIFTRW_GOLDEN_UPDATE`)
+	})
+
+	t.Run("no plugin", func(t *testing.T) {
+		ft := runFake(Test{ThriftFile: "testdata/keyvalue.thrift", Dir: dir})
+		assert.True(t, ft.fatal)
+		assert.Contains(t, ft.errors[0], "a plugin with a ServiceGenerator must be provided")
+	})
+
+	t.Run("compile error", func(t *testing.T) {
+		ft := runFake(Test{Plugin: _namesPlugin, ThriftFile: "testdata/does_not_exist.thrift", Dir: dir})
+		assert.True(t, ft.fatal)
+		assert.Contains(t, ft.errors[0], `failed to generate code for "testdata/does_not_exist.thrift"`)
+	})
+}
@@ -0,0 +1,7 @@
+package keyvalue
+
+// KeyValue stores values by key.
+var KeyValueFunctions = []string{
+	"getValue",
+	"setValue",
+}
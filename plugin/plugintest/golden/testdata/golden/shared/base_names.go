@@ -0,0 +1,5 @@
+package shared
+
+var BaseFunctions = []string{
+	"healthy",
+}
@@ -0,0 +1,9 @@
+include "./shared.thrift"
+
+/** KeyValue stores values by key. */
+service KeyValue extends shared.Base {
+    /** Gets the value for a key. */
+    binary getValue(1: string key)
+
+    void setValue(1: string key, 2: binary value)
+}
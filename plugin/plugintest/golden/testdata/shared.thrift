@@ -0,0 +1,3 @@
+service Base {
+    bool healthy()
+}
@@ -27,6 +27,7 @@ import (
 	"go/parser"
 	"go/token"
 	"sort"
+	"strings"
 	"text/template"
 
 	"go.uber.org/thriftrw/internal/goast"
@@ -149,6 +150,14 @@ func (g *goFileGenerator) FormatType(t *api.Type) (string, error) {
 			return "string", nil
 		case api.SimpleTypeStructEmpty:
 			return "struct{}", nil
+		case api.SimpleTypeUint8:
+			return "uint8", nil
+		case api.SimpleTypeUint16:
+			return "uint16", nil
+		case api.SimpleTypeUint32:
+			return "uint32", nil
+		case api.SimpleTypeUint64:
+			return "uint64", nil
 		default:
 			return "", fmt.Errorf("unknown simple type: %v", *t.SimpleType)
 		}
@@ -190,21 +199,12 @@ func (g *goFileGenerator) FormatType(t *api.Type) (string, error) {
 // Generates a Go file with the given name using the provided template and
 // template data.
 func (g *goFileGenerator) Generate(filename, tmpl string, data interface{}) ([]byte, error) {
-	funcs := template.FuncMap{
+	buff, err := g.execute(filename, tmpl, data, template.FuncMap{
 		"import":     g.Import,
 		"formatType": g.FormatType,
-	}
-	for k, v := range g.templateFuncs {
-		funcs[k] = v
-	}
-
-	t, err := template.New(filename).Delims("<", ">").Funcs(funcs).Parse(tmpl)
+		"formatDoc":  formatDoc,
+	})
 	if err != nil {
-		return nil, fmt.Errorf("failed to parse template %q: %v", filename, err)
-	}
-
-	var buff bytes.Buffer
-	if err := t.Execute(&buff, data); err != nil {
 		return nil, err
 	}
 
@@ -236,6 +236,40 @@ func (g *goFileGenerator) Generate(filename, tmpl string, data interface{}) ([]b
 	return buff.Bytes(), nil
 }
 
+// execute runs the given template with the provided functions in addition
+// to those specified with TemplateFunc.
+func (g *goFileGenerator) execute(filename, tmpl string, data interface{}, funcs template.FuncMap) (bytes.Buffer, error) {
+	var buff bytes.Buffer
+	for k, v := range g.templateFuncs {
+		funcs[k] = v
+	}
+
+	t, err := template.New(filename).Delims("<", ">").Funcs(funcs).Parse(tmpl)
+	if err != nil {
+		return buff, fmt.Errorf("failed to parse template %q: %v", filename, err)
+	}
+
+	err = t.Execute(&buff, data)
+	return buff, err
+}
+
+// formatDoc formats a docstring as a Go comment block. The returned string
+// ends with a newline if it's non-empty.
+func formatDoc(s string) string {
+	if len(s) == 0 {
+		return ""
+	}
+	lines := strings.Split(s, "\n")
+	for i, l := range lines {
+		if len(l) == 0 {
+			lines[i] = "//"
+		} else {
+			lines[i] = "// " + l
+		}
+	}
+	return strings.Join(lines, "\n") + "\n"
+}
+
 // GoFileFromTemplate generates a Go file from the given template and template
 // data.
 //
@@ -262,6 +296,12 @@ func (g *goFileGenerator) Generate(filename, tmpl string, data interface{}) ([]b
 //
 // 	var value <formatType .Type>
 //
+// formatDoc: Formats a docstring as a Go comment, prefixing each line with
+// "//". This returns an empty string if the docstring is empty. Docstrings
+// are optional in the plugin API, so use the GetDoc accessor to render them.
+//
+// 	<formatDoc .GetDoc>type <.Name>Client interface {
+//
 // More functions may be added to the template using the TemplateFunc template
 // option. If the name of a TemplateFunc conflicts with a pre-defined function,
 // the TemplateFunc takes precedence.
@@ -270,3 +310,26 @@ func (g *goFileGenerator) Generate(filename, tmpl string, data interface{}) ([]b
 func GoFileFromTemplate(filename, tmpl string, data interface{}, opts ...TemplateOption) ([]byte, error) {
 	return newGoFileGenerator(opts).Generate(filename, tmpl, data)
 }
+
+// TextFileFromTemplate generates a file that isn't Go code from the given
+// template and template data. Use this for artifacts like documentation or
+// schemas; these are returned in the Artifacts field of the
+// GenerateServiceResponse.
+//
+// The templating system is the same as GoFileFromTemplate's, with "<" and
+// ">" as the delimiters, but the output is not parsed or formatted and
+// the import and formatType functions are not available. Functions added with
+// the TemplateFunc option are available.
+//
+// 	TextFileFromTemplate(
+// 		"schema/keyvalue.json",
+// 		`{"service": "<.Name>"}`,
+// 		service,
+// 	)
+func TextFileFromTemplate(filename, tmpl string, data interface{}, opts ...TemplateOption) ([]byte, error) {
+	buff, err := newGoFileGenerator(opts).execute(filename, tmpl, data, make(template.FuncMap))
+	if err != nil {
+		return nil, err
+	}
+	return buff.Bytes(), nil
+}
@@ -7,6 +7,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/thriftrw/plugin/api"
+	"go.uber.org/thriftrw/ptr"
 )
 
 func TestGoFileFromTemplate(t *testing.T) {
@@ -107,6 +108,40 @@ func TestGoFileFromTemplate(t *testing.T) {
 				`}`,
 			),
 		},
+		{
+			desc: "docstrings",
+			template: `
+				package foo
+
+				<formatDoc .GetDoc>type <.Name>Client interface {
+					<range .Functions>
+					<formatDoc .GetDoc><.Name>()
+					<end>
+				}
+			`,
+			data: &api.Service{
+				Name: "KeyValue",
+				Doc:  ptr.String("KeyValue stores values.\n\nValues are never evicted."),
+				Functions: []*api.Function{
+					{Name: "Get", Doc: ptr.String("Get retrieves a value.")},
+					{Name: "Put"},
+				},
+			},
+			wantBody: unlines(
+				`package foo`,
+				``,
+				`// KeyValue stores values.`,
+				`//`,
+				`// Values are never evicted.`,
+				`type KeyValueClient interface {`,
+				``,
+				`	// Get retrieves a value.`,
+				`	Get()`,
+				``,
+				`	Put()`,
+				`}`,
+			),
+		},
 		{
 			desc: "import",
 			template: `
@@ -274,6 +309,49 @@ func TestGoFileFromTemplate(t *testing.T) {
 	}
 }
 
+func TestTextFileFromTemplate(t *testing.T) {
+	tests := []struct {
+		desc     string
+		template string
+		data     interface{}
+		options  []TemplateOption
+
+		wantBody  string
+		wantError string
+	}{
+		{
+			desc:     "not formatted",
+			template: "# <.Name>\n\n  import \"foo\"\n",
+			data:     &api.Service{Name: "KeyValue"},
+			wantBody: "# KeyValue\n\n  import \"foo\"\n",
+		},
+		{
+			desc:     "template func",
+			template: `{"name": "<lower .Name>"}`,
+			data:     &api.Service{Name: "KeyValue"},
+			options:  []TemplateOption{TemplateFunc("lower", strings.ToLower)},
+			wantBody: `{"name": "keyvalue"}`,
+		},
+		{
+			desc:      "Go functions are not available",
+			template:  `<import "foo">`,
+			wantError: `function "import" not defined`,
+		},
+	}
+
+	for _, tt := range tests {
+		got, err := TextFileFromTemplate("test.md", tt.template, tt.data, tt.options...)
+		if tt.wantError != "" {
+			if assert.Error(t, err, tt.desc) {
+				assert.Contains(t, err.Error(), tt.wantError, tt.desc)
+			}
+		} else {
+			assert.NoError(t, err, tt.desc)
+			assert.Equal(t, tt.wantBody, string(got), tt.desc)
+		}
+	}
+}
+
 // unlines joins the given lines with newlines in between followied by a
 // trailing newline.
 func unlines(lines ...string) string {
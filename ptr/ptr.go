@@ -46,6 +46,26 @@ func Int64(x int64) *int64 {
 	return &x
 }
 
+// Uint8 converts a uint8 to a pointer
+func Uint8(x uint8) *uint8 {
+	return &x
+}
+
+// Uint16 converts a uint16 to a pointer
+func Uint16(x uint16) *uint16 {
+	return &x
+}
+
+// Uint32 converts a uint32 to a pointer
+func Uint32(x uint32) *uint32 {
+	return &x
+}
+
+// Uint64 converts a uint64 to a pointer
+func Uint64(x uint64) *uint64 {
+	return &x
+}
+
 // Float64 converts a float64 to a pointer
 func Float64(x float64) *float64 {
 	return &x
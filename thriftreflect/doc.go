@@ -0,0 +1,44 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Package thriftreflect provides access at runtime to the Thrift definitions
+// from which code was generated.
+//
+// Each package generated by ThriftRW exposes a ThriftModule holding the
+// Thrift file it was generated from, unless it was generated with
+// --no-embed-idl. Packages generated with --register-idl also register their
+// module with this package on initialization. Registered modules may be
+// listed with Modules or found by the import path of their Go package with
+// ModuleByPackage.
+//
+// The embedded IDL is compiled lazily, the first time it is needed, and the
+// result is cached. LookupStruct and LookupService describe the structs and
+// services defined in a module. Structs of registered modules provide a
+// constructor for their generated Go type.
+//
+// 	s, err := thriftreflect.LookupStruct(keyvalue.ThriftModule, "Item")
+// 	if err != nil {
+// 		return err
+// 	}
+// 	for _, f := range s.Fields {
+// 		fmt.Println(f.ID, f.Name, f.Type.ThriftName())
+// 	}
+// 	item := s.New().(*keyvalue.Item)
+package thriftreflect
@@ -0,0 +1,253 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package thriftreflect
+
+import (
+	"fmt"
+	"os"
+	"path"
+	"sort"
+	"strings"
+
+	"go.uber.org/thriftrw/compile"
+)
+
+// Struct describes a struct, union, or exception defined in a Thrift file.
+type Struct struct {
+	// Module in which this struct was defined.
+	Module *ThriftModule
+
+	// Name of the struct in the Thrift file.
+	Name string
+
+	// Fields of the struct, ordered by their field IDs.
+	Fields []*Field
+
+	// Spec is the compiled representation of the struct.
+	Spec *compile.StructSpec
+
+	// New returns a pointer to a new, empty value of the Go type generated
+	// for this struct. This is nil if the module was not registered, or if
+	// no such type was generated, as is the case for unions generated as
+	// interfaces.
+	New Constructor
+}
+
+// Field describes a field of a struct, or an argument or exception of a
+// function.
+type Field struct {
+	ID       int16
+	Name     string
+	Required bool
+	Type     compile.TypeSpec
+
+	// Default value of the field, or nil if it doesn't have one.
+	Default compile.ConstantValue
+}
+
+// Service describes a service defined in a Thrift file.
+type Service struct {
+	// Module in which this service was defined.
+	Module *ThriftModule
+
+	// Name of the service in the Thrift file.
+	Name string
+
+	// Functions of the service, ordered by name. This does not include
+	// functions inherited from parent services.
+	Functions []*Function
+
+	// Spec is the compiled representation of the service.
+	Spec *compile.ServiceSpec
+}
+
+// Function describes a function of a service.
+type Function struct {
+	Name   string
+	OneWay bool
+
+	// Arguments of the function, ordered by their field IDs.
+	Args []*Field
+
+	// Type returned by the function, or nil if it doesn't return anything.
+	ReturnType compile.TypeSpec
+
+	// Exceptions raised by the function, ordered by their field IDs.
+	Exceptions []*Field
+}
+
+// Compile compiles the IDL embedded in the given module, along with the
+// modules it includes.
+//
+// Results are cached for registered modules so the IDL is compiled at most
+// once.
+func Compile(m *ThriftModule) (*compile.Module, error) {
+	if e := lookupEntry(m.Package); e != nil && e.Module == m {
+		return e.compile()
+	}
+	return compileModule(m)
+}
+
+func (e *entry) compile() (*compile.Module, error) {
+	e.compileOnce.Do(func() {
+		e.compiled, e.compileErr = compileModule(e.Module)
+	})
+	return e.compiled, e.compileErr
+}
+
+func compileModule(m *ThriftModule) (*compile.Module, error) {
+	fs := make(memFS)
+	fs.add(m)
+
+	compiled, err := compile.Compile(fs.path(m), compile.Filesystem(fs), compile.NonStrict())
+	if err != nil {
+		return nil, fmt.Errorf("could not compile module %q: %v", m.FilePath, err)
+	}
+	return compiled, nil
+}
+
+// LookupStruct looks up the struct, union, or exception with the given name
+// defined in the given module.
+func LookupStruct(m *ThriftModule, name string) (*Struct, error) {
+	compiled, err := Compile(m)
+	if err != nil {
+		return nil, err
+	}
+
+	t, ok := compiled.Types[name]
+	if !ok {
+		return nil, fmt.Errorf("module %q does not define %q", m.FilePath, name)
+	}
+
+	spec, ok := t.(*compile.StructSpec)
+	if !ok {
+		return nil, fmt.Errorf("%q in module %q is not a struct, union, or exception", name, m.FilePath)
+	}
+
+	s := &Struct{
+		Module: m,
+		Name:   name,
+		Fields: fields(spec.Fields),
+		Spec:   spec,
+	}
+	if e := lookupEntry(m.Package); e != nil && e.Module == m {
+		s.New = e.Types[name]
+	}
+	return s, nil
+}
+
+// LookupService looks up the service with the given name defined in the
+// given module.
+func LookupService(m *ThriftModule, name string) (*Service, error) {
+	compiled, err := Compile(m)
+	if err != nil {
+		return nil, err
+	}
+
+	spec, ok := compiled.Services[name]
+	if !ok {
+		return nil, fmt.Errorf("module %q does not define service %q", m.FilePath, name)
+	}
+
+	names := make([]string, 0, len(spec.Functions))
+	for name := range spec.Functions {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	functions := make([]*Function, len(names))
+	for i, name := range names {
+		f := spec.Functions[name]
+		function := &Function{
+			Name:   f.Name,
+			OneWay: f.OneWay,
+			Args:   fields(compile.FieldGroup(f.ArgsSpec)),
+		}
+		if f.ResultSpec != nil {
+			function.ReturnType = f.ResultSpec.ReturnType
+			function.Exceptions = fields(f.ResultSpec.Exceptions)
+		}
+		functions[i] = function
+	}
+
+	return &Service{
+		Module:    m,
+		Name:      name,
+		Functions: functions,
+		Spec:      spec,
+	}, nil
+}
+
+// fields builds descriptors for the given fields, ordered by field ID.
+func fields(fs compile.FieldGroup) []*Field {
+	if len(fs) == 0 {
+		return nil
+	}
+
+	fields := make([]*Field, len(fs))
+	for i, f := range fs {
+		fields[i] = &Field{
+			ID:       f.ID,
+			Name:     f.Name,
+			Required: f.Required,
+			Type:     f.Type,
+			Default:  f.Default,
+		}
+	}
+	sort.Slice(fields, func(i, j int) bool {
+		return fields[i].ID < fields[j].ID
+	})
+	return fields
+}
+
+// memFS is an in-memory compile.FS holding the IDL of modules, keyed by
+// their paths relative to the Thrift root, rooted at "/".
+type memFS map[string][]byte
+
+var _ compile.FS = memFS(nil)
+
+// add adds the given module and the modules it includes to the filesystem.
+func (fs memFS) add(m *ThriftModule) {
+	p := fs.path(m)
+	if _, ok := fs[p]; ok {
+		return
+	}
+
+	fs[p] = []byte(m.Raw)
+	for _, inc := range m.Includes {
+		fs.add(inc)
+	}
+}
+
+func (memFS) path(m *ThriftModule) string {
+	return path.Join("/", strings.Replace(m.FilePath, "\\", "/", -1))
+}
+
+func (fs memFS) Read(filename string) ([]byte, error) {
+	if b, ok := fs[path.Clean(filename)]; ok {
+		return b, nil
+	}
+	return nil, os.ErrNotExist
+}
+
+func (memFS) Abs(p string) (string, error) {
+	return path.Join("/", p), nil
+}
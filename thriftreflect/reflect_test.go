@@ -0,0 +1,124 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package thriftreflect
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCompileUnregistered(t *testing.T) {
+	shared := &ThriftModule{
+		Name:     "shared",
+		Package:  "example.com/idl/common/shared",
+		FilePath: "common/shared.thrift",
+		Raw:      "struct Point { 1: required double x; 2: required double y }",
+	}
+	m := &ThriftModule{
+		Name:     "shapes",
+		Package:  "example.com/idl/shapes",
+		FilePath: "shapes.thrift",
+		Includes: []*ThriftModule{shared},
+		Raw: `
+			include "./common/shared.thrift"
+			struct Circle {
+				1: required shared.Point center
+				2: optional double radius = 1.0
+			}
+		`,
+	}
+
+	compiled, err := Compile(m)
+	require.NoError(t, err)
+	assert.Equal(t, "/shapes.thrift", compiled.ThriftPath)
+	assert.Contains(t, compiled.Includes, "shared")
+
+	s, err := LookupStruct(m, "Circle")
+	require.NoError(t, err)
+	assert.Nil(t, s.New, "unregistered modules don't have constructors")
+	require.Len(t, s.Fields, 2)
+	assert.Equal(t, "Point", s.Fields[0].Type.ThriftName())
+	assert.False(t, s.Fields[1].Required)
+	assert.NotNil(t, s.Fields[1].Default)
+}
+
+func TestCompileErrors(t *testing.T) {
+	tests := []struct {
+		desc    string
+		give    *ThriftModule
+		wantErr string
+	}{
+		{
+			desc: "syntax error",
+			give: &ThriftModule{
+				FilePath: "foo.thrift",
+				Raw:      "struct {",
+			},
+			wantErr: `could not compile module "foo.thrift"`,
+		},
+		{
+			desc: "missing include",
+			give: &ThriftModule{
+				FilePath: "foo.thrift",
+				Raw:      `include "./bar.thrift"`,
+			},
+			wantErr: `could not compile module "foo.thrift"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			_, err := Compile(tt.give)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantErr)
+
+			_, err = LookupStruct(tt.give, "Foo")
+			assert.Error(t, err)
+			_, err = LookupService(tt.give, "Foo")
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestRegisterConflict(t *testing.T) {
+	m := &ThriftModule{Package: "example.com/idl/conflict", FilePath: "conflict.thrift"}
+	Register(m, nil)
+	defer func() {
+		_registry.Lock()
+		delete(_registry.entries, m.Package)
+		_registry.conflicts = nil
+		_registry.Unlock()
+	}()
+
+	assert.Equal(t, m, ModuleByPackage("example.com/idl/conflict"))
+	Register(m, nil)
+	assert.Empty(t, Conflicts(), "registering the same module again is a no-op")
+
+	Register(&ThriftModule{Package: "example.com/idl/conflict", FilePath: "other.thrift"}, nil)
+	assert.Equal(t, m, ModuleByPackage("example.com/idl/conflict"), "first module must be kept")
+	errs := Conflicts()
+	require.Len(t, errs, 1)
+	assert.Contains(t, errs[0].Error(),
+		`cannot register module "other.thrift" for package "example.com/idl/conflict": `+
+			`module "conflict.thrift" is already registered for it`)
+}
@@ -0,0 +1,121 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package thriftreflect
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+
+	"go.uber.org/thriftrw/compile"
+)
+
+// Constructor returns a pointer to a new, empty value of a generated type.
+type Constructor func() interface{}
+
+// entry is a module in the registry.
+type entry struct {
+	Module *ThriftModule
+
+	// Constructors for generated types, keyed by their Thrift names.
+	Types map[string]Constructor
+
+	// Result of compiling the module. Populated lazily.
+	compileOnce sync.Once
+	compiled    *compile.Module
+	compileErr  error
+}
+
+var _registry = struct {
+	sync.RWMutex
+
+	// Keyed by the import path of the generated package.
+	entries map[string]*entry
+
+	// Modules which were not registered because a different module was
+	// already registered for the same package.
+	conflicts []error
+}{entries: make(map[string]*entry)}
+
+// Register registers a module generated by ThriftRW with the runtime
+// registry, along with constructors for the types generated for it, keyed by
+// their Thrift names.
+//
+// Code generated with --register-idl calls this from an init function. If a
+// different module was already registered for the same Go package, the
+// first registration is kept and the conflict is reported by Conflicts.
+func Register(m *ThriftModule, types map[string]Constructor) {
+	_registry.Lock()
+	defer _registry.Unlock()
+
+	if e, ok := _registry.entries[m.Package]; ok {
+		if e.Module != m {
+			_registry.conflicts = append(_registry.conflicts, fmt.Errorf(
+				"thriftreflect: cannot register module %q for package %q: "+
+					"module %q is already registered for it",
+				m.FilePath, m.Package, e.Module.FilePath))
+		}
+		return
+	}
+
+	_registry.entries[m.Package] = &entry{Module: m, Types: types}
+}
+
+// Conflicts returns an error for each module that was not registered because
+// a different module had already been registered for the same Go package.
+func Conflicts() []error {
+	_registry.RLock()
+	defer _registry.RUnlock()
+
+	return append([]error(nil), _registry.conflicts...)
+}
+
+// Modules returns all registered modules, ordered by the import paths of
+// their Go packages.
+func Modules() []*ThriftModule {
+	_registry.RLock()
+	defer _registry.RUnlock()
+
+	modules := make([]*ThriftModule, 0, len(_registry.entries))
+	for _, e := range _registry.entries {
+		modules = append(modules, e.Module)
+	}
+	sort.Slice(modules, func(i, j int) bool {
+		return modules[i].Package < modules[j].Package
+	})
+	return modules
+}
+
+// ModuleByPackage returns the registered module generated into the Go
+// package with the given import path, or nil if there isn't one.
+func ModuleByPackage(importPath string) *ThriftModule {
+	if e := lookupEntry(importPath); e != nil {
+		return e.Module
+	}
+	return nil
+}
+
+// lookupEntry returns the registry entry for the given package, or nil.
+func lookupEntry(importPath string) *entry {
+	_registry.RLock()
+	defer _registry.RUnlock()
+	return _registry.entries[importPath]
+}
@@ -0,0 +1,126 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Package thrifttime provides the go.adapter implementations used by code
+// generated for fields annotated with go.time or go.duration.
+//
+// Given,
+//
+// 	struct Event {
+// 		1: required i64 (go.time = "unix_millis") createdAt
+// 		2: optional i64 (go.duration = "ms") timeout
+// 	}
+//
+// The generated struct will use time.Time and time.Duration.
+//
+// 	type Event struct {
+// 		CreatedAt time.Time
+// 		Timeout   *time.Duration
+// 	}
+//
+// These adapters may also be referenced directly with go.adapter.
+//
+// 	i64 (go.type = "time.Time", go.adapter = "go.uber.org/thriftrw/thrifttime.UnixMillis")
+package thrifttime
+
+import (
+	"fmt"
+	"math"
+	"time"
+
+	"go.uber.org/thriftrw/wire"
+)
+
+// Timestamp maps an i64 holding the number of Units elapsed since the Unix
+// epoch to a time.Time. Times are decoded in UTC.
+type Timestamp struct {
+	// Unit is one of time.Second, time.Millisecond, time.Microsecond, or
+	// time.Nanosecond.
+	Unit time.Duration
+}
+
+// Adapters for timestamps of different precisions.
+var (
+	UnixSeconds = Timestamp{Unit: time.Second}
+	UnixMillis  = Timestamp{Unit: time.Millisecond}
+	UnixMicros  = Timestamp{Unit: time.Microsecond}
+	UnixNanos   = Timestamp{Unit: time.Nanosecond}
+)
+
+// ToWire converts the given time into an i64 Value. Precision beyond the
+// Unit is truncated. It fails if the number of Units since the Unix epoch does
+// not fit into an i64, as is the case for the zero time.Time with
+// nanosecond precision.
+func (a Timestamp) ToWire(t time.Time) (wire.Value, error) {
+	perSecond := int64(time.Second / a.Unit)
+	sec, frac := t.Unix(), int64(t.Nanosecond())/int64(a.Unit)
+	if sec > math.MaxInt64/perSecond || sec < math.MinInt64/perSecond ||
+		(sec > 0 && frac > math.MaxInt64-sec*perSecond) {
+		return wire.Value{}, fmt.Errorf("%v is out of range for an i64 of %v since the Unix epoch", t, a.Unit)
+	}
+	return wire.NewValueI64(sec*perSecond + frac), nil
+}
+
+// FromWire reads a time.Time from an i64 Value.
+func (a Timestamp) FromWire(w wire.Value) (time.Time, error) {
+	perSecond := int64(time.Second / a.Unit)
+	v := w.GetI64()
+	return time.Unix(v/perSecond, (v%perSecond)*int64(a.Unit)).UTC(), nil
+}
+
+// Equals returns true if both times represent the same instant.
+func (Timestamp) Equals(l, r time.Time) bool {
+	return l.Equal(r)
+}
+
+// Duration maps an i64 holding a number of Units to a time.Duration.
+type Duration struct {
+	// Unit is the smallest duration representable on the wire.
+	Unit time.Duration
+}
+
+// Adapters for durations of different precisions.
+var (
+	Seconds      = Duration{Unit: time.Second}
+	Milliseconds = Duration{Unit: time.Millisecond}
+	Microseconds = Duration{Unit: time.Microsecond}
+	Nanoseconds  = Duration{Unit: time.Nanosecond}
+)
+
+// ToWire converts the given duration into an i64 Value. Precision beyond the
+// Unit is truncated.
+func (a Duration) ToWire(d time.Duration) (wire.Value, error) {
+	return wire.NewValueI64(int64(d / a.Unit)), nil
+}
+
+// FromWire reads a time.Duration from an i64 Value. It fails if the value
+// does not fit into a time.Duration.
+func (a Duration) FromWire(w wire.Value) (time.Duration, error) {
+	v := w.GetI64()
+	if v > math.MaxInt64/int64(a.Unit) || v < math.MinInt64/int64(a.Unit) {
+		return 0, fmt.Errorf("%d * %v is out of range for time.Duration", v, a.Unit)
+	}
+	return time.Duration(v) * a.Unit, nil
+}
+
+// Equals returns true if both durations are equal.
+func (Duration) Equals(l, r time.Duration) bool {
+	return l == r
+}
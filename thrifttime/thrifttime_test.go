@@ -0,0 +1,138 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package thrifttime
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/thriftrw/wire"
+)
+
+func TestTimestamp(t *testing.T) {
+	tests := []struct {
+		desc    string
+		adapter Timestamp
+		time    time.Time
+		wire    int64
+		// Time after a round trip, if different from time.
+		want time.Time
+	}{
+		{
+			desc:    "seconds",
+			adapter: UnixSeconds,
+			time:    time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
+			wire:    1577836800,
+		},
+		{
+			desc:    "millis truncated",
+			adapter: UnixMillis,
+			time:    time.Date(2020, 1, 1, 0, 0, 0, 1500999, time.UTC),
+			wire:    1577836800001,
+			want:    time.Date(2020, 1, 1, 0, 0, 0, 1000000, time.UTC),
+		},
+		{
+			desc:    "millis before epoch",
+			adapter: UnixMillis,
+			time:    time.Date(1969, 12, 31, 23, 59, 59, 500000000, time.UTC),
+			wire:    -500,
+		},
+		{
+			desc:    "micros",
+			adapter: UnixMicros,
+			time:    time.Unix(1, 2000).UTC(),
+			wire:    1000002,
+		},
+		{
+			desc:    "nanos",
+			adapter: UnixNanos,
+			time:    time.Unix(0, -1).UTC(),
+			wire:    -1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			w, err := tt.adapter.ToWire(tt.time)
+			require.NoError(t, err)
+			assert.Equal(t, wire.NewValueI64(tt.wire), w)
+
+			want := tt.want
+			if want.IsZero() {
+				want = tt.time
+			}
+			got, err := tt.adapter.FromWire(w)
+			require.NoError(t, err)
+			assert.True(t, tt.adapter.Equals(want, got), "expected %v, got %v", want, got)
+			assert.Equal(t, time.UTC, got.Location())
+		})
+	}
+}
+
+func TestTimestampOutOfRange(t *testing.T) {
+	maxNanos := time.Unix(0, math.MaxInt64)
+
+	w, err := UnixNanos.ToWire(maxNanos)
+	require.NoError(t, err)
+	assert.Equal(t, wire.NewValueI64(math.MaxInt64), w)
+
+	tests := []struct {
+		desc    string
+		adapter Timestamp
+		time    time.Time
+	}{
+		{"zero nanos", UnixNanos, time.Time{}},
+		{"after max nanos", UnixNanos, maxNanos.Add(time.Nanosecond)},
+		{"far past micros", UnixMicros, time.Date(-300000, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{"far future millis", UnixMillis, time.Date(300000000, 1, 1, 0, 0, 0, 0, time.UTC)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			_, err := tt.adapter.ToWire(tt.time)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), "is out of range for an i64")
+		})
+	}
+
+	_, err = UnixSeconds.ToWire(time.Time{})
+	assert.NoError(t, err, "the zero time fits in seconds")
+}
+
+func TestDuration(t *testing.T) {
+	w, err := Milliseconds.ToWire(1500999 * time.Microsecond)
+	require.NoError(t, err)
+	assert.Equal(t, wire.NewValueI64(1500), w)
+
+	d, err := Milliseconds.FromWire(w)
+	require.NoError(t, err)
+	assert.Equal(t, 1500*time.Millisecond, d)
+
+	d, err = Seconds.FromWire(wire.NewValueI64(-90))
+	require.NoError(t, err)
+	assert.Equal(t, -90*time.Second, d)
+
+	_, err = Seconds.FromWire(wire.NewValueI64(math.MaxInt64))
+	assert.Error(t, err)
+}